@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"io"
@@ -9,7 +10,10 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/observeinc/terraform-provider-observe/client/internal/collect"
 	"github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
 )
 
 var (
@@ -103,6 +107,83 @@ func (c *Client) LookupDataset(ctx context.Context, workspaceID string, name str
 	return c.Meta.LookupDataset(ctx, workspaceID, name)
 }
 
+// billingInfoCall is a retrieval of billing info shared by all concurrent
+// callers for a workspace
+type billingInfoCall struct {
+	done chan struct{}
+	info []meta.ObjectBillingInfo
+	err  error
+}
+
+// detachedContext retains the values of a context, but is never cancelled
+type detachedContext struct {
+	context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}       { return nil }
+func (detachedContext) Err() error                  { return nil }
+
+// GetDatasetBillingInfo returns 24h credit usage for all datasets in
+// workspace. Successful results are cached per workspace for the lifetime of
+// the client, while failures are retried by the next caller.
+func (c *Client) GetDatasetBillingInfo(ctx context.Context, workspaceID string) ([]meta.ObjectBillingInfo, error) {
+	c.billingInfoMu.Lock()
+	if c.billingInfo == nil {
+		c.billingInfo = make(map[string]*billingInfoCall)
+	}
+	call, ok := c.billingInfo[workspaceID]
+	if !ok {
+		call = &billingInfoCall{done: make(chan struct{})}
+		c.billingInfo[workspaceID] = call
+		// the call is shared, so it must outlive the caller which started it
+		go c.fetchDatasetBillingInfo(detachedContext{ctx}, workspaceID, call)
+	}
+	c.billingInfoMu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.info, call.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (c *Client) fetchDatasetBillingInfo(ctx context.Context, workspaceID string, call *billingInfoCall) {
+	call.info, call.err = c.Meta.GetDatasetBillingInfo(ctx, workspaceID)
+	if call.err != nil {
+		c.billingInfoMu.Lock()
+		delete(c.billingInfo, workspaceID)
+		c.billingInfoMu.Unlock()
+	}
+	close(call.done)
+}
+
+// GetDatasetDoctorReport diagnoses dataset and up to upLevels of its ancestors
+func (c *Client) GetDatasetDoctorReport(ctx context.Context, id string, upLevels *int) ([]meta.DatasetReport, error) {
+	return c.Meta.GetDatasetDoctorReport(ctx, id, upLevels)
+}
+
+// ListDatasetLineage returns the inputs of all datasets
+func (c *Client) ListDatasetLineage(ctx context.Context) ([]meta.DatasetLineage, error) {
+	return c.Meta.ListDatasetLineage(ctx)
+}
+
+// ListWorkspaceDatasetLineage returns the inputs of all datasets in a workspace
+func (c *Client) ListWorkspaceDatasetLineage(ctx context.Context, workspaceId string) ([]meta.DatasetLineage, error) {
+	return c.Meta.ListWorkspaceDatasetLineage(ctx, workspaceId)
+}
+
+// GetPathsBetweenDatasets returns link paths between two datasets
+func (c *Client) GetPathsBetweenDatasets(ctx context.Context, from, to string, limit *types.Int64Scalar) ([]meta.RelationshipPath, error) {
+	return c.Meta.GetPathsBetweenDatasets(ctx, from, to, limit)
+}
+
+// ListMonitorsForDataset returns monitors which read from dataset
+func (c *Client) ListMonitorsForDataset(ctx context.Context, datasetID string) ([]meta.DatasetMonitor, error) {
+	return c.Meta.ListMonitorsForDataset(ctx, datasetID)
+}
+
 // CreateForeignKey
 func (c *Client) CreateForeignKey(ctx context.Context, workspaceID string, input *meta.DeferredForeignKeyInput) (*meta.DeferredForeignKey, error) {
 	if !c.Flags[flagObs2110] {
@@ -264,6 +345,22 @@ func (c *Client) Observe(ctx context.Context, path string, body io.Reader, tags
 	return c.Collect.Observe(ctx, path, body, tags, options...)
 }
 
+// BatchOptions configures chunked submission of observations
+type BatchOptions = collect.BatchOptions
+
+// BatchWriter submits records in size-bounded chunks
+type BatchWriter = collect.BatchWriter
+
+// ObserveChunk submits a single chunk of observations
+func (c *Client) ObserveChunk(ctx context.Context, path string, chunk []byte, options BatchOptions) error {
+	return c.Collect.ObserveChunk(ctx, path, chunk, options)
+}
+
+// NewBatchWriter returns a writer which submits records in chunks
+func (c *Client) NewBatchWriter(ctx context.Context, path string, options BatchOptions) *BatchWriter {
+	return c.Collect.NewBatchWriter(ctx, path, options)
+}
+
 // CreateChannelAction creates a channel action
 func (c *Client) CreateChannelAction(ctx context.Context, workspaceId string, input *meta.ActionInput, channels []string) (*meta.ChannelAction, error) {
 	if !c.Flags[flagObs2110] {
@@ -394,8 +491,45 @@ func (c *Client) GetLayeredSettingRecord(ctx context.Context, id string) (*meta.
 }
 
 // Query for result
-func (c *Client) Query(ctx context.Context, stages []*meta.StageInput, params *meta.QueryParams) (result []*meta.TaskResult, err error) {
-	return c.Meta.DatasetQueryOutput(ctx, stages, params)
+func (c *Client) Query(ctx context.Context, stages []*meta.StageInput, params *meta.QueryParams, parameterValues []meta.ParameterBindingInput) (result []*meta.TaskResult, err error) {
+	return c.Meta.DatasetQueryOutput(ctx, stages, params, parameterValues)
+}
+
+// QueryCursor reads a page of rows from a query cursor
+func (c *Client) QueryCursor(ctx context.Context, cursorId string, offset int64, numRows int64) (*types.PaginatedResults, error) {
+	return c.Meta.QueryCursor(ctx, cursorId, offset, numRows)
+}
+
+// ExportQuery runs a query and prepares a URL from which results can be downloaded
+func (c *Client) ExportQuery(ctx context.Context, query *meta.MultiStageQueryInput, params *meta.QueryParams, rowCount *types.Int64Scalar, filename *string, format *meta.ExportFileFormat) (*meta.ExportCursorResult, error) {
+	return c.Meta.ExportQuery(ctx, query, params, rowCount, filename, format)
+}
+
+// DownloadExport writes the contents of an export URL to w. Export URLs are
+// pre-authorized, so the request is sent without credentials.
+func (c *Client) DownloadExport(ctx context.Context, exportURL string, w io.Writer) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, exportURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build new request: %w", err)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if c.Insecure {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+
+	resp, err := (&http.Client{Transport: transport}).Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status downloading export: %s", resp.Status)
+	}
+
+	_, err = io.Copy(w, resp.Body)
+	return err
 }
 
 // CreateMonitorAction creates a monitor action
@@ -1249,11 +1383,48 @@ func (c *Client) GetUser(ctx context.Context, id string) (*meta.User, error) {
 	return c.Meta.GetUser(ctx, id)
 }
 
+// GetCurrentUser retrieves the user the client is authenticated as
+func (c *Client) GetCurrentUser(ctx context.Context) (*meta.User, error) {
+	return c.Meta.GetCurrentUser(ctx)
+}
+
 // LookupUser by email.
 func (c *Client) LookupUser(ctx context.Context, email string) (*meta.User, error) {
 	return c.Meta.LookupUser(ctx, email)
 }
 
+// InviteUser invites a user by email
+func (c *Client) InviteUser(ctx context.Context, input *meta.UserInput) (*meta.User, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.InviteUser(ctx, input)
+}
+
+// UpdateUser updates a user
+func (c *Client) UpdateUser(ctx context.Context, id string, input *meta.UserInput) (*meta.User, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.UpdateUser(ctx, id, input)
+}
+
+// UpdateUsers applies the same update to several users
+func (c *Client) UpdateUsers(ctx context.Context, ids []string, input *meta.UserInput) ([]meta.User, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.UpdateUsers(ctx, ids, input)
+}
+
+// ListUsers returns all users of the current customer
+func (c *Client) ListUsers(ctx context.Context) ([]meta.User, error) {
+	return c.Meta.ListUsers(ctx)
+}
+
 // CreateRbacGroupmember creates an rbacgroupmember
 func (c *Client) CreateRbacGroupmember(ctx context.Context, input *meta.RbacGroupmemberInput) (*meta.RbacGroupmember, error) {
 	if !c.Flags[flagObs2110] {
@@ -1281,6 +1452,20 @@ func (c *Client) DeleteRbacGroupmember(ctx context.Context, id string) error {
 	return c.Meta.DeleteRbacGroupmember(ctx, id)
 }
 
+// ListRbacGroupmembers returns the direct memberships of a group
+func (c *Client) ListRbacGroupmembers(ctx context.Context, groupID string) ([]meta.RbacGroupmember, error) {
+	return c.Meta.ListRbacGroupmembers(ctx, groupID)
+}
+
+// SetRbacGroupmembers replaces all direct memberships of a group
+func (c *Client) SetRbacGroupmembers(ctx context.Context, groupID string, users []types.UserIdScalar, groups []string) ([]meta.RbacGroupmember, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.SetRbacGroupmembers(ctx, groupID, users, groups)
+}
+
 // GetRbacGroupmember by ID
 func (c *Client) GetRbacGroupmember(ctx context.Context, id string) (*meta.RbacGroupmember, error) {
 	return c.Meta.GetRbacGroupmember(ctx, id)
@@ -1318,6 +1503,300 @@ func (c *Client) GetRbacStatement(ctx context.Context, id string) (*meta.RbacSta
 	return c.Meta.GetRbacStatement(ctx, id)
 }
 
+// ListRbacStatements returns all rbacstatements
+func (c *Client) ListRbacStatements(ctx context.Context) ([]meta.RbacStatement, error) {
+	return c.Meta.ListRbacStatements(ctx)
+}
+
+// MutateRbacStatements creates, updates and deletes rbacstatements atomically
+func (c *Client) MutateRbacStatements(ctx context.Context, toCreate []meta.RbacStatementInput, toUpdate []meta.UpdateRbacStatementInput, toDelete []string) (*meta.MutateRbacStatementsResult, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.MutateRbacStatements(ctx, toCreate, toUpdate, toDelete)
+}
+
+// RbacTestRequests simulates rbac requests for a user
+func (c *Client) RbacTestRequests(ctx context.Context, user types.UserIdScalar, requests []meta.RbacRequestInput) ([]meta.RbacTestRequestResult, error) {
+	return c.Meta.RbacTestRequests(ctx, user, requests)
+}
+
+// RbacUserMatches returns all rbacstatements affecting a user
+func (c *Client) RbacUserMatches(ctx context.Context, user types.UserIdScalar) ([]meta.RbacStatement, error) {
+	return c.Meta.RbacUserMatches(ctx, user)
+}
+
+// RbacObjectMatches returns all rbacstatements affecting an object
+func (c *Client) RbacObjectMatches(ctx context.Context, object meta.RbacRequestObjectInput) ([]meta.RbacStatement, error) {
+	return c.Meta.RbacObjectMatches(ctx, object)
+}
+
+// GetCurrentCustomerSso returns the sso configuration of the current customer
+func (c *Client) GetCurrentCustomerSso(ctx context.Context) (string, *meta.CustomerSso, error) {
+	return c.Meta.GetCurrentCustomerSso(ctx)
+}
+
+// UpdateCurrentCustomerSso updates the sso configuration of the current customer
+func (c *Client) UpdateCurrentCustomerSso(ctx context.Context, input *meta.CustomerSsoInput) (*meta.CustomerSso, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.UpdateCurrentCustomerSso(ctx, input)
+}
+
+// GetWorkspaceObjectOwner returns the owner of a workspace object
+func (c *Client) GetWorkspaceObjectOwner(ctx context.Context, t oid.Type, id string) (*meta.WorkspaceObjectOwner, error) {
+	return c.Meta.GetWorkspaceObjectOwner(ctx, t, id)
+}
+
+// SetWorkspaceObjectOwner transfers ownership of a workspace object
+func (c *Client) SetWorkspaceObjectOwner(ctx context.Context, id string, owner types.UserIdScalar) error {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.SetWorkspaceObjectOwner(ctx, id, owner)
+}
+
+// ListWorkspaceObjectOwners returns the owners of all objects in a workspace
+func (c *Client) ListWorkspaceObjectOwners(ctx context.Context, workspaceId string, maxCount int64) ([]meta.WorkspaceObjectOwner, bool, error) {
+	return c.Meta.ListWorkspaceObjectOwners(ctx, workspaceId, maxCount)
+}
+
+// CreateIncident creates an incident
+func (c *Client) CreateIncident(ctx context.Context, workspaceId string, input *meta.IncidentInput) (*meta.Incident, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.CreateIncident(ctx, workspaceId, input)
+}
+
+// UpdateIncident updates an incident
+func (c *Client) UpdateIncident(ctx context.Context, id string, input *meta.IncidentInput) (*meta.Incident, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.UpdateIncident(ctx, id, input)
+}
+
+// DeleteIncident deletes an incident
+func (c *Client) DeleteIncident(ctx context.Context, id string) error {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.DeleteIncident(ctx, id)
+}
+
+// GetIncident retrieves an incident
+func (c *Client) GetIncident(ctx context.Context, id string) (*meta.Incident, error) {
+	return c.Meta.GetIncident(ctx, id)
+}
+
+// AddIncidentUsers adds users to an incident
+func (c *Client) AddIncidentUsers(ctx context.Context, id string, users []types.UserIdScalar) (*meta.Incident, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.AddIncidentUsers(ctx, id, users)
+}
+
+// AddIncidentSlackchannels adds slack channels to an incident
+func (c *Client) AddIncidentSlackchannels(ctx context.Context, id string, channels []meta.IncidentSlackchannelInput) (*meta.Incident, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.AddIncidentSlackchannels(ctx, id, channels)
+}
+
+// AddIncidentWorksheets adds worksheets to an incident
+func (c *Client) AddIncidentWorksheets(ctx context.Context, id string, worksheets []string) (*meta.Incident, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.AddIncidentWorksheets(ctx, id, worksheets)
+}
+
+// AddIncidentDashboards adds dashboards to an incident
+func (c *Client) AddIncidentDashboards(ctx context.Context, id string, dashboards []string) (*meta.Incident, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.AddIncidentDashboards(ctx, id, dashboards)
+}
+
+// RemoveIncidentUsers removes users from an incident
+func (c *Client) RemoveIncidentUsers(ctx context.Context, id string, users []types.UserIdScalar) (*meta.Incident, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.RemoveIncidentUsers(ctx, id, users)
+}
+
+// RemoveIncidentSlackchannels removes slack channels from an incident
+func (c *Client) RemoveIncidentSlackchannels(ctx context.Context, id string, channels []meta.IncidentSlackchannelInput) (*meta.Incident, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.RemoveIncidentSlackchannels(ctx, id, channels)
+}
+
+// RemoveIncidentWorksheets removes worksheets from an incident
+func (c *Client) RemoveIncidentWorksheets(ctx context.Context, id string, worksheets []string) (*meta.Incident, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.RemoveIncidentWorksheets(ctx, id, worksheets)
+}
+
+// RemoveIncidentDashboards removes dashboards from an incident
+func (c *Client) RemoveIncidentDashboards(ctx context.Context, id string, dashboards []string) (*meta.Incident, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.RemoveIncidentDashboards(ctx, id, dashboards)
+}
+
+// GetIncidentsForStatus lists incidents by status
+func (c *Client) GetIncidentsForStatus(ctx context.Context, status meta.IncidentStatus, startingAt, endingAt *types.TimeScalar) ([]meta.Incident, error) {
+	return c.Meta.GetIncidentsForStatus(ctx, status, startingAt, endingAt)
+}
+
+// GetIncidentsForTextQuery lists incidents matching a text query
+func (c *Client) GetIncidentsForTextQuery(ctx context.Context, text string) ([]meta.Incident, error) {
+	return c.Meta.GetIncidentsForTextQuery(ctx, text)
+}
+
+// CreateMonitorMuteRule creates a monitor mute rule attached to v1 monitors
+func (c *Client) CreateMonitorMuteRule(ctx context.Context, input *meta.MonitorMuteRuleInput, monitorIds []string) (*meta.MonitorMuteRule, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.CreateMonitorMuteRule(ctx, input, monitorIds)
+}
+
+// UpdateMonitorMuteRule updates a monitor mute rule and the v1 monitors it is attached to
+func (c *Client) UpdateMonitorMuteRule(ctx context.Context, id string, input *meta.MonitorMuteRuleInput, monitorIds []string) (*meta.MonitorMuteRule, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.UpdateMonitorMuteRule(ctx, id, input, monitorIds)
+}
+
+// DeleteMonitorMuteRule deletes a monitor mute rule
+func (c *Client) DeleteMonitorMuteRule(ctx context.Context, id string) error {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.DeleteMonitorMuteRule(ctx, id)
+}
+
+// GetMonitorMuteRule retrieves a monitor mute rule
+func (c *Client) GetMonitorMuteRule(ctx context.Context, id string) (*meta.MonitorMuteRule, error) {
+	return c.Meta.GetMonitorMuteRule(ctx, id)
+}
+
+// ListActiveMonitorMuteRuleIds retrieves the IDs of mute rules currently muting a v1 monitor
+func (c *Client) ListActiveMonitorMuteRuleIds(ctx context.Context, monitorId string) ([]string, error) {
+	return c.Meta.ListActiveMonitorMuteRuleIds(ctx, monitorId)
+}
+
+// ListFeatureFlags retrieves all feature flags and whether they are enabled for the current user
+func (c *Client) ListFeatureFlags(ctx context.Context) ([]meta.FeatureFlag, error) {
+	return c.Meta.ListFeatureFlags(ctx)
+}
+
+// GetFeatureFlag retrieves a feature flag
+func (c *Client) GetFeatureFlag(ctx context.Context, name string) (*meta.FeatureFlag, error) {
+	return c.Meta.GetFeatureFlag(ctx, name)
+}
+
+// UpdateFeatureFlagForUser enables or disables a feature flag for the current user
+func (c *Client) UpdateFeatureFlagForUser(ctx context.Context, name string, enabled bool) (*meta.FeatureFlag, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.UpdateFeatureFlagForUser(ctx, name, enabled)
+}
+
+// CreateBlob creates a blob
+func (c *Client) CreateBlob(ctx context.Context, userId *types.UserIdScalar, name string, input *meta.BlobInput, makePermanent bool) (*meta.Blob, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.CreateBlob(ctx, userId, name, input, makePermanent)
+}
+
+// UpdateBlob updates a blob
+func (c *Client) UpdateBlob(ctx context.Context, userId *types.UserIdScalar, name string, input *meta.BlobInput, makePermanent bool) (*meta.Blob, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.UpdateBlob(ctx, userId, name, input, makePermanent)
+}
+
+// DeleteBlob deletes a blob
+func (c *Client) DeleteBlob(ctx context.Context, userId *types.UserIdScalar, name string) error {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.DeleteBlob(ctx, userId, name)
+}
+
+// GetBlob retrieves a blob
+func (c *Client) GetBlob(ctx context.Context, userId *types.UserIdScalar, name string) (*meta.Blob, error) {
+	return c.Meta.GetBlob(ctx, userId, name)
+}
+
+// ListBlobs retrieves blobs readable by the current user
+func (c *Client) ListBlobs(ctx context.Context, userId *types.UserIdScalar, kind *string, includeWorldReadable bool) ([]meta.Blob, error) {
+	return c.Meta.ListBlobs(ctx, userId, kind, includeWorldReadable)
+}
+
+// SearchDashboards retrieves dashboards matching the given search terms
+func (c *Client) SearchDashboards(ctx context.Context, terms meta.DWSearchInput, maxCount *types.Int64Scalar) ([]meta.DashboardSearchResult, []string, error) {
+	return c.Meta.SearchDashboards(ctx, terms, maxCount)
+}
+
+// SearchWorksheets retrieves worksheets matching the given search terms
+func (c *Client) SearchWorksheets(ctx context.Context, terms meta.DWSearchInput, maxCount *types.Int64Scalar) ([]meta.WorksheetSearchResult, []string, error) {
+	return c.Meta.SearchWorksheets(ctx, terms, maxCount)
+}
+
+// SearchMetrics retrieves metrics matching the given filters
+func (c *Client) SearchMetrics(ctx context.Context, workspaces []string, inDatasets []string, linkToDatasets []string, correlationTags []string, match string) ([]meta.MetricSearchMatch, int64, error) {
+	return c.Meta.SearchMetrics(ctx, workspaces, inDatasets, linkToDatasets, correlationTags, match)
+}
+
+// SearchResourceInstances retrieves resource instances matching the given key fragments
+func (c *Client) SearchResourceInstances(ctx context.Context, keyFragments []string, datasetIds []string, startTime *types.TimeScalar, endTime *types.TimeScalar, perDatasetLimit *types.Int64Scalar, globalLimit *types.Int64Scalar, pagination *meta.PaginationInput) (*types.PaginatedResults, error) {
+	return c.Meta.SearchResourceInstances(ctx, keyFragments, datasetIds, startTime, endTime, perDatasetLimit, globalLimit, pagination)
+}
+
+// SearchDatasets retrieves datasets matching the given filters
+func (c *Client) SearchDatasets(ctx context.Context, projects []string, labels []string, columns []string, correlationTags []string, reachableFrom *string, interfaces []string, mode meta.SearchMode) ([]meta.DatasetSearchMatch, error) {
+	return c.Meta.SearchDatasets(ctx, projects, labels, columns, correlationTags, reachableFrom, interfaces, mode)
+}
+
 // CreateFiledrop creates a filedrop
 func (c *Client) CreateFiledrop(ctx context.Context, workspaceId string, datastreamId string, input *meta.FiledropInput) (*meta.Filedrop, error) {
 	if !c.Flags[flagObs2110] {
@@ -1462,3 +1941,31 @@ func (c *Client) GetIngestInfo(ctx context.Context) (*meta.IngestInfo, error) {
 func (c *Client) GetCloudInfo(ctx context.Context) (*meta.CloudInfo, error) {
 	return c.Meta.GetCloudInfo(ctx)
 }
+
+// CreateAccelerationJob submits an acceleration job
+func (c *Client) CreateAccelerationJob(ctx context.Context, input *meta.AccelerationJobInput) (*meta.AccelerationJob, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.CreateAccelerationJob(ctx, input)
+}
+
+// GetAccelerationJob returns acceleration job status by ID
+func (c *Client) GetAccelerationJob(ctx context.Context, id string) (*meta.AccelerationJob, error) {
+	return c.Meta.GetAccelerationJob(ctx, id)
+}
+
+// CancelAccelerationJob cancels a running acceleration job
+func (c *Client) CancelAccelerationJob(ctx context.Context, id string) (*meta.AccelerationJob, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.CancelAccelerationJob(ctx, id)
+}
+
+// EstimateAccelerationJobCost estimates the cost of an acceleration job without submitting it
+func (c *Client) EstimateAccelerationJobCost(ctx context.Context, input *meta.AccelerationJobInput) ([]meta.DatasetCostEstimate, error) {
+	return c.Meta.EstimateAccelerationJobCost(ctx, input)
+}
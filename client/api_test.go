@@ -0,0 +1,138 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/observeinc/terraform-provider-observe/client/meta"
+)
+
+func TestGetDatasetBillingInfoCached(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		requests = make(map[string]int)
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Variables struct {
+				WorkspaceId string `json:"workspaceId"`
+			} `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		requests[req.Variables.WorkspaceId]++
+		mu.Unlock()
+
+		fmt.Fprintf(w, `{"data":{"billingInfo":{"datasets24h":[{"id":"%s","periodFrom":"2024-01-01T00:00:00Z","periodTo":"2024-01-02T00:00:00Z","credits":1.5}]}}}`, req.Variables.WorkspaceId+"0")
+	}))
+	defer server.Close()
+
+	metaAPI, err := meta.New(server.URL, server.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &Client{Meta: metaAPI}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		for _, workspace := range []string{"1", "2"} {
+			wg.Add(1)
+			go func(workspace string) {
+				defer wg.Done()
+				info, err := client.GetDatasetBillingInfo(context.Background(), workspace)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if len(info) != 1 || info[0].Id != workspace+"0" {
+					t.Errorf("unexpected billing info for workspace %s: %v", workspace, info)
+				}
+			}(workspace)
+		}
+	}
+	wg.Wait()
+
+	for _, workspace := range []string{"1", "2"} {
+		if n := requests[workspace]; n != 1 {
+			t.Errorf("expected 1 request for workspace %s, got %d", workspace, n)
+		}
+	}
+}
+
+func TestGetDatasetBillingInfoNotCachedOnFailure(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		requests int
+		release  = make(chan struct{})
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		n := requests
+		mu.Unlock()
+
+		switch n {
+		case 1:
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		case 2:
+			// outlive the caller which started the request
+			<-release
+			fallthrough
+		default:
+			fmt.Fprint(w, `{"data":{"billingInfo":{"datasets24h":[{"id":"10","periodFrom":"2024-01-01T00:00:00Z","periodTo":"2024-01-02T00:00:00Z","credits":1.5}]}}}`)
+		}
+	}))
+	defer server.Close()
+
+	metaAPI, err := meta.New(server.URL, server.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &Client{Meta: metaAPI}
+
+	if _, err := client.GetDatasetBillingInfo(context.Background(), "1"); err == nil {
+		t.Fatal("expected error")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error)
+	go func() {
+		_, err := client.GetDatasetBillingInfo(ctx, "1")
+		errCh <- err
+	}()
+	for {
+		mu.Lock()
+		n := requests
+		mu.Unlock()
+		if n == 2 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	if err := <-errCh; err != context.Canceled {
+		t.Fatalf("expected cancellation, got %v", err)
+	}
+	close(release)
+
+	// the request started by the cancelled caller completes and is reused
+	info, err := client.GetDatasetBillingInfo(context.Background(), "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(info) != 1 || info[0].Id != "10" {
+		t.Fatalf("unexpected billing info: %v", info)
+	}
+	if requests != 2 {
+		t.Fatalf("expected 2 requests, got %d", requests)
+	}
+}
@@ -32,6 +32,15 @@ type Client struct {
 	// our API does not allow concurrent FK creation, so we use a lock as a workaround
 	obs2110 sync.Mutex
 
+	// billing info is computed per workspace, so we only retrieve it once
+	// rather than once per dataset
+	billingInfoMu sync.Mutex
+	billingInfo   map[string]*billingInfoCall
+
+	// objects deleted through this client, so that delete protection can
+	// tell which dependents are being destroyed in the same run
+	deletes deleteTracker
+
 	Meta     *meta.Client
 	Customer *customer.Client
 	Collect  *collect.Client
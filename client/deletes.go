@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"sync"
+
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+)
+
+// deleteTracker records objects deleted through the client. Since a single
+// client serves every resource in a Terraform run, this tells us which
+// objects are destroyed alongside each other.
+type deleteTracker struct {
+	mu      sync.Mutex
+	deleted map[oid.OID]bool
+	// active counts deletes in progress which are not waiting on others
+	active int
+	// changed is closed and replaced whenever a delete completes
+	changed chan struct{}
+}
+
+// BeginDelete records that id is being deleted. The returned function must be
+// called once the deletion has completed, reporting whether it succeeded.
+func (c *Client) BeginDelete(id oid.OID) (end func(deleted bool)) {
+	t := &c.deletes
+	key := oid.OID{Type: id.Type, Id: id.Id}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.deleted == nil {
+		t.deleted = make(map[oid.OID]bool)
+		t.changed = make(chan struct{})
+	}
+	t.active++
+
+	var once sync.Once
+	return func(deleted bool) {
+		once.Do(func() {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+			if deleted {
+				t.deleted[key] = true
+			}
+			t.active--
+			close(t.changed)
+			t.changed = make(chan struct{})
+		})
+	}
+}
+
+// Deleted returns true if id has been deleted through this client.
+func (c *Client) Deleted(id oid.OID) bool {
+	t := &c.deletes
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.deleted[oid.OID{Type: id.Type, Id: id.Id}]
+}
+
+// WaitForDeletes blocks until another delete completes. It must only be
+// called between BeginDelete and the end of that delete. If every other
+// delete in progress is itself waiting, none can complete, and false is
+// returned immediately.
+func (c *Client) WaitForDeletes(ctx context.Context) (bool, error) {
+	t := &c.deletes
+	t.mu.Lock()
+	if t.active <= 1 {
+		t.mu.Unlock()
+		return false, nil
+	}
+	changed := t.changed
+	t.active--
+	t.mu.Unlock()
+
+	defer func() {
+		t.mu.Lock()
+		t.active++
+		t.mu.Unlock()
+	}()
+
+	select {
+	case <-changed:
+		return true, nil
+	case <-ctx.Done():
+		return false, ctx.Err()
+	}
+}
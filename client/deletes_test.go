@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+)
+
+func TestDeleteTracker(t *testing.T) {
+	client := &Client{}
+	ctx := context.Background()
+
+	failed := client.BeginDelete(oid.DatasetOid("1"))
+	failed(false)
+	if client.Deleted(oid.DatasetOid("1")) {
+		t.Fatal("failed delete recorded as deleted")
+	}
+
+	self := client.BeginDelete(oid.DatasetOid("2"))
+	if waited, err := client.WaitForDeletes(ctx); err != nil || waited {
+		t.Fatalf("expected no wait without other deletes, got %t, %v", waited, err)
+	}
+
+	other := client.BeginDelete(oid.MonitorOid("3"))
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		other(true)
+	}()
+	if waited, err := client.WaitForDeletes(ctx); err != nil || !waited {
+		t.Fatalf("expected to wait for other delete, got %t, %v", waited, err)
+	}
+	if !client.Deleted(oid.MonitorOid("3")) {
+		t.Fatal("expected monitor to be recorded as deleted")
+	}
+
+	// two deletes waiting on each other must not deadlock
+	blocked := client.BeginDelete(oid.DatasetOid("4"))
+	result := make(chan bool)
+	go func() {
+		waited, _ := client.WaitForDeletes(ctx)
+		result <- waited
+	}()
+	for {
+		client.deletes.mu.Lock()
+		active := client.deletes.active
+		client.deletes.mu.Unlock()
+		if active == 1 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if waited, _ := client.WaitForDeletes(ctx); waited {
+		t.Fatal("expected no wait when all other deletes are waiting")
+	}
+	self(false)
+	if !<-result {
+		t.Fatal("expected waiting delete to be woken")
+	}
+	blocked(false)
+}
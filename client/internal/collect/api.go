@@ -43,6 +43,15 @@ func (c *Client) Observe(ctx context.Context, s string, body io.Reader, tags map
 	case http.StatusOK, http.StatusAccepted, http.StatusNoContent:
 		return nil
 	default:
-		return fmt.Errorf(strings.ToLower(http.StatusText(resp.StatusCode)))
+		return &StatusError{StatusCode: resp.StatusCode}
 	}
 }
+
+// StatusError is returned when the collector rejects a request
+type StatusError struct {
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return strings.ToLower(http.StatusText(e.StatusCode))
+}
@@ -0,0 +1,196 @@
+package collect
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+)
+
+// BatchOptions configures how chunks of observations are submitted
+type BatchOptions struct {
+	// Tags are set on every chunk
+	Tags map[string]string
+	// ContentType of every chunk, e.g. application/x-ndjson
+	ContentType string
+	// Header is written as the first line of every chunk, e.g. the header
+	// row of a CSV file
+	Header []byte
+	// MaxChunkSize bounds the uncompressed size of a chunk in bytes. Zero
+	// means chunks are unbounded.
+	MaxChunkSize int
+	// Gzip compresses chunks before submission
+	Gzip bool
+	// Retries is the number of times a chunk is resubmitted after a
+	// transient failure
+	Retries int
+	// RetryInterval is the delay before the first retry, doubling on every
+	// subsequent attempt
+	RetryInterval time.Duration
+	// RetryServerErrors also retries 5xx responses. The collector may have
+	// processed a request which failed with a server error, so retrying it
+	// risks submitting observations twice.
+	RetryServerErrors bool
+	// RequestOptions are applied to every request, after the content type
+	// and encoding have been set
+	RequestOptions []func(*http.Request)
+}
+
+// ObserveChunk submits a single chunk of data, compressing it and retrying
+// transient failures as configured.
+func (c *Client) ObserveChunk(ctx context.Context, path string, chunk []byte, options BatchOptions) error {
+	body := chunk
+	if options.Gzip {
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		if _, err := zw.Write(chunk); err != nil {
+			return fmt.Errorf("failed to compress chunk: %w", err)
+		}
+		if err := zw.Close(); err != nil {
+			return fmt.Errorf("failed to compress chunk: %w", err)
+		}
+		body = buf.Bytes()
+	}
+
+	requestOptions := []func(*http.Request){
+		func(req *http.Request) {
+			if options.ContentType != "" {
+				req.Header.Set("Content-Type", options.ContentType)
+			}
+			if options.Gzip {
+				req.Header.Set("Content-Encoding", "gzip")
+			}
+		},
+	}
+	requestOptions = append(requestOptions, options.RequestOptions...)
+
+	delay := options.RetryInterval
+	for attempt := 0; ; attempt++ {
+		err := c.Observe(ctx, path, bytes.NewReader(body), options.Tags, requestOptions...)
+		if err == nil || !isRetryable(ctx, err, options.RetryServerErrors) {
+			return err
+		}
+		if attempt >= options.Retries {
+			return fmt.Errorf("%w (after %d attempts)", err, attempt+1)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		delay *= 2
+	}
+}
+
+// isRetryable returns true for errors which may succeed on resubmission.
+// Since observations are not deduplicated, only failures which guarantee
+// the request was not processed, or which the collector explicitly marks as
+// transient, are retried. Server errors are only retried if requested.
+func isRetryable(ctx context.Context, err error, serverErrors bool) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		switch statusErr.StatusCode {
+		case http.StatusRequestTimeout, http.StatusTooManyRequests:
+			return true
+		}
+		return serverErrors && statusErr.StatusCode >= 500
+	}
+	// failing to connect means nothing was sent. Timeouts are excluded, since
+	// a request may still be processed after the client gives up on it.
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return opErr.Op == "dial" && !opErr.Timeout()
+	}
+	return false
+}
+
+// BatchWriter buffers records into size-bounded, newline delimited chunks,
+// submitting each chunk once full.
+type BatchWriter struct {
+	client  *Client
+	ctx     context.Context
+	path    string
+	options BatchOptions
+
+	buf      bytes.Buffer
+	pending  int
+	accepted int
+	chunks   int
+}
+
+// NewBatchWriter returns a writer submitting chunks of records to path
+func (c *Client) NewBatchWriter(ctx context.Context, path string, options BatchOptions) *BatchWriter {
+	return &BatchWriter{
+		client:  c,
+		ctx:     ctx,
+		path:    path,
+		options: options,
+	}
+}
+
+// Write adds a record to the current chunk, first submitting the chunk if
+// the record would not fit. Records must not contain a trailing newline.
+func (w *BatchWriter) Write(record []byte) error {
+	size := len(record) + 1
+	if limit := w.options.MaxChunkSize; limit > 0 {
+		if w.headerSize()+size > limit {
+			return fmt.Errorf("record of %d bytes exceeds maximum chunk size of %d bytes", len(record), limit)
+		}
+		if w.pending > 0 && w.buf.Len()+size > limit {
+			if err := w.Flush(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if w.pending == 0 && len(w.options.Header) > 0 {
+		w.buf.Write(w.options.Header)
+		w.buf.WriteByte('\n')
+	}
+	w.buf.Write(record)
+	w.buf.WriteByte('\n')
+	w.pending++
+	return nil
+}
+
+// Flush submits any buffered records
+func (w *BatchWriter) Flush() error {
+	if w.pending == 0 {
+		return nil
+	}
+	if err := w.client.ObserveChunk(w.ctx, w.path, w.buf.Bytes(), w.options); err != nil {
+		return fmt.Errorf("failed to submit chunk %d: %w", w.chunks+1, err)
+	}
+	w.accepted += w.pending
+	w.chunks++
+	w.pending = 0
+	w.buf.Reset()
+	return nil
+}
+
+// Accepted returns the number of records accepted by the collector
+func (w *BatchWriter) Accepted() int {
+	return w.accepted
+}
+
+// Chunks returns the number of chunks accepted by the collector
+func (w *BatchWriter) Chunks() int {
+	return w.chunks
+}
+
+func (w *BatchWriter) headerSize() int {
+	if len(w.options.Header) == 0 {
+		return 0
+	}
+	return len(w.options.Header) + 1
+}
@@ -0,0 +1,369 @@
+package collect
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+)
+
+type testRequest struct {
+	Path            string
+	Query           string
+	ContentType     string
+	ContentEncoding string
+	Body            string
+}
+
+// testCollector records requests, responding with the given status codes in
+// order before accepting all subsequent requests.
+type testCollector struct {
+	sync.Mutex
+	statuses []int
+	requests []testRequest
+}
+
+func (c *testCollector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.Lock()
+	defer c.Unlock()
+
+	var body io.Reader = r.Body
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		zr, err := gzip.NewReader(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		body = zr
+	}
+	data, err := io.ReadAll(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	c.requests = append(c.requests, testRequest{
+		Path:            r.URL.Path,
+		Query:           r.URL.RawQuery,
+		ContentType:     r.Header.Get("Content-Type"),
+		ContentEncoding: r.Header.Get("Content-Encoding"),
+		Body:            string(data),
+	})
+
+	status := http.StatusAccepted
+	if len(c.statuses) > 0 {
+		status, c.statuses = c.statuses[0], c.statuses[1:]
+	}
+	w.WriteHeader(status)
+}
+
+func newTestCollector(t *testing.T, statuses ...int) (*Client, *testCollector) {
+	collector := &testCollector{statuses: statuses}
+	server := httptest.NewServer(collector)
+	t.Cleanup(server.Close)
+
+	client, err := New(server.URL, server.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, collector
+}
+
+func TestBatchWriterChunks(t *testing.T) {
+	client, collector := newTestCollector(t)
+
+	w := client.NewBatchWriter(context.Background(), "/test", BatchOptions{
+		Tags:         map[string]string{"source": "test"},
+		ContentType:  "text/csv",
+		Header:       []byte("a,b"),
+		MaxChunkSize: 12,
+	})
+	for _, record := range []string{"1,2", "3,4", "5,6", "7,8"} {
+		if err := w.Write([]byte(record)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := w.Accepted(); got != 4 {
+		t.Fatalf("expected 4 records accepted, got %d", got)
+	}
+	if got := w.Chunks(); got != 2 {
+		t.Fatalf("expected 2 chunks, got %d", got)
+	}
+
+	expected := []string{"a,b\n1,2\n3,4\n", "a,b\n5,6\n7,8\n"}
+	if len(collector.requests) != len(expected) {
+		t.Fatalf("expected %d requests, got %d", len(expected), len(collector.requests))
+	}
+	for i, req := range collector.requests {
+		if req.Body != expected[i] {
+			t.Errorf("chunk %d: expected %q, got %q", i, expected[i], req.Body)
+		}
+		if req.Path != "/v1/observations/test" {
+			t.Errorf("chunk %d: unexpected path %q", i, req.Path)
+		}
+		if req.Query != "source=test" {
+			t.Errorf("chunk %d: unexpected query %q", i, req.Query)
+		}
+		if req.ContentType != "text/csv" {
+			t.Errorf("chunk %d: unexpected content type %q", i, req.ContentType)
+		}
+	}
+}
+
+func TestBatchWriterRecordTooLarge(t *testing.T) {
+	client, collector := newTestCollector(t)
+
+	w := client.NewBatchWriter(context.Background(), "/", BatchOptions{MaxChunkSize: 8})
+	if err := w.Write([]byte("1234")); err != nil {
+		t.Fatal(err)
+	}
+	err := w.Write([]byte("123456789"))
+	if err == nil || !strings.Contains(err.Error(), "exceeds maximum chunk size") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(collector.requests) != 0 {
+		t.Fatalf("expected no requests, got %d", len(collector.requests))
+	}
+}
+
+func TestBatchWriterGzip(t *testing.T) {
+	client, collector := newTestCollector(t)
+
+	w := client.NewBatchWriter(context.Background(), "/", BatchOptions{
+		ContentType: "application/x-ndjson",
+		Gzip:        true,
+	})
+	for _, record := range []string{`{"a":1}`, `{"a":2}`} {
+		if err := w.Write([]byte(record)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(collector.requests) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(collector.requests))
+	}
+	req := collector.requests[0]
+	if req.ContentEncoding != "gzip" {
+		t.Fatalf("unexpected content encoding %q", req.ContentEncoding)
+	}
+	if expected := "{\"a\":1}\n{\"a\":2}\n"; req.Body != expected {
+		t.Fatalf("expected %q, got %q", expected, req.Body)
+	}
+}
+
+func TestObserveChunkRetries(t *testing.T) {
+	testcases := []struct {
+		Name         string
+		Statuses     []int
+		Retries      int
+		ServerErrors bool
+		Requests     int
+		Error        string
+	}{
+		{
+			Name:     "retry transient failures",
+			Statuses: []int{http.StatusRequestTimeout, http.StatusTooManyRequests},
+			Retries:  2,
+			Requests: 3,
+		},
+		{
+			Name:     "do not retry server errors by default",
+			Statuses: []int{http.StatusServiceUnavailable},
+			Retries:  2,
+			Requests: 1,
+			Error:    "service unavailable",
+		},
+		{
+			Name:         "retry server errors",
+			Statuses:     []int{http.StatusServiceUnavailable, http.StatusTooManyRequests},
+			Retries:      2,
+			ServerErrors: true,
+			Requests:     3,
+		},
+		{
+			Name:         "exhaust retries",
+			Statuses:     []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway},
+			Retries:      1,
+			ServerErrors: true,
+			Requests:     2,
+			Error:        "bad gateway (after 2 attempts)",
+		},
+		{
+			Name:     "do not retry client errors",
+			Statuses: []int{http.StatusBadRequest},
+			Retries:  3,
+			Requests: 1,
+			Error:    "bad request",
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.Name, func(t *testing.T) {
+			client, collector := newTestCollector(t, tt.Statuses...)
+
+			err := client.ObserveChunk(context.Background(), "/", []byte("{}"), BatchOptions{
+				Retries:           tt.Retries,
+				RetryInterval:     time.Millisecond,
+				RetryServerErrors: tt.ServerErrors,
+			})
+			switch {
+			case tt.Error == "" && err != nil:
+				t.Fatal(err)
+			case tt.Error != "" && (err == nil || err.Error() != tt.Error):
+				t.Fatalf("expected error %q, got %v", tt.Error, err)
+			}
+			if len(collector.requests) != tt.Requests {
+				t.Fatalf("expected %d requests, got %d", tt.Requests, len(collector.requests))
+			}
+		})
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	testcases := []struct {
+		Name         string
+		Context      context.Context
+		Err          error
+		ServerErrors bool
+		Retryable    bool
+	}{
+		{
+			Name: "server error",
+			Err:  &StatusError{StatusCode: http.StatusServiceUnavailable},
+		},
+		{
+			Name:         "server error when opted in",
+			Err:          &StatusError{StatusCode: http.StatusServiceUnavailable},
+			ServerErrors: true,
+			Retryable:    true,
+		},
+		{
+			Name:      "request timeout",
+			Err:       &StatusError{StatusCode: http.StatusRequestTimeout},
+			Retryable: true,
+		},
+		{
+			Name: "client error",
+			Err:  &StatusError{StatusCode: http.StatusBadRequest},
+		},
+		{
+			Name:      "connection refused",
+			Err:       &url.Error{Op: "Post", Err: &net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}},
+			Retryable: true,
+		},
+		{
+			Name: "dial timeout",
+			Err:  &url.Error{Op: "Post", Err: &net.OpError{Op: "dial", Err: os.ErrDeadlineExceeded}},
+		},
+		{
+			Name: "connection reset after sending",
+			Err:  &url.Error{Op: "Post", Err: &net.OpError{Op: "read", Err: syscall.ECONNRESET}},
+		},
+		{
+			Name: "client timeout",
+			Err:  &url.Error{Op: "Post", Err: context.DeadlineExceeded},
+		},
+		{
+			Name: "request construction",
+			Err:  fmt.Errorf("failed to build new request: %s", errors.New("invalid method")),
+		},
+		{
+			Name:         "canceled context",
+			Context:      canceled,
+			Err:          &StatusError{StatusCode: http.StatusServiceUnavailable},
+			ServerErrors: true,
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.Name, func(t *testing.T) {
+			ctx := tt.Context
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			if got := isRetryable(ctx, tt.Err, tt.ServerErrors); got != tt.Retryable {
+				t.Fatalf("expected %t, got %t", tt.Retryable, got)
+			}
+		})
+	}
+}
+
+func TestObserveChunkTransportErrors(t *testing.T) {
+	t.Run("retry connection refused", func(t *testing.T) {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		endpoint := "http://" + listener.Addr().String()
+		listener.Close()
+
+		client, err := New(endpoint, http.DefaultClient)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = client.ObserveChunk(context.Background(), "/", []byte("{}"), BatchOptions{
+			Retries:       2,
+			RetryInterval: time.Millisecond,
+		})
+		if err == nil || !strings.Contains(err.Error(), "(after 3 attempts)") {
+			t.Fatalf("expected error after 3 attempts, got %v", err)
+		}
+	})
+
+	t.Run("do not retry timeouts", func(t *testing.T) {
+		var (
+			mu       sync.Mutex
+			requests int
+			release  = make(chan struct{})
+		)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			requests++
+			mu.Unlock()
+			<-release
+		}))
+		t.Cleanup(server.Close)
+		t.Cleanup(func() { close(release) })
+
+		httpClient := server.Client()
+		httpClient.Timeout = 50 * time.Millisecond
+		client, err := New(server.URL, httpClient)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = client.ObserveChunk(context.Background(), "/", []byte("{}"), BatchOptions{
+			Retries:       2,
+			RetryInterval: time.Millisecond,
+		})
+		if err == nil || strings.Contains(err.Error(), "attempts") {
+			t.Fatalf("expected a single failed attempt, got %v", err)
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		if requests != 1 {
+			t.Fatalf("expected 1 request, got %d", requests)
+		}
+	})
+}
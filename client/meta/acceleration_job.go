@@ -0,0 +1,43 @@
+package meta
+
+import (
+	"context"
+)
+
+type accelerationJobResponse interface {
+	GetAccelerationJob() AccelerationJob
+}
+
+func accelerationJobOrError(r accelerationJobResponse, err error) (*AccelerationJob, error) {
+	if err != nil {
+		return nil, err
+	}
+	result := r.GetAccelerationJob()
+	return &result, nil
+}
+
+// CreateAccelerationJob submits an acceleration job. If input.DryRun is set,
+// the returned job is never scheduled and carries an invalid ID.
+func (client *Client) CreateAccelerationJob(ctx context.Context, input *AccelerationJobInput) (*AccelerationJob, error) {
+	resp, err := createAccelerationJob(ctx, client.Gql, *input)
+	return accelerationJobOrError(resp, err)
+}
+
+func (client *Client) GetAccelerationJob(ctx context.Context, id string) (*AccelerationJob, error) {
+	resp, err := getAccelerationJob(ctx, client.Gql, id)
+	return accelerationJobOrError(resp, err)
+}
+
+func (client *Client) CancelAccelerationJob(ctx context.Context, id string) (*AccelerationJob, error) {
+	resp, err := cancelAccelerationJob(ctx, client.Gql, id)
+	return accelerationJobOrError(resp, err)
+}
+
+// EstimateAccelerationJobCost returns a cost estimate per affected dataset.
+func (client *Client) EstimateAccelerationJobCost(ctx context.Context, input *AccelerationJobInput) ([]DatasetCostEstimate, error) {
+	resp, err := estimateAccelerationJobCost(ctx, client.Gql, *input)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Estimates, nil
+}
@@ -0,0 +1,52 @@
+package meta
+
+import (
+	"context"
+
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+)
+
+// BlobMaxSize is the maximum size of a blob value, in bytes
+const BlobMaxSize = 128 * 1024
+
+type blobResponse interface {
+	GetBlob() Blob
+}
+
+func blobOrError(r blobResponse, err error) (*Blob, error) {
+	if err != nil {
+		return nil, err
+	}
+	result := r.GetBlob()
+	return &result, nil
+}
+
+// GetBlob reads a blob. If no user is given, the blob is looked up for the
+// user the client is authenticated as.
+func (client *Client) GetBlob(ctx context.Context, userId *types.UserIdScalar, name string) (*Blob, error) {
+	resp, err := getBlob(ctx, client.Gql, userId, name)
+	return blobOrError(resp, err)
+}
+
+func (client *Client) ListBlobs(ctx context.Context, userId *types.UserIdScalar, kind *string, includeWorldReadable bool) ([]Blob, error) {
+	resp, err := listBlobs(ctx, client.Gql, userId, kind, &includeWorldReadable)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Blobs, nil
+}
+
+func (client *Client) CreateBlob(ctx context.Context, userId *types.UserIdScalar, name string, input *BlobInput, makePermanent bool) (*Blob, error) {
+	resp, err := createBlob(ctx, client.Gql, userId, name, *input, &makePermanent)
+	return blobOrError(resp, err)
+}
+
+func (client *Client) UpdateBlob(ctx context.Context, userId *types.UserIdScalar, name string, input *BlobInput, makePermanent bool) (*Blob, error) {
+	resp, err := updateBlob(ctx, client.Gql, userId, name, *input, &makePermanent)
+	return blobOrError(resp, err)
+}
+
+func (client *Client) DeleteBlob(ctx context.Context, userId *types.UserIdScalar, name string) error {
+	resp, err := deleteBlob(ctx, client.Gql, userId, name)
+	return optionalResultStatusError(resp, err)
+}
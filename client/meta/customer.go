@@ -0,0 +1,29 @@
+package meta
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetCurrentCustomerSso retrieves the SSO configuration of the current
+// customer, along with the customer ID.
+func (client *Client) GetCurrentCustomerSso(ctx context.Context) (string, *CustomerSso, error) {
+	resp, err := getCurrentCustomerSso(ctx, client.Gql)
+	if err != nil {
+		return "", nil, err
+	}
+	if resp.Customer == nil {
+		return "", nil, fmt.Errorf("current customer not found")
+	}
+	return resp.Customer.Id, &resp.Customer.Sso, nil
+}
+
+// UpdateCurrentCustomerSso updates the SSO configuration of the current
+// customer. Unset fields are left unchanged.
+func (client *Client) UpdateCurrentCustomerSso(ctx context.Context, input *CustomerSsoInput) (*CustomerSso, error) {
+	resp, err := updateCurrentCustomerSso(ctx, client.Gql, *input)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.Sso, nil
+}
@@ -2,7 +2,9 @@ package meta
 
 import (
 	"context"
+	"errors"
 
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
 	oid "github.com/observeinc/terraform-provider-observe/client/oid"
 )
 
@@ -69,6 +71,16 @@ func (client *Client) ListDatasets(ctx context.Context) (ds []*Dataset, err erro
 	return result, nil
 }
 
+// GetDatasetBillingInfo retrieves credit usage over the last 24 hours for all
+// datasets in a workspace.
+func (client *Client) GetDatasetBillingInfo(ctx context.Context, workspaceId string) ([]ObjectBillingInfo, error) {
+	resp, err := getDatasetBillingInfo(ctx, client.Gql, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+	return resp.BillingInfo.Datasets24h, nil
+}
+
 func (client *Client) SaveSourceDataset(ctx context.Context, workspaceId string, input *DatasetDefinitionInput, sourceInput *SourceTableDefinitionInput) (*Dataset, error) {
 	resp, err := saveSourceDataset(ctx, client.Gql, workspaceId, *input, *sourceInput, dep())
 	return datasetOrError(resp.Dataset, err)
@@ -82,3 +94,61 @@ func (d *Dataset) Oid() *oid.OID {
 		Version: &version,
 	}
 }
+
+// GetDatasetDoctorReport diagnoses a dataset and its upstream datasets. If
+// upLevels is nil, all ancestors are checked.
+func (client *Client) GetDatasetDoctorReport(ctx context.Context, id string, upLevels *int) ([]DatasetReport, error) {
+	resp, err := getDatasetDoctorReport(ctx, client.Gql, id, upLevels)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Report == nil {
+		return nil, errors.New("dataset doctor report not found")
+	}
+	return resp.Report.Datasets, nil
+}
+
+// ListDatasetLineage retrieves the inputs of all datasets across workspaces,
+// from which the dataset dependency graph can be assembled.
+func (client *Client) ListDatasetLineage(ctx context.Context) ([]DatasetLineage, error) {
+	resp, err := listDatasetLineage(ctx, client.Gql)
+	if err != nil {
+		return nil, err
+	}
+	var result []DatasetLineage
+	for _, ws := range resp.Workspaces {
+		result = append(result, ws.Datasets...)
+	}
+	return result, nil
+}
+
+// ListWorkspaceDatasetLineage retrieves the inputs of all datasets in a
+// workspace.
+func (client *Client) ListWorkspaceDatasetLineage(ctx context.Context, workspaceId string) ([]DatasetLineage, error) {
+	resp, err := listWorkspaceDatasetLineage(ctx, client.Gql, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Workspace == nil {
+		return nil, errors.New("workspace not found")
+	}
+	return resp.Workspace.Datasets, nil
+}
+
+// GetPathsBetweenDatasets retrieves link paths between two datasets, cheapest first.
+func (client *Client) GetPathsBetweenDatasets(ctx context.Context, from, to string, limit *types.Int64Scalar) ([]RelationshipPath, error) {
+	resp, err := getPathsBetweenDatasets(ctx, client.Gql, from, to, limit)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Paths, nil
+}
+
+// ListMonitorsForDataset retrieves monitors which read from a dataset.
+func (client *Client) ListMonitorsForDataset(ctx context.Context, datasetId string) ([]DatasetMonitor, error) {
+	resp, err := listMonitorsForDataset(ctx, client.Gql, datasetId)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Monitors, nil
+}
@@ -0,0 +1,37 @@
+package meta
+
+import (
+	"context"
+)
+
+type featureFlagResponse interface {
+	GetFeatureFlag() FeatureFlag
+}
+
+func featureFlagOrError(r featureFlagResponse, err error) (*FeatureFlag, error) {
+	if err != nil {
+		return nil, err
+	}
+	result := r.GetFeatureFlag()
+	return &result, nil
+}
+
+func (client *Client) ListFeatureFlags(ctx context.Context) ([]FeatureFlag, error) {
+	resp, err := listFeatureFlags(ctx, client.Gql)
+	if err != nil {
+		return nil, err
+	}
+	return resp.FeatureFlags, nil
+}
+
+func (client *Client) GetFeatureFlag(ctx context.Context, name string) (*FeatureFlag, error) {
+	resp, err := getFeatureFlag(ctx, client.Gql, name)
+	return featureFlagOrError(resp, err)
+}
+
+// UpdateFeatureFlagForUser overrides a feature flag for the user the client
+// is authenticated as.
+func (client *Client) UpdateFeatureFlagForUser(ctx context.Context, name string, enabled bool) (*FeatureFlag, error) {
+	resp, err := updateFeatureFlagForUser(ctx, client.Gql, FeatureFlagInput{Name: name, IsEnabled: enabled})
+	return featureFlagOrError(resp, err)
+}
@@ -11,6 +11,165 @@ import (
 	"github.com/observeinc/terraform-provider-observe/client/meta/types"
 )
 
+// AccelerationJob includes the GraphQL fields of AccelerationJob requested by the fragment AccelerationJob.
+// The GraphQL type's documentation follows.
+//
+// This is the acceleration job returned from the backend.
+type AccelerationJob struct {
+	// A unique identifier for the acceleration job. An invalid jobId might be
+	// returned for a failed create operation or a dry run create operation.
+	JobId string `json:"jobId"`
+	// Optional context provided by the caller.
+	Context *string `json:"context"`
+	// When the acceleration job was created.
+	CreatedDate types.TimeScalar `json:"createdDate"`
+	// Current state of the acceleration job.
+	State AccelerationJobState `json:"state"`
+	// When the state of the acceleration job was last updated.
+	StateLastUpdatedDate types.TimeScalar `json:"stateLastUpdatedDate"`
+	// Percentage of the acceleration job that has completed.
+	Progress float64 `json:"progress"`
+	// Optional value of the credits used for this acceleration job summed for all datasets
+	Credits *float64 `json:"credits"`
+	// Status of the requests in this job. One per dataset.
+	DatasetStatuses []AccelerationJobDatasetStatusesAccelerationRequestStatus `json:"datasetStatuses"`
+}
+
+// GetJobId returns AccelerationJob.JobId, and is useful for accessing the field via an interface.
+func (v *AccelerationJob) GetJobId() string { return v.JobId }
+
+// GetContext returns AccelerationJob.Context, and is useful for accessing the field via an interface.
+func (v *AccelerationJob) GetContext() *string { return v.Context }
+
+// GetCreatedDate returns AccelerationJob.CreatedDate, and is useful for accessing the field via an interface.
+func (v *AccelerationJob) GetCreatedDate() types.TimeScalar { return v.CreatedDate }
+
+// GetState returns AccelerationJob.State, and is useful for accessing the field via an interface.
+func (v *AccelerationJob) GetState() AccelerationJobState { return v.State }
+
+// GetStateLastUpdatedDate returns AccelerationJob.StateLastUpdatedDate, and is useful for accessing the field via an interface.
+func (v *AccelerationJob) GetStateLastUpdatedDate() types.TimeScalar { return v.StateLastUpdatedDate }
+
+// GetProgress returns AccelerationJob.Progress, and is useful for accessing the field via an interface.
+func (v *AccelerationJob) GetProgress() float64 { return v.Progress }
+
+// GetCredits returns AccelerationJob.Credits, and is useful for accessing the field via an interface.
+func (v *AccelerationJob) GetCredits() *float64 { return v.Credits }
+
+// GetDatasetStatuses returns AccelerationJob.DatasetStatuses, and is useful for accessing the field via an interface.
+func (v *AccelerationJob) GetDatasetStatuses() []AccelerationJobDatasetStatusesAccelerationRequestStatus {
+	return v.DatasetStatuses
+}
+
+// AccelerationJobDatasetStatusesAccelerationRequestStatus includes the requested fields of the GraphQL type AccelerationRequestStatus.
+// The GraphQL type's documentation follows.
+//
+// This is the status of the acceleration request for a particular dataset in an
+// accleration job returned from the backend.
+type AccelerationJobDatasetStatusesAccelerationRequestStatus struct {
+	DatasetId string `json:"datasetId"`
+	// Whether the dataset is directly requested in the owning acceleration job.
+	IsDirect bool `json:"isDirect"`
+	// Percentage of the acceleration request that is completed. 1 means fully
+	// completed.
+	Progress float64 `json:"progress"`
+	// Optional credits used for this particular dataset in the parent acceleration job.
+	Credits *float64 `json:"credits"`
+}
+
+// GetDatasetId returns AccelerationJobDatasetStatusesAccelerationRequestStatus.DatasetId, and is useful for accessing the field via an interface.
+func (v *AccelerationJobDatasetStatusesAccelerationRequestStatus) GetDatasetId() string {
+	return v.DatasetId
+}
+
+// GetIsDirect returns AccelerationJobDatasetStatusesAccelerationRequestStatus.IsDirect, and is useful for accessing the field via an interface.
+func (v *AccelerationJobDatasetStatusesAccelerationRequestStatus) GetIsDirect() bool {
+	return v.IsDirect
+}
+
+// GetProgress returns AccelerationJobDatasetStatusesAccelerationRequestStatus.Progress, and is useful for accessing the field via an interface.
+func (v *AccelerationJobDatasetStatusesAccelerationRequestStatus) GetProgress() float64 {
+	return v.Progress
+}
+
+// GetCredits returns AccelerationJobDatasetStatusesAccelerationRequestStatus.Credits, and is useful for accessing the field via an interface.
+func (v *AccelerationJobDatasetStatusesAccelerationRequestStatus) GetCredits() *float64 {
+	return v.Credits
+}
+
+type AccelerationJobInput struct {
+	// An acceleration job contains a collection of acceleration requests on
+	// individual datasets. It is OK to have duplicate or overlapping requests.
+	// Backend will handle that.
+	Requests []AccelerationRequestInput `json:"requests"`
+	// Optional context provided by the caller.
+	Context *string `json:"context"`
+	// If dryRun is set to true, the created job won't actually be added to the
+	// system for acceleration. The returned job will have an invalid id (all zero
+	// UUID). The dry run can be used to peek what the created job would look like
+	// before actually creating it. Note that it's not guaranteed the job ID will be
+	// the same between a dry run and a real run. The other fields could also change
+	// if the dry run and real run are far apart in time.
+	DryRun *bool `json:"dryRun"`
+}
+
+// GetRequests returns AccelerationJobInput.Requests, and is useful for accessing the field via an interface.
+func (v *AccelerationJobInput) GetRequests() []AccelerationRequestInput { return v.Requests }
+
+// GetContext returns AccelerationJobInput.Context, and is useful for accessing the field via an interface.
+func (v *AccelerationJobInput) GetContext() *string { return v.Context }
+
+// GetDryRun returns AccelerationJobInput.DryRun, and is useful for accessing the field via an interface.
+func (v *AccelerationJobInput) GetDryRun() *bool { return v.DryRun }
+
+type AccelerationJobState string
+
+const (
+	AccelerationJobStateRunning   AccelerationJobState = "RUNNING"
+	AccelerationJobStateCompleted AccelerationJobState = "COMPLETED"
+	AccelerationJobStateCancelled AccelerationJobState = "CANCELLED"
+)
+
+type AccelerationRequestInput struct {
+	// The ID of the dataset to be accelerated in this request.
+	DatasetId string `json:"datasetId"`
+	// The time ranges to be accelerated. It is OK to have duplicate or overlapping
+	// ranges. Backend will handle that. DatasetInfo.unacceleratedWindows can be used
+	// as intervals directly.
+	Intervals []TimeRangeInput `json:"intervals"`
+}
+
+// GetDatasetId returns AccelerationRequestInput.DatasetId, and is useful for accessing the field via an interface.
+func (v *AccelerationRequestInput) GetDatasetId() string { return v.DatasetId }
+
+// GetIntervals returns AccelerationRequestInput.Intervals, and is useful for accessing the field via an interface.
+func (v *AccelerationRequestInput) GetIntervals() []TimeRangeInput { return v.Intervals }
+
+type AccelerationState string
+
+const (
+	// Dataset is newly created/updated and acceleration has just started. It can
+	// be queried through inlining.
+	AccelerationStateInitializing AccelerationState = "Initializing"
+	// Normal operation, we are actively accelerating new data as they come in.
+	AccelerationStateLive AccelerationState = "Live"
+	// Like normal operation (Live), but additionally this dataset is updated as fast
+	// as possible. As long as this dataset is in live mode, the freshness goal is
+	// reduced to "zero" and reset to the original value again afterwards.
+	AccelerationStateLivemode AccelerationState = "LiveMode"
+	// Acceleration is unavailable because the dataset or its upstream dataset is
+	// broken (has compilation error). The dataset cannot be queried.
+	AccelerationStateUnavailable AccelerationState = "Unavailable"
+	// Acceleration is intentionally disabled, and the dataset can still be queried
+	// (through inlining). This covers the case where the dataset is not accelerable or
+	// acceleration is explicitly disabled.
+	AccelerationStateDisabled AccelerationState = "Disabled"
+	// Acceleration is failing at runtime. As a result querying the dataset may
+	// return outdated results. This is critical error and usually cannot be fixed
+	// by the user.
+	AccelerationStateError AccelerationState = "Error"
+)
+
 // ActionDestinationLink includes the GraphQL fields of ActionDestinationLink requested by the fragment ActionDestinationLink.
 type ActionDestinationLink struct {
 	// Takes in a private or public destination id created from an earlier createDestination API call.
@@ -297,6 +456,65 @@ func (v *AppVariableInput) GetName() string { return v.Name }
 // GetValue returns AppVariableInput.Value, and is useful for accessing the field via an interface.
 func (v *AppVariableInput) GetValue() string { return v.Value }
 
+// Blob includes the GraphQL fields of Blob requested by the fragment Blob.
+// The GraphQL type's documentation follows.
+//
+// Note that blobs have their own simple access control scheme,
+// and do not belong to projects (which in turn grant ACLs.)
+// This scheme is described by SimpleScope: user, customer, or
+// world; readable or writable.
+type Blob struct {
+	UserId      types.UserIdScalar `json:"userId"`
+	Name        string             `json:"name"`
+	Kind        string             `json:"kind"`
+	Value       types.JsonObject   `json:"value"`
+	Readable    SimpleScope        `json:"readable"`
+	Writable    SimpleScope        `json:"writable"`
+	UpdatedDate types.TimeScalar   `json:"updatedDate"`
+}
+
+// GetUserId returns Blob.UserId, and is useful for accessing the field via an interface.
+func (v *Blob) GetUserId() types.UserIdScalar { return v.UserId }
+
+// GetName returns Blob.Name, and is useful for accessing the field via an interface.
+func (v *Blob) GetName() string { return v.Name }
+
+// GetKind returns Blob.Kind, and is useful for accessing the field via an interface.
+func (v *Blob) GetKind() string { return v.Kind }
+
+// GetValue returns Blob.Value, and is useful for accessing the field via an interface.
+func (v *Blob) GetValue() types.JsonObject { return v.Value }
+
+// GetReadable returns Blob.Readable, and is useful for accessing the field via an interface.
+func (v *Blob) GetReadable() SimpleScope { return v.Readable }
+
+// GetWritable returns Blob.Writable, and is useful for accessing the field via an interface.
+func (v *Blob) GetWritable() SimpleScope { return v.Writable }
+
+// GetUpdatedDate returns Blob.UpdatedDate, and is useful for accessing the field via an interface.
+func (v *Blob) GetUpdatedDate() types.TimeScalar { return v.UpdatedDate }
+
+// if one of the fields is not specified, it will default
+// to a sane value on create, or the previous value on update
+type BlobInput struct {
+	Kind     *string           `json:"kind,omitempty"`
+	Value    *types.JsonObject `json:"value"`
+	Readable *SimpleScope      `json:"readable,omitempty"`
+	Writable *SimpleScope      `json:"writable,omitempty"`
+}
+
+// GetKind returns BlobInput.Kind, and is useful for accessing the field via an interface.
+func (v *BlobInput) GetKind() *string { return v.Kind }
+
+// GetValue returns BlobInput.Value, and is useful for accessing the field via an interface.
+func (v *BlobInput) GetValue() *types.JsonObject { return v.Value }
+
+// GetReadable returns BlobInput.Readable, and is useful for accessing the field via an interface.
+func (v *BlobInput) GetReadable() *SimpleScope { return v.Readable }
+
+// GetWritable returns BlobInput.Writable, and is useful for accessing the field via an interface.
+func (v *BlobInput) GetWritable() *SimpleScope { return v.Writable }
+
 // Board includes the GraphQL fields of Board requested by the fragment Board.
 type Board struct {
 	Id        string           `json:"id"`
@@ -942,6 +1160,20 @@ const (
 	CompareFunctionIsnotnull      CompareFunction = "IsNotNull"
 )
 
+// A very low confidence indicates the there was no data to perform the cost estimation. A low confidence
+// indicates that the estimate is made using incomplete data. A medium confidence indicates that the
+// backfill cost estimation is made using the ongoing data and the prediction is decent but could be
+// improved if there was backfill data available. A high confidence indicates that we had all the
+// appropriate backfill data to make a good estimation.
+type ConfidenceCostEstimate string
+
+const (
+	ConfidenceCostEstimateVerylow ConfidenceCostEstimate = "VeryLow"
+	ConfidenceCostEstimateLow     ConfidenceCostEstimate = "Low"
+	ConfidenceCostEstimateMedium  ConfidenceCostEstimate = "Medium"
+	ConfidenceCostEstimateHigh    ConfidenceCostEstimate = "High"
+)
+
 type CursorCacheMode string
 
 const (
@@ -956,6 +1188,85 @@ const (
 	CursorCacheModeCacheifmoredata CursorCacheMode = "CacheIfMoreData"
 )
 
+// CustomerSso includes the GraphQL fields of CustomerSso requested by the fragment CustomerSso.
+type CustomerSso struct {
+	SsoLocalFlag bool             `json:"ssoLocalFlag"`
+	ScimFlag     bool             `json:"scimFlag"`
+	SamlUrl      string           `json:"samlUrl"`
+	SamlCert     string           `json:"samlCert"`
+	SamlExpires  types.TimeScalar `json:"samlExpires"`
+}
+
+// GetSsoLocalFlag returns CustomerSso.SsoLocalFlag, and is useful for accessing the field via an interface.
+func (v *CustomerSso) GetSsoLocalFlag() bool { return v.SsoLocalFlag }
+
+// GetScimFlag returns CustomerSso.ScimFlag, and is useful for accessing the field via an interface.
+func (v *CustomerSso) GetScimFlag() bool { return v.ScimFlag }
+
+// GetSamlUrl returns CustomerSso.SamlUrl, and is useful for accessing the field via an interface.
+func (v *CustomerSso) GetSamlUrl() string { return v.SamlUrl }
+
+// GetSamlCert returns CustomerSso.SamlCert, and is useful for accessing the field via an interface.
+func (v *CustomerSso) GetSamlCert() string { return v.SamlCert }
+
+// GetSamlExpires returns CustomerSso.SamlExpires, and is useful for accessing the field via an interface.
+func (v *CustomerSso) GetSamlExpires() types.TimeScalar { return v.SamlExpires }
+
+type CustomerSsoInput struct {
+	SsoLocalFlag *bool   `json:"ssoLocalFlag,omitempty"`
+	ScimFlag     *bool   `json:"scimFlag,omitempty"`
+	SamlUrl      *string `json:"samlUrl,omitempty"`
+	SamlCert     *string `json:"samlCert,omitempty"`
+}
+
+// GetSsoLocalFlag returns CustomerSsoInput.SsoLocalFlag, and is useful for accessing the field via an interface.
+func (v *CustomerSsoInput) GetSsoLocalFlag() *bool { return v.SsoLocalFlag }
+
+// GetScimFlag returns CustomerSsoInput.ScimFlag, and is useful for accessing the field via an interface.
+func (v *CustomerSsoInput) GetScimFlag() *bool { return v.ScimFlag }
+
+// GetSamlUrl returns CustomerSsoInput.SamlUrl, and is useful for accessing the field via an interface.
+func (v *CustomerSsoInput) GetSamlUrl() *string { return v.SamlUrl }
+
+// GetSamlCert returns CustomerSsoInput.SamlCert, and is useful for accessing the field via an interface.
+func (v *CustomerSsoInput) GetSamlCert() *string { return v.SamlCert }
+
+// Same search input used for Dashboards and Worksheets, hence, DWSearchInput.
+type DWSearchInput struct {
+	Name          []string               `json:"name"`
+	WorkspaceId   []string               `json:"workspaceId"`
+	WorkspaceName []string               `json:"workspaceName"`
+	FolderId      []string               `json:"folderId"`
+	FolderName    []string               `json:"folderName"`
+	User          []types.UserIdScalar   `json:"user"`
+	Parameter     []ParameterSearchInput `json:"parameter"`
+	Input         []InputSearchInput     `json:"input"`
+}
+
+// GetName returns DWSearchInput.Name, and is useful for accessing the field via an interface.
+func (v *DWSearchInput) GetName() []string { return v.Name }
+
+// GetWorkspaceId returns DWSearchInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *DWSearchInput) GetWorkspaceId() []string { return v.WorkspaceId }
+
+// GetWorkspaceName returns DWSearchInput.WorkspaceName, and is useful for accessing the field via an interface.
+func (v *DWSearchInput) GetWorkspaceName() []string { return v.WorkspaceName }
+
+// GetFolderId returns DWSearchInput.FolderId, and is useful for accessing the field via an interface.
+func (v *DWSearchInput) GetFolderId() []string { return v.FolderId }
+
+// GetFolderName returns DWSearchInput.FolderName, and is useful for accessing the field via an interface.
+func (v *DWSearchInput) GetFolderName() []string { return v.FolderName }
+
+// GetUser returns DWSearchInput.User, and is useful for accessing the field via an interface.
+func (v *DWSearchInput) GetUser() []types.UserIdScalar { return v.User }
+
+// GetParameter returns DWSearchInput.Parameter, and is useful for accessing the field via an interface.
+func (v *DWSearchInput) GetParameter() []ParameterSearchInput { return v.Parameter }
+
+// GetInput returns DWSearchInput.Input, and is useful for accessing the field via an interface.
+func (v *DWSearchInput) GetInput() []InputSearchInput { return v.Input }
+
 // Dashboard includes the GraphQL fields of Dashboard requested by the fragment Dashboard.
 type Dashboard struct {
 	Id              string                                     `json:"id"`
@@ -1223,6 +1534,50 @@ func (v *DashboardParametersParameterSpecValueKindValueTypeSpecArrayItemTypeValu
 	return v.KeyForDatasetId
 }
 
+// DashboardSearchResult includes the GraphQL fields of DashboardSearchResult requested by the fragment DashboardSearchResult.
+type DashboardSearchResult struct {
+	Score     types.Int64Scalar              `json:"score"`
+	Dashboard DashboardSearchResultDashboard `json:"dashboard"`
+}
+
+// GetScore returns DashboardSearchResult.Score, and is useful for accessing the field via an interface.
+func (v *DashboardSearchResult) GetScore() types.Int64Scalar { return v.Score }
+
+// GetDashboard returns DashboardSearchResult.Dashboard, and is useful for accessing the field via an interface.
+func (v *DashboardSearchResult) GetDashboard() DashboardSearchResultDashboard { return v.Dashboard }
+
+// DashboardSearchResultDashboard includes the requested fields of the GraphQL type Dashboard.
+type DashboardSearchResultDashboard struct {
+	Id          string           `json:"id"`
+	Name        string           `json:"name"`
+	Description *string          `json:"description"`
+	IconUrl     *string          `json:"iconUrl"`
+	WorkspaceId string           `json:"workspaceId"`
+	FolderId    string           `json:"folderId"`
+	UpdatedDate types.TimeScalar `json:"updatedDate"`
+}
+
+// GetId returns DashboardSearchResultDashboard.Id, and is useful for accessing the field via an interface.
+func (v *DashboardSearchResultDashboard) GetId() string { return v.Id }
+
+// GetName returns DashboardSearchResultDashboard.Name, and is useful for accessing the field via an interface.
+func (v *DashboardSearchResultDashboard) GetName() string { return v.Name }
+
+// GetDescription returns DashboardSearchResultDashboard.Description, and is useful for accessing the field via an interface.
+func (v *DashboardSearchResultDashboard) GetDescription() *string { return v.Description }
+
+// GetIconUrl returns DashboardSearchResultDashboard.IconUrl, and is useful for accessing the field via an interface.
+func (v *DashboardSearchResultDashboard) GetIconUrl() *string { return v.IconUrl }
+
+// GetWorkspaceId returns DashboardSearchResultDashboard.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *DashboardSearchResultDashboard) GetWorkspaceId() string { return v.WorkspaceId }
+
+// GetFolderId returns DashboardSearchResultDashboard.FolderId, and is useful for accessing the field via an interface.
+func (v *DashboardSearchResultDashboard) GetFolderId() string { return v.FolderId }
+
+// GetUpdatedDate returns DashboardSearchResultDashboard.UpdatedDate, and is useful for accessing the field via an interface.
+func (v *DashboardSearchResultDashboard) GetUpdatedDate() types.TimeScalar { return v.UpdatedDate }
+
 // DashboardStagesStageQuery includes the requested fields of the GraphQL type StageQuery.
 type DashboardStagesStageQuery struct {
 	Id       *string                                         `json:"id"`
@@ -1295,11 +1650,18 @@ type Dataset struct {
 	ManagedById          *string            `json:"managedById"`
 	// Optional custom configured override value of the on demand materialization
 	// range for the dataset.
-	OnDemandMaterializationLength *types.Int64Scalar                       `json:"onDemandMaterializationLength"`
-	ForeignKeys                   []DatasetForeignKeysForeignKey           `json:"foreignKeys"`
-	Transform                     *DatasetTransform                        `json:"transform"`
-	Typedef                       DatasetTypedef                           `json:"typedef"`
-	SourceTable                   *DatasetSourceTableSourceTableDefinition `json:"sourceTable"`
+	OnDemandMaterializationLength *types.Int64Scalar `json:"onDemandMaterializationLength"`
+	Accelerable                   bool               `json:"accelerable"`
+	// True if this dataset is hibernated. In this case, the dataset will not
+	// automatically accelerate new data. You can still query the dataset on the
+	// accelerated range and issue manual acceleration jobs.
+	Hibernated       bool                                     `json:"hibernated"`
+	CompilationError *DatasetCompilationError                 `json:"compilationError"`
+	AccelerationInfo DatasetAccelerationInfo                  `json:"accelerationInfo"`
+	ForeignKeys      []DatasetForeignKeysForeignKey           `json:"foreignKeys"`
+	Transform        *DatasetTransform                        `json:"transform"`
+	Typedef          DatasetTypedef                           `json:"typedef"`
+	SourceTable      *DatasetSourceTableSourceTableDefinition `json:"sourceTable"`
 }
 
 // GetWorkspaceId returns Dataset.WorkspaceId, and is useful for accessing the field via an interface.
@@ -1343,6 +1705,18 @@ func (v *Dataset) GetOnDemandMaterializationLength() *types.Int64Scalar {
 	return v.OnDemandMaterializationLength
 }
 
+// GetAccelerable returns Dataset.Accelerable, and is useful for accessing the field via an interface.
+func (v *Dataset) GetAccelerable() bool { return v.Accelerable }
+
+// GetHibernated returns Dataset.Hibernated, and is useful for accessing the field via an interface.
+func (v *Dataset) GetHibernated() bool { return v.Hibernated }
+
+// GetCompilationError returns Dataset.CompilationError, and is useful for accessing the field via an interface.
+func (v *Dataset) GetCompilationError() *DatasetCompilationError { return v.CompilationError }
+
+// GetAccelerationInfo returns Dataset.AccelerationInfo, and is useful for accessing the field via an interface.
+func (v *Dataset) GetAccelerationInfo() DatasetAccelerationInfo { return v.AccelerationInfo }
+
 // GetForeignKeys returns Dataset.ForeignKeys, and is useful for accessing the field via an interface.
 func (v *Dataset) GetForeignKeys() []DatasetForeignKeysForeignKey { return v.ForeignKeys }
 
@@ -1355,6 +1729,106 @@ func (v *Dataset) GetTypedef() DatasetTypedef { return v.Typedef }
 // GetSourceTable returns Dataset.SourceTable, and is useful for accessing the field via an interface.
 func (v *Dataset) GetSourceTable() *DatasetSourceTableSourceTableDefinition { return v.SourceTable }
 
+// DatasetAccelerationInfo includes the requested fields of the GraphQL type AccelerationInfo.
+type DatasetAccelerationInfo struct {
+	State AccelerationState `json:"state"`
+	// Staleness of the dataset (averaged over some moving window). 5min means we
+	// may not return data received in the last 5 minutes. A float value in
+	// seconds.
+	// Empty if alwaysAccelerated is true.
+	StalenessSeconds *float64 `json:"stalenessSeconds"`
+	// The actual target staleness target of the dataset. Note that this can be
+	// higher than the configured staleness target, due to decaying or credit
+	// manager overrides. Also if this value is different from the field above,
+	// it means the dataset is freshness decayed.
+	// Empty if alwaysAccelerated is true.
+	TargetStalenessSeconds *float64 `json:"targetStalenessSeconds"`
+	// Acceleration errors. Only not null if the state is "Error". Note that right
+	// now it only includes acceleration error of the particular dataset, but in
+	// the future shall include upstream dataset's errors.
+	Errors []DatasetAccelerationInfoErrorsAccelerationError `json:"errors"`
+}
+
+// GetState returns DatasetAccelerationInfo.State, and is useful for accessing the field via an interface.
+func (v *DatasetAccelerationInfo) GetState() AccelerationState { return v.State }
+
+// GetStalenessSeconds returns DatasetAccelerationInfo.StalenessSeconds, and is useful for accessing the field via an interface.
+func (v *DatasetAccelerationInfo) GetStalenessSeconds() *float64 { return v.StalenessSeconds }
+
+// GetTargetStalenessSeconds returns DatasetAccelerationInfo.TargetStalenessSeconds, and is useful for accessing the field via an interface.
+func (v *DatasetAccelerationInfo) GetTargetStalenessSeconds() *float64 {
+	return v.TargetStalenessSeconds
+}
+
+// GetErrors returns DatasetAccelerationInfo.Errors, and is useful for accessing the field via an interface.
+func (v *DatasetAccelerationInfo) GetErrors() []DatasetAccelerationInfoErrorsAccelerationError {
+	return v.Errors
+}
+
+// DatasetAccelerationInfoErrorsAccelerationError includes the requested fields of the GraphQL type AccelerationError.
+type DatasetAccelerationInfoErrorsAccelerationError struct {
+	// Error text
+	ErrorText string `json:"errorText"`
+}
+
+// GetErrorText returns DatasetAccelerationInfoErrorsAccelerationError.ErrorText, and is useful for accessing the field via an interface.
+func (v *DatasetAccelerationInfoErrorsAccelerationError) GetErrorText() string { return v.ErrorText }
+
+// DatasetCompilationError includes the requested fields of the GraphQL type CompilationError.
+// The GraphQL type's documentation follows.
+//
+// A dataset could fail to compile either because its OPAL was wrong or
+// because one of its dependencies' OPAL was wrong. CompilationError of
+// a dataset tells you the compilation error string and also where the
+// error originated.
+type DatasetCompilationError struct {
+	Error            string `json:"error"`
+	ErrorInDatasetId string `json:"errorInDatasetId"`
+}
+
+// GetError returns DatasetCompilationError.Error, and is useful for accessing the field via an interface.
+func (v *DatasetCompilationError) GetError() string { return v.Error }
+
+// GetErrorInDatasetId returns DatasetCompilationError.ErrorInDatasetId, and is useful for accessing the field via an interface.
+func (v *DatasetCompilationError) GetErrorInDatasetId() string { return v.ErrorInDatasetId }
+
+// DatasetCostEstimate includes the GraphQL fields of DatasetCostEstimate requested by the fragment DatasetCostEstimate.
+type DatasetCostEstimate struct {
+	DatasetId string `json:"datasetId"`
+	// Cost estimate OCCs of materializing the dataset for the given input window.
+	AbsoluteCostEstimate float64 `json:"absoluteCostEstimate"`
+	// Additional cost OCCs of materializing the dataset on top of already existing acceleration requests.
+	// To given an example, User 1 issues a request to backfill dataset for last 10 days. User 2 then issues
+	// a request to backfill the same dataset for the last 20 days.
+	// For User 1, absoluteCostEstimate and additionalCostEstimate are same i.e. of 10 days.
+	// For User 2, absoluteCostEstimate corresponds to backfilling 20 days and additionalCostEstimate
+	// corresponds to backfilling for 10 days.
+	AdditionalCostEstimate float64 `json:"additionalCostEstimate"`
+	// Confidence for the cost estimation of the absolute cost estimate of the dataset.
+	ConfidenceAbsoluteCostEstimate ConfidenceCostEstimate `json:"confidenceAbsoluteCostEstimate"`
+	// Confidence for the cost estimation of the additional cost estimate of the dataset.
+	ConfidenceAdditionalCostEstimate ConfidenceCostEstimate `json:"confidenceAdditionalCostEstimate"`
+}
+
+// GetDatasetId returns DatasetCostEstimate.DatasetId, and is useful for accessing the field via an interface.
+func (v *DatasetCostEstimate) GetDatasetId() string { return v.DatasetId }
+
+// GetAbsoluteCostEstimate returns DatasetCostEstimate.AbsoluteCostEstimate, and is useful for accessing the field via an interface.
+func (v *DatasetCostEstimate) GetAbsoluteCostEstimate() float64 { return v.AbsoluteCostEstimate }
+
+// GetAdditionalCostEstimate returns DatasetCostEstimate.AdditionalCostEstimate, and is useful for accessing the field via an interface.
+func (v *DatasetCostEstimate) GetAdditionalCostEstimate() float64 { return v.AdditionalCostEstimate }
+
+// GetConfidenceAbsoluteCostEstimate returns DatasetCostEstimate.ConfidenceAbsoluteCostEstimate, and is useful for accessing the field via an interface.
+func (v *DatasetCostEstimate) GetConfidenceAbsoluteCostEstimate() ConfidenceCostEstimate {
+	return v.ConfidenceAbsoluteCostEstimate
+}
+
+// GetConfidenceAdditionalCostEstimate returns DatasetCostEstimate.ConfidenceAdditionalCostEstimate, and is useful for accessing the field via an interface.
+func (v *DatasetCostEstimate) GetConfidenceAdditionalCostEstimate() ConfidenceCostEstimate {
+	return v.ConfidenceAdditionalCostEstimate
+}
+
 type DatasetDefinitionInput struct {
 	Dataset  DatasetInput                    `json:"dataset"`
 	Schema   []DatasetFieldDefInput          `json:"schema"`
@@ -1530,6 +2004,38 @@ func (v *DatasetInput) GetOnDemandMaterializationLength() *types.Int64Scalar {
 // GetManagedById returns DatasetInput.ManagedById, and is useful for accessing the field via an interface.
 func (v *DatasetInput) GetManagedById() *string { return v.ManagedById }
 
+// DatasetLineage includes the GraphQL fields of Dataset requested by the fragment DatasetLineage.
+type DatasetLineage struct {
+	Id          string                                    `json:"id"`
+	Name        string                                    `json:"name"`
+	WorkspaceId string                                    `json:"workspaceId"`
+	Inputs      []DatasetLineageInputsDatasetInputDataset `json:"inputs"`
+}
+
+// GetId returns DatasetLineage.Id, and is useful for accessing the field via an interface.
+func (v *DatasetLineage) GetId() string { return v.Id }
+
+// GetName returns DatasetLineage.Name, and is useful for accessing the field via an interface.
+func (v *DatasetLineage) GetName() string { return v.Name }
+
+// GetWorkspaceId returns DatasetLineage.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *DatasetLineage) GetWorkspaceId() string { return v.WorkspaceId }
+
+// GetInputs returns DatasetLineage.Inputs, and is useful for accessing the field via an interface.
+func (v *DatasetLineage) GetInputs() []DatasetLineageInputsDatasetInputDataset { return v.Inputs }
+
+// DatasetLineageInputsDatasetInputDataset includes the requested fields of the GraphQL type DatasetInputDataset.
+type DatasetLineageInputsDatasetInputDataset struct {
+	DatasetId string    `json:"datasetId"`
+	InputRole InputRole `json:"inputRole"`
+}
+
+// GetDatasetId returns DatasetLineageInputsDatasetInputDataset.DatasetId, and is useful for accessing the field via an interface.
+func (v *DatasetLineageInputsDatasetInputDataset) GetDatasetId() string { return v.DatasetId }
+
+// GetInputRole returns DatasetLineageInputsDatasetInputDataset.InputRole, and is useful for accessing the field via an interface.
+func (v *DatasetLineageInputsDatasetInputDataset) GetInputRole() InputRole { return v.InputRole }
+
 type DatasetLinkSchemaInput struct {
 	TargetDataset    *types.Int64Scalar `json:"targetDataset"`
 	TargetStageLabel *string            `json:"targetStageLabel"`
@@ -1557,6 +2063,22 @@ func (v *DatasetLinkSchemaInput) GetSrcFields() []string { return v.SrcFields }
 // GetDstFields returns DatasetLinkSchemaInput.DstFields, and is useful for accessing the field via an interface.
 func (v *DatasetLinkSchemaInput) GetDstFields() []string { return v.DstFields }
 
+// DatasetMonitor includes the GraphQL fields of Monitor requested by the fragment DatasetMonitor.
+type DatasetMonitor struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	WorkspaceId string `json:"workspaceId"`
+}
+
+// GetId returns DatasetMonitor.Id, and is useful for accessing the field via an interface.
+func (v *DatasetMonitor) GetId() string { return v.Id }
+
+// GetName returns DatasetMonitor.Name, and is useful for accessing the field via an interface.
+func (v *DatasetMonitor) GetName() string { return v.Name }
+
+// GetWorkspaceId returns DatasetMonitor.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *DatasetMonitor) GetWorkspaceId() string { return v.WorkspaceId }
+
 // DatasetOutboundShare includes the GraphQL fields of DatasetOutboundShare requested by the fragment DatasetOutboundShare.
 type DatasetOutboundShare struct {
 	Id              string  `json:"id"`
@@ -1662,6 +2184,262 @@ func (v *DatasetOutboundShareStatus) GetState() DatasetOutboundShareState { retu
 // GetError returns DatasetOutboundShareStatus.Error, and is useful for accessing the field via an interface.
 func (v *DatasetOutboundShareStatus) GetError() *string { return v.Error }
 
+// DatasetReport includes the GraphQL fields of DatasetReport requested by the fragment DatasetReport.
+type DatasetReport struct {
+	// The dataset this report is for
+	DatasetId    string `json:"datasetId"`
+	DatasetLabel string `json:"datasetLabel"`
+	WorkspaceId  string `json:"workspaceId"`
+	// If the doctor has comments, they go here -- this may include anything
+	// from "this is not accelerable because of stage X" to "the given name is
+	// not advised" to "the function name X is deprecated, use Y instead."
+	DoctorComments []string `json:"doctorComments"`
+	// inputDatasets are datasets bound as data inputs to this dataset
+	InputDatasets []string `json:"inputDatasets"`
+	// each stage may have errors and warnings
+	StageNotes []DatasetReportStageNotesDatasetStageNote `json:"stageNotes"`
+	// accelerationInfo is convenient
+	AccelerationInfo    *DatasetReportAccelerationInfo                   `json:"accelerationInfo"`
+	OngoingErrorReason  *DatasetReportOngoingErrorReasonReportEventInfo  `json:"ongoingErrorReason"`
+	BackfillErrorReason *DatasetReportBackfillErrorReasonReportEventInfo `json:"backfillErrorReason"`
+}
+
+// GetDatasetId returns DatasetReport.DatasetId, and is useful for accessing the field via an interface.
+func (v *DatasetReport) GetDatasetId() string { return v.DatasetId }
+
+// GetDatasetLabel returns DatasetReport.DatasetLabel, and is useful for accessing the field via an interface.
+func (v *DatasetReport) GetDatasetLabel() string { return v.DatasetLabel }
+
+// GetWorkspaceId returns DatasetReport.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *DatasetReport) GetWorkspaceId() string { return v.WorkspaceId }
+
+// GetDoctorComments returns DatasetReport.DoctorComments, and is useful for accessing the field via an interface.
+func (v *DatasetReport) GetDoctorComments() []string { return v.DoctorComments }
+
+// GetInputDatasets returns DatasetReport.InputDatasets, and is useful for accessing the field via an interface.
+func (v *DatasetReport) GetInputDatasets() []string { return v.InputDatasets }
+
+// GetStageNotes returns DatasetReport.StageNotes, and is useful for accessing the field via an interface.
+func (v *DatasetReport) GetStageNotes() []DatasetReportStageNotesDatasetStageNote {
+	return v.StageNotes
+}
+
+// GetAccelerationInfo returns DatasetReport.AccelerationInfo, and is useful for accessing the field via an interface.
+func (v *DatasetReport) GetAccelerationInfo() *DatasetReportAccelerationInfo {
+	return v.AccelerationInfo
+}
+
+// GetOngoingErrorReason returns DatasetReport.OngoingErrorReason, and is useful for accessing the field via an interface.
+func (v *DatasetReport) GetOngoingErrorReason() *DatasetReportOngoingErrorReasonReportEventInfo {
+	return v.OngoingErrorReason
+}
+
+// GetBackfillErrorReason returns DatasetReport.BackfillErrorReason, and is useful for accessing the field via an interface.
+func (v *DatasetReport) GetBackfillErrorReason() *DatasetReportBackfillErrorReasonReportEventInfo {
+	return v.BackfillErrorReason
+}
+
+// DatasetReportAccelerationInfo includes the requested fields of the GraphQL type AccelerationInfo.
+type DatasetReportAccelerationInfo struct {
+	State AccelerationState `json:"state"`
+	// Staleness of the dataset (averaged over some moving window). 5min means we
+	// may not return data received in the last 5 minutes. A float value in
+	// seconds.
+	// Empty if alwaysAccelerated is true.
+	StalenessSeconds *float64 `json:"stalenessSeconds"`
+	// The actual target staleness target of the dataset. Note that this can be
+	// higher than the configured staleness target, due to decaying or credit
+	// manager overrides. Also if this value is different from the field above,
+	// it means the dataset is freshness decayed.
+	// Empty if alwaysAccelerated is true.
+	TargetStalenessSeconds *float64 `json:"targetStalenessSeconds"`
+	// Acceleration errors. Only not null if the state is "Error". Note that right
+	// now it only includes acceleration error of the particular dataset, but in
+	// the future shall include upstream dataset's errors.
+	Errors []DatasetReportAccelerationInfoErrorsAccelerationError `json:"errors"`
+}
+
+// GetState returns DatasetReportAccelerationInfo.State, and is useful for accessing the field via an interface.
+func (v *DatasetReportAccelerationInfo) GetState() AccelerationState { return v.State }
+
+// GetStalenessSeconds returns DatasetReportAccelerationInfo.StalenessSeconds, and is useful for accessing the field via an interface.
+func (v *DatasetReportAccelerationInfo) GetStalenessSeconds() *float64 { return v.StalenessSeconds }
+
+// GetTargetStalenessSeconds returns DatasetReportAccelerationInfo.TargetStalenessSeconds, and is useful for accessing the field via an interface.
+func (v *DatasetReportAccelerationInfo) GetTargetStalenessSeconds() *float64 {
+	return v.TargetStalenessSeconds
+}
+
+// GetErrors returns DatasetReportAccelerationInfo.Errors, and is useful for accessing the field via an interface.
+func (v *DatasetReportAccelerationInfo) GetErrors() []DatasetReportAccelerationInfoErrorsAccelerationError {
+	return v.Errors
+}
+
+// DatasetReportAccelerationInfoErrorsAccelerationError includes the requested fields of the GraphQL type AccelerationError.
+type DatasetReportAccelerationInfoErrorsAccelerationError struct {
+	// Error text
+	ErrorText string `json:"errorText"`
+}
+
+// GetErrorText returns DatasetReportAccelerationInfoErrorsAccelerationError.ErrorText, and is useful for accessing the field via an interface.
+func (v *DatasetReportAccelerationInfoErrorsAccelerationError) GetErrorText() string {
+	return v.ErrorText
+}
+
+// DatasetReportBackfillErrorReasonReportEventInfo includes the requested fields of the GraphQL type ReportEventInfo.
+type DatasetReportBackfillErrorReasonReportEventInfo struct {
+	Text string           `json:"text"`
+	Time types.TimeScalar `json:"time"`
+}
+
+// GetText returns DatasetReportBackfillErrorReasonReportEventInfo.Text, and is useful for accessing the field via an interface.
+func (v *DatasetReportBackfillErrorReasonReportEventInfo) GetText() string { return v.Text }
+
+// GetTime returns DatasetReportBackfillErrorReasonReportEventInfo.Time, and is useful for accessing the field via an interface.
+func (v *DatasetReportBackfillErrorReasonReportEventInfo) GetTime() types.TimeScalar { return v.Time }
+
+// DatasetReportOngoingErrorReasonReportEventInfo includes the requested fields of the GraphQL type ReportEventInfo.
+type DatasetReportOngoingErrorReasonReportEventInfo struct {
+	Text string           `json:"text"`
+	Time types.TimeScalar `json:"time"`
+}
+
+// GetText returns DatasetReportOngoingErrorReasonReportEventInfo.Text, and is useful for accessing the field via an interface.
+func (v *DatasetReportOngoingErrorReasonReportEventInfo) GetText() string { return v.Text }
+
+// GetTime returns DatasetReportOngoingErrorReasonReportEventInfo.Time, and is useful for accessing the field via an interface.
+func (v *DatasetReportOngoingErrorReasonReportEventInfo) GetTime() types.TimeScalar { return v.Time }
+
+// DatasetReportStageNotesDatasetStageNote includes the requested fields of the GraphQL type DatasetStageNote.
+type DatasetReportStageNotesDatasetStageNote struct {
+	StageId  string                                                           `json:"stageId"`
+	Errors   []DatasetReportStageNotesDatasetStageNoteErrorsPipelineSymbol    `json:"errors"`
+	Warnings []DatasetReportStageNotesDatasetStageNoteWarningsPipelineWarning `json:"warnings"`
+}
+
+// GetStageId returns DatasetReportStageNotesDatasetStageNote.StageId, and is useful for accessing the field via an interface.
+func (v *DatasetReportStageNotesDatasetStageNote) GetStageId() string { return v.StageId }
+
+// GetErrors returns DatasetReportStageNotesDatasetStageNote.Errors, and is useful for accessing the field via an interface.
+func (v *DatasetReportStageNotesDatasetStageNote) GetErrors() []DatasetReportStageNotesDatasetStageNoteErrorsPipelineSymbol {
+	return v.Errors
+}
+
+// GetWarnings returns DatasetReportStageNotesDatasetStageNote.Warnings, and is useful for accessing the field via an interface.
+func (v *DatasetReportStageNotesDatasetStageNote) GetWarnings() []DatasetReportStageNotesDatasetStageNoteWarningsPipelineWarning {
+	return v.Warnings
+}
+
+// DatasetReportStageNotesDatasetStageNoteErrorsPipelineSymbol includes the requested fields of the GraphQL type PipelineSymbol.
+type DatasetReportStageNotesDatasetStageNoteErrorsPipelineSymbol struct {
+	Comment string `json:"comment"`
+}
+
+// GetComment returns DatasetReportStageNotesDatasetStageNoteErrorsPipelineSymbol.Comment, and is useful for accessing the field via an interface.
+func (v *DatasetReportStageNotesDatasetStageNoteErrorsPipelineSymbol) GetComment() string {
+	return v.Comment
+}
+
+// DatasetReportStageNotesDatasetStageNoteWarningsPipelineWarning includes the requested fields of the GraphQL type PipelineWarning.
+type DatasetReportStageNotesDatasetStageNoteWarningsPipelineWarning struct {
+	Symbol DatasetReportStageNotesDatasetStageNoteWarningsPipelineWarningSymbolPipelineSymbol `json:"symbol"`
+}
+
+// GetSymbol returns DatasetReportStageNotesDatasetStageNoteWarningsPipelineWarning.Symbol, and is useful for accessing the field via an interface.
+func (v *DatasetReportStageNotesDatasetStageNoteWarningsPipelineWarning) GetSymbol() DatasetReportStageNotesDatasetStageNoteWarningsPipelineWarningSymbolPipelineSymbol {
+	return v.Symbol
+}
+
+// DatasetReportStageNotesDatasetStageNoteWarningsPipelineWarningSymbolPipelineSymbol includes the requested fields of the GraphQL type PipelineSymbol.
+type DatasetReportStageNotesDatasetStageNoteWarningsPipelineWarningSymbolPipelineSymbol struct {
+	Comment string `json:"comment"`
+}
+
+// GetComment returns DatasetReportStageNotesDatasetStageNoteWarningsPipelineWarningSymbolPipelineSymbol.Comment, and is useful for accessing the field via an interface.
+func (v *DatasetReportStageNotesDatasetStageNoteWarningsPipelineWarningSymbolPipelineSymbol) GetComment() string {
+	return v.Comment
+}
+
+// DatasetSearchMatch includes the GraphQL fields of DatasetMatch requested by the fragment DatasetSearchMatch.
+type DatasetSearchMatch struct {
+	Dataset   DatasetSearchMatchDataset                   `json:"dataset"`
+	MatchData DatasetSearchMatchMatchDataDatasetMatchData `json:"matchData"`
+}
+
+// GetDataset returns DatasetSearchMatch.Dataset, and is useful for accessing the field via an interface.
+func (v *DatasetSearchMatch) GetDataset() DatasetSearchMatchDataset { return v.Dataset }
+
+// GetMatchData returns DatasetSearchMatch.MatchData, and is useful for accessing the field via an interface.
+func (v *DatasetSearchMatch) GetMatchData() DatasetSearchMatchMatchDataDatasetMatchData {
+	return v.MatchData
+}
+
+// DatasetSearchMatchDataset includes the requested fields of the GraphQL type Dataset.
+type DatasetSearchMatchDataset struct {
+	Id          string                                                    `json:"id"`
+	Name        string                                                    `json:"name"`
+	Path        string                                                    `json:"path"`
+	WorkspaceId string                                                    `json:"workspaceId"`
+	Interfaces  []DatasetSearchMatchDatasetInterfacesImplementedInterface `json:"interfaces"`
+}
+
+// GetId returns DatasetSearchMatchDataset.Id, and is useful for accessing the field via an interface.
+func (v *DatasetSearchMatchDataset) GetId() string { return v.Id }
+
+// GetName returns DatasetSearchMatchDataset.Name, and is useful for accessing the field via an interface.
+func (v *DatasetSearchMatchDataset) GetName() string { return v.Name }
+
+// GetPath returns DatasetSearchMatchDataset.Path, and is useful for accessing the field via an interface.
+func (v *DatasetSearchMatchDataset) GetPath() string { return v.Path }
+
+// GetWorkspaceId returns DatasetSearchMatchDataset.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *DatasetSearchMatchDataset) GetWorkspaceId() string { return v.WorkspaceId }
+
+// GetInterfaces returns DatasetSearchMatchDataset.Interfaces, and is useful for accessing the field via an interface.
+func (v *DatasetSearchMatchDataset) GetInterfaces() []DatasetSearchMatchDatasetInterfacesImplementedInterface {
+	return v.Interfaces
+}
+
+// DatasetSearchMatchDatasetInterfacesImplementedInterface includes the requested fields of the GraphQL type ImplementedInterface.
+type DatasetSearchMatchDatasetInterfacesImplementedInterface struct {
+	Path string `json:"path"`
+}
+
+// GetPath returns DatasetSearchMatchDatasetInterfacesImplementedInterface.Path, and is useful for accessing the field via an interface.
+func (v *DatasetSearchMatchDatasetInterfacesImplementedInterface) GetPath() string { return v.Path }
+
+// DatasetSearchMatchMatchDataDatasetMatchData includes the requested fields of the GraphQL type DatasetMatchData.
+type DatasetSearchMatchMatchDataDatasetMatchData struct {
+	Score                 float64  `json:"score"`
+	MatchedLabel          []string `json:"matchedLabel"`
+	MatchedColumn         []string `json:"matchedColumn"`
+	MatchedCorrelationTag []string `json:"matchedCorrelationTag"`
+	MatchedInterface      []string `json:"matchedInterface"`
+}
+
+// GetScore returns DatasetSearchMatchMatchDataDatasetMatchData.Score, and is useful for accessing the field via an interface.
+func (v *DatasetSearchMatchMatchDataDatasetMatchData) GetScore() float64 { return v.Score }
+
+// GetMatchedLabel returns DatasetSearchMatchMatchDataDatasetMatchData.MatchedLabel, and is useful for accessing the field via an interface.
+func (v *DatasetSearchMatchMatchDataDatasetMatchData) GetMatchedLabel() []string {
+	return v.MatchedLabel
+}
+
+// GetMatchedColumn returns DatasetSearchMatchMatchDataDatasetMatchData.MatchedColumn, and is useful for accessing the field via an interface.
+func (v *DatasetSearchMatchMatchDataDatasetMatchData) GetMatchedColumn() []string {
+	return v.MatchedColumn
+}
+
+// GetMatchedCorrelationTag returns DatasetSearchMatchMatchDataDatasetMatchData.MatchedCorrelationTag, and is useful for accessing the field via an interface.
+func (v *DatasetSearchMatchMatchDataDatasetMatchData) GetMatchedCorrelationTag() []string {
+	return v.MatchedCorrelationTag
+}
+
+// GetMatchedInterface returns DatasetSearchMatchMatchDataDatasetMatchData.MatchedInterface, and is useful for accessing the field via an interface.
+func (v *DatasetSearchMatchMatchDataDatasetMatchData) GetMatchedInterface() []string {
+	return v.MatchedInterface
+}
+
 // DatasetSourceTableSourceTableDefinition includes the requested fields of the GraphQL type SourceTableDefinition.
 type DatasetSourceTableSourceTableDefinition struct {
 	Schema                string                                                                            `json:"schema"`
@@ -2229,6 +3007,42 @@ func (v *EmailActionInput) GetIsHtml() *bool { return v.IsHtml }
 // GetFragments returns EmailActionInput.Fragments, and is useful for accessing the field via an interface.
 func (v *EmailActionInput) GetFragments() *types.JsonObject { return v.Fragments }
 
+// ExportCursorResult includes the GraphQL fields of ExportCursorResult requested by the fragment ExportCursorResult.
+type ExportCursorResult struct {
+	// If the data from the cursor can be had by calling GET on a URL, this is
+	// the URL.
+	ExportUrl string `json:"exportUrl"`
+	// The export URL will expire at some time in the future. This is that time.
+	ExportUrlExpiration *types.TimeScalar `json:"exportUrlExpiration"`
+	// This is the filename you provided, or a generated filename if none was
+	// part of the request
+	ExportFilename *string `json:"exportFilename"`
+	// This is the format you requested, or the default if none was part of the
+	// request
+	ExportFormat *ExportFileFormat `json:"exportFormat"`
+}
+
+// GetExportUrl returns ExportCursorResult.ExportUrl, and is useful for accessing the field via an interface.
+func (v *ExportCursorResult) GetExportUrl() string { return v.ExportUrl }
+
+// GetExportUrlExpiration returns ExportCursorResult.ExportUrlExpiration, and is useful for accessing the field via an interface.
+func (v *ExportCursorResult) GetExportUrlExpiration() *types.TimeScalar { return v.ExportUrlExpiration }
+
+// GetExportFilename returns ExportCursorResult.ExportFilename, and is useful for accessing the field via an interface.
+func (v *ExportCursorResult) GetExportFilename() *string { return v.ExportFilename }
+
+// GetExportFormat returns ExportCursorResult.ExportFormat, and is useful for accessing the field via an interface.
+func (v *ExportCursorResult) GetExportFormat() *ExportFileFormat { return v.ExportFormat }
+
+type ExportFileFormat string
+
+const (
+	// Comma Separated Values
+	ExportFileFormatCsv ExportFileFormat = "Csv"
+	// Newline Delimited JSON
+	ExportFileFormatNdjson ExportFileFormat = "NDJson"
+)
+
 type FacetFunction string
 
 const (
@@ -2240,6 +3054,37 @@ const (
 	FacetFunctionIsnotnull      FacetFunction = "IsNotNull"
 )
 
+// FeatureFlag includes the GraphQL fields of FeatureFlag requested by the fragment FeatureFlag.
+type FeatureFlag struct {
+	Name                 string `json:"name"`
+	Description          string `json:"description"`
+	IsEnabled            bool   `json:"isEnabled"`
+	IsSelfServiceEnabled bool   `json:"isSelfServiceEnabled"`
+}
+
+// GetName returns FeatureFlag.Name, and is useful for accessing the field via an interface.
+func (v *FeatureFlag) GetName() string { return v.Name }
+
+// GetDescription returns FeatureFlag.Description, and is useful for accessing the field via an interface.
+func (v *FeatureFlag) GetDescription() string { return v.Description }
+
+// GetIsEnabled returns FeatureFlag.IsEnabled, and is useful for accessing the field via an interface.
+func (v *FeatureFlag) GetIsEnabled() bool { return v.IsEnabled }
+
+// GetIsSelfServiceEnabled returns FeatureFlag.IsSelfServiceEnabled, and is useful for accessing the field via an interface.
+func (v *FeatureFlag) GetIsSelfServiceEnabled() bool { return v.IsSelfServiceEnabled }
+
+type FeatureFlagInput struct {
+	Name      string `json:"name"`
+	IsEnabled bool   `json:"isEnabled"`
+}
+
+// GetName returns FeatureFlagInput.Name, and is useful for accessing the field via an interface.
+func (v *FeatureFlagInput) GetName() string { return v.Name }
+
+// GetIsEnabled returns FeatureFlagInput.IsEnabled, and is useful for accessing the field via an interface.
+func (v *FeatureFlagInput) GetIsEnabled() bool { return v.IsEnabled }
+
 // Filedrop includes the GraphQL fields of Filedrop requested by the fragment Filedrop.
 type Filedrop struct {
 	Id          string  `json:"id"`
@@ -2743,31 +3588,164 @@ func (v *HttpRequestConfig) GetHeaders() *types.JsonObject { return v.Headers }
 // GetParams returns HttpRequestConfig.Params, and is useful for accessing the field via an interface.
 func (v *HttpRequestConfig) GetParams() *types.JsonObject { return v.Params }
 
-// IngestInfo includes the GraphQL fields of IngestInfo requested by the fragment IngestInfo.
-// The GraphQL type's documentation follows.
-//
-// Information on the data ingestion endpoint, full URL format is:
-// <scheme>://<customerId>.collect.<domain>:<port>/
-type IngestInfo struct {
-	CollectUrl string `json:"collectUrl"`
-	Domain     string `json:"domain"`
-	Scheme     string `json:"scheme"`
-	Port       string `json:"port"`
+// Incident includes the GraphQL fields of Incident requested by the fragment Incident.
+type Incident struct {
+	Id            string                                      `json:"id"`
+	Name          string                                      `json:"name"`
+	Description   *string                                     `json:"description"`
+	IconUrl       *string                                     `json:"iconUrl"`
+	WorkspaceId   string                                      `json:"workspaceId"`
+	FolderId      string                                      `json:"folderId"`
+	Status        IncidentStatus                              `json:"status"`
+	InactiveTime  *types.TimeScalar                           `json:"inactiveTime"`
+	ClosedTime    *types.TimeScalar                           `json:"closedTime"`
+	Users         []IncidentUsersUserInfo                     `json:"users"`
+	SlackChannels []IncidentSlackChannelsIncidentSlackchannel `json:"slackChannels"`
+	Worksheets    []string                                    `json:"worksheets"`
+	Dashboards    []string                                    `json:"dashboards"`
 }
 
-// GetCollectUrl returns IngestInfo.CollectUrl, and is useful for accessing the field via an interface.
-func (v *IngestInfo) GetCollectUrl() string { return v.CollectUrl }
+// GetId returns Incident.Id, and is useful for accessing the field via an interface.
+func (v *Incident) GetId() string { return v.Id }
 
-// GetDomain returns IngestInfo.Domain, and is useful for accessing the field via an interface.
-func (v *IngestInfo) GetDomain() string { return v.Domain }
+// GetName returns Incident.Name, and is useful for accessing the field via an interface.
+func (v *Incident) GetName() string { return v.Name }
 
-// GetScheme returns IngestInfo.Scheme, and is useful for accessing the field via an interface.
-func (v *IngestInfo) GetScheme() string { return v.Scheme }
+// GetDescription returns Incident.Description, and is useful for accessing the field via an interface.
+func (v *Incident) GetDescription() *string { return v.Description }
 
-// GetPort returns IngestInfo.Port, and is useful for accessing the field via an interface.
-func (v *IngestInfo) GetPort() string { return v.Port }
+// GetIconUrl returns Incident.IconUrl, and is useful for accessing the field via an interface.
+func (v *Incident) GetIconUrl() *string { return v.IconUrl }
 
-type InputDefinitionInput struct {
+// GetWorkspaceId returns Incident.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *Incident) GetWorkspaceId() string { return v.WorkspaceId }
+
+// GetFolderId returns Incident.FolderId, and is useful for accessing the field via an interface.
+func (v *Incident) GetFolderId() string { return v.FolderId }
+
+// GetStatus returns Incident.Status, and is useful for accessing the field via an interface.
+func (v *Incident) GetStatus() IncidentStatus { return v.Status }
+
+// GetInactiveTime returns Incident.InactiveTime, and is useful for accessing the field via an interface.
+func (v *Incident) GetInactiveTime() *types.TimeScalar { return v.InactiveTime }
+
+// GetClosedTime returns Incident.ClosedTime, and is useful for accessing the field via an interface.
+func (v *Incident) GetClosedTime() *types.TimeScalar { return v.ClosedTime }
+
+// GetUsers returns Incident.Users, and is useful for accessing the field via an interface.
+func (v *Incident) GetUsers() []IncidentUsersUserInfo { return v.Users }
+
+// GetSlackChannels returns Incident.SlackChannels, and is useful for accessing the field via an interface.
+func (v *Incident) GetSlackChannels() []IncidentSlackChannelsIncidentSlackchannel {
+	return v.SlackChannels
+}
+
+// GetWorksheets returns Incident.Worksheets, and is useful for accessing the field via an interface.
+func (v *Incident) GetWorksheets() []string { return v.Worksheets }
+
+// GetDashboards returns Incident.Dashboards, and is useful for accessing the field via an interface.
+func (v *Incident) GetDashboards() []string { return v.Dashboards }
+
+type IncidentInput struct {
+	Status      IncidentStatus `json:"status"`
+	Name        string         `json:"name"`
+	IconUrl     *string        `json:"iconUrl"`
+	Description *string        `json:"description"`
+	ManagedById *string        `json:"managedById"`
+	FolderId    *string        `json:"folderId"`
+}
+
+// GetStatus returns IncidentInput.Status, and is useful for accessing the field via an interface.
+func (v *IncidentInput) GetStatus() IncidentStatus { return v.Status }
+
+// GetName returns IncidentInput.Name, and is useful for accessing the field via an interface.
+func (v *IncidentInput) GetName() string { return v.Name }
+
+// GetIconUrl returns IncidentInput.IconUrl, and is useful for accessing the field via an interface.
+func (v *IncidentInput) GetIconUrl() *string { return v.IconUrl }
+
+// GetDescription returns IncidentInput.Description, and is useful for accessing the field via an interface.
+func (v *IncidentInput) GetDescription() *string { return v.Description }
+
+// GetManagedById returns IncidentInput.ManagedById, and is useful for accessing the field via an interface.
+func (v *IncidentInput) GetManagedById() *string { return v.ManagedById }
+
+// GetFolderId returns IncidentInput.FolderId, and is useful for accessing the field via an interface.
+func (v *IncidentInput) GetFolderId() *string { return v.FolderId }
+
+type IncidentQueryInput struct {
+	Text string `json:"Text"`
+}
+
+// GetText returns IncidentQueryInput.Text, and is useful for accessing the field via an interface.
+func (v *IncidentQueryInput) GetText() string { return v.Text }
+
+// IncidentSlackChannelsIncidentSlackchannel includes the requested fields of the GraphQL type IncidentSlackchannel.
+type IncidentSlackChannelsIncidentSlackchannel struct {
+	ConnectionID   string `json:"connectionID"`
+	SlackchannelID string `json:"slackchannelID"`
+}
+
+// GetConnectionID returns IncidentSlackChannelsIncidentSlackchannel.ConnectionID, and is useful for accessing the field via an interface.
+func (v *IncidentSlackChannelsIncidentSlackchannel) GetConnectionID() string { return v.ConnectionID }
+
+// GetSlackchannelID returns IncidentSlackChannelsIncidentSlackchannel.SlackchannelID, and is useful for accessing the field via an interface.
+func (v *IncidentSlackChannelsIncidentSlackchannel) GetSlackchannelID() string {
+	return v.SlackchannelID
+}
+
+type IncidentSlackchannelInput struct {
+	ConnectionID   string `json:"connectionID"`
+	SlackchannelID string `json:"slackchannelID"`
+}
+
+// GetConnectionID returns IncidentSlackchannelInput.ConnectionID, and is useful for accessing the field via an interface.
+func (v *IncidentSlackchannelInput) GetConnectionID() string { return v.ConnectionID }
+
+// GetSlackchannelID returns IncidentSlackchannelInput.SlackchannelID, and is useful for accessing the field via an interface.
+func (v *IncidentSlackchannelInput) GetSlackchannelID() string { return v.SlackchannelID }
+
+type IncidentStatus string
+
+const (
+	IncidentStatusActive   IncidentStatus = "Active"
+	IncidentStatusClosed   IncidentStatus = "Closed"
+	IncidentStatusInactive IncidentStatus = "Inactive"
+)
+
+// IncidentUsersUserInfo includes the requested fields of the GraphQL type UserInfo.
+type IncidentUsersUserInfo struct {
+	UserId types.UserIdScalar `json:"userId"`
+}
+
+// GetUserId returns IncidentUsersUserInfo.UserId, and is useful for accessing the field via an interface.
+func (v *IncidentUsersUserInfo) GetUserId() types.UserIdScalar { return v.UserId }
+
+// IngestInfo includes the GraphQL fields of IngestInfo requested by the fragment IngestInfo.
+// The GraphQL type's documentation follows.
+//
+// Information on the data ingestion endpoint, full URL format is:
+// <scheme>://<customerId>.collect.<domain>:<port>/
+type IngestInfo struct {
+	CollectUrl string `json:"collectUrl"`
+	Domain     string `json:"domain"`
+	Scheme     string `json:"scheme"`
+	Port       string `json:"port"`
+}
+
+// GetCollectUrl returns IngestInfo.CollectUrl, and is useful for accessing the field via an interface.
+func (v *IngestInfo) GetCollectUrl() string { return v.CollectUrl }
+
+// GetDomain returns IngestInfo.Domain, and is useful for accessing the field via an interface.
+func (v *IngestInfo) GetDomain() string { return v.Domain }
+
+// GetScheme returns IngestInfo.Scheme, and is useful for accessing the field via an interface.
+func (v *IngestInfo) GetScheme() string { return v.Scheme }
+
+// GetPort returns IngestInfo.Port, and is useful for accessing the field via an interface.
+func (v *IngestInfo) GetPort() string { return v.Port }
+
+type InputDefinitionInput struct {
 	// Assign the short and unique user mnemonic for this input, used in @tableref expressions
 	InputName string `json:"inputName"`
 	// If this input is to be used for a purpose other than "slurp data," then specify that here.
@@ -2839,6 +3817,18 @@ const (
 	InputRoleReference InputRole = "Reference"
 )
 
+type InputSearchInput struct {
+	// name is a dataset path, which gets resolved to ID before matching. Not resolved means no match.
+	Name []string `json:"name"`
+	Id   []string `json:"id"`
+}
+
+// GetName returns InputSearchInput.Name, and is useful for accessing the field via an interface.
+func (v *InputSearchInput) GetName() []string { return v.Name }
+
+// GetId returns InputSearchInput.Id, and is useful for accessing the field via an interface.
+func (v *InputSearchInput) GetId() []string { return v.Id }
+
 // LayeredSettingRecord includes the GraphQL fields of LayeredSettingRecord requested by the fragment LayeredSettingRecord.
 // The GraphQL type's documentation follows.
 //
@@ -3045,6 +4035,88 @@ func (v *LinkFieldInput) GetColumn() string { return v.Column }
 // GetPath returns LinkFieldInput.Path, and is useful for accessing the field via an interface.
 func (v *LinkFieldInput) GetPath() *string { return v.Path }
 
+// MetricSearchMatch includes the GraphQL fields of MetricMatch requested by the fragment MetricSearchMatch.
+type MetricSearchMatch struct {
+	DatasetId *string                 `json:"datasetId"`
+	Metric    MetricSearchMatchMetric `json:"metric"`
+}
+
+// GetDatasetId returns MetricSearchMatch.DatasetId, and is useful for accessing the field via an interface.
+func (v *MetricSearchMatch) GetDatasetId() *string { return v.DatasetId }
+
+// GetMetric returns MetricSearchMatch.Metric, and is useful for accessing the field via an interface.
+func (v *MetricSearchMatch) GetMetric() MetricSearchMatchMetric { return v.Metric }
+
+// MetricSearchMatchMetric includes the requested fields of the GraphQL type Metric.
+type MetricSearchMatchMetric struct {
+	Name string `json:"name"`
+	// Format: <dataset-alias>.<metric-name>. If an alias is not defined for the dataset, the name is instead used as the alias
+	NameWithPath string                `json:"nameWithPath"`
+	Type         MetricType            `json:"type"`
+	Unit         string                `json:"unit"`
+	Description  string                `json:"description"`
+	Rollup       string                `json:"rollup"`
+	Aggregate    string                `json:"aggregate"`
+	Interval     *types.DurationScalar `json:"interval"`
+	// Whether the metric has been defined explicitly by user.
+	// Non-user-defined metrics are discovered by scanning metric data.
+	UserDefined bool        `json:"userDefined"`
+	State       MetricState `json:"state"`
+}
+
+// GetName returns MetricSearchMatchMetric.Name, and is useful for accessing the field via an interface.
+func (v *MetricSearchMatchMetric) GetName() string { return v.Name }
+
+// GetNameWithPath returns MetricSearchMatchMetric.NameWithPath, and is useful for accessing the field via an interface.
+func (v *MetricSearchMatchMetric) GetNameWithPath() string { return v.NameWithPath }
+
+// GetType returns MetricSearchMatchMetric.Type, and is useful for accessing the field via an interface.
+func (v *MetricSearchMatchMetric) GetType() MetricType { return v.Type }
+
+// GetUnit returns MetricSearchMatchMetric.Unit, and is useful for accessing the field via an interface.
+func (v *MetricSearchMatchMetric) GetUnit() string { return v.Unit }
+
+// GetDescription returns MetricSearchMatchMetric.Description, and is useful for accessing the field via an interface.
+func (v *MetricSearchMatchMetric) GetDescription() string { return v.Description }
+
+// GetRollup returns MetricSearchMatchMetric.Rollup, and is useful for accessing the field via an interface.
+func (v *MetricSearchMatchMetric) GetRollup() string { return v.Rollup }
+
+// GetAggregate returns MetricSearchMatchMetric.Aggregate, and is useful for accessing the field via an interface.
+func (v *MetricSearchMatchMetric) GetAggregate() string { return v.Aggregate }
+
+// GetInterval returns MetricSearchMatchMetric.Interval, and is useful for accessing the field via an interface.
+func (v *MetricSearchMatchMetric) GetInterval() *types.DurationScalar { return v.Interval }
+
+// GetUserDefined returns MetricSearchMatchMetric.UserDefined, and is useful for accessing the field via an interface.
+func (v *MetricSearchMatchMetric) GetUserDefined() bool { return v.UserDefined }
+
+// GetState returns MetricSearchMatchMetric.State, and is useful for accessing the field via an interface.
+func (v *MetricSearchMatchMetric) GetState() MetricState { return v.State }
+
+type MetricState string
+
+const (
+	// A metric in Active state is usable and currently reporting
+	MetricStateActive MetricState = "Active"
+	// A metric in Inactive state is usable, but not currently reporting
+	MetricStateInactive MetricState = "Inactive"
+	// A metric in Error state is unusable because the metric dataset has errors in its definition
+	MetricStateError MetricState = "Error"
+)
+
+type MetricType string
+
+const (
+	MetricTypeCumulativecounter MetricType = "CumulativeCounter"
+	MetricTypeCounter           MetricType = "Counter"
+	MetricTypeRatepersec        MetricType = "RatePerSec"
+	MetricTypeDelta             MetricType = "Delta"
+	MetricTypeGauge             MetricType = "Gauge"
+	MetricTypeTdigest           MetricType = "Tdigest"
+	MetricTypeSample            MetricType = "Sample"
+)
+
 // ModuleVersion includes the GraphQL fields of ModuleVersion requested by the fragment ModuleVersion.
 // The GraphQL type's documentation follows.
 //
@@ -3767,80 +4839,176 @@ func (v *MonitorInput) GetNotificationSpec() *NotificationSpecificationInput {
 // GetChannels returns MonitorInput.Channels, and is useful for accessing the field via an interface.
 func (v *MonitorInput) GetChannels() []string { return v.Channels }
 
-// MonitorNotificationSpecNotificationSpecification includes the requested fields of the GraphQL type NotificationSpecification.
-type MonitorNotificationSpecNotificationSpecification struct {
-	// should these go in each applicable Rule instead?
-	Merge             *NotificationMerge     `json:"merge"`
-	Importance        NotificationImportance `json:"importance"`
-	NotifyOnReminder  *bool                  `json:"notifyOnReminder"`
-	ReminderFrequency types.DurationScalar   `json:"reminderFrequency"`
-	NotifyOnClose     *bool                  `json:"notifyOnClose"`
+// MonitorMuteRule includes the GraphQL fields of MonitorMuteRule requested by the fragment MonitorMuteRule.
+type MonitorMuteRule struct {
+	Id          string  `json:"id"`
+	WorkspaceId string  `json:"workspaceId"`
+	FolderId    string  `json:"folderId"`
+	Name        string  `json:"name"`
+	IconUrl     *string `json:"iconUrl"`
+	Description *string `json:"description"`
+	// Apply the mute rule from this date, defaulting to now when missing.
+	StartDate *types.TimeScalar `json:"startDate"`
+	// How long the mute rule will apply, starting from startDate. Empty duration mutes the monitor indefinitely.
+	Duration *types.DurationScalar                             `json:"duration"`
+	Filter   *MonitorMuteRuleFilterMonitorMuteRuleFilterObject `json:"-"`
 }
 
-// GetMerge returns MonitorNotificationSpecNotificationSpecification.Merge, and is useful for accessing the field via an interface.
-func (v *MonitorNotificationSpecNotificationSpecification) GetMerge() *NotificationMerge {
-	return v.Merge
+// GetId returns MonitorMuteRule.Id, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRule) GetId() string { return v.Id }
+
+// GetWorkspaceId returns MonitorMuteRule.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRule) GetWorkspaceId() string { return v.WorkspaceId }
+
+// GetFolderId returns MonitorMuteRule.FolderId, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRule) GetFolderId() string { return v.FolderId }
+
+// GetName returns MonitorMuteRule.Name, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRule) GetName() string { return v.Name }
+
+// GetIconUrl returns MonitorMuteRule.IconUrl, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRule) GetIconUrl() *string { return v.IconUrl }
+
+// GetDescription returns MonitorMuteRule.Description, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRule) GetDescription() *string { return v.Description }
+
+// GetStartDate returns MonitorMuteRule.StartDate, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRule) GetStartDate() *types.TimeScalar { return v.StartDate }
+
+// GetDuration returns MonitorMuteRule.Duration, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRule) GetDuration() *types.DurationScalar { return v.Duration }
+
+// GetFilter returns MonitorMuteRule.Filter, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRule) GetFilter() *MonitorMuteRuleFilterMonitorMuteRuleFilterObject {
+	return v.Filter
 }
 
-// GetImportance returns MonitorNotificationSpecNotificationSpecification.Importance, and is useful for accessing the field via an interface.
-func (v *MonitorNotificationSpecNotificationSpecification) GetImportance() NotificationImportance {
-	return v.Importance
+func (v *MonitorMuteRule) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*MonitorMuteRule
+		Filter json.RawMessage `json:"filter"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.MonitorMuteRule = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Filter
+		src := firstPass.Filter
+		if len(src) != 0 && string(src) != "null" {
+			*dst = new(MonitorMuteRuleFilterMonitorMuteRuleFilterObject)
+			err = __unmarshalMonitorMuteRuleFilterMonitorMuteRuleFilterObject(
+				src, *dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal MonitorMuteRule.Filter: %w", err)
+			}
+		}
+	}
+	return nil
 }
 
-// GetNotifyOnReminder returns MonitorNotificationSpecNotificationSpecification.NotifyOnReminder, and is useful for accessing the field via an interface.
-func (v *MonitorNotificationSpecNotificationSpecification) GetNotifyOnReminder() *bool {
-	return v.NotifyOnReminder
+type __premarshalMonitorMuteRule struct {
+	Id string `json:"id"`
+
+	WorkspaceId string `json:"workspaceId"`
+
+	FolderId string `json:"folderId"`
+
+	Name string `json:"name"`
+
+	IconUrl *string `json:"iconUrl"`
+
+	Description *string `json:"description"`
+
+	StartDate *types.TimeScalar `json:"startDate"`
+
+	Duration *types.DurationScalar `json:"duration"`
+
+	Filter json.RawMessage `json:"filter"`
 }
 
-// GetReminderFrequency returns MonitorNotificationSpecNotificationSpecification.ReminderFrequency, and is useful for accessing the field via an interface.
-func (v *MonitorNotificationSpecNotificationSpecification) GetReminderFrequency() types.DurationScalar {
-	return v.ReminderFrequency
+func (v *MonitorMuteRule) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
 }
 
-// GetNotifyOnClose returns MonitorNotificationSpecNotificationSpecification.NotifyOnClose, and is useful for accessing the field via an interface.
-func (v *MonitorNotificationSpecNotificationSpecification) GetNotifyOnClose() *bool {
-	return v.NotifyOnClose
+func (v *MonitorMuteRule) __premarshalJSON() (*__premarshalMonitorMuteRule, error) {
+	var retval __premarshalMonitorMuteRule
+
+	retval.Id = v.Id
+	retval.WorkspaceId = v.WorkspaceId
+	retval.FolderId = v.FolderId
+	retval.Name = v.Name
+	retval.IconUrl = v.IconUrl
+	retval.Description = v.Description
+	retval.StartDate = v.StartDate
+	retval.Duration = v.Duration
+	{
+
+		dst := &retval.Filter
+		src := v.Filter
+		if src != nil {
+			var err error
+			*dst, err = __marshalMonitorMuteRuleFilterMonitorMuteRuleFilterObject(
+				src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal MonitorMuteRule.Filter: %w", err)
+			}
+		}
+	}
+	return &retval, nil
 }
 
-// MonitorQueryMultiStageQuery includes the requested fields of the GraphQL type MultiStageQuery.
-type MonitorQueryMultiStageQuery struct {
-	OutputStage string       `json:"outputStage"`
-	Stages      []StageQuery `json:"stages"`
+// MonitorMuteRuleFilterMonitorMuteRuleFilterAll includes the requested fields of the GraphQL type MonitorMuteRuleFilterAll.
+type MonitorMuteRuleFilterMonitorMuteRuleFilterAll struct {
+	Typename   *string                   `json:"__typename"`
+	FilterType MonitorMuteRuleFilterType `json:"filterType"`
 }
 
-// GetOutputStage returns MonitorQueryMultiStageQuery.OutputStage, and is useful for accessing the field via an interface.
-func (v *MonitorQueryMultiStageQuery) GetOutputStage() string { return v.OutputStage }
+// GetTypename returns MonitorMuteRuleFilterMonitorMuteRuleFilterAll.Typename, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleFilterMonitorMuteRuleFilterAll) GetTypename() *string { return v.Typename }
 
-// GetStages returns MonitorQueryMultiStageQuery.Stages, and is useful for accessing the field via an interface.
-func (v *MonitorQueryMultiStageQuery) GetStages() []StageQuery { return v.Stages }
+// GetFilterType returns MonitorMuteRuleFilterMonitorMuteRuleFilterAll.FilterType, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleFilterMonitorMuteRuleFilterAll) GetFilterType() MonitorMuteRuleFilterType {
+	return v.FilterType
+}
 
-// MonitorRule includes the requested fields of the GraphQL interface MonitorRule.
+// MonitorMuteRuleFilterMonitorMuteRuleFilterObject includes the requested fields of the GraphQL interface MonitorMuteRuleFilterObject.
 //
-// MonitorRule is implemented by the following types:
-// MonitorRuleMonitorRuleChange
-// MonitorRuleMonitorRuleCount
-// MonitorRuleMonitorRuleFacet
-// MonitorRuleMonitorRuleLog
-// MonitorRuleMonitorRulePromote
-// MonitorRuleMonitorRuleThreshold
-type MonitorRule interface {
-	implementsGraphQLInterfaceMonitorRule()
+// MonitorMuteRuleFilterMonitorMuteRuleFilterObject is implemented by the following types:
+// MonitorMuteRuleFilterMonitorMuteRuleFilterAll
+// MonitorMuteRuleFilterMonitorMuteRuleFilterPerColumn
+// MonitorMuteRuleFilterMonitorMuteRuleFilterPerResource
+type MonitorMuteRuleFilterMonitorMuteRuleFilterObject interface {
+	implementsGraphQLInterfaceMonitorMuteRuleFilterMonitorMuteRuleFilterObject()
 	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
 	GetTypename() *string
-	// GetSourceColumn returns the interface-field "sourceColumn" from its implementation.
-	GetSourceColumn() string
-	// GetGroupByGroups returns the interface-field "groupByGroups" from its implementation.
-	GetGroupByGroups() []MonitorRuleGroupByGroupsMonitorGroupInfo
+	// GetFilterType returns the interface-field "filterType" from its implementation.
+	GetFilterType() MonitorMuteRuleFilterType
 }
 
-func (v *MonitorRuleMonitorRuleChange) implementsGraphQLInterfaceMonitorRule()    {}
-func (v *MonitorRuleMonitorRuleCount) implementsGraphQLInterfaceMonitorRule()     {}
-func (v *MonitorRuleMonitorRuleFacet) implementsGraphQLInterfaceMonitorRule()     {}
-func (v *MonitorRuleMonitorRuleLog) implementsGraphQLInterfaceMonitorRule()       {}
-func (v *MonitorRuleMonitorRulePromote) implementsGraphQLInterfaceMonitorRule()   {}
-func (v *MonitorRuleMonitorRuleThreshold) implementsGraphQLInterfaceMonitorRule() {}
+func (v *MonitorMuteRuleFilterMonitorMuteRuleFilterAll) implementsGraphQLInterfaceMonitorMuteRuleFilterMonitorMuteRuleFilterObject() {
+}
+func (v *MonitorMuteRuleFilterMonitorMuteRuleFilterPerColumn) implementsGraphQLInterfaceMonitorMuteRuleFilterMonitorMuteRuleFilterObject() {
+}
+func (v *MonitorMuteRuleFilterMonitorMuteRuleFilterPerResource) implementsGraphQLInterfaceMonitorMuteRuleFilterMonitorMuteRuleFilterObject() {
+}
 
-func __unmarshalMonitorRule(b []byte, v *MonitorRule) error {
+func __unmarshalMonitorMuteRuleFilterMonitorMuteRuleFilterObject(b []byte, v *MonitorMuteRuleFilterMonitorMuteRuleFilterObject) error {
 	if string(b) == "null" {
 		return nil
 	}
@@ -3854,65 +5022,360 @@ func __unmarshalMonitorRule(b []byte, v *MonitorRule) error {
 	}
 
 	switch tn.TypeName {
-	case "MonitorRuleChange":
-		*v = new(MonitorRuleMonitorRuleChange)
-		return json.Unmarshal(b, *v)
-	case "MonitorRuleCount":
-		*v = new(MonitorRuleMonitorRuleCount)
-		return json.Unmarshal(b, *v)
-	case "MonitorRuleFacet":
-		*v = new(MonitorRuleMonitorRuleFacet)
+	case "MonitorMuteRuleFilterAll":
+		*v = new(MonitorMuteRuleFilterMonitorMuteRuleFilterAll)
 		return json.Unmarshal(b, *v)
-	case "MonitorRuleLog":
-		*v = new(MonitorRuleMonitorRuleLog)
-		return json.Unmarshal(b, *v)
-	case "MonitorRulePromote":
-		*v = new(MonitorRuleMonitorRulePromote)
+	case "MonitorMuteRuleFilterPerColumn":
+		*v = new(MonitorMuteRuleFilterMonitorMuteRuleFilterPerColumn)
 		return json.Unmarshal(b, *v)
-	case "MonitorRuleThreshold":
-		*v = new(MonitorRuleMonitorRuleThreshold)
+	case "MonitorMuteRuleFilterPerResource":
+		*v = new(MonitorMuteRuleFilterMonitorMuteRuleFilterPerResource)
 		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
-			"response was missing MonitorRule.__typename")
+			"response was missing MonitorMuteRuleFilterObject.__typename")
 	default:
 		return fmt.Errorf(
-			`unexpected concrete type for MonitorRule: "%v"`, tn.TypeName)
+			`unexpected concrete type for MonitorMuteRuleFilterMonitorMuteRuleFilterObject: "%v"`, tn.TypeName)
 	}
 }
 
-func __marshalMonitorRule(v *MonitorRule) ([]byte, error) {
+func __marshalMonitorMuteRuleFilterMonitorMuteRuleFilterObject(v *MonitorMuteRuleFilterMonitorMuteRuleFilterObject) ([]byte, error) {
 
 	var typename string
 	switch v := (*v).(type) {
-	case *MonitorRuleMonitorRuleChange:
-		typename = "MonitorRuleChange"
+	case *MonitorMuteRuleFilterMonitorMuteRuleFilterAll:
+		typename = "MonitorMuteRuleFilterAll"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*MonitorRuleMonitorRuleChange
+			*MonitorMuteRuleFilterMonitorMuteRuleFilterAll
 		}{typename, v}
 		return json.Marshal(result)
-	case *MonitorRuleMonitorRuleCount:
-		typename = "MonitorRuleCount"
+	case *MonitorMuteRuleFilterMonitorMuteRuleFilterPerColumn:
+		typename = "MonitorMuteRuleFilterPerColumn"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*MonitorRuleMonitorRuleCount
+			*MonitorMuteRuleFilterMonitorMuteRuleFilterPerColumn
 		}{typename, v}
 		return json.Marshal(result)
-	case *MonitorRuleMonitorRuleFacet:
-		typename = "MonitorRuleFacet"
+	case *MonitorMuteRuleFilterMonitorMuteRuleFilterPerResource:
+		typename = "MonitorMuteRuleFilterPerResource"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*MonitorRuleMonitorRuleFacet
+			*MonitorMuteRuleFilterMonitorMuteRuleFilterPerResource
 		}{typename, v}
 		return json.Marshal(result)
-	case *MonitorRuleMonitorRuleLog:
-		typename = "MonitorRuleLog"
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for MonitorMuteRuleFilterMonitorMuteRuleFilterObject: "%T"`, v)
+	}
+}
 
-		result := struct {
+// MonitorMuteRuleFilterMonitorMuteRuleFilterPerColumn includes the requested fields of the GraphQL type MonitorMuteRuleFilterPerColumn.
+type MonitorMuteRuleFilterMonitorMuteRuleFilterPerColumn struct {
+	Typename   *string                   `json:"__typename"`
+	FilterType MonitorMuteRuleFilterType `json:"filterType"`
+	ColumnID   string                    `json:"columnID"`
+	Values     []string                  `json:"values"`
+}
+
+// GetTypename returns MonitorMuteRuleFilterMonitorMuteRuleFilterPerColumn.Typename, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleFilterMonitorMuteRuleFilterPerColumn) GetTypename() *string {
+	return v.Typename
+}
+
+// GetFilterType returns MonitorMuteRuleFilterMonitorMuteRuleFilterPerColumn.FilterType, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleFilterMonitorMuteRuleFilterPerColumn) GetFilterType() MonitorMuteRuleFilterType {
+	return v.FilterType
+}
+
+// GetColumnID returns MonitorMuteRuleFilterMonitorMuteRuleFilterPerColumn.ColumnID, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleFilterMonitorMuteRuleFilterPerColumn) GetColumnID() string { return v.ColumnID }
+
+// GetValues returns MonitorMuteRuleFilterMonitorMuteRuleFilterPerColumn.Values, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleFilterMonitorMuteRuleFilterPerColumn) GetValues() []string { return v.Values }
+
+// MonitorMuteRuleFilterMonitorMuteRuleFilterPerResource includes the requested fields of the GraphQL type MonitorMuteRuleFilterPerResource.
+type MonitorMuteRuleFilterMonitorMuteRuleFilterPerResource struct {
+	Typename   *string                   `json:"__typename"`
+	FilterType MonitorMuteRuleFilterType `json:"filterType"`
+	// resourceIds expect datasetId and the primaryKeyValue.name to be the same across all provided resource ids objects.
+	ResourceIds []MonitorMuteRuleFilterMonitorMuteRuleFilterPerResourceResourceIdsResourceId `json:"resourceIds"`
+}
+
+// GetTypename returns MonitorMuteRuleFilterMonitorMuteRuleFilterPerResource.Typename, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleFilterMonitorMuteRuleFilterPerResource) GetTypename() *string {
+	return v.Typename
+}
+
+// GetFilterType returns MonitorMuteRuleFilterMonitorMuteRuleFilterPerResource.FilterType, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleFilterMonitorMuteRuleFilterPerResource) GetFilterType() MonitorMuteRuleFilterType {
+	return v.FilterType
+}
+
+// GetResourceIds returns MonitorMuteRuleFilterMonitorMuteRuleFilterPerResource.ResourceIds, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleFilterMonitorMuteRuleFilterPerResource) GetResourceIds() []MonitorMuteRuleFilterMonitorMuteRuleFilterPerResourceResourceIdsResourceId {
+	return v.ResourceIds
+}
+
+// MonitorMuteRuleFilterMonitorMuteRuleFilterPerResourceResourceIdsResourceId includes the requested fields of the GraphQL type ResourceId.
+type MonitorMuteRuleFilterMonitorMuteRuleFilterPerResourceResourceIdsResourceId struct {
+	DatasetId       string                                                                                                    `json:"datasetId"`
+	PrimaryKeyValue []MonitorMuteRuleFilterMonitorMuteRuleFilterPerResourceResourceIdsResourceIdPrimaryKeyValueColumnAndValue `json:"primaryKeyValue"`
+}
+
+// GetDatasetId returns MonitorMuteRuleFilterMonitorMuteRuleFilterPerResourceResourceIdsResourceId.DatasetId, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleFilterMonitorMuteRuleFilterPerResourceResourceIdsResourceId) GetDatasetId() string {
+	return v.DatasetId
+}
+
+// GetPrimaryKeyValue returns MonitorMuteRuleFilterMonitorMuteRuleFilterPerResourceResourceIdsResourceId.PrimaryKeyValue, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleFilterMonitorMuteRuleFilterPerResourceResourceIdsResourceId) GetPrimaryKeyValue() []MonitorMuteRuleFilterMonitorMuteRuleFilterPerResourceResourceIdsResourceIdPrimaryKeyValueColumnAndValue {
+	return v.PrimaryKeyValue
+}
+
+// MonitorMuteRuleFilterMonitorMuteRuleFilterPerResourceResourceIdsResourceIdPrimaryKeyValueColumnAndValue includes the requested fields of the GraphQL type ColumnAndValue.
+type MonitorMuteRuleFilterMonitorMuteRuleFilterPerResourceResourceIdsResourceIdPrimaryKeyValueColumnAndValue struct {
+	Name  string  `json:"name"`
+	Value *string `json:"value"`
+}
+
+// GetName returns MonitorMuteRuleFilterMonitorMuteRuleFilterPerResourceResourceIdsResourceIdPrimaryKeyValueColumnAndValue.Name, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleFilterMonitorMuteRuleFilterPerResourceResourceIdsResourceIdPrimaryKeyValueColumnAndValue) GetName() string {
+	return v.Name
+}
+
+// GetValue returns MonitorMuteRuleFilterMonitorMuteRuleFilterPerResourceResourceIdsResourceIdPrimaryKeyValueColumnAndValue.Value, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleFilterMonitorMuteRuleFilterPerResourceResourceIdsResourceIdPrimaryKeyValueColumnAndValue) GetValue() *string {
+	return v.Value
+}
+
+type MonitorMuteRuleFilterPerColumnInput struct {
+	ColumnID string   `json:"columnID"`
+	Values   []string `json:"values"`
+}
+
+// GetColumnID returns MonitorMuteRuleFilterPerColumnInput.ColumnID, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleFilterPerColumnInput) GetColumnID() string { return v.ColumnID }
+
+// GetValues returns MonitorMuteRuleFilterPerColumnInput.Values, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleFilterPerColumnInput) GetValues() []string { return v.Values }
+
+type MonitorMuteRuleFilterPerResourceInput struct {
+	ResourceIds []ResourceIdInput `json:"resourceIds"`
+}
+
+// GetResourceIds returns MonitorMuteRuleFilterPerResourceInput.ResourceIds, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleFilterPerResourceInput) GetResourceIds() []ResourceIdInput {
+	return v.ResourceIds
+}
+
+type MonitorMuteRuleFilterType string
+
+const (
+	MonitorMuteRuleFilterTypeFilterall         MonitorMuteRuleFilterType = "FilterAll"
+	MonitorMuteRuleFilterTypeFilterpercolumn   MonitorMuteRuleFilterType = "FilterPerColumn"
+	MonitorMuteRuleFilterTypeFilterperresource MonitorMuteRuleFilterType = "FilterPerResource"
+)
+
+type MonitorMuteRuleInput struct {
+	StartDate         *types.TimeScalar                      `json:"startDate"`
+	Duration          *types.DurationScalar                  `json:"duration"`
+	FilterPerColumn   *MonitorMuteRuleFilterPerColumnInput   `json:"filterPerColumn"`
+	FilterPerResource *MonitorMuteRuleFilterPerResourceInput `json:"filterPerResource"`
+	WorkspaceId       string                                 `json:"workspaceId"`
+	Name              string                                 `json:"name"`
+	IconUrl           *string                                `json:"iconUrl"`
+	Description       *string                                `json:"description"`
+	ManagedById       *string                                `json:"managedById"`
+	FolderId          *string                                `json:"folderId"`
+}
+
+// GetStartDate returns MonitorMuteRuleInput.StartDate, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleInput) GetStartDate() *types.TimeScalar { return v.StartDate }
+
+// GetDuration returns MonitorMuteRuleInput.Duration, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleInput) GetDuration() *types.DurationScalar { return v.Duration }
+
+// GetFilterPerColumn returns MonitorMuteRuleInput.FilterPerColumn, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleInput) GetFilterPerColumn() *MonitorMuteRuleFilterPerColumnInput {
+	return v.FilterPerColumn
+}
+
+// GetFilterPerResource returns MonitorMuteRuleInput.FilterPerResource, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleInput) GetFilterPerResource() *MonitorMuteRuleFilterPerResourceInput {
+	return v.FilterPerResource
+}
+
+// GetWorkspaceId returns MonitorMuteRuleInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleInput) GetWorkspaceId() string { return v.WorkspaceId }
+
+// GetName returns MonitorMuteRuleInput.Name, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleInput) GetName() string { return v.Name }
+
+// GetIconUrl returns MonitorMuteRuleInput.IconUrl, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleInput) GetIconUrl() *string { return v.IconUrl }
+
+// GetDescription returns MonitorMuteRuleInput.Description, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleInput) GetDescription() *string { return v.Description }
+
+// GetManagedById returns MonitorMuteRuleInput.ManagedById, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleInput) GetManagedById() *string { return v.ManagedById }
+
+// GetFolderId returns MonitorMuteRuleInput.FolderId, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleInput) GetFolderId() *string { return v.FolderId }
+
+// MonitorNotificationSpecNotificationSpecification includes the requested fields of the GraphQL type NotificationSpecification.
+type MonitorNotificationSpecNotificationSpecification struct {
+	// should these go in each applicable Rule instead?
+	Merge             *NotificationMerge     `json:"merge"`
+	Importance        NotificationImportance `json:"importance"`
+	NotifyOnReminder  *bool                  `json:"notifyOnReminder"`
+	ReminderFrequency types.DurationScalar   `json:"reminderFrequency"`
+	NotifyOnClose     *bool                  `json:"notifyOnClose"`
+}
+
+// GetMerge returns MonitorNotificationSpecNotificationSpecification.Merge, and is useful for accessing the field via an interface.
+func (v *MonitorNotificationSpecNotificationSpecification) GetMerge() *NotificationMerge {
+	return v.Merge
+}
+
+// GetImportance returns MonitorNotificationSpecNotificationSpecification.Importance, and is useful for accessing the field via an interface.
+func (v *MonitorNotificationSpecNotificationSpecification) GetImportance() NotificationImportance {
+	return v.Importance
+}
+
+// GetNotifyOnReminder returns MonitorNotificationSpecNotificationSpecification.NotifyOnReminder, and is useful for accessing the field via an interface.
+func (v *MonitorNotificationSpecNotificationSpecification) GetNotifyOnReminder() *bool {
+	return v.NotifyOnReminder
+}
+
+// GetReminderFrequency returns MonitorNotificationSpecNotificationSpecification.ReminderFrequency, and is useful for accessing the field via an interface.
+func (v *MonitorNotificationSpecNotificationSpecification) GetReminderFrequency() types.DurationScalar {
+	return v.ReminderFrequency
+}
+
+// GetNotifyOnClose returns MonitorNotificationSpecNotificationSpecification.NotifyOnClose, and is useful for accessing the field via an interface.
+func (v *MonitorNotificationSpecNotificationSpecification) GetNotifyOnClose() *bool {
+	return v.NotifyOnClose
+}
+
+// MonitorQueryMultiStageQuery includes the requested fields of the GraphQL type MultiStageQuery.
+type MonitorQueryMultiStageQuery struct {
+	OutputStage string       `json:"outputStage"`
+	Stages      []StageQuery `json:"stages"`
+}
+
+// GetOutputStage returns MonitorQueryMultiStageQuery.OutputStage, and is useful for accessing the field via an interface.
+func (v *MonitorQueryMultiStageQuery) GetOutputStage() string { return v.OutputStage }
+
+// GetStages returns MonitorQueryMultiStageQuery.Stages, and is useful for accessing the field via an interface.
+func (v *MonitorQueryMultiStageQuery) GetStages() []StageQuery { return v.Stages }
+
+// MonitorRule includes the requested fields of the GraphQL interface MonitorRule.
+//
+// MonitorRule is implemented by the following types:
+// MonitorRuleMonitorRuleChange
+// MonitorRuleMonitorRuleCount
+// MonitorRuleMonitorRuleFacet
+// MonitorRuleMonitorRuleLog
+// MonitorRuleMonitorRulePromote
+// MonitorRuleMonitorRuleThreshold
+type MonitorRule interface {
+	implementsGraphQLInterfaceMonitorRule()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() *string
+	// GetSourceColumn returns the interface-field "sourceColumn" from its implementation.
+	GetSourceColumn() string
+	// GetGroupByGroups returns the interface-field "groupByGroups" from its implementation.
+	GetGroupByGroups() []MonitorRuleGroupByGroupsMonitorGroupInfo
+}
+
+func (v *MonitorRuleMonitorRuleChange) implementsGraphQLInterfaceMonitorRule()    {}
+func (v *MonitorRuleMonitorRuleCount) implementsGraphQLInterfaceMonitorRule()     {}
+func (v *MonitorRuleMonitorRuleFacet) implementsGraphQLInterfaceMonitorRule()     {}
+func (v *MonitorRuleMonitorRuleLog) implementsGraphQLInterfaceMonitorRule()       {}
+func (v *MonitorRuleMonitorRulePromote) implementsGraphQLInterfaceMonitorRule()   {}
+func (v *MonitorRuleMonitorRuleThreshold) implementsGraphQLInterfaceMonitorRule() {}
+
+func __unmarshalMonitorRule(b []byte, v *MonitorRule) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "MonitorRuleChange":
+		*v = new(MonitorRuleMonitorRuleChange)
+		return json.Unmarshal(b, *v)
+	case "MonitorRuleCount":
+		*v = new(MonitorRuleMonitorRuleCount)
+		return json.Unmarshal(b, *v)
+	case "MonitorRuleFacet":
+		*v = new(MonitorRuleMonitorRuleFacet)
+		return json.Unmarshal(b, *v)
+	case "MonitorRuleLog":
+		*v = new(MonitorRuleMonitorRuleLog)
+		return json.Unmarshal(b, *v)
+	case "MonitorRulePromote":
+		*v = new(MonitorRuleMonitorRulePromote)
+		return json.Unmarshal(b, *v)
+	case "MonitorRuleThreshold":
+		*v = new(MonitorRuleMonitorRuleThreshold)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing MonitorRule.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for MonitorRule: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalMonitorRule(v *MonitorRule) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *MonitorRuleMonitorRuleChange:
+		typename = "MonitorRuleChange"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*MonitorRuleMonitorRuleChange
+		}{typename, v}
+		return json.Marshal(result)
+	case *MonitorRuleMonitorRuleCount:
+		typename = "MonitorRuleCount"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*MonitorRuleMonitorRuleCount
+		}{typename, v}
+		return json.Marshal(result)
+	case *MonitorRuleMonitorRuleFacet:
+		typename = "MonitorRuleFacet"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*MonitorRuleMonitorRuleFacet
+		}{typename, v}
+		return json.Marshal(result)
+	case *MonitorRuleMonitorRuleLog:
+		typename = "MonitorRuleLog"
+
+		result := struct {
 			TypeName string `json:"__typename"`
 			*MonitorRuleMonitorRuleLog
 		}{typename, v}
@@ -5610,6 +7073,26 @@ const (
 	NullOrderingLast    NullOrdering = "Last"
 )
 
+// ObjectBillingInfo includes the GraphQL fields of ObjectBillingInfo requested by the fragment ObjectBillingInfo.
+type ObjectBillingInfo struct {
+	Id         string           `json:"id"`
+	PeriodFrom types.TimeScalar `json:"periodFrom"`
+	PeriodTo   types.TimeScalar `json:"periodTo"`
+	Credits    float64          `json:"credits"`
+}
+
+// GetId returns ObjectBillingInfo.Id, and is useful for accessing the field via an interface.
+func (v *ObjectBillingInfo) GetId() string { return v.Id }
+
+// GetPeriodFrom returns ObjectBillingInfo.PeriodFrom, and is useful for accessing the field via an interface.
+func (v *ObjectBillingInfo) GetPeriodFrom() types.TimeScalar { return v.PeriodFrom }
+
+// GetPeriodTo returns ObjectBillingInfo.PeriodTo, and is useful for accessing the field via an interface.
+func (v *ObjectBillingInfo) GetPeriodTo() types.TimeScalar { return v.PeriodTo }
+
+// GetCredits returns ObjectBillingInfo.Credits, and is useful for accessing the field via an interface.
+func (v *ObjectBillingInfo) GetCredits() float64 { return v.Credits }
+
 // At some point in the future, we may have Segments as business objects,
 // and be able to bookmark them. Technically, we can bookmark bookmark groups, but
 // there is no current UI using that feature.
@@ -5670,6 +7153,26 @@ func (v *ParameterBindingInput) GetId() string { return v.Id }
 // GetValue returns ParameterBindingInput.Value, and is useful for accessing the field via an interface.
 func (v *ParameterBindingInput) GetValue() types.Value { return v.Value }
 
+type ParameterSearchInput struct {
+	// name will do case insensitive substring match against the name AND id of the parameter
+	Name     []string           `json:"name"`
+	Kind     []ValueType        `json:"kind"`
+	Resource []string           `json:"resource"`
+	Input    []InputSearchInput `json:"input"`
+}
+
+// GetName returns ParameterSearchInput.Name, and is useful for accessing the field via an interface.
+func (v *ParameterSearchInput) GetName() []string { return v.Name }
+
+// GetKind returns ParameterSearchInput.Kind, and is useful for accessing the field via an interface.
+func (v *ParameterSearchInput) GetKind() []ValueType { return v.Kind }
+
+// GetResource returns ParameterSearchInput.Resource, and is useful for accessing the field via an interface.
+func (v *ParameterSearchInput) GetResource() []string { return v.Resource }
+
+// GetInput returns ParameterSearchInput.Input, and is useful for accessing the field via an interface.
+func (v *ParameterSearchInput) GetInput() []InputSearchInput { return v.Input }
+
 // Whever you can "save" a worksheet-like entity, you can also save the
 // parameters that go with it. This is so that the worksheet component in the FE
 // can have a unified API to work against. You can also save the parameterValues
@@ -7392,6 +8895,51 @@ func (v *RbacObjectInput) GetOwner() *bool { return v.Owner }
 // GetAll returns RbacObjectInput.All, and is useful for accessing the field via an interface.
 func (v *RbacObjectInput) GetAll() *bool { return v.All }
 
+type RbacRequestInput struct {
+	Object RbacRequestObjectInput `json:"object"`
+	Role   RbacRole               `json:"role"`
+}
+
+// GetObject returns RbacRequestInput.Object, and is useful for accessing the field via an interface.
+func (v *RbacRequestInput) GetObject() RbacRequestObjectInput { return v.Object }
+
+// GetRole returns RbacRequestInput.Role, and is useful for accessing the field via an interface.
+func (v *RbacRequestInput) GetRole() RbacRole { return v.Role }
+
+// A RequestObject is different from an Object, because the RequestObject
+// provides all of the values, such that each Statement can match against
+// it based on its own scoped values. For values that aren't possible to
+// determine (mainly, folder for things not in folders, or objectid for
+// non-ID components like 'superadmin') provide the literal "0". These
+// are only used as inputs, when attempting to pre-flight some particular
+// RBAC check.
+type RbacRequestObjectInput struct {
+	ObjectId    string `json:"objectId"`
+	FolderId    string `json:"folderId"`
+	WorkspaceId string `json:"workspaceId"`
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	IsOwner     bool   `json:"isOwner"`
+}
+
+// GetObjectId returns RbacRequestObjectInput.ObjectId, and is useful for accessing the field via an interface.
+func (v *RbacRequestObjectInput) GetObjectId() string { return v.ObjectId }
+
+// GetFolderId returns RbacRequestObjectInput.FolderId, and is useful for accessing the field via an interface.
+func (v *RbacRequestObjectInput) GetFolderId() string { return v.FolderId }
+
+// GetWorkspaceId returns RbacRequestObjectInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *RbacRequestObjectInput) GetWorkspaceId() string { return v.WorkspaceId }
+
+// GetType returns RbacRequestObjectInput.Type, and is useful for accessing the field via an interface.
+func (v *RbacRequestObjectInput) GetType() string { return v.Type }
+
+// GetName returns RbacRequestObjectInput.Name, and is useful for accessing the field via an interface.
+func (v *RbacRequestObjectInput) GetName() string { return v.Name }
+
+// GetIsOwner returns RbacRequestObjectInput.IsOwner, and is useful for accessing the field via an interface.
+func (v *RbacRequestObjectInput) GetIsOwner() bool { return v.IsOwner }
+
 type RbacRole string
 
 const (
@@ -7508,37 +9056,108 @@ func (v *RbacSubjectInput) GetGroupId() *string { return v.GroupId }
 // GetAll returns RbacSubjectInput.All, and is useful for accessing the field via an interface.
 func (v *RbacSubjectInput) GetAll() *bool { return v.All }
 
-type ResourceIdInput struct {
-	DatasetId       string                `json:"datasetId"`
-	PrimaryKeyValue []ColumnAndValueInput `json:"primaryKeyValue"`
-	TimeRange       *TimeRangeInput       `json:"timeRange"`
+// RelationshipPath includes the GraphQL fields of RelationshipPath requested by the fragment RelationshipPath.
+type RelationshipPath struct {
+	FromDatasetId string                                        `json:"fromDatasetId"`
+	ToDatasetId   string                                        `json:"toDatasetId"`
+	Cost          types.Int64Scalar                             `json:"cost"`
+	Path          []RelationshipPathPathRelationshipPathElement `json:"path"`
 }
 
-// GetDatasetId returns ResourceIdInput.DatasetId, and is useful for accessing the field via an interface.
-func (v *ResourceIdInput) GetDatasetId() string { return v.DatasetId }
+// GetFromDatasetId returns RelationshipPath.FromDatasetId, and is useful for accessing the field via an interface.
+func (v *RelationshipPath) GetFromDatasetId() string { return v.FromDatasetId }
 
-// GetPrimaryKeyValue returns ResourceIdInput.PrimaryKeyValue, and is useful for accessing the field via an interface.
-func (v *ResourceIdInput) GetPrimaryKeyValue() []ColumnAndValueInput { return v.PrimaryKeyValue }
+// GetToDatasetId returns RelationshipPath.ToDatasetId, and is useful for accessing the field via an interface.
+func (v *RelationshipPath) GetToDatasetId() string { return v.ToDatasetId }
 
-// GetTimeRange returns ResourceIdInput.TimeRange, and is useful for accessing the field via an interface.
-func (v *ResourceIdInput) GetTimeRange() *TimeRangeInput { return v.TimeRange }
+// GetCost returns RelationshipPath.Cost, and is useful for accessing the field via an interface.
+func (v *RelationshipPath) GetCost() types.Int64Scalar { return v.Cost }
 
-// What pieces of result to include in the response to an OPAL query.
-type ResultKind string
+// GetPath returns RelationshipPath.Path, and is useful for accessing the field via an interface.
+func (v *RelationshipPath) GetPath() []RelationshipPathPathRelationshipPathElement { return v.Path }
 
-const (
-	ResultKindResultkindschema          ResultKind = "ResultKindSchema"
-	ResultKindResultkinddata            ResultKind = "ResultKindData"
-	ResultKindResultkindstats           ResultKind = "ResultKindStats"
-	ResultKindResultkindcolumnstats     ResultKind = "ResultKindColumnStats"
-	ResultKindResultkindvolumestats     ResultKind = "ResultKindVolumeStats"
-	ResultKindResultkindsuppress        ResultKind = "ResultKindSuppress"
-	ResultKindResultkindprogress        ResultKind = "ResultKindProgress"
-	ResultKindResultkindmetricdiscovery ResultKind = "ResultKindMetricDiscovery"
-)
+// RelationshipPathPathRelationshipPathElement includes the requested fields of the GraphQL type RelationshipPathElement.
+type RelationshipPathPathRelationshipPathElement struct {
+	ToDatasetId string `json:"toDatasetId"`
+	// one of forwardKey or backwardKey will be used
+	ForwardKey *RelationshipPathPathRelationshipPathElementForwardKeyForeignKey `json:"forwardKey"`
+	ReverseKey *RelationshipPathPathRelationshipPathElementReverseKeyRelatedKey `json:"reverseKey"`
+}
 
-// ResultStatus includes the GraphQL fields of ResultStatus requested by the fragment ResultStatus.
-type ResultStatus struct {
+// GetToDatasetId returns RelationshipPathPathRelationshipPathElement.ToDatasetId, and is useful for accessing the field via an interface.
+func (v *RelationshipPathPathRelationshipPathElement) GetToDatasetId() string { return v.ToDatasetId }
+
+// GetForwardKey returns RelationshipPathPathRelationshipPathElement.ForwardKey, and is useful for accessing the field via an interface.
+func (v *RelationshipPathPathRelationshipPathElement) GetForwardKey() *RelationshipPathPathRelationshipPathElementForwardKeyForeignKey {
+	return v.ForwardKey
+}
+
+// GetReverseKey returns RelationshipPathPathRelationshipPathElement.ReverseKey, and is useful for accessing the field via an interface.
+func (v *RelationshipPathPathRelationshipPathElement) GetReverseKey() *RelationshipPathPathRelationshipPathElementReverseKeyRelatedKey {
+	return v.ReverseKey
+}
+
+// RelationshipPathPathRelationshipPathElementForwardKeyForeignKey includes the requested fields of the GraphQL type ForeignKey.
+type RelationshipPathPathRelationshipPathElementForwardKeyForeignKey struct {
+	Id    *string `json:"id"`
+	Label *string `json:"label"`
+}
+
+// GetId returns RelationshipPathPathRelationshipPathElementForwardKeyForeignKey.Id, and is useful for accessing the field via an interface.
+func (v *RelationshipPathPathRelationshipPathElementForwardKeyForeignKey) GetId() *string {
+	return v.Id
+}
+
+// GetLabel returns RelationshipPathPathRelationshipPathElementForwardKeyForeignKey.Label, and is useful for accessing the field via an interface.
+func (v *RelationshipPathPathRelationshipPathElementForwardKeyForeignKey) GetLabel() *string {
+	return v.Label
+}
+
+// RelationshipPathPathRelationshipPathElementReverseKeyRelatedKey includes the requested fields of the GraphQL type RelatedKey.
+// The GraphQL type's documentation follows.
+//
+// A RelatedKey is like a ForeignKey, but it may not be a full
+// primary key to the target dataset.
+type RelationshipPathPathRelationshipPathElementReverseKeyRelatedKey struct {
+	Label string `json:"label"`
+}
+
+// GetLabel returns RelationshipPathPathRelationshipPathElementReverseKeyRelatedKey.Label, and is useful for accessing the field via an interface.
+func (v *RelationshipPathPathRelationshipPathElementReverseKeyRelatedKey) GetLabel() string {
+	return v.Label
+}
+
+type ResourceIdInput struct {
+	DatasetId       string                `json:"datasetId"`
+	PrimaryKeyValue []ColumnAndValueInput `json:"primaryKeyValue"`
+	TimeRange       *TimeRangeInput       `json:"timeRange"`
+}
+
+// GetDatasetId returns ResourceIdInput.DatasetId, and is useful for accessing the field via an interface.
+func (v *ResourceIdInput) GetDatasetId() string { return v.DatasetId }
+
+// GetPrimaryKeyValue returns ResourceIdInput.PrimaryKeyValue, and is useful for accessing the field via an interface.
+func (v *ResourceIdInput) GetPrimaryKeyValue() []ColumnAndValueInput { return v.PrimaryKeyValue }
+
+// GetTimeRange returns ResourceIdInput.TimeRange, and is useful for accessing the field via an interface.
+func (v *ResourceIdInput) GetTimeRange() *TimeRangeInput { return v.TimeRange }
+
+// What pieces of result to include in the response to an OPAL query.
+type ResultKind string
+
+const (
+	ResultKindResultkindschema          ResultKind = "ResultKindSchema"
+	ResultKindResultkinddata            ResultKind = "ResultKindData"
+	ResultKindResultkindstats           ResultKind = "ResultKindStats"
+	ResultKindResultkindcolumnstats     ResultKind = "ResultKindColumnStats"
+	ResultKindResultkindvolumestats     ResultKind = "ResultKindVolumeStats"
+	ResultKindResultkindsuppress        ResultKind = "ResultKindSuppress"
+	ResultKindResultkindprogress        ResultKind = "ResultKindProgress"
+	ResultKindResultkindmetricdiscovery ResultKind = "ResultKindMetricDiscovery"
+)
+
+// ResultStatus includes the GraphQL fields of ResultStatus requested by the fragment ResultStatus.
+type ResultStatus struct {
 	Success      bool              `json:"success"`
 	ErrorMessage string            `json:"errorMessage"`
 	DetailedInfo *types.JsonObject `json:"detailedInfo"`
@@ -7633,6 +9252,13 @@ const (
 	SearchMatchKindSearchmatchcolumns SearchMatchKind = "SearchMatchColumns"
 )
 
+type SearchMode string
+
+const (
+	SearchModeInclusivemode SearchMode = "InclusiveMode"
+	SearchModeExclusivemode SearchMode = "ExclusiveMode"
+)
+
 // SettingAndTargetScope includes the GraphQL fields of SettingAndTargetScope requested by the fragment SettingAndTargetScope.
 type SettingAndTargetScope struct {
 	Setting string                     `json:"setting"`
@@ -7656,6 +9282,14 @@ func (v *SettingAndTargetScopeInput) GetSetting() string { return v.Setting }
 // GetTarget returns SettingAndTargetScopeInput.Target, and is useful for accessing the field via an interface.
 func (v *SettingAndTargetScopeInput) GetTarget() LayeredSettingRecordTargetInput { return v.Target }
 
+type SimpleScope string
+
+const (
+	SimpleScopeScopeuser     SimpleScope = "ScopeUser"
+	SimpleScopeScopecustomer SimpleScope = "ScopeCustomer"
+	SimpleScopeScopeworld    SimpleScope = "ScopeWorld"
+)
+
 // SnowflakeAccount includes the GraphQL fields of SnowflakeAccount requested by the fragment SnowflakeAccount.
 type SnowflakeAccount struct {
 	// The name of the organization that owns the Snowflake account.
@@ -8085,6 +9719,8 @@ type TaskResult struct {
 	EndTime   *types.TimeScalar `json:"endTime"`
 	// You used to paginate the data yourself out of S3 -- not needed anymore
 	ResultCursor *interface{} `json:"resultCursor"`
+	// Read the results you asked for, through the apiserver
+	PaginatedResults *types.PaginatedResults `json:"paginatedResults"`
 	// how to understand the columns in the result from Snowflake --
 	ResultSchema *TaskResultResultSchemaTaskResultSchema `json:"resultSchema"`
 }
@@ -8104,6 +9740,9 @@ func (v *TaskResult) GetEndTime() *types.TimeScalar { return v.EndTime }
 // GetResultCursor returns TaskResult.ResultCursor, and is useful for accessing the field via an interface.
 func (v *TaskResult) GetResultCursor() *interface{} { return v.ResultCursor }
 
+// GetPaginatedResults returns TaskResult.PaginatedResults, and is useful for accessing the field via an interface.
+func (v *TaskResult) GetPaginatedResults() *types.PaginatedResults { return v.PaginatedResults }
+
 // GetResultSchema returns TaskResult.ResultSchema, and is useful for accessing the field via an interface.
 func (v *TaskResult) GetResultSchema() *TaskResultResultSchemaTaskResultSchema { return v.ResultSchema }
 
@@ -8200,10 +9839,37 @@ const (
 	TimeUnitNanosecond  TimeUnit = "Nanosecond"
 )
 
+type UpdateRbacStatementInput struct {
+	Id          string           `json:"id"`
+	Description string           `json:"description"`
+	Subject     RbacSubjectInput `json:"subject"`
+	Object      RbacObjectInput  `json:"object"`
+	Role        RbacRole         `json:"role"`
+}
+
+// GetId returns UpdateRbacStatementInput.Id, and is useful for accessing the field via an interface.
+func (v *UpdateRbacStatementInput) GetId() string { return v.Id }
+
+// GetDescription returns UpdateRbacStatementInput.Description, and is useful for accessing the field via an interface.
+func (v *UpdateRbacStatementInput) GetDescription() string { return v.Description }
+
+// GetSubject returns UpdateRbacStatementInput.Subject, and is useful for accessing the field via an interface.
+func (v *UpdateRbacStatementInput) GetSubject() RbacSubjectInput { return v.Subject }
+
+// GetObject returns UpdateRbacStatementInput.Object, and is useful for accessing the field via an interface.
+func (v *UpdateRbacStatementInput) GetObject() RbacObjectInput { return v.Object }
+
+// GetRole returns UpdateRbacStatementInput.Role, and is useful for accessing the field via an interface.
+func (v *UpdateRbacStatementInput) GetRole() RbacRole { return v.Role }
+
 // User includes the GraphQL fields of User requested by the fragment User.
 type User struct {
 	Id      types.UserIdScalar `json:"id"`
 	Email   string             `json:"email"`
+	Label   string             `json:"label"`
+	Role    string             `json:"role"`
+	Status  UserStatus         `json:"status"`
+	Type    []UserType         `json:"type"`
 	Comment *string            `json:"comment"`
 }
 
@@ -8213,9 +9879,82 @@ func (v *User) GetId() types.UserIdScalar { return v.Id }
 // GetEmail returns User.Email, and is useful for accessing the field via an interface.
 func (v *User) GetEmail() string { return v.Email }
 
+// GetLabel returns User.Label, and is useful for accessing the field via an interface.
+func (v *User) GetLabel() string { return v.Label }
+
+// GetRole returns User.Role, and is useful for accessing the field via an interface.
+func (v *User) GetRole() string { return v.Role }
+
+// GetStatus returns User.Status, and is useful for accessing the field via an interface.
+func (v *User) GetStatus() UserStatus { return v.Status }
+
+// GetType returns User.Type, and is useful for accessing the field via an interface.
+func (v *User) GetType() []UserType { return v.Type }
+
 // GetComment returns User.Comment, and is useful for accessing the field via an interface.
 func (v *User) GetComment() *string { return v.Comment }
 
+type UserInput struct {
+	// cannot update
+	Email *string `json:"email,omitempty"`
+	// self or admin privilege required to update
+	Label    *string `json:"label,omitempty"`
+	Timezone *string `json:"timezone,omitempty"`
+	Locale   *string `json:"locale,omitempty"`
+	// admin privilege required to update
+	Role           *string           `json:"role,omitempty"`
+	Comment        *string           `json:"comment,omitempty"`
+	ExpirationTime *types.TimeScalar `json:"expirationTime,omitempty"`
+	Status         *UserStatus       `json:"status,omitempty"`
+	RbacGroups     []string          `json:"rbacGroups,omitempty"`
+}
+
+// GetEmail returns UserInput.Email, and is useful for accessing the field via an interface.
+func (v *UserInput) GetEmail() *string { return v.Email }
+
+// GetLabel returns UserInput.Label, and is useful for accessing the field via an interface.
+func (v *UserInput) GetLabel() *string { return v.Label }
+
+// GetTimezone returns UserInput.Timezone, and is useful for accessing the field via an interface.
+func (v *UserInput) GetTimezone() *string { return v.Timezone }
+
+// GetLocale returns UserInput.Locale, and is useful for accessing the field via an interface.
+func (v *UserInput) GetLocale() *string { return v.Locale }
+
+// GetRole returns UserInput.Role, and is useful for accessing the field via an interface.
+func (v *UserInput) GetRole() *string { return v.Role }
+
+// GetComment returns UserInput.Comment, and is useful for accessing the field via an interface.
+func (v *UserInput) GetComment() *string { return v.Comment }
+
+// GetExpirationTime returns UserInput.ExpirationTime, and is useful for accessing the field via an interface.
+func (v *UserInput) GetExpirationTime() *types.TimeScalar { return v.ExpirationTime }
+
+// GetStatus returns UserInput.Status, and is useful for accessing the field via an interface.
+func (v *UserInput) GetStatus() *UserStatus { return v.Status }
+
+// GetRbacGroups returns UserInput.RbacGroups, and is useful for accessing the field via an interface.
+func (v *UserInput) GetRbacGroups() []string { return v.RbacGroups }
+
+type UserStatus string
+
+const (
+	UserStatusUserstatusdeleted     UserStatus = "UserStatusDeleted"
+	UserStatusUserstatusdisabled    UserStatus = "UserStatusDisabled"
+	UserStatusUserstatusidpdisabled UserStatus = "UserStatusIdpDisabled"
+	UserStatusUserstatuscreated     UserStatus = "UserStatusCreated"
+	UserStatusUserstatusactive      UserStatus = "UserStatusActive"
+)
+
+type UserType string
+
+const (
+	UserTypeUsertypeemail  UserType = "UserTypeEmail"
+	UserTypeUsertypeoauth2 UserType = "UserTypeOauth2"
+	UserTypeUsertypesaml2  UserType = "UserTypeSaml2"
+	UserTypeUsertypesystem UserType = "UserTypeSystem"
+)
+
 // These are the OPAL native types that can go into worksheet parameters.  Some
 // of the native OPAL types aren't (currently?) exposed to the worksheet
 // parameters, but it's likely we will expand this to the full roster over time.
@@ -8388,6 +10127,50 @@ func (v *WorksheetInput) GetLabel() *string { return v.Label }
 // GetIcon returns WorksheetInput.Icon, and is useful for accessing the field via an interface.
 func (v *WorksheetInput) GetIcon() *string { return v.Icon }
 
+// WorksheetSearchResult includes the GraphQL fields of WorksheetSearchResult requested by the fragment WorksheetSearchResult.
+type WorksheetSearchResult struct {
+	Score     types.Int64Scalar              `json:"score"`
+	Worksheet WorksheetSearchResultWorksheet `json:"worksheet"`
+}
+
+// GetScore returns WorksheetSearchResult.Score, and is useful for accessing the field via an interface.
+func (v *WorksheetSearchResult) GetScore() types.Int64Scalar { return v.Score }
+
+// GetWorksheet returns WorksheetSearchResult.Worksheet, and is useful for accessing the field via an interface.
+func (v *WorksheetSearchResult) GetWorksheet() WorksheetSearchResultWorksheet { return v.Worksheet }
+
+// WorksheetSearchResultWorksheet includes the requested fields of the GraphQL type Worksheet.
+type WorksheetSearchResultWorksheet struct {
+	Id          string           `json:"id"`
+	Name        string           `json:"name"`
+	Description *string          `json:"description"`
+	IconUrl     *string          `json:"iconUrl"`
+	WorkspaceId string           `json:"workspaceId"`
+	FolderId    string           `json:"folderId"`
+	UpdatedDate types.TimeScalar `json:"updatedDate"`
+}
+
+// GetId returns WorksheetSearchResultWorksheet.Id, and is useful for accessing the field via an interface.
+func (v *WorksheetSearchResultWorksheet) GetId() string { return v.Id }
+
+// GetName returns WorksheetSearchResultWorksheet.Name, and is useful for accessing the field via an interface.
+func (v *WorksheetSearchResultWorksheet) GetName() string { return v.Name }
+
+// GetDescription returns WorksheetSearchResultWorksheet.Description, and is useful for accessing the field via an interface.
+func (v *WorksheetSearchResultWorksheet) GetDescription() *string { return v.Description }
+
+// GetIconUrl returns WorksheetSearchResultWorksheet.IconUrl, and is useful for accessing the field via an interface.
+func (v *WorksheetSearchResultWorksheet) GetIconUrl() *string { return v.IconUrl }
+
+// GetWorkspaceId returns WorksheetSearchResultWorksheet.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *WorksheetSearchResultWorksheet) GetWorkspaceId() string { return v.WorkspaceId }
+
+// GetFolderId returns WorksheetSearchResultWorksheet.FolderId, and is useful for accessing the field via an interface.
+func (v *WorksheetSearchResultWorksheet) GetFolderId() string { return v.FolderId }
+
+// GetUpdatedDate returns WorksheetSearchResultWorksheet.UpdatedDate, and is useful for accessing the field via an interface.
+func (v *WorksheetSearchResultWorksheet) GetUpdatedDate() types.TimeScalar { return v.UpdatedDate }
+
 // Workspace includes the GraphQL fields of Project requested by the fragment Workspace.
 // The GraphQL type's documentation follows.
 //
@@ -8440,6 +10223,64 @@ func (v *__addCorrelationTagInput) GetPath() LinkFieldInput { return v.Path }
 // GetTag returns __addCorrelationTagInput.Tag, and is useful for accessing the field via an interface.
 func (v *__addCorrelationTagInput) GetTag() string { return v.Tag }
 
+// __addIncidentDashboardsInput is used internally by genqlient
+type __addIncidentDashboardsInput struct {
+	Id         string   `json:"id"`
+	Dashboards []string `json:"dashboards"`
+}
+
+// GetId returns __addIncidentDashboardsInput.Id, and is useful for accessing the field via an interface.
+func (v *__addIncidentDashboardsInput) GetId() string { return v.Id }
+
+// GetDashboards returns __addIncidentDashboardsInput.Dashboards, and is useful for accessing the field via an interface.
+func (v *__addIncidentDashboardsInput) GetDashboards() []string { return v.Dashboards }
+
+// __addIncidentSlackchannelsInput is used internally by genqlient
+type __addIncidentSlackchannelsInput struct {
+	Id       string                      `json:"id"`
+	Channels []IncidentSlackchannelInput `json:"channels"`
+}
+
+// GetId returns __addIncidentSlackchannelsInput.Id, and is useful for accessing the field via an interface.
+func (v *__addIncidentSlackchannelsInput) GetId() string { return v.Id }
+
+// GetChannels returns __addIncidentSlackchannelsInput.Channels, and is useful for accessing the field via an interface.
+func (v *__addIncidentSlackchannelsInput) GetChannels() []IncidentSlackchannelInput {
+	return v.Channels
+}
+
+// __addIncidentUsersInput is used internally by genqlient
+type __addIncidentUsersInput struct {
+	Id    string               `json:"id"`
+	Users []types.UserIdScalar `json:"users"`
+}
+
+// GetId returns __addIncidentUsersInput.Id, and is useful for accessing the field via an interface.
+func (v *__addIncidentUsersInput) GetId() string { return v.Id }
+
+// GetUsers returns __addIncidentUsersInput.Users, and is useful for accessing the field via an interface.
+func (v *__addIncidentUsersInput) GetUsers() []types.UserIdScalar { return v.Users }
+
+// __addIncidentWorksheetsInput is used internally by genqlient
+type __addIncidentWorksheetsInput struct {
+	Id         string   `json:"id"`
+	Worksheets []string `json:"worksheets"`
+}
+
+// GetId returns __addIncidentWorksheetsInput.Id, and is useful for accessing the field via an interface.
+func (v *__addIncidentWorksheetsInput) GetId() string { return v.Id }
+
+// GetWorksheets returns __addIncidentWorksheetsInput.Worksheets, and is useful for accessing the field via an interface.
+func (v *__addIncidentWorksheetsInput) GetWorksheets() []string { return v.Worksheets }
+
+// __cancelAccelerationJobInput is used internally by genqlient
+type __cancelAccelerationJobInput struct {
+	JobId string `json:"jobId"`
+}
+
+// GetJobId returns __cancelAccelerationJobInput.JobId, and is useful for accessing the field via an interface.
+func (v *__cancelAccelerationJobInput) GetJobId() string { return v.JobId }
+
 // __clearDefaultDashboardInput is used internally by genqlient
 type __clearDefaultDashboardInput struct {
 	Dsid string `json:"dsid"`
@@ -8448,6 +10289,14 @@ type __clearDefaultDashboardInput struct {
 // GetDsid returns __clearDefaultDashboardInput.Dsid, and is useful for accessing the field via an interface.
 func (v *__clearDefaultDashboardInput) GetDsid() string { return v.Dsid }
 
+// __createAccelerationJobInput is used internally by genqlient
+type __createAccelerationJobInput struct {
+	Job AccelerationJobInput `json:"job"`
+}
+
+// GetJob returns __createAccelerationJobInput.Job, and is useful for accessing the field via an interface.
+func (v *__createAccelerationJobInput) GetJob() AccelerationJobInput { return v.Job }
+
 // __createAppDataSourceInput is used internally by genqlient
 type __createAppDataSourceInput struct {
 	Config AppDataSourceInput `json:"config"`
@@ -8468,6 +10317,26 @@ func (v *__createAppInput) GetWorkspaceId() string { return v.WorkspaceId }
 // GetConfig returns __createAppInput.Config, and is useful for accessing the field via an interface.
 func (v *__createAppInput) GetConfig() AppInput { return v.Config }
 
+// __createBlobInput is used internally by genqlient
+type __createBlobInput struct {
+	UserId        *types.UserIdScalar `json:"userId"`
+	Name          string              `json:"name"`
+	Info          BlobInput           `json:"info"`
+	MakePermanent *bool               `json:"makePermanent"`
+}
+
+// GetUserId returns __createBlobInput.UserId, and is useful for accessing the field via an interface.
+func (v *__createBlobInput) GetUserId() *types.UserIdScalar { return v.UserId }
+
+// GetName returns __createBlobInput.Name, and is useful for accessing the field via an interface.
+func (v *__createBlobInput) GetName() string { return v.Name }
+
+// GetInfo returns __createBlobInput.Info, and is useful for accessing the field via an interface.
+func (v *__createBlobInput) GetInfo() BlobInput { return v.Info }
+
+// GetMakePermanent returns __createBlobInput.MakePermanent, and is useful for accessing the field via an interface.
+func (v *__createBlobInput) GetMakePermanent() *bool { return v.MakePermanent }
+
 // __createBoardInput is used internally by genqlient
 type __createBoardInput struct {
 	DatasetId string     `json:"datasetId"`
@@ -8604,6 +10473,18 @@ func (v *__createFolderInput) GetWorkspaceId() string { return v.WorkspaceId }
 // GetConfig returns __createFolderInput.Config, and is useful for accessing the field via an interface.
 func (v *__createFolderInput) GetConfig() FolderInput { return v.Config }
 
+// __createIncidentInput is used internally by genqlient
+type __createIncidentInput struct {
+	WorkspaceId string        `json:"workspaceId"`
+	Input       IncidentInput `json:"input"`
+}
+
+// GetWorkspaceId returns __createIncidentInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *__createIncidentInput) GetWorkspaceId() string { return v.WorkspaceId }
+
+// GetInput returns __createIncidentInput.Input, and is useful for accessing the field via an interface.
+func (v *__createIncidentInput) GetInput() IncidentInput { return v.Input }
+
 // __createLayeredSettingRecordInput is used internally by genqlient
 type __createLayeredSettingRecordInput struct {
 	SettingRecord LayeredSettingRecordInput `json:"settingRecord"`
@@ -8644,6 +10525,18 @@ func (v *__createMonitorInput) GetWorkspaceId() string { return v.WorkspaceId }
 // GetMonitor returns __createMonitorInput.Monitor, and is useful for accessing the field via an interface.
 func (v *__createMonitorInput) GetMonitor() MonitorInput { return v.Monitor }
 
+// __createMonitorMuteRuleForMonitorsInput is used internally by genqlient
+type __createMonitorMuteRuleForMonitorsInput struct {
+	Input      MonitorMuteRuleInput `json:"input"`
+	MonitorIds []string             `json:"monitorIds"`
+}
+
+// GetInput returns __createMonitorMuteRuleForMonitorsInput.Input, and is useful for accessing the field via an interface.
+func (v *__createMonitorMuteRuleForMonitorsInput) GetInput() MonitorMuteRuleInput { return v.Input }
+
+// GetMonitorIds returns __createMonitorMuteRuleForMonitorsInput.MonitorIds, and is useful for accessing the field via an interface.
+func (v *__createMonitorMuteRuleForMonitorsInput) GetMonitorIds() []string { return v.MonitorIds }
+
 // __createMonitorV2ActionInput is used internally by genqlient
 type __createMonitorV2ActionInput struct {
 	WorkspaceId string               `json:"workspaceId"`
@@ -8788,6 +10681,18 @@ type __deleteAppInput struct {
 // GetId returns __deleteAppInput.Id, and is useful for accessing the field via an interface.
 func (v *__deleteAppInput) GetId() string { return v.Id }
 
+// __deleteBlobInput is used internally by genqlient
+type __deleteBlobInput struct {
+	UserId *types.UserIdScalar `json:"userId"`
+	Name   string              `json:"name"`
+}
+
+// GetUserId returns __deleteBlobInput.UserId, and is useful for accessing the field via an interface.
+func (v *__deleteBlobInput) GetUserId() *types.UserIdScalar { return v.UserId }
+
+// GetName returns __deleteBlobInput.Name, and is useful for accessing the field via an interface.
+func (v *__deleteBlobInput) GetName() string { return v.Name }
+
 // __deleteBoardInput is used internally by genqlient
 type __deleteBoardInput struct {
 	Id string `json:"id"`
@@ -8904,6 +10809,14 @@ type __deleteFolderInput struct {
 // GetId returns __deleteFolderInput.Id, and is useful for accessing the field via an interface.
 func (v *__deleteFolderInput) GetId() string { return v.Id }
 
+// __deleteIncidentInput is used internally by genqlient
+type __deleteIncidentInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteIncidentInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteIncidentInput) GetId() string { return v.Id }
+
 // __deleteLayeredSettingRecordInput is used internally by genqlient
 type __deleteLayeredSettingRecordInput struct {
 	Id string `json:"id"`
@@ -8936,6 +10849,14 @@ type __deleteMonitorInput struct {
 // GetId returns __deleteMonitorInput.Id, and is useful for accessing the field via an interface.
 func (v *__deleteMonitorInput) GetId() string { return v.Id }
 
+// __deleteMonitorMuteRuleInput is used internally by genqlient
+type __deleteMonitorMuteRuleInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteMonitorMuteRuleInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteMonitorMuteRuleInput) GetId() string { return v.Id }
+
 // __deleteMonitorV2ActionInput is used internally by genqlient
 type __deleteMonitorV2ActionInput struct {
 	Id string `json:"id"`
@@ -9024,6 +10945,54 @@ type __deleteWorkspaceInput struct {
 // GetId returns __deleteWorkspaceInput.Id, and is useful for accessing the field via an interface.
 func (v *__deleteWorkspaceInput) GetId() string { return v.Id }
 
+// __estimateAccelerationJobCostInput is used internally by genqlient
+type __estimateAccelerationJobCostInput struct {
+	Job AccelerationJobInput `json:"job"`
+}
+
+// GetJob returns __estimateAccelerationJobCostInput.Job, and is useful for accessing the field via an interface.
+func (v *__estimateAccelerationJobCostInput) GetJob() AccelerationJobInput { return v.Job }
+
+// __exportQueryInput is used internally by genqlient
+type __exportQueryInput struct {
+	Query        MultiStageQueryInput `json:"query"`
+	Params       QueryParams          `json:"params"`
+	RowCount     *types.Int64Scalar   `json:"rowCount"`
+	Filename     *string              `json:"filename"`
+	ExportFormat *ExportFileFormat    `json:"exportFormat"`
+}
+
+// GetQuery returns __exportQueryInput.Query, and is useful for accessing the field via an interface.
+func (v *__exportQueryInput) GetQuery() MultiStageQueryInput { return v.Query }
+
+// GetParams returns __exportQueryInput.Params, and is useful for accessing the field via an interface.
+func (v *__exportQueryInput) GetParams() QueryParams { return v.Params }
+
+// GetRowCount returns __exportQueryInput.RowCount, and is useful for accessing the field via an interface.
+func (v *__exportQueryInput) GetRowCount() *types.Int64Scalar { return v.RowCount }
+
+// GetFilename returns __exportQueryInput.Filename, and is useful for accessing the field via an interface.
+func (v *__exportQueryInput) GetFilename() *string { return v.Filename }
+
+// GetExportFormat returns __exportQueryInput.ExportFormat, and is useful for accessing the field via an interface.
+func (v *__exportQueryInput) GetExportFormat() *ExportFileFormat { return v.ExportFormat }
+
+// __getAccelerationJobInput is used internally by genqlient
+type __getAccelerationJobInput struct {
+	JobId string `json:"jobId"`
+}
+
+// GetJobId returns __getAccelerationJobInput.JobId, and is useful for accessing the field via an interface.
+func (v *__getAccelerationJobInput) GetJobId() string { return v.JobId }
+
+// __getActiveMonitorMuteRulesInput is used internally by genqlient
+type __getActiveMonitorMuteRulesInput struct {
+	MonitorId string `json:"monitorId"`
+}
+
+// GetMonitorId returns __getActiveMonitorMuteRulesInput.MonitorId, and is useful for accessing the field via an interface.
+func (v *__getActiveMonitorMuteRulesInput) GetMonitorId() string { return v.MonitorId }
+
 // __getAppDataSourceInput is used internally by genqlient
 type __getAppDataSourceInput struct {
 	Id string `json:"id"`
@@ -9040,6 +11009,18 @@ type __getAppInput struct {
 // GetId returns __getAppInput.Id, and is useful for accessing the field via an interface.
 func (v *__getAppInput) GetId() string { return v.Id }
 
+// __getBlobInput is used internally by genqlient
+type __getBlobInput struct {
+	UserId *types.UserIdScalar `json:"userId"`
+	Name   string              `json:"name"`
+}
+
+// GetUserId returns __getBlobInput.UserId, and is useful for accessing the field via an interface.
+func (v *__getBlobInput) GetUserId() *types.UserIdScalar { return v.UserId }
+
+// GetName returns __getBlobInput.Name, and is useful for accessing the field via an interface.
+func (v *__getBlobInput) GetName() string { return v.Name }
+
 // __getBoardInput is used internally by genqlient
 type __getBoardInput struct {
 	Id string `json:"id"`
@@ -9096,6 +11077,22 @@ type __getDashboardLinkInput struct {
 // GetId returns __getDashboardLinkInput.Id, and is useful for accessing the field via an interface.
 func (v *__getDashboardLinkInput) GetId() string { return v.Id }
 
+// __getDashboardOwnerInput is used internally by genqlient
+type __getDashboardOwnerInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getDashboardOwnerInput.Id, and is useful for accessing the field via an interface.
+func (v *__getDashboardOwnerInput) GetId() string { return v.Id }
+
+// __getDatasetBillingInfoInput is used internally by genqlient
+type __getDatasetBillingInfoInput struct {
+	WorkspaceId string `json:"workspaceId"`
+}
+
+// GetWorkspaceId returns __getDatasetBillingInfoInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *__getDatasetBillingInfoInput) GetWorkspaceId() string { return v.WorkspaceId }
+
 // __getDatasetCorrelationTagsInput is used internally by genqlient
 type __getDatasetCorrelationTagsInput struct {
 	DatasetId string `json:"datasetId"`
@@ -9104,6 +11101,18 @@ type __getDatasetCorrelationTagsInput struct {
 // GetDatasetId returns __getDatasetCorrelationTagsInput.DatasetId, and is useful for accessing the field via an interface.
 func (v *__getDatasetCorrelationTagsInput) GetDatasetId() string { return v.DatasetId }
 
+// __getDatasetDoctorReportInput is used internally by genqlient
+type __getDatasetDoctorReportInput struct {
+	Id       string `json:"id"`
+	UpLevels *int   `json:"upLevels"`
+}
+
+// GetId returns __getDatasetDoctorReportInput.Id, and is useful for accessing the field via an interface.
+func (v *__getDatasetDoctorReportInput) GetId() string { return v.Id }
+
+// GetUpLevels returns __getDatasetDoctorReportInput.UpLevels, and is useful for accessing the field via an interface.
+func (v *__getDatasetDoctorReportInput) GetUpLevels() *int { return v.UpLevels }
+
 // __getDatasetInput is used internally by genqlient
 type __getDatasetInput struct {
 	Id string `json:"id"`
@@ -9120,10 +11129,19 @@ type __getDatasetOutboundShareInput struct {
 // GetId returns __getDatasetOutboundShareInput.Id, and is useful for accessing the field via an interface.
 func (v *__getDatasetOutboundShareInput) GetId() string { return v.Id }
 
+// __getDatasetOwnerInput is used internally by genqlient
+type __getDatasetOwnerInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getDatasetOwnerInput.Id, and is useful for accessing the field via an interface.
+func (v *__getDatasetOwnerInput) GetId() string { return v.Id }
+
 // __getDatasetQueryOutputInput is used internally by genqlient
 type __getDatasetQueryOutputInput struct {
-	Query  []*StageInput `json:"query"`
-	Params QueryParams   `json:"params"`
+	Query           []*StageInput           `json:"query"`
+	Params          QueryParams             `json:"params"`
+	ParameterValues []ParameterBindingInput `json:"parameterValues"`
 }
 
 // GetQuery returns __getDatasetQueryOutputInput.Query, and is useful for accessing the field via an interface.
@@ -9132,6 +11150,11 @@ func (v *__getDatasetQueryOutputInput) GetQuery() []*StageInput { return v.Query
 // GetParams returns __getDatasetQueryOutputInput.Params, and is useful for accessing the field via an interface.
 func (v *__getDatasetQueryOutputInput) GetParams() QueryParams { return v.Params }
 
+// GetParameterValues returns __getDatasetQueryOutputInput.ParameterValues, and is useful for accessing the field via an interface.
+func (v *__getDatasetQueryOutputInput) GetParameterValues() []ParameterBindingInput {
+	return v.ParameterValues
+}
+
 // __getDatastreamInput is used internally by genqlient
 type __getDatastreamInput struct {
 	Id string `json:"id"`
@@ -9164,6 +11187,14 @@ type __getDeferredForeignKeyInput struct {
 // GetId returns __getDeferredForeignKeyInput.Id, and is useful for accessing the field via an interface.
 func (v *__getDeferredForeignKeyInput) GetId() string { return v.Id }
 
+// __getFeatureFlagInput is used internally by genqlient
+type __getFeatureFlagInput struct {
+	Name string `json:"name"`
+}
+
+// GetName returns __getFeatureFlagInput.Name, and is useful for accessing the field via an interface.
+func (v *__getFeatureFlagInput) GetName() string { return v.Name }
+
 // __getFiledropInput is used internally by genqlient
 type __getFiledropInput struct {
 	Id string `json:"id"`
@@ -9180,6 +11211,46 @@ type __getFolderInput struct {
 // GetId returns __getFolderInput.Id, and is useful for accessing the field via an interface.
 func (v *__getFolderInput) GetId() string { return v.Id }
 
+// __getFolderOwnerInput is used internally by genqlient
+type __getFolderOwnerInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getFolderOwnerInput.Id, and is useful for accessing the field via an interface.
+func (v *__getFolderOwnerInput) GetId() string { return v.Id }
+
+// __getIncidentInput is used internally by genqlient
+type __getIncidentInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getIncidentInput.Id, and is useful for accessing the field via an interface.
+func (v *__getIncidentInput) GetId() string { return v.Id }
+
+// __getIncidentsForStatusInput is used internally by genqlient
+type __getIncidentsForStatusInput struct {
+	Status     IncidentStatus    `json:"status"`
+	StartingAt *types.TimeScalar `json:"startingAt"`
+	EndingAt   *types.TimeScalar `json:"endingAt"`
+}
+
+// GetStatus returns __getIncidentsForStatusInput.Status, and is useful for accessing the field via an interface.
+func (v *__getIncidentsForStatusInput) GetStatus() IncidentStatus { return v.Status }
+
+// GetStartingAt returns __getIncidentsForStatusInput.StartingAt, and is useful for accessing the field via an interface.
+func (v *__getIncidentsForStatusInput) GetStartingAt() *types.TimeScalar { return v.StartingAt }
+
+// GetEndingAt returns __getIncidentsForStatusInput.EndingAt, and is useful for accessing the field via an interface.
+func (v *__getIncidentsForStatusInput) GetEndingAt() *types.TimeScalar { return v.EndingAt }
+
+// __getIncidentsForTextQueryInput is used internally by genqlient
+type __getIncidentsForTextQueryInput struct {
+	Query IncidentQueryInput `json:"query"`
+}
+
+// GetQuery returns __getIncidentsForTextQueryInput.Query, and is useful for accessing the field via an interface.
+func (v *__getIncidentsForTextQueryInput) GetQuery() IncidentQueryInput { return v.Query }
+
 // __getLayeredSettingRecordInput is used internally by genqlient
 type __getLayeredSettingRecordInput struct {
 	Id string `json:"id"`
@@ -9212,6 +11283,14 @@ type __getMonitorInput struct {
 // GetId returns __getMonitorInput.Id, and is useful for accessing the field via an interface.
 func (v *__getMonitorInput) GetId() string { return v.Id }
 
+// __getMonitorMuteRuleInput is used internally by genqlient
+type __getMonitorMuteRuleInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getMonitorMuteRuleInput.Id, and is useful for accessing the field via an interface.
+func (v *__getMonitorMuteRuleInput) GetId() string { return v.Id }
+
 // __getMonitorV2ActionInput is used internally by genqlient
 type __getMonitorV2ActionInput struct {
 	Id string `json:"id"`
@@ -9236,6 +11315,30 @@ type __getMonitorV2Input struct {
 // GetId returns __getMonitorV2Input.Id, and is useful for accessing the field via an interface.
 func (v *__getMonitorV2Input) GetId() string { return v.Id }
 
+// __getMonitorV2OwnerInput is used internally by genqlient
+type __getMonitorV2OwnerInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getMonitorV2OwnerInput.Id, and is useful for accessing the field via an interface.
+func (v *__getMonitorV2OwnerInput) GetId() string { return v.Id }
+
+// __getPathsBetweenDatasetsInput is used internally by genqlient
+type __getPathsBetweenDatasetsInput struct {
+	From  string             `json:"from"`
+	To    string             `json:"to"`
+	Limit *types.Int64Scalar `json:"limit"`
+}
+
+// GetFrom returns __getPathsBetweenDatasetsInput.From, and is useful for accessing the field via an interface.
+func (v *__getPathsBetweenDatasetsInput) GetFrom() string { return v.From }
+
+// GetTo returns __getPathsBetweenDatasetsInput.To, and is useful for accessing the field via an interface.
+func (v *__getPathsBetweenDatasetsInput) GetTo() string { return v.To }
+
+// GetLimit returns __getPathsBetweenDatasetsInput.Limit, and is useful for accessing the field via an interface.
+func (v *__getPathsBetweenDatasetsInput) GetLimit() *types.Int64Scalar { return v.Limit }
+
 // __getPollerInput is used internally by genqlient
 type __getPollerInput struct {
 	Id string `json:"id"`
@@ -9252,6 +11355,22 @@ type __getPreferredPathInput struct {
 // GetId returns __getPreferredPathInput.Id, and is useful for accessing the field via an interface.
 func (v *__getPreferredPathInput) GetId() string { return v.Id }
 
+// __getQueryCursorInput is used internally by genqlient
+type __getQueryCursorInput struct {
+	CursorId string            `json:"cursorId"`
+	Offset   types.Int64Scalar `json:"offset"`
+	NumRows  types.Int64Scalar `json:"numRows"`
+}
+
+// GetCursorId returns __getQueryCursorInput.CursorId, and is useful for accessing the field via an interface.
+func (v *__getQueryCursorInput) GetCursorId() string { return v.CursorId }
+
+// GetOffset returns __getQueryCursorInput.Offset, and is useful for accessing the field via an interface.
+func (v *__getQueryCursorInput) GetOffset() types.Int64Scalar { return v.Offset }
+
+// GetNumRows returns __getQueryCursorInput.NumRows, and is useful for accessing the field via an interface.
+func (v *__getQueryCursorInput) GetNumRows() types.Int64Scalar { return v.NumRows }
+
 // __getRbacGroupInput is used internally by genqlient
 type __getRbacGroupInput struct {
 	Id string `json:"id"`
@@ -9312,6 +11431,14 @@ type __getWorksheetInput struct {
 // GetId returns __getWorksheetInput.Id, and is useful for accessing the field via an interface.
 func (v *__getWorksheetInput) GetId() string { return v.Id }
 
+// __getWorksheetOwnerInput is used internally by genqlient
+type __getWorksheetOwnerInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getWorksheetOwnerInput.Id, and is useful for accessing the field via an interface.
+func (v *__getWorksheetOwnerInput) GetId() string { return v.Id }
+
 // __getWorkspaceInput is used internally by genqlient
 type __getWorkspaceInput struct {
 	Id string `json:"id"`
@@ -9320,29 +11447,81 @@ type __getWorkspaceInput struct {
 // GetId returns __getWorkspaceInput.Id, and is useful for accessing the field via an interface.
 func (v *__getWorkspaceInput) GetId() string { return v.Id }
 
-// __lookupAppInput is used internally by genqlient
-type __lookupAppInput struct {
-	WorkspaceId string `json:"workspaceId"`
-	Name        string `json:"name"`
+// __inviteUserInput is used internally by genqlient
+type __inviteUserInput struct {
+	Input UserInput `json:"input"`
 }
 
-// GetWorkspaceId returns __lookupAppInput.WorkspaceId, and is useful for accessing the field via an interface.
-func (v *__lookupAppInput) GetWorkspaceId() string { return v.WorkspaceId }
-
-// GetName returns __lookupAppInput.Name, and is useful for accessing the field via an interface.
-func (v *__lookupAppInput) GetName() string { return v.Name }
+// GetInput returns __inviteUserInput.Input, and is useful for accessing the field via an interface.
+func (v *__inviteUserInput) GetInput() UserInput { return v.Input }
 
-// __lookupDatasetInput is used internally by genqlient
-type __lookupDatasetInput struct {
-	WorkspaceId string `json:"workspaceId"`
-	Name        string `json:"name"`
+// __listBlobsInput is used internally by genqlient
+type __listBlobsInput struct {
+	UserId               *types.UserIdScalar `json:"userId"`
+	Kind                 *string             `json:"kind"`
+	IncludeWorldReadable *bool               `json:"includeWorldReadable"`
 }
 
-// GetWorkspaceId returns __lookupDatasetInput.WorkspaceId, and is useful for accessing the field via an interface.
-func (v *__lookupDatasetInput) GetWorkspaceId() string { return v.WorkspaceId }
+// GetUserId returns __listBlobsInput.UserId, and is useful for accessing the field via an interface.
+func (v *__listBlobsInput) GetUserId() *types.UserIdScalar { return v.UserId }
 
-// GetName returns __lookupDatasetInput.Name, and is useful for accessing the field via an interface.
-func (v *__lookupDatasetInput) GetName() string { return v.Name }
+// GetKind returns __listBlobsInput.Kind, and is useful for accessing the field via an interface.
+func (v *__listBlobsInput) GetKind() *string { return v.Kind }
+
+// GetIncludeWorldReadable returns __listBlobsInput.IncludeWorldReadable, and is useful for accessing the field via an interface.
+func (v *__listBlobsInput) GetIncludeWorldReadable() *bool { return v.IncludeWorldReadable }
+
+// __listMonitorsForDatasetInput is used internally by genqlient
+type __listMonitorsForDatasetInput struct {
+	DatasetId string `json:"datasetId"`
+}
+
+// GetDatasetId returns __listMonitorsForDatasetInput.DatasetId, and is useful for accessing the field via an interface.
+func (v *__listMonitorsForDatasetInput) GetDatasetId() string { return v.DatasetId }
+
+// __listWorkspaceDatasetLineageInput is used internally by genqlient
+type __listWorkspaceDatasetLineageInput struct {
+	WorkspaceId string `json:"workspaceId"`
+}
+
+// GetWorkspaceId returns __listWorkspaceDatasetLineageInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *__listWorkspaceDatasetLineageInput) GetWorkspaceId() string { return v.WorkspaceId }
+
+// __listWorkspaceObjectOwnersInput is used internally by genqlient
+type __listWorkspaceObjectOwnersInput struct {
+	WorkspaceId string             `json:"workspaceId"`
+	MaxCount    *types.Int64Scalar `json:"maxCount"`
+}
+
+// GetWorkspaceId returns __listWorkspaceObjectOwnersInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *__listWorkspaceObjectOwnersInput) GetWorkspaceId() string { return v.WorkspaceId }
+
+// GetMaxCount returns __listWorkspaceObjectOwnersInput.MaxCount, and is useful for accessing the field via an interface.
+func (v *__listWorkspaceObjectOwnersInput) GetMaxCount() *types.Int64Scalar { return v.MaxCount }
+
+// __lookupAppInput is used internally by genqlient
+type __lookupAppInput struct {
+	WorkspaceId string `json:"workspaceId"`
+	Name        string `json:"name"`
+}
+
+// GetWorkspaceId returns __lookupAppInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *__lookupAppInput) GetWorkspaceId() string { return v.WorkspaceId }
+
+// GetName returns __lookupAppInput.Name, and is useful for accessing the field via an interface.
+func (v *__lookupAppInput) GetName() string { return v.Name }
+
+// __lookupDatasetInput is used internally by genqlient
+type __lookupDatasetInput struct {
+	WorkspaceId string `json:"workspaceId"`
+	Name        string `json:"name"`
+}
+
+// GetWorkspaceId returns __lookupDatasetInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *__lookupDatasetInput) GetWorkspaceId() string { return v.WorkspaceId }
+
+// GetName returns __lookupDatasetInput.Name, and is useful for accessing the field via an interface.
+func (v *__lookupDatasetInput) GetName() string { return v.Name }
 
 // __lookupDatastreamInput is used internally by genqlient
 type __lookupDatastreamInput struct {
@@ -9428,6 +11607,50 @@ type __lookupWorkspaceInput struct {
 // GetName returns __lookupWorkspaceInput.Name, and is useful for accessing the field via an interface.
 func (v *__lookupWorkspaceInput) GetName() string { return v.Name }
 
+// __mutateRbacStatementsInput is used internally by genqlient
+type __mutateRbacStatementsInput struct {
+	ToCreate []RbacStatementInput       `json:"toCreate"`
+	ToUpdate []UpdateRbacStatementInput `json:"toUpdate"`
+	ToDelete []string                   `json:"toDelete"`
+}
+
+// GetToCreate returns __mutateRbacStatementsInput.ToCreate, and is useful for accessing the field via an interface.
+func (v *__mutateRbacStatementsInput) GetToCreate() []RbacStatementInput { return v.ToCreate }
+
+// GetToUpdate returns __mutateRbacStatementsInput.ToUpdate, and is useful for accessing the field via an interface.
+func (v *__mutateRbacStatementsInput) GetToUpdate() []UpdateRbacStatementInput { return v.ToUpdate }
+
+// GetToDelete returns __mutateRbacStatementsInput.ToDelete, and is useful for accessing the field via an interface.
+func (v *__mutateRbacStatementsInput) GetToDelete() []string { return v.ToDelete }
+
+// __rbacObjectMatchesInput is used internally by genqlient
+type __rbacObjectMatchesInput struct {
+	Object RbacRequestObjectInput `json:"object"`
+}
+
+// GetObject returns __rbacObjectMatchesInput.Object, and is useful for accessing the field via an interface.
+func (v *__rbacObjectMatchesInput) GetObject() RbacRequestObjectInput { return v.Object }
+
+// __rbacTestRequestsInput is used internally by genqlient
+type __rbacTestRequestsInput struct {
+	User     types.UserIdScalar `json:"user"`
+	Requests []RbacRequestInput `json:"requests"`
+}
+
+// GetUser returns __rbacTestRequestsInput.User, and is useful for accessing the field via an interface.
+func (v *__rbacTestRequestsInput) GetUser() types.UserIdScalar { return v.User }
+
+// GetRequests returns __rbacTestRequestsInput.Requests, and is useful for accessing the field via an interface.
+func (v *__rbacTestRequestsInput) GetRequests() []RbacRequestInput { return v.Requests }
+
+// __rbacUserMatchesInput is used internally by genqlient
+type __rbacUserMatchesInput struct {
+	User types.UserIdScalar `json:"user"`
+}
+
+// GetUser returns __rbacUserMatchesInput.User, and is useful for accessing the field via an interface.
+func (v *__rbacUserMatchesInput) GetUser() types.UserIdScalar { return v.User }
+
 // __removeCorrelationTagInput is used internally by genqlient
 type __removeCorrelationTagInput struct {
 	DatasetId string         `json:"datasetId"`
@@ -9444,6 +11667,56 @@ func (v *__removeCorrelationTagInput) GetPath() LinkFieldInput { return v.Path }
 // GetTag returns __removeCorrelationTagInput.Tag, and is useful for accessing the field via an interface.
 func (v *__removeCorrelationTagInput) GetTag() string { return v.Tag }
 
+// __removeIncidentDashboardsInput is used internally by genqlient
+type __removeIncidentDashboardsInput struct {
+	Id         string   `json:"id"`
+	Dashboards []string `json:"dashboards"`
+}
+
+// GetId returns __removeIncidentDashboardsInput.Id, and is useful for accessing the field via an interface.
+func (v *__removeIncidentDashboardsInput) GetId() string { return v.Id }
+
+// GetDashboards returns __removeIncidentDashboardsInput.Dashboards, and is useful for accessing the field via an interface.
+func (v *__removeIncidentDashboardsInput) GetDashboards() []string { return v.Dashboards }
+
+// __removeIncidentSlackchannelsInput is used internally by genqlient
+type __removeIncidentSlackchannelsInput struct {
+	Id       string                      `json:"id"`
+	Channels []IncidentSlackchannelInput `json:"channels"`
+}
+
+// GetId returns __removeIncidentSlackchannelsInput.Id, and is useful for accessing the field via an interface.
+func (v *__removeIncidentSlackchannelsInput) GetId() string { return v.Id }
+
+// GetChannels returns __removeIncidentSlackchannelsInput.Channels, and is useful for accessing the field via an interface.
+func (v *__removeIncidentSlackchannelsInput) GetChannels() []IncidentSlackchannelInput {
+	return v.Channels
+}
+
+// __removeIncidentUsersInput is used internally by genqlient
+type __removeIncidentUsersInput struct {
+	Id    string               `json:"id"`
+	Users []types.UserIdScalar `json:"users"`
+}
+
+// GetId returns __removeIncidentUsersInput.Id, and is useful for accessing the field via an interface.
+func (v *__removeIncidentUsersInput) GetId() string { return v.Id }
+
+// GetUsers returns __removeIncidentUsersInput.Users, and is useful for accessing the field via an interface.
+func (v *__removeIncidentUsersInput) GetUsers() []types.UserIdScalar { return v.Users }
+
+// __removeIncidentWorksheetsInput is used internally by genqlient
+type __removeIncidentWorksheetsInput struct {
+	Id         string   `json:"id"`
+	Worksheets []string `json:"worksheets"`
+}
+
+// GetId returns __removeIncidentWorksheetsInput.Id, and is useful for accessing the field via an interface.
+func (v *__removeIncidentWorksheetsInput) GetId() string { return v.Id }
+
+// GetWorksheets returns __removeIncidentWorksheetsInput.Worksheets, and is useful for accessing the field via an interface.
+func (v *__removeIncidentWorksheetsInput) GetWorksheets() []string { return v.Worksheets }
+
 // __saveActionWithDestinationLinksInput is used internally by genqlient
 type __saveActionWithDestinationLinksInput struct {
 	ActionId         string                       `json:"actionId"`
@@ -9530,6 +11803,74 @@ type __saveWorksheetInput struct {
 // GetWorksheetInput returns __saveWorksheetInput.WorksheetInput, and is useful for accessing the field via an interface.
 func (v *__saveWorksheetInput) GetWorksheetInput() WorksheetInput { return v.WorksheetInput }
 
+// __searchDashboardsInput is used internally by genqlient
+type __searchDashboardsInput struct {
+	Terms    DWSearchInput      `json:"terms"`
+	MaxCount *types.Int64Scalar `json:"maxCount"`
+}
+
+// GetTerms returns __searchDashboardsInput.Terms, and is useful for accessing the field via an interface.
+func (v *__searchDashboardsInput) GetTerms() DWSearchInput { return v.Terms }
+
+// GetMaxCount returns __searchDashboardsInput.MaxCount, and is useful for accessing the field via an interface.
+func (v *__searchDashboardsInput) GetMaxCount() *types.Int64Scalar { return v.MaxCount }
+
+// __searchDatasetsInput is used internally by genqlient
+type __searchDatasetsInput struct {
+	Projects              []string    `json:"projects"`
+	LabelMatches          []string    `json:"labelMatches"`
+	ColumnMatches         []string    `json:"columnMatches"`
+	CorrelationTagMatches []string    `json:"correlationTagMatches"`
+	ReachableFromDataset  *string     `json:"reachableFromDataset"`
+	ImplementsInterfaces  []string    `json:"implementsInterfaces"`
+	SearchMode            *SearchMode `json:"searchMode"`
+}
+
+// GetProjects returns __searchDatasetsInput.Projects, and is useful for accessing the field via an interface.
+func (v *__searchDatasetsInput) GetProjects() []string { return v.Projects }
+
+// GetLabelMatches returns __searchDatasetsInput.LabelMatches, and is useful for accessing the field via an interface.
+func (v *__searchDatasetsInput) GetLabelMatches() []string { return v.LabelMatches }
+
+// GetColumnMatches returns __searchDatasetsInput.ColumnMatches, and is useful for accessing the field via an interface.
+func (v *__searchDatasetsInput) GetColumnMatches() []string { return v.ColumnMatches }
+
+// GetCorrelationTagMatches returns __searchDatasetsInput.CorrelationTagMatches, and is useful for accessing the field via an interface.
+func (v *__searchDatasetsInput) GetCorrelationTagMatches() []string { return v.CorrelationTagMatches }
+
+// GetReachableFromDataset returns __searchDatasetsInput.ReachableFromDataset, and is useful for accessing the field via an interface.
+func (v *__searchDatasetsInput) GetReachableFromDataset() *string { return v.ReachableFromDataset }
+
+// GetImplementsInterfaces returns __searchDatasetsInput.ImplementsInterfaces, and is useful for accessing the field via an interface.
+func (v *__searchDatasetsInput) GetImplementsInterfaces() []string { return v.ImplementsInterfaces }
+
+// GetSearchMode returns __searchDatasetsInput.SearchMode, and is useful for accessing the field via an interface.
+func (v *__searchDatasetsInput) GetSearchMode() *SearchMode { return v.SearchMode }
+
+// __searchMetricsInput is used internally by genqlient
+type __searchMetricsInput struct {
+	Workspaces            []string `json:"workspaces"`
+	InDatasets            []string `json:"inDatasets"`
+	LinkToDatasets        []string `json:"linkToDatasets"`
+	CorrelationTagMatches []string `json:"correlationTagMatches"`
+	Match                 string   `json:"match"`
+}
+
+// GetWorkspaces returns __searchMetricsInput.Workspaces, and is useful for accessing the field via an interface.
+func (v *__searchMetricsInput) GetWorkspaces() []string { return v.Workspaces }
+
+// GetInDatasets returns __searchMetricsInput.InDatasets, and is useful for accessing the field via an interface.
+func (v *__searchMetricsInput) GetInDatasets() []string { return v.InDatasets }
+
+// GetLinkToDatasets returns __searchMetricsInput.LinkToDatasets, and is useful for accessing the field via an interface.
+func (v *__searchMetricsInput) GetLinkToDatasets() []string { return v.LinkToDatasets }
+
+// GetCorrelationTagMatches returns __searchMetricsInput.CorrelationTagMatches, and is useful for accessing the field via an interface.
+func (v *__searchMetricsInput) GetCorrelationTagMatches() []string { return v.CorrelationTagMatches }
+
+// GetMatch returns __searchMetricsInput.Match, and is useful for accessing the field via an interface.
+func (v *__searchMetricsInput) GetMatch() string { return v.Match }
+
 // __searchMonitorActionsInput is used internally by genqlient
 type __searchMonitorActionsInput struct {
 	WorkspaceId *string `json:"workspaceId"`
@@ -9562,6 +11903,52 @@ func (v *__searchMonitorV2ActionInput) GetNameExact() *string { return v.NameExa
 // GetNameSubstring returns __searchMonitorV2ActionInput.NameSubstring, and is useful for accessing the field via an interface.
 func (v *__searchMonitorV2ActionInput) GetNameSubstring() *string { return v.NameSubstring }
 
+// __searchResourceInstancesInput is used internally by genqlient
+type __searchResourceInstancesInput struct {
+	KeyFragments    []string           `json:"keyFragments"`
+	DatasetIds      []string           `json:"datasetIds"`
+	StartTime       *types.TimeScalar  `json:"startTime"`
+	EndTime         *types.TimeScalar  `json:"endTime"`
+	PerDatasetLimit *types.Int64Scalar `json:"perDatasetLimit"`
+	GlobalLimit     *types.Int64Scalar `json:"globalLimit"`
+	Pagination      *PaginationInput   `json:"pagination"`
+}
+
+// GetKeyFragments returns __searchResourceInstancesInput.KeyFragments, and is useful for accessing the field via an interface.
+func (v *__searchResourceInstancesInput) GetKeyFragments() []string { return v.KeyFragments }
+
+// GetDatasetIds returns __searchResourceInstancesInput.DatasetIds, and is useful for accessing the field via an interface.
+func (v *__searchResourceInstancesInput) GetDatasetIds() []string { return v.DatasetIds }
+
+// GetStartTime returns __searchResourceInstancesInput.StartTime, and is useful for accessing the field via an interface.
+func (v *__searchResourceInstancesInput) GetStartTime() *types.TimeScalar { return v.StartTime }
+
+// GetEndTime returns __searchResourceInstancesInput.EndTime, and is useful for accessing the field via an interface.
+func (v *__searchResourceInstancesInput) GetEndTime() *types.TimeScalar { return v.EndTime }
+
+// GetPerDatasetLimit returns __searchResourceInstancesInput.PerDatasetLimit, and is useful for accessing the field via an interface.
+func (v *__searchResourceInstancesInput) GetPerDatasetLimit() *types.Int64Scalar {
+	return v.PerDatasetLimit
+}
+
+// GetGlobalLimit returns __searchResourceInstancesInput.GlobalLimit, and is useful for accessing the field via an interface.
+func (v *__searchResourceInstancesInput) GetGlobalLimit() *types.Int64Scalar { return v.GlobalLimit }
+
+// GetPagination returns __searchResourceInstancesInput.Pagination, and is useful for accessing the field via an interface.
+func (v *__searchResourceInstancesInput) GetPagination() *PaginationInput { return v.Pagination }
+
+// __searchWorksheetsInput is used internally by genqlient
+type __searchWorksheetsInput struct {
+	Terms    DWSearchInput      `json:"terms"`
+	MaxCount *types.Int64Scalar `json:"maxCount"`
+}
+
+// GetTerms returns __searchWorksheetsInput.Terms, and is useful for accessing the field via an interface.
+func (v *__searchWorksheetsInput) GetTerms() DWSearchInput { return v.Terms }
+
+// GetMaxCount returns __searchWorksheetsInput.MaxCount, and is useful for accessing the field via an interface.
+func (v *__searchWorksheetsInput) GetMaxCount() *types.Int64Scalar { return v.MaxCount }
+
 // __setChannelsForChannelActionInput is used internally by genqlient
 type __setChannelsForChannelActionInput struct {
 	ActionId   string   `json:"actionId"`
@@ -9606,6 +11993,34 @@ type __setRbacDefaultGroupInput struct {
 // GetId returns __setRbacDefaultGroupInput.Id, and is useful for accessing the field via an interface.
 func (v *__setRbacDefaultGroupInput) GetId() string { return v.Id }
 
+// __setRbacGroupmembersInput is used internally by genqlient
+type __setRbacGroupmembersInput struct {
+	GroupId      string               `json:"groupId"`
+	MemberUsers  []types.UserIdScalar `json:"memberUsers"`
+	MemberGroups []string             `json:"memberGroups"`
+}
+
+// GetGroupId returns __setRbacGroupmembersInput.GroupId, and is useful for accessing the field via an interface.
+func (v *__setRbacGroupmembersInput) GetGroupId() string { return v.GroupId }
+
+// GetMemberUsers returns __setRbacGroupmembersInput.MemberUsers, and is useful for accessing the field via an interface.
+func (v *__setRbacGroupmembersInput) GetMemberUsers() []types.UserIdScalar { return v.MemberUsers }
+
+// GetMemberGroups returns __setRbacGroupmembersInput.MemberGroups, and is useful for accessing the field via an interface.
+func (v *__setRbacGroupmembersInput) GetMemberGroups() []string { return v.MemberGroups }
+
+// __setWorkspaceObjectOwnerInput is used internally by genqlient
+type __setWorkspaceObjectOwnerInput struct {
+	Id    string             `json:"id"`
+	Owner types.UserIdScalar `json:"owner"`
+}
+
+// GetId returns __setWorkspaceObjectOwnerInput.Id, and is useful for accessing the field via an interface.
+func (v *__setWorkspaceObjectOwnerInput) GetId() string { return v.Id }
+
+// GetOwner returns __setWorkspaceObjectOwnerInput.Owner, and is useful for accessing the field via an interface.
+func (v *__setWorkspaceObjectOwnerInput) GetOwner() types.UserIdScalar { return v.Owner }
+
 // __updateAppDataSourceInput is used internally by genqlient
 type __updateAppDataSourceInput struct {
 	Id     string             `json:"id"`
@@ -9630,6 +12045,26 @@ func (v *__updateAppInput) GetId() string { return v.Id }
 // GetConfig returns __updateAppInput.Config, and is useful for accessing the field via an interface.
 func (v *__updateAppInput) GetConfig() AppInput { return v.Config }
 
+// __updateBlobInput is used internally by genqlient
+type __updateBlobInput struct {
+	UserId        *types.UserIdScalar `json:"userId"`
+	Name          string              `json:"name"`
+	Info          BlobInput           `json:"info"`
+	MakePermanent *bool               `json:"makePermanent"`
+}
+
+// GetUserId returns __updateBlobInput.UserId, and is useful for accessing the field via an interface.
+func (v *__updateBlobInput) GetUserId() *types.UserIdScalar { return v.UserId }
+
+// GetName returns __updateBlobInput.Name, and is useful for accessing the field via an interface.
+func (v *__updateBlobInput) GetName() string { return v.Name }
+
+// GetInfo returns __updateBlobInput.Info, and is useful for accessing the field via an interface.
+func (v *__updateBlobInput) GetInfo() BlobInput { return v.Info }
+
+// GetMakePermanent returns __updateBlobInput.MakePermanent, and is useful for accessing the field via an interface.
+func (v *__updateBlobInput) GetMakePermanent() *bool { return v.MakePermanent }
+
 // __updateBoardInput is used internally by genqlient
 type __updateBoardInput struct {
 	Id    string     `json:"id"`
@@ -9666,6 +12101,14 @@ func (v *__updateChannelInput) GetId() string { return v.Id }
 // GetChannel returns __updateChannelInput.Channel, and is useful for accessing the field via an interface.
 func (v *__updateChannelInput) GetChannel() ChannelInput { return v.Channel }
 
+// __updateCurrentCustomerSsoInput is used internally by genqlient
+type __updateCurrentCustomerSsoInput struct {
+	Sso CustomerSsoInput `json:"sso"`
+}
+
+// GetSso returns __updateCurrentCustomerSsoInput.Sso, and is useful for accessing the field via an interface.
+func (v *__updateCurrentCustomerSsoInput) GetSso() CustomerSsoInput { return v.Sso }
+
 // __updateDashboardLinkInput is used internally by genqlient
 type __updateDashboardLinkInput struct {
 	Id    string             `json:"id"`
@@ -9726,6 +12169,14 @@ func (v *__updateDeferredForeignKeyInput) GetId() string { return v.Id }
 // GetKeyInput returns __updateDeferredForeignKeyInput.KeyInput, and is useful for accessing the field via an interface.
 func (v *__updateDeferredForeignKeyInput) GetKeyInput() DeferredForeignKeyInput { return v.KeyInput }
 
+// __updateFeatureFlagForUserInput is used internally by genqlient
+type __updateFeatureFlagForUserInput struct {
+	Input FeatureFlagInput `json:"input"`
+}
+
+// GetInput returns __updateFeatureFlagForUserInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateFeatureFlagForUserInput) GetInput() FeatureFlagInput { return v.Input }
+
 // __updateFiledropInput is used internally by genqlient
 type __updateFiledropInput struct {
 	Id    string        `json:"id"`
@@ -9750,6 +12201,18 @@ func (v *__updateFolderInput) GetId() string { return v.Id }
 // GetConfig returns __updateFolderInput.Config, and is useful for accessing the field via an interface.
 func (v *__updateFolderInput) GetConfig() FolderInput { return v.Config }
 
+// __updateIncidentInput is used internally by genqlient
+type __updateIncidentInput struct {
+	Id    string        `json:"id"`
+	Input IncidentInput `json:"input"`
+}
+
+// GetId returns __updateIncidentInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateIncidentInput) GetId() string { return v.Id }
+
+// GetInput returns __updateIncidentInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateIncidentInput) GetInput() IncidentInput { return v.Input }
+
 // __updateLayeredSettingRecordInput is used internally by genqlient
 type __updateLayeredSettingRecordInput struct {
 	SettingRecord LayeredSettingRecordInput `json:"settingRecord"`
@@ -9798,6 +12261,22 @@ func (v *__updateMonitorInput) GetId() string { return v.Id }
 // GetMonitor returns __updateMonitorInput.Monitor, and is useful for accessing the field via an interface.
 func (v *__updateMonitorInput) GetMonitor() MonitorInput { return v.Monitor }
 
+// __updateMonitorMuteRuleForMonitorsInput is used internally by genqlient
+type __updateMonitorMuteRuleForMonitorsInput struct {
+	Id         string               `json:"id"`
+	Input      MonitorMuteRuleInput `json:"input"`
+	MonitorIds []string             `json:"monitorIds"`
+}
+
+// GetId returns __updateMonitorMuteRuleForMonitorsInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateMonitorMuteRuleForMonitorsInput) GetId() string { return v.Id }
+
+// GetInput returns __updateMonitorMuteRuleForMonitorsInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateMonitorMuteRuleForMonitorsInput) GetInput() MonitorMuteRuleInput { return v.Input }
+
+// GetMonitorIds returns __updateMonitorMuteRuleForMonitorsInput.MonitorIds, and is useful for accessing the field via an interface.
+func (v *__updateMonitorMuteRuleForMonitorsInput) GetMonitorIds() []string { return v.MonitorIds }
+
 // __updateMonitorV2ActionInput is used internally by genqlient
 type __updateMonitorV2ActionInput struct {
 	Id    string               `json:"id"`
@@ -9906,6 +12385,30 @@ func (v *__updateSnowflakeOutboundShareInput) GetId() string { return v.Id }
 // GetInput returns __updateSnowflakeOutboundShareInput.Input, and is useful for accessing the field via an interface.
 func (v *__updateSnowflakeOutboundShareInput) GetInput() SnowflakeOutboundShareInput { return v.Input }
 
+// __updateUserInput is used internally by genqlient
+type __updateUserInput struct {
+	Id    types.UserIdScalar `json:"id"`
+	Input UserInput          `json:"input"`
+}
+
+// GetId returns __updateUserInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateUserInput) GetId() types.UserIdScalar { return v.Id }
+
+// GetInput returns __updateUserInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateUserInput) GetInput() UserInput { return v.Input }
+
+// __updateUsersInput is used internally by genqlient
+type __updateUsersInput struct {
+	Ids   []types.UserIdScalar `json:"ids"`
+	Input UserInput            `json:"input"`
+}
+
+// GetIds returns __updateUsersInput.Ids, and is useful for accessing the field via an interface.
+func (v *__updateUsersInput) GetIds() []types.UserIdScalar { return v.Ids }
+
+// GetInput returns __updateUsersInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateUsersInput) GetInput() UserInput { return v.Input }
+
 // __updateWorkspaceInput is used internally by genqlient
 type __updateWorkspaceInput struct {
 	Id     string         `json:"id"`
@@ -9926,6 +12429,51 @@ type addCorrelationTagResponse struct {
 // GetResultStatus returns addCorrelationTagResponse.ResultStatus, and is useful for accessing the field via an interface.
 func (v *addCorrelationTagResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
 
+// addIncidentDashboardsResponse is returned by addIncidentDashboards on success.
+type addIncidentDashboardsResponse struct {
+	Incident Incident `json:"incident"`
+}
+
+// GetIncident returns addIncidentDashboardsResponse.Incident, and is useful for accessing the field via an interface.
+func (v *addIncidentDashboardsResponse) GetIncident() Incident { return v.Incident }
+
+// addIncidentSlackchannelsResponse is returned by addIncidentSlackchannels on success.
+type addIncidentSlackchannelsResponse struct {
+	Incident Incident `json:"incident"`
+}
+
+// GetIncident returns addIncidentSlackchannelsResponse.Incident, and is useful for accessing the field via an interface.
+func (v *addIncidentSlackchannelsResponse) GetIncident() Incident { return v.Incident }
+
+// addIncidentUsersResponse is returned by addIncidentUsers on success.
+type addIncidentUsersResponse struct {
+	Incident Incident `json:"incident"`
+}
+
+// GetIncident returns addIncidentUsersResponse.Incident, and is useful for accessing the field via an interface.
+func (v *addIncidentUsersResponse) GetIncident() Incident { return v.Incident }
+
+// addIncidentWorksheetsResponse is returned by addIncidentWorksheets on success.
+type addIncidentWorksheetsResponse struct {
+	Incident Incident `json:"incident"`
+}
+
+// GetIncident returns addIncidentWorksheetsResponse.Incident, and is useful for accessing the field via an interface.
+func (v *addIncidentWorksheetsResponse) GetIncident() Incident { return v.Incident }
+
+// cancelAccelerationJobResponse is returned by cancelAccelerationJob on success.
+type cancelAccelerationJobResponse struct {
+	// Cancels an acceleration job identified by the jobId. If the operation is
+	// successful, an acceleration job with state "Cancelled" is returned. If the
+	// operation fails, an invalid object is returned together with errors.
+	AccelerationJob AccelerationJob `json:"accelerationJob"`
+}
+
+// GetAccelerationJob returns cancelAccelerationJobResponse.AccelerationJob, and is useful for accessing the field via an interface.
+func (v *cancelAccelerationJobResponse) GetAccelerationJob() AccelerationJob {
+	return v.AccelerationJob
+}
+
 // clearDefaultDashboardResponse is returned by clearDefaultDashboard on success.
 type clearDefaultDashboardResponse struct {
 	ResultStatus ResultStatus `json:"resultStatus"`
@@ -9934,6 +12482,21 @@ type clearDefaultDashboardResponse struct {
 // GetResultStatus returns clearDefaultDashboardResponse.ResultStatus, and is useful for accessing the field via an interface.
 func (v *clearDefaultDashboardResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
 
+// createAccelerationJobResponse is returned by createAccelerationJob on success.
+type createAccelerationJobResponse struct {
+	// Create and submit an acceleration job to the backend, which contains multiple
+	// acceleration requests. If the operaiton is successful, a job object with
+	// detailed status is returned and caller can poll backend later for its updated
+	// status. If the operation fails, an invalid job object is returned together
+	// with errors.
+	AccelerationJob AccelerationJob `json:"accelerationJob"`
+}
+
+// GetAccelerationJob returns createAccelerationJobResponse.AccelerationJob, and is useful for accessing the field via an interface.
+func (v *createAccelerationJobResponse) GetAccelerationJob() AccelerationJob {
+	return v.AccelerationJob
+}
+
 // createAppDataSourceResponse is returned by createAppDataSource on success.
 type createAppDataSourceResponse struct {
 	Appdatasource AppDataSource `json:"appdatasource"`
@@ -9950,6 +12513,14 @@ type createAppResponse struct {
 // GetApp returns createAppResponse.App, and is useful for accessing the field via an interface.
 func (v *createAppResponse) GetApp() App { return v.App }
 
+// createBlobResponse is returned by createBlob on success.
+type createBlobResponse struct {
+	Blob Blob `json:"blob"`
+}
+
+// GetBlob returns createBlobResponse.Blob, and is useful for accessing the field via an interface.
+func (v *createBlobResponse) GetBlob() Blob { return v.Blob }
+
 // createBoardResponse is returned by createBoard on success.
 type createBoardResponse struct {
 	Board Board `json:"board"`
@@ -10104,6 +12675,14 @@ type createFolderResponse struct {
 // GetFolder returns createFolderResponse.Folder, and is useful for accessing the field via an interface.
 func (v *createFolderResponse) GetFolder() Folder { return v.Folder }
 
+// createIncidentResponse is returned by createIncident on success.
+type createIncidentResponse struct {
+	Incident Incident `json:"incident"`
+}
+
+// GetIncident returns createIncidentResponse.Incident, and is useful for accessing the field via an interface.
+func (v *createIncidentResponse) GetIncident() Incident { return v.Incident }
+
 // createLayeredSettingRecordResponse is returned by createLayeredSettingRecord on success.
 type createLayeredSettingRecordResponse struct {
 	LayeredSettingRecord LayeredSettingRecord `json:"layeredSettingRecord"`
@@ -10206,6 +12785,16 @@ type createMonitorMonitorMonitorUpdateResult struct {
 // GetMonitor returns createMonitorMonitorMonitorUpdateResult.Monitor, and is useful for accessing the field via an interface.
 func (v *createMonitorMonitorMonitorUpdateResult) GetMonitor() Monitor { return v.Monitor }
 
+// createMonitorMuteRuleForMonitorsResponse is returned by createMonitorMuteRuleForMonitors on success.
+type createMonitorMuteRuleForMonitorsResponse struct {
+	MonitorMuteRule MonitorMuteRule `json:"monitorMuteRule"`
+}
+
+// GetMonitorMuteRule returns createMonitorMuteRuleForMonitorsResponse.MonitorMuteRule, and is useful for accessing the field via an interface.
+func (v *createMonitorMuteRuleForMonitorsResponse) GetMonitorMuteRule() MonitorMuteRule {
+	return v.MonitorMuteRule
+}
+
 // createMonitorResponse is returned by createMonitor on success.
 type createMonitorResponse struct {
 	Monitor *createMonitorMonitorMonitorUpdateResult `json:"monitor"`
@@ -10345,6 +12934,14 @@ type deleteAppResponse struct {
 // GetResultStatus returns deleteAppResponse.ResultStatus, and is useful for accessing the field via an interface.
 func (v *deleteAppResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
 
+// deleteBlobResponse is returned by deleteBlob on success.
+type deleteBlobResponse struct {
+	ResultStatus *ResultStatus `json:"resultStatus"`
+}
+
+// GetResultStatus returns deleteBlobResponse.ResultStatus, and is useful for accessing the field via an interface.
+func (v *deleteBlobResponse) GetResultStatus() *ResultStatus { return v.ResultStatus }
+
 // deleteBoardResponse is returned by deleteBoard on success.
 type deleteBoardResponse struct {
 	ResultStatus ResultStatus `json:"resultStatus"`
@@ -10460,6 +13057,14 @@ type deleteFolderResponse struct {
 // GetResultStatus returns deleteFolderResponse.ResultStatus, and is useful for accessing the field via an interface.
 func (v *deleteFolderResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
 
+// deleteIncidentResponse is returned by deleteIncident on success.
+type deleteIncidentResponse struct {
+	ResultStatus ResultStatus `json:"resultStatus"`
+}
+
+// GetResultStatus returns deleteIncidentResponse.ResultStatus, and is useful for accessing the field via an interface.
+func (v *deleteIncidentResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
+
 // deleteLayeredSettingRecordDeleteLayeredSettingRecordDeletedLayeredSettingRecordsResult includes the requested fields of the GraphQL type DeletedLayeredSettingRecordsResult.
 type deleteLayeredSettingRecordDeleteLayeredSettingRecordDeletedLayeredSettingRecordsResult struct {
 	ResultStatus ResultStatus `json:"resultStatus"`
@@ -10496,6 +13101,14 @@ type deleteMonitorActionResponse struct {
 // GetResultStatus returns deleteMonitorActionResponse.ResultStatus, and is useful for accessing the field via an interface.
 func (v *deleteMonitorActionResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
 
+// deleteMonitorMuteRuleResponse is returned by deleteMonitorMuteRule on success.
+type deleteMonitorMuteRuleResponse struct {
+	ResultStatus ResultStatus `json:"resultStatus"`
+}
+
+// GetResultStatus returns deleteMonitorMuteRuleResponse.ResultStatus, and is useful for accessing the field via an interface.
+func (v *deleteMonitorMuteRuleResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
+
 // deleteMonitorResponse is returned by deleteMonitor on success.
 type deleteMonitorResponse struct {
 	ResultStatus ResultStatus `json:"resultStatus"`
@@ -10592,6 +13205,82 @@ type deleteWorkspaceResponse struct {
 // GetResultStatus returns deleteWorkspaceResponse.ResultStatus, and is useful for accessing the field via an interface.
 func (v *deleteWorkspaceResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
 
+// estimateAccelerationJobCostResponse is returned by estimateAccelerationJobCost on success.
+type estimateAccelerationJobCostResponse struct {
+	// Estimate the costs of an acceleration job.
+	Estimates []DatasetCostEstimate `json:"estimates"`
+}
+
+// GetEstimates returns estimateAccelerationJobCostResponse.Estimates, and is useful for accessing the field via an interface.
+func (v *estimateAccelerationJobCostResponse) GetEstimates() []DatasetCostEstimate {
+	return v.Estimates
+}
+
+// exportQueryResponse is returned by exportQuery on success.
+type exportQueryResponse struct {
+	// Given a query (such as you'd pass to datasetProgressive() or checkQueries()),
+	// run the query, and export the results to a cursor, then prepare the export URL for
+	// that cursor with the same parameters as exportCursor(), and return that URL.
+	Export ExportCursorResult `json:"export"`
+}
+
+// GetExport returns exportQueryResponse.Export, and is useful for accessing the field via an interface.
+func (v *exportQueryResponse) GetExport() ExportCursorResult { return v.Export }
+
+// getAccelerationJobResponse is returned by getAccelerationJob on success.
+type getAccelerationJobResponse struct {
+	// Get the full state of an acceleration job identified by the jobId. If the job
+	// can be found, a job object with defailed status is returned. If the job is not
+	// found, an invalid job object is returned together with errors.
+	AccelerationJob AccelerationJob `json:"accelerationJob"`
+}
+
+// GetAccelerationJob returns getAccelerationJobResponse.AccelerationJob, and is useful for accessing the field via an interface.
+func (v *getAccelerationJobResponse) GetAccelerationJob() AccelerationJob { return v.AccelerationJob }
+
+// getActiveMonitorMuteRulesMonitor includes the requested fields of the GraphQL type Monitor.
+type getActiveMonitorMuteRulesMonitor struct {
+	// We preserve monitor update history. Historic monitors do not have activeMonitorInfo populated,
+	// only the most current (active) monitors do.
+	ActiveMonitorInfo *getActiveMonitorMuteRulesMonitorActiveMonitorInfo `json:"activeMonitorInfo"`
+}
+
+// GetActiveMonitorInfo returns getActiveMonitorMuteRulesMonitor.ActiveMonitorInfo, and is useful for accessing the field via an interface.
+func (v *getActiveMonitorMuteRulesMonitor) GetActiveMonitorInfo() *getActiveMonitorMuteRulesMonitorActiveMonitorInfo {
+	return v.ActiveMonitorInfo
+}
+
+// getActiveMonitorMuteRulesMonitorActiveMonitorInfo includes the requested fields of the GraphQL type ActiveMonitorInfo.
+type getActiveMonitorMuteRulesMonitorActiveMonitorInfo struct {
+	// Currently active global and per-monitor mute rules, sorted by the startDate.
+	MuteRules []getActiveMonitorMuteRulesMonitorActiveMonitorInfoMuteRulesMonitorMuteRule `json:"muteRules"`
+}
+
+// GetMuteRules returns getActiveMonitorMuteRulesMonitorActiveMonitorInfo.MuteRules, and is useful for accessing the field via an interface.
+func (v *getActiveMonitorMuteRulesMonitorActiveMonitorInfo) GetMuteRules() []getActiveMonitorMuteRulesMonitorActiveMonitorInfoMuteRulesMonitorMuteRule {
+	return v.MuteRules
+}
+
+// getActiveMonitorMuteRulesMonitorActiveMonitorInfoMuteRulesMonitorMuteRule includes the requested fields of the GraphQL type MonitorMuteRule.
+type getActiveMonitorMuteRulesMonitorActiveMonitorInfoMuteRulesMonitorMuteRule struct {
+	Id string `json:"id"`
+}
+
+// GetId returns getActiveMonitorMuteRulesMonitorActiveMonitorInfoMuteRulesMonitorMuteRule.Id, and is useful for accessing the field via an interface.
+func (v *getActiveMonitorMuteRulesMonitorActiveMonitorInfoMuteRulesMonitorMuteRule) GetId() string {
+	return v.Id
+}
+
+// getActiveMonitorMuteRulesResponse is returned by getActiveMonitorMuteRules on success.
+type getActiveMonitorMuteRulesResponse struct {
+	Monitor getActiveMonitorMuteRulesMonitor `json:"monitor"`
+}
+
+// GetMonitor returns getActiveMonitorMuteRulesResponse.Monitor, and is useful for accessing the field via an interface.
+func (v *getActiveMonitorMuteRulesResponse) GetMonitor() getActiveMonitorMuteRulesMonitor {
+	return v.Monitor
+}
+
 // getAppDataSourceResponse is returned by getAppDataSource on success.
 type getAppDataSourceResponse struct {
 	Appdatasource AppDataSource `json:"appdatasource"`
@@ -10608,6 +13297,16 @@ type getAppResponse struct {
 // GetApp returns getAppResponse.App, and is useful for accessing the field via an interface.
 func (v *getAppResponse) GetApp() App { return v.App }
 
+// getBlobResponse is returned by getBlob on success.
+type getBlobResponse struct {
+	// Read a specific blob by its primary key (user ID plus name)
+	// If user ID is not specified, the user ID in the auth token is used.
+	Blob Blob `json:"blob"`
+}
+
+// GetBlob returns getBlobResponse.Blob, and is useful for accessing the field via an interface.
+func (v *getBlobResponse) GetBlob() Blob { return v.Blob }
+
 // getBoardResponse is returned by getBoard on success.
 type getBoardResponse struct {
 	Board Board `json:"board"`
@@ -10746,6 +13445,36 @@ type getCurrentCustomerResponse struct {
 // GetCustomer returns getCurrentCustomerResponse.Customer, and is useful for accessing the field via an interface.
 func (v *getCurrentCustomerResponse) GetCustomer() *getCurrentCustomerCustomer { return v.Customer }
 
+// getCurrentCustomerSsoCustomer includes the requested fields of the GraphQL type Customer.
+type getCurrentCustomerSsoCustomer struct {
+	Id  string      `json:"id"`
+	Sso CustomerSso `json:"sso"`
+}
+
+// GetId returns getCurrentCustomerSsoCustomer.Id, and is useful for accessing the field via an interface.
+func (v *getCurrentCustomerSsoCustomer) GetId() string { return v.Id }
+
+// GetSso returns getCurrentCustomerSsoCustomer.Sso, and is useful for accessing the field via an interface.
+func (v *getCurrentCustomerSsoCustomer) GetSso() CustomerSso { return v.Sso }
+
+// getCurrentCustomerSsoResponse is returned by getCurrentCustomerSso on success.
+type getCurrentCustomerSsoResponse struct {
+	Customer *getCurrentCustomerSsoCustomer `json:"customer"`
+}
+
+// GetCustomer returns getCurrentCustomerSsoResponse.Customer, and is useful for accessing the field via an interface.
+func (v *getCurrentCustomerSsoResponse) GetCustomer() *getCurrentCustomerSsoCustomer {
+	return v.Customer
+}
+
+// getCurrentUserResponse is returned by getCurrentUser on success.
+type getCurrentUserResponse struct {
+	User *User `json:"user"`
+}
+
+// GetUser returns getCurrentUserResponse.User, and is useful for accessing the field via an interface.
+func (v *getCurrentUserResponse) GetUser() *User { return v.User }
+
 // getDashboardLinkResponse is returned by getDashboardLink on success.
 type getDashboardLinkResponse struct {
 	DashboardLink DashboardLink `json:"dashboardLink"`
@@ -10754,6 +13483,34 @@ type getDashboardLinkResponse struct {
 // GetDashboardLink returns getDashboardLinkResponse.DashboardLink, and is useful for accessing the field via an interface.
 func (v *getDashboardLinkResponse) GetDashboardLink() DashboardLink { return v.DashboardLink }
 
+// getDashboardOwnerObjectDashboard includes the requested fields of the GraphQL type Dashboard.
+type getDashboardOwnerObjectDashboard struct {
+	Id          string             `json:"id"`
+	Name        string             `json:"name"`
+	WorkspaceId string             `json:"workspaceId"`
+	CreatedBy   types.UserIdScalar `json:"createdBy"`
+}
+
+// GetId returns getDashboardOwnerObjectDashboard.Id, and is useful for accessing the field via an interface.
+func (v *getDashboardOwnerObjectDashboard) GetId() string { return v.Id }
+
+// GetName returns getDashboardOwnerObjectDashboard.Name, and is useful for accessing the field via an interface.
+func (v *getDashboardOwnerObjectDashboard) GetName() string { return v.Name }
+
+// GetWorkspaceId returns getDashboardOwnerObjectDashboard.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *getDashboardOwnerObjectDashboard) GetWorkspaceId() string { return v.WorkspaceId }
+
+// GetCreatedBy returns getDashboardOwnerObjectDashboard.CreatedBy, and is useful for accessing the field via an interface.
+func (v *getDashboardOwnerObjectDashboard) GetCreatedBy() types.UserIdScalar { return v.CreatedBy }
+
+// getDashboardOwnerResponse is returned by getDashboardOwner on success.
+type getDashboardOwnerResponse struct {
+	Object getDashboardOwnerObjectDashboard `json:"object"`
+}
+
+// GetObject returns getDashboardOwnerResponse.Object, and is useful for accessing the field via an interface.
+func (v *getDashboardOwnerResponse) GetObject() getDashboardOwnerObjectDashboard { return v.Object }
+
 // getDashboardResponse is returned by getDashboard on success.
 type getDashboardResponse struct {
 	Dashboard Dashboard `json:"dashboard"`
@@ -10762,6 +13519,26 @@ type getDashboardResponse struct {
 // GetDashboard returns getDashboardResponse.Dashboard, and is useful for accessing the field via an interface.
 func (v *getDashboardResponse) GetDashboard() Dashboard { return v.Dashboard }
 
+// getDatasetBillingInfoBillingInfoDatasetAndMonitorBillingInfo includes the requested fields of the GraphQL type DatasetAndMonitorBillingInfo.
+type getDatasetBillingInfoBillingInfoDatasetAndMonitorBillingInfo struct {
+	Datasets24h []ObjectBillingInfo `json:"datasets24h"`
+}
+
+// GetDatasets24h returns getDatasetBillingInfoBillingInfoDatasetAndMonitorBillingInfo.Datasets24h, and is useful for accessing the field via an interface.
+func (v *getDatasetBillingInfoBillingInfoDatasetAndMonitorBillingInfo) GetDatasets24h() []ObjectBillingInfo {
+	return v.Datasets24h
+}
+
+// getDatasetBillingInfoResponse is returned by getDatasetBillingInfo on success.
+type getDatasetBillingInfoResponse struct {
+	BillingInfo getDatasetBillingInfoBillingInfoDatasetAndMonitorBillingInfo `json:"billingInfo"`
+}
+
+// GetBillingInfo returns getDatasetBillingInfoResponse.BillingInfo, and is useful for accessing the field via an interface.
+func (v *getDatasetBillingInfoResponse) GetBillingInfo() getDatasetBillingInfoBillingInfoDatasetAndMonitorBillingInfo {
+	return v.BillingInfo
+}
+
 // getDatasetCorrelationTagsCorrelationTagsDataset includes the requested fields of the GraphQL type Dataset.
 type getDatasetCorrelationTagsCorrelationTagsDataset struct {
 	CorrelationTagMappings []getDatasetCorrelationTagsCorrelationTagsDatasetCorrelationTagMappingsCorrelationTagMapping `json:"correlationTagMappings"`
@@ -10814,17 +13591,83 @@ func (v *getDatasetCorrelationTagsResponse) GetCorrelationTags() *getDatasetCorr
 	return v.CorrelationTags
 }
 
-// getDatasetOutboundShareResponse is returned by getDatasetOutboundShare on success.
-type getDatasetOutboundShareResponse struct {
-	DatasetOutboundShare DatasetOutboundShare `json:"datasetOutboundShare"`
+// getDatasetDoctorReportReportDatasetDoctorReport includes the requested fields of the GraphQL type DatasetDoctorReport.
+type getDatasetDoctorReportReportDatasetDoctorReport struct {
+	// Some particular dataset was the "seed" of this report -- this is the tip
+	// of the iceberg, and the most-interesting dataset in the reported datasets
+	// output.
+	DoctorForDataset string `json:"doctorForDataset"`
+	// All interesting upstream datasets end up in this flat list -- the actual
+	// graph can be constructed by following the inputDatasets links.
+	Datasets []DatasetReport `json:"datasets"`
 }
 
-// GetDatasetOutboundShare returns getDatasetOutboundShareResponse.DatasetOutboundShare, and is useful for accessing the field via an interface.
-func (v *getDatasetOutboundShareResponse) GetDatasetOutboundShare() DatasetOutboundShare {
-	return v.DatasetOutboundShare
+// GetDoctorForDataset returns getDatasetDoctorReportReportDatasetDoctorReport.DoctorForDataset, and is useful for accessing the field via an interface.
+func (v *getDatasetDoctorReportReportDatasetDoctorReport) GetDoctorForDataset() string {
+	return v.DoctorForDataset
 }
 
-// getDatasetQueryOutputResponse is returned by getDatasetQueryOutput on success.
+// GetDatasets returns getDatasetDoctorReportReportDatasetDoctorReport.Datasets, and is useful for accessing the field via an interface.
+func (v *getDatasetDoctorReportReportDatasetDoctorReport) GetDatasets() []DatasetReport {
+	return v.Datasets
+}
+
+// getDatasetDoctorReportResponse is returned by getDatasetDoctorReport on success.
+type getDatasetDoctorReportResponse struct {
+	// The control UI is focused on some particular stage. checkQuery() and friends are helpful
+	// for that, but sometimes you're looking for a more holostic "what the hell is wrong with
+	// this dataset" view, which you may be able to get in one swell foop from this call. Note
+	// that the call may take a few seconds if the dataset has many upstream datasets.
+	// If upLevels is 0, only the dataset is checked. If upLevels is 1, the dataset and its
+	// immediate ancestors are checked, and so on. If upLevels is not set at all, then all
+	// ancestors up to the observation dataset will be checked!
+	Report *getDatasetDoctorReportReportDatasetDoctorReport `json:"report"`
+}
+
+// GetReport returns getDatasetDoctorReportResponse.Report, and is useful for accessing the field via an interface.
+func (v *getDatasetDoctorReportResponse) GetReport() *getDatasetDoctorReportReportDatasetDoctorReport {
+	return v.Report
+}
+
+// getDatasetOutboundShareResponse is returned by getDatasetOutboundShare on success.
+type getDatasetOutboundShareResponse struct {
+	DatasetOutboundShare DatasetOutboundShare `json:"datasetOutboundShare"`
+}
+
+// GetDatasetOutboundShare returns getDatasetOutboundShareResponse.DatasetOutboundShare, and is useful for accessing the field via an interface.
+func (v *getDatasetOutboundShareResponse) GetDatasetOutboundShare() DatasetOutboundShare {
+	return v.DatasetOutboundShare
+}
+
+// getDatasetOwnerObjectDataset includes the requested fields of the GraphQL type Dataset.
+type getDatasetOwnerObjectDataset struct {
+	Id          string             `json:"id"`
+	Name        string             `json:"name"`
+	WorkspaceId string             `json:"workspaceId"`
+	CreatedBy   types.UserIdScalar `json:"createdBy"`
+}
+
+// GetId returns getDatasetOwnerObjectDataset.Id, and is useful for accessing the field via an interface.
+func (v *getDatasetOwnerObjectDataset) GetId() string { return v.Id }
+
+// GetName returns getDatasetOwnerObjectDataset.Name, and is useful for accessing the field via an interface.
+func (v *getDatasetOwnerObjectDataset) GetName() string { return v.Name }
+
+// GetWorkspaceId returns getDatasetOwnerObjectDataset.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *getDatasetOwnerObjectDataset) GetWorkspaceId() string { return v.WorkspaceId }
+
+// GetCreatedBy returns getDatasetOwnerObjectDataset.CreatedBy, and is useful for accessing the field via an interface.
+func (v *getDatasetOwnerObjectDataset) GetCreatedBy() types.UserIdScalar { return v.CreatedBy }
+
+// getDatasetOwnerResponse is returned by getDatasetOwner on success.
+type getDatasetOwnerResponse struct {
+	Object *getDatasetOwnerObjectDataset `json:"object"`
+}
+
+// GetObject returns getDatasetOwnerResponse.Object, and is useful for accessing the field via an interface.
+func (v *getDatasetOwnerResponse) GetObject() *getDatasetOwnerObjectDataset { return v.Object }
+
+// getDatasetQueryOutputResponse is returned by getDatasetQueryOutput on success.
 type getDatasetQueryOutputResponse struct {
 	// Given some datasets and pipeline expressions, run the query and extract the
 	// query results.  Errors in input specification come back as GQL/HTTP errors,
@@ -10881,6 +13724,14 @@ func (v *getDeferredForeignKeyResponse) GetDeferredForeignKey() *DeferredForeign
 	return v.DeferredForeignKey
 }
 
+// getFeatureFlagResponse is returned by getFeatureFlag on success.
+type getFeatureFlagResponse struct {
+	FeatureFlag FeatureFlag `json:"featureFlag"`
+}
+
+// GetFeatureFlag returns getFeatureFlagResponse.FeatureFlag, and is useful for accessing the field via an interface.
+func (v *getFeatureFlagResponse) GetFeatureFlag() FeatureFlag { return v.FeatureFlag }
+
 // getFiledropResponse is returned by getFiledrop on success.
 type getFiledropResponse struct {
 	Filedrop *Filedrop `json:"filedrop"`
@@ -10889,6 +13740,34 @@ type getFiledropResponse struct {
 // GetFiledrop returns getFiledropResponse.Filedrop, and is useful for accessing the field via an interface.
 func (v *getFiledropResponse) GetFiledrop() *Filedrop { return v.Filedrop }
 
+// getFolderOwnerObjectFolder includes the requested fields of the GraphQL type Folder.
+type getFolderOwnerObjectFolder struct {
+	Id          string             `json:"id"`
+	Name        string             `json:"name"`
+	WorkspaceId string             `json:"workspaceId"`
+	CreatedBy   types.UserIdScalar `json:"createdBy"`
+}
+
+// GetId returns getFolderOwnerObjectFolder.Id, and is useful for accessing the field via an interface.
+func (v *getFolderOwnerObjectFolder) GetId() string { return v.Id }
+
+// GetName returns getFolderOwnerObjectFolder.Name, and is useful for accessing the field via an interface.
+func (v *getFolderOwnerObjectFolder) GetName() string { return v.Name }
+
+// GetWorkspaceId returns getFolderOwnerObjectFolder.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *getFolderOwnerObjectFolder) GetWorkspaceId() string { return v.WorkspaceId }
+
+// GetCreatedBy returns getFolderOwnerObjectFolder.CreatedBy, and is useful for accessing the field via an interface.
+func (v *getFolderOwnerObjectFolder) GetCreatedBy() types.UserIdScalar { return v.CreatedBy }
+
+// getFolderOwnerResponse is returned by getFolderOwner on success.
+type getFolderOwnerResponse struct {
+	Object getFolderOwnerObjectFolder `json:"object"`
+}
+
+// GetObject returns getFolderOwnerResponse.Object, and is useful for accessing the field via an interface.
+func (v *getFolderOwnerResponse) GetObject() getFolderOwnerObjectFolder { return v.Object }
+
 // getFolderResponse is returned by getFolder on success.
 type getFolderResponse struct {
 	Folder Folder `json:"folder"`
@@ -10897,6 +13776,30 @@ type getFolderResponse struct {
 // GetFolder returns getFolderResponse.Folder, and is useful for accessing the field via an interface.
 func (v *getFolderResponse) GetFolder() Folder { return v.Folder }
 
+// getIncidentResponse is returned by getIncident on success.
+type getIncidentResponse struct {
+	Incident Incident `json:"incident"`
+}
+
+// GetIncident returns getIncidentResponse.Incident, and is useful for accessing the field via an interface.
+func (v *getIncidentResponse) GetIncident() Incident { return v.Incident }
+
+// getIncidentsForStatusResponse is returned by getIncidentsForStatus on success.
+type getIncidentsForStatusResponse struct {
+	Incidents []Incident `json:"incidents"`
+}
+
+// GetIncidents returns getIncidentsForStatusResponse.Incidents, and is useful for accessing the field via an interface.
+func (v *getIncidentsForStatusResponse) GetIncidents() []Incident { return v.Incidents }
+
+// getIncidentsForTextQueryResponse is returned by getIncidentsForTextQuery on success.
+type getIncidentsForTextQueryResponse struct {
+	Incidents []Incident `json:"incidents"`
+}
+
+// GetIncidents returns getIncidentsForTextQueryResponse.Incidents, and is useful for accessing the field via an interface.
+func (v *getIncidentsForTextQueryResponse) GetIncidents() []Incident { return v.Incidents }
+
 // getIngestInfoIngestCustomer includes the requested fields of the GraphQL type Customer.
 type getIngestInfoIngestCustomer struct {
 	IngestInfo IngestInfo `json:"ingestInfo"`
@@ -11007,6 +13910,14 @@ func (v *getMonitorActionResponse) __premarshalJSON() (*__premarshalgetMonitorAc
 	return &retval, nil
 }
 
+// getMonitorMuteRuleResponse is returned by getMonitorMuteRule on success.
+type getMonitorMuteRuleResponse struct {
+	MonitorMuteRule MonitorMuteRule `json:"monitorMuteRule"`
+}
+
+// GetMonitorMuteRule returns getMonitorMuteRuleResponse.MonitorMuteRule, and is useful for accessing the field via an interface.
+func (v *getMonitorMuteRuleResponse) GetMonitorMuteRule() MonitorMuteRule { return v.MonitorMuteRule }
+
 // getMonitorResponse is returned by getMonitor on success.
 type getMonitorResponse struct {
 	Monitor Monitor `json:"monitor"`
@@ -11033,6 +13944,34 @@ func (v *getMonitorV2DestinationResponse) GetMonitorV2Destination() MonitorV2Des
 	return v.MonitorV2Destination
 }
 
+// getMonitorV2OwnerObjectMonitorV2 includes the requested fields of the GraphQL type MonitorV2.
+type getMonitorV2OwnerObjectMonitorV2 struct {
+	Id          string             `json:"id"`
+	Name        string             `json:"name"`
+	WorkspaceId string             `json:"workspaceId"`
+	CreatedBy   types.UserIdScalar `json:"createdBy"`
+}
+
+// GetId returns getMonitorV2OwnerObjectMonitorV2.Id, and is useful for accessing the field via an interface.
+func (v *getMonitorV2OwnerObjectMonitorV2) GetId() string { return v.Id }
+
+// GetName returns getMonitorV2OwnerObjectMonitorV2.Name, and is useful for accessing the field via an interface.
+func (v *getMonitorV2OwnerObjectMonitorV2) GetName() string { return v.Name }
+
+// GetWorkspaceId returns getMonitorV2OwnerObjectMonitorV2.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *getMonitorV2OwnerObjectMonitorV2) GetWorkspaceId() string { return v.WorkspaceId }
+
+// GetCreatedBy returns getMonitorV2OwnerObjectMonitorV2.CreatedBy, and is useful for accessing the field via an interface.
+func (v *getMonitorV2OwnerObjectMonitorV2) GetCreatedBy() types.UserIdScalar { return v.CreatedBy }
+
+// getMonitorV2OwnerResponse is returned by getMonitorV2Owner on success.
+type getMonitorV2OwnerResponse struct {
+	Object getMonitorV2OwnerObjectMonitorV2 `json:"object"`
+}
+
+// GetObject returns getMonitorV2OwnerResponse.Object, and is useful for accessing the field via an interface.
+func (v *getMonitorV2OwnerResponse) GetObject() getMonitorV2OwnerObjectMonitorV2 { return v.Object }
+
 // getMonitorV2Response is returned by getMonitorV2 on success.
 type getMonitorV2Response struct {
 	MonitorV2 MonitorV2 `json:"monitorV2"`
@@ -11041,6 +13980,14 @@ type getMonitorV2Response struct {
 // GetMonitorV2 returns getMonitorV2Response.MonitorV2, and is useful for accessing the field via an interface.
 func (v *getMonitorV2Response) GetMonitorV2() MonitorV2 { return v.MonitorV2 }
 
+// getPathsBetweenDatasetsResponse is returned by getPathsBetweenDatasets on success.
+type getPathsBetweenDatasetsResponse struct {
+	Paths []RelationshipPath `json:"paths"`
+}
+
+// GetPaths returns getPathsBetweenDatasetsResponse.Paths, and is useful for accessing the field via an interface.
+func (v *getPathsBetweenDatasetsResponse) GetPaths() []RelationshipPath { return v.Paths }
+
 // getPollerResponse is returned by getPoller on success.
 type getPollerResponse struct {
 	Poller Poller `json:"poller"`
@@ -11059,6 +14006,17 @@ func (v *getPreferredPathResponse) GetPreferredPathWithStatus() PreferredPathWit
 	return v.PreferredPathWithStatus
 }
 
+// getQueryCursorResponse is returned by getQueryCursor on success.
+type getQueryCursorResponse struct {
+	// Pull more results from a cursor. rollupFilter provides more granular
+	// filter for rolled-up results. Must be nil for any unrolled-up result.
+	// Default to the "all" mode for backward compatibility.
+	Page *types.PaginatedResults `json:"page"`
+}
+
+// GetPage returns getQueryCursorResponse.Page, and is useful for accessing the field via an interface.
+func (v *getQueryCursorResponse) GetPage() *types.PaginatedResults { return v.Page }
+
 // getRbacDefaultGroupResponse is returned by getRbacDefaultGroup on success.
 type getRbacDefaultGroupResponse struct {
 	// Get the group users will be assigned to by default
@@ -11128,6 +14086,34 @@ type getUserResponse struct {
 // GetUser returns getUserResponse.User, and is useful for accessing the field via an interface.
 func (v *getUserResponse) GetUser() *User { return v.User }
 
+// getWorksheetOwnerObjectWorksheet includes the requested fields of the GraphQL type Worksheet.
+type getWorksheetOwnerObjectWorksheet struct {
+	Id          string             `json:"id"`
+	Name        string             `json:"name"`
+	WorkspaceId string             `json:"workspaceId"`
+	CreatedBy   types.UserIdScalar `json:"createdBy"`
+}
+
+// GetId returns getWorksheetOwnerObjectWorksheet.Id, and is useful for accessing the field via an interface.
+func (v *getWorksheetOwnerObjectWorksheet) GetId() string { return v.Id }
+
+// GetName returns getWorksheetOwnerObjectWorksheet.Name, and is useful for accessing the field via an interface.
+func (v *getWorksheetOwnerObjectWorksheet) GetName() string { return v.Name }
+
+// GetWorkspaceId returns getWorksheetOwnerObjectWorksheet.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *getWorksheetOwnerObjectWorksheet) GetWorkspaceId() string { return v.WorkspaceId }
+
+// GetCreatedBy returns getWorksheetOwnerObjectWorksheet.CreatedBy, and is useful for accessing the field via an interface.
+func (v *getWorksheetOwnerObjectWorksheet) GetCreatedBy() types.UserIdScalar { return v.CreatedBy }
+
+// getWorksheetOwnerResponse is returned by getWorksheetOwner on success.
+type getWorksheetOwnerResponse struct {
+	Object *getWorksheetOwnerObjectWorksheet `json:"object"`
+}
+
+// GetObject returns getWorksheetOwnerResponse.Object, and is useful for accessing the field via an interface.
+func (v *getWorksheetOwnerResponse) GetObject() *getWorksheetOwnerObjectWorksheet { return v.Object }
+
 // getWorksheetResponse is returned by getWorksheet on success.
 type getWorksheetResponse struct {
 	Worksheet *Worksheet `json:"worksheet"`
@@ -11144,6 +14130,50 @@ type getWorkspaceResponse struct {
 // GetWorkspace returns getWorkspaceResponse.Workspace, and is useful for accessing the field via an interface.
 func (v *getWorkspaceResponse) GetWorkspace() *Workspace { return v.Workspace }
 
+// inviteUserResponse is returned by inviteUser on success.
+type inviteUserResponse struct {
+	// returns token that must come back to apiserver to complete the account setup
+	Token string `json:"token"`
+}
+
+// GetToken returns inviteUserResponse.Token, and is useful for accessing the field via an interface.
+func (v *inviteUserResponse) GetToken() string { return v.Token }
+
+// listBlobsResponse is returned by listBlobs on success.
+type listBlobsResponse struct {
+	// Each field is restrictive (AND)
+	// If you don't specify any of the filter kinds, you will get all blobs
+	// that are readable by the current user within the current customer.
+	// Usually, you will want to specify a blob kind in this search.
+	Blobs []Blob `json:"blobs"`
+}
+
+// GetBlobs returns listBlobsResponse.Blobs, and is useful for accessing the field via an interface.
+func (v *listBlobsResponse) GetBlobs() []Blob { return v.Blobs }
+
+// listDatasetLineageResponse is returned by listDatasetLineage on success.
+type listDatasetLineageResponse struct {
+	Workspaces []listDatasetLineageWorkspacesProject `json:"workspaces"`
+}
+
+// GetWorkspaces returns listDatasetLineageResponse.Workspaces, and is useful for accessing the field via an interface.
+func (v *listDatasetLineageResponse) GetWorkspaces() []listDatasetLineageWorkspacesProject {
+	return v.Workspaces
+}
+
+// listDatasetLineageWorkspacesProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// Project and Workspace are the same thing We call it Workspace in the UI
+// design now, so at some point, maybe update the API to match the updated
+// design?
+type listDatasetLineageWorkspacesProject struct {
+	Datasets []DatasetLineage `json:"datasets"`
+}
+
+// GetDatasets returns listDatasetLineageWorkspacesProject.Datasets, and is useful for accessing the field via an interface.
+func (v *listDatasetLineageWorkspacesProject) GetDatasets() []DatasetLineage { return v.Datasets }
+
 // listDatasetsDatasetsProject includes the requested fields of the GraphQL type Project.
 // The GraphQL type's documentation follows.
 //
@@ -11165,6 +14195,298 @@ type listDatasetsResponse struct {
 // GetDatasets returns listDatasetsResponse.Datasets, and is useful for accessing the field via an interface.
 func (v *listDatasetsResponse) GetDatasets() []listDatasetsDatasetsProject { return v.Datasets }
 
+// listFeatureFlagsResponse is returned by listFeatureFlags on success.
+type listFeatureFlagsResponse struct {
+	FeatureFlags []FeatureFlag `json:"featureFlags"`
+}
+
+// GetFeatureFlags returns listFeatureFlagsResponse.FeatureFlags, and is useful for accessing the field via an interface.
+func (v *listFeatureFlagsResponse) GetFeatureFlags() []FeatureFlag { return v.FeatureFlags }
+
+// listMonitorsForDatasetResponse is returned by listMonitorsForDataset on success.
+type listMonitorsForDatasetResponse struct {
+	Monitors []DatasetMonitor `json:"monitors"`
+}
+
+// GetMonitors returns listMonitorsForDatasetResponse.Monitors, and is useful for accessing the field via an interface.
+func (v *listMonitorsForDatasetResponse) GetMonitors() []DatasetMonitor { return v.Monitors }
+
+// listRbacGroupmembersResponse is returned by listRbacGroupmembers on success.
+type listRbacGroupmembersResponse struct {
+	// All group memberships defined in this tenant.
+	RbacGroupmembers []RbacGroupmember `json:"rbacGroupmembers"`
+}
+
+// GetRbacGroupmembers returns listRbacGroupmembersResponse.RbacGroupmembers, and is useful for accessing the field via an interface.
+func (v *listRbacGroupmembersResponse) GetRbacGroupmembers() []RbacGroupmember {
+	return v.RbacGroupmembers
+}
+
+// listRbacStatementsResponse is returned by listRbacStatements on success.
+type listRbacStatementsResponse struct {
+	// All RBAC statements defined in this tenant.
+	RbacStatements []RbacStatement `json:"rbacStatements"`
+}
+
+// GetRbacStatements returns listRbacStatementsResponse.RbacStatements, and is useful for accessing the field via an interface.
+func (v *listRbacStatementsResponse) GetRbacStatements() []RbacStatement { return v.RbacStatements }
+
+// listWorkspaceDatasetLineageResponse is returned by listWorkspaceDatasetLineage on success.
+type listWorkspaceDatasetLineageResponse struct {
+	Workspace *listWorkspaceDatasetLineageWorkspaceProject `json:"workspace"`
+}
+
+// GetWorkspace returns listWorkspaceDatasetLineageResponse.Workspace, and is useful for accessing the field via an interface.
+func (v *listWorkspaceDatasetLineageResponse) GetWorkspace() *listWorkspaceDatasetLineageWorkspaceProject {
+	return v.Workspace
+}
+
+// listWorkspaceDatasetLineageWorkspaceProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// Project and Workspace are the same thing We call it Workspace in the UI
+// design now, so at some point, maybe update the API to match the updated
+// design?
+type listWorkspaceDatasetLineageWorkspaceProject struct {
+	Datasets []DatasetLineage `json:"datasets"`
+}
+
+// GetDatasets returns listWorkspaceDatasetLineageWorkspaceProject.Datasets, and is useful for accessing the field via an interface.
+func (v *listWorkspaceDatasetLineageWorkspaceProject) GetDatasets() []DatasetLineage {
+	return v.Datasets
+}
+
+// listWorkspaceObjectOwnersDashboardsDashboardSearchResultWrapper includes the requested fields of the GraphQL type DashboardSearchResultWrapper.
+type listWorkspaceObjectOwnersDashboardsDashboardSearchResultWrapper struct {
+	Dashboards []listWorkspaceObjectOwnersDashboardsDashboardSearchResultWrapperDashboardsDashboardSearchResult `json:"dashboards"`
+}
+
+// GetDashboards returns listWorkspaceObjectOwnersDashboardsDashboardSearchResultWrapper.Dashboards, and is useful for accessing the field via an interface.
+func (v *listWorkspaceObjectOwnersDashboardsDashboardSearchResultWrapper) GetDashboards() []listWorkspaceObjectOwnersDashboardsDashboardSearchResultWrapperDashboardsDashboardSearchResult {
+	return v.Dashboards
+}
+
+// listWorkspaceObjectOwnersDashboardsDashboardSearchResultWrapperDashboardsDashboardSearchResult includes the requested fields of the GraphQL type DashboardSearchResult.
+type listWorkspaceObjectOwnersDashboardsDashboardSearchResultWrapperDashboardsDashboardSearchResult struct {
+	Dashboard listWorkspaceObjectOwnersDashboardsDashboardSearchResultWrapperDashboardsDashboardSearchResultDashboard `json:"dashboard"`
+}
+
+// GetDashboard returns listWorkspaceObjectOwnersDashboardsDashboardSearchResultWrapperDashboardsDashboardSearchResult.Dashboard, and is useful for accessing the field via an interface.
+func (v *listWorkspaceObjectOwnersDashboardsDashboardSearchResultWrapperDashboardsDashboardSearchResult) GetDashboard() listWorkspaceObjectOwnersDashboardsDashboardSearchResultWrapperDashboardsDashboardSearchResultDashboard {
+	return v.Dashboard
+}
+
+// listWorkspaceObjectOwnersDashboardsDashboardSearchResultWrapperDashboardsDashboardSearchResultDashboard includes the requested fields of the GraphQL type Dashboard.
+type listWorkspaceObjectOwnersDashboardsDashboardSearchResultWrapperDashboardsDashboardSearchResultDashboard struct {
+	Id          string             `json:"id"`
+	Name        string             `json:"name"`
+	WorkspaceId string             `json:"workspaceId"`
+	CreatedBy   types.UserIdScalar `json:"createdBy"`
+}
+
+// GetId returns listWorkspaceObjectOwnersDashboardsDashboardSearchResultWrapperDashboardsDashboardSearchResultDashboard.Id, and is useful for accessing the field via an interface.
+func (v *listWorkspaceObjectOwnersDashboardsDashboardSearchResultWrapperDashboardsDashboardSearchResultDashboard) GetId() string {
+	return v.Id
+}
+
+// GetName returns listWorkspaceObjectOwnersDashboardsDashboardSearchResultWrapperDashboardsDashboardSearchResultDashboard.Name, and is useful for accessing the field via an interface.
+func (v *listWorkspaceObjectOwnersDashboardsDashboardSearchResultWrapperDashboardsDashboardSearchResultDashboard) GetName() string {
+	return v.Name
+}
+
+// GetWorkspaceId returns listWorkspaceObjectOwnersDashboardsDashboardSearchResultWrapperDashboardsDashboardSearchResultDashboard.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *listWorkspaceObjectOwnersDashboardsDashboardSearchResultWrapperDashboardsDashboardSearchResultDashboard) GetWorkspaceId() string {
+	return v.WorkspaceId
+}
+
+// GetCreatedBy returns listWorkspaceObjectOwnersDashboardsDashboardSearchResultWrapperDashboardsDashboardSearchResultDashboard.CreatedBy, and is useful for accessing the field via an interface.
+func (v *listWorkspaceObjectOwnersDashboardsDashboardSearchResultWrapperDashboardsDashboardSearchResultDashboard) GetCreatedBy() types.UserIdScalar {
+	return v.CreatedBy
+}
+
+// listWorkspaceObjectOwnersMonitorsMonitorV2SearchResult includes the requested fields of the GraphQL type MonitorV2SearchResult.
+type listWorkspaceObjectOwnersMonitorsMonitorV2SearchResult struct {
+	Results []listWorkspaceObjectOwnersMonitorsMonitorV2SearchResultResultsMonitorV2 `json:"results"`
+}
+
+// GetResults returns listWorkspaceObjectOwnersMonitorsMonitorV2SearchResult.Results, and is useful for accessing the field via an interface.
+func (v *listWorkspaceObjectOwnersMonitorsMonitorV2SearchResult) GetResults() []listWorkspaceObjectOwnersMonitorsMonitorV2SearchResultResultsMonitorV2 {
+	return v.Results
+}
+
+// listWorkspaceObjectOwnersMonitorsMonitorV2SearchResultResultsMonitorV2 includes the requested fields of the GraphQL type MonitorV2.
+type listWorkspaceObjectOwnersMonitorsMonitorV2SearchResultResultsMonitorV2 struct {
+	Id          string             `json:"id"`
+	Name        string             `json:"name"`
+	WorkspaceId string             `json:"workspaceId"`
+	CreatedBy   types.UserIdScalar `json:"createdBy"`
+}
+
+// GetId returns listWorkspaceObjectOwnersMonitorsMonitorV2SearchResultResultsMonitorV2.Id, and is useful for accessing the field via an interface.
+func (v *listWorkspaceObjectOwnersMonitorsMonitorV2SearchResultResultsMonitorV2) GetId() string {
+	return v.Id
+}
+
+// GetName returns listWorkspaceObjectOwnersMonitorsMonitorV2SearchResultResultsMonitorV2.Name, and is useful for accessing the field via an interface.
+func (v *listWorkspaceObjectOwnersMonitorsMonitorV2SearchResultResultsMonitorV2) GetName() string {
+	return v.Name
+}
+
+// GetWorkspaceId returns listWorkspaceObjectOwnersMonitorsMonitorV2SearchResultResultsMonitorV2.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *listWorkspaceObjectOwnersMonitorsMonitorV2SearchResultResultsMonitorV2) GetWorkspaceId() string {
+	return v.WorkspaceId
+}
+
+// GetCreatedBy returns listWorkspaceObjectOwnersMonitorsMonitorV2SearchResultResultsMonitorV2.CreatedBy, and is useful for accessing the field via an interface.
+func (v *listWorkspaceObjectOwnersMonitorsMonitorV2SearchResultResultsMonitorV2) GetCreatedBy() types.UserIdScalar {
+	return v.CreatedBy
+}
+
+// listWorkspaceObjectOwnersResponse is returned by listWorkspaceObjectOwners on success.
+type listWorkspaceObjectOwnersResponse struct {
+	Workspace  *listWorkspaceObjectOwnersWorkspaceProject                      `json:"workspace"`
+	Monitors   listWorkspaceObjectOwnersMonitorsMonitorV2SearchResult          `json:"monitors"`
+	Dashboards listWorkspaceObjectOwnersDashboardsDashboardSearchResultWrapper `json:"dashboards"`
+	Worksheets listWorkspaceObjectOwnersWorksheetsWorksheetSearchResultWrapper `json:"worksheets"`
+}
+
+// GetWorkspace returns listWorkspaceObjectOwnersResponse.Workspace, and is useful for accessing the field via an interface.
+func (v *listWorkspaceObjectOwnersResponse) GetWorkspace() *listWorkspaceObjectOwnersWorkspaceProject {
+	return v.Workspace
+}
+
+// GetMonitors returns listWorkspaceObjectOwnersResponse.Monitors, and is useful for accessing the field via an interface.
+func (v *listWorkspaceObjectOwnersResponse) GetMonitors() listWorkspaceObjectOwnersMonitorsMonitorV2SearchResult {
+	return v.Monitors
+}
+
+// GetDashboards returns listWorkspaceObjectOwnersResponse.Dashboards, and is useful for accessing the field via an interface.
+func (v *listWorkspaceObjectOwnersResponse) GetDashboards() listWorkspaceObjectOwnersDashboardsDashboardSearchResultWrapper {
+	return v.Dashboards
+}
+
+// GetWorksheets returns listWorkspaceObjectOwnersResponse.Worksheets, and is useful for accessing the field via an interface.
+func (v *listWorkspaceObjectOwnersResponse) GetWorksheets() listWorkspaceObjectOwnersWorksheetsWorksheetSearchResultWrapper {
+	return v.Worksheets
+}
+
+// listWorkspaceObjectOwnersWorksheetsWorksheetSearchResultWrapper includes the requested fields of the GraphQL type WorksheetSearchResultWrapper.
+type listWorkspaceObjectOwnersWorksheetsWorksheetSearchResultWrapper struct {
+	Worksheets []listWorkspaceObjectOwnersWorksheetsWorksheetSearchResultWrapperWorksheetsWorksheetSearchResult `json:"worksheets"`
+}
+
+// GetWorksheets returns listWorkspaceObjectOwnersWorksheetsWorksheetSearchResultWrapper.Worksheets, and is useful for accessing the field via an interface.
+func (v *listWorkspaceObjectOwnersWorksheetsWorksheetSearchResultWrapper) GetWorksheets() []listWorkspaceObjectOwnersWorksheetsWorksheetSearchResultWrapperWorksheetsWorksheetSearchResult {
+	return v.Worksheets
+}
+
+// listWorkspaceObjectOwnersWorksheetsWorksheetSearchResultWrapperWorksheetsWorksheetSearchResult includes the requested fields of the GraphQL type WorksheetSearchResult.
+type listWorkspaceObjectOwnersWorksheetsWorksheetSearchResultWrapperWorksheetsWorksheetSearchResult struct {
+	Worksheet listWorkspaceObjectOwnersWorksheetsWorksheetSearchResultWrapperWorksheetsWorksheetSearchResultWorksheet `json:"worksheet"`
+}
+
+// GetWorksheet returns listWorkspaceObjectOwnersWorksheetsWorksheetSearchResultWrapperWorksheetsWorksheetSearchResult.Worksheet, and is useful for accessing the field via an interface.
+func (v *listWorkspaceObjectOwnersWorksheetsWorksheetSearchResultWrapperWorksheetsWorksheetSearchResult) GetWorksheet() listWorkspaceObjectOwnersWorksheetsWorksheetSearchResultWrapperWorksheetsWorksheetSearchResultWorksheet {
+	return v.Worksheet
+}
+
+// listWorkspaceObjectOwnersWorksheetsWorksheetSearchResultWrapperWorksheetsWorksheetSearchResultWorksheet includes the requested fields of the GraphQL type Worksheet.
+type listWorkspaceObjectOwnersWorksheetsWorksheetSearchResultWrapperWorksheetsWorksheetSearchResultWorksheet struct {
+	Id          string             `json:"id"`
+	Name        string             `json:"name"`
+	WorkspaceId string             `json:"workspaceId"`
+	CreatedBy   types.UserIdScalar `json:"createdBy"`
+}
+
+// GetId returns listWorkspaceObjectOwnersWorksheetsWorksheetSearchResultWrapperWorksheetsWorksheetSearchResultWorksheet.Id, and is useful for accessing the field via an interface.
+func (v *listWorkspaceObjectOwnersWorksheetsWorksheetSearchResultWrapperWorksheetsWorksheetSearchResultWorksheet) GetId() string {
+	return v.Id
+}
+
+// GetName returns listWorkspaceObjectOwnersWorksheetsWorksheetSearchResultWrapperWorksheetsWorksheetSearchResultWorksheet.Name, and is useful for accessing the field via an interface.
+func (v *listWorkspaceObjectOwnersWorksheetsWorksheetSearchResultWrapperWorksheetsWorksheetSearchResultWorksheet) GetName() string {
+	return v.Name
+}
+
+// GetWorkspaceId returns listWorkspaceObjectOwnersWorksheetsWorksheetSearchResultWrapperWorksheetsWorksheetSearchResultWorksheet.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *listWorkspaceObjectOwnersWorksheetsWorksheetSearchResultWrapperWorksheetsWorksheetSearchResultWorksheet) GetWorkspaceId() string {
+	return v.WorkspaceId
+}
+
+// GetCreatedBy returns listWorkspaceObjectOwnersWorksheetsWorksheetSearchResultWrapperWorksheetsWorksheetSearchResultWorksheet.CreatedBy, and is useful for accessing the field via an interface.
+func (v *listWorkspaceObjectOwnersWorksheetsWorksheetSearchResultWrapperWorksheetsWorksheetSearchResultWorksheet) GetCreatedBy() types.UserIdScalar {
+	return v.CreatedBy
+}
+
+// listWorkspaceObjectOwnersWorkspaceProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// Project and Workspace are the same thing We call it Workspace in the UI
+// design now, so at some point, maybe update the API to match the updated
+// design?
+type listWorkspaceObjectOwnersWorkspaceProject struct {
+	Datasets []listWorkspaceObjectOwnersWorkspaceProjectDatasetsDataset `json:"datasets"`
+	Folders  []listWorkspaceObjectOwnersWorkspaceProjectFoldersFolder   `json:"folders"`
+}
+
+// GetDatasets returns listWorkspaceObjectOwnersWorkspaceProject.Datasets, and is useful for accessing the field via an interface.
+func (v *listWorkspaceObjectOwnersWorkspaceProject) GetDatasets() []listWorkspaceObjectOwnersWorkspaceProjectDatasetsDataset {
+	return v.Datasets
+}
+
+// GetFolders returns listWorkspaceObjectOwnersWorkspaceProject.Folders, and is useful for accessing the field via an interface.
+func (v *listWorkspaceObjectOwnersWorkspaceProject) GetFolders() []listWorkspaceObjectOwnersWorkspaceProjectFoldersFolder {
+	return v.Folders
+}
+
+// listWorkspaceObjectOwnersWorkspaceProjectDatasetsDataset includes the requested fields of the GraphQL type Dataset.
+type listWorkspaceObjectOwnersWorkspaceProjectDatasetsDataset struct {
+	Id          string             `json:"id"`
+	Name        string             `json:"name"`
+	WorkspaceId string             `json:"workspaceId"`
+	CreatedBy   types.UserIdScalar `json:"createdBy"`
+}
+
+// GetId returns listWorkspaceObjectOwnersWorkspaceProjectDatasetsDataset.Id, and is useful for accessing the field via an interface.
+func (v *listWorkspaceObjectOwnersWorkspaceProjectDatasetsDataset) GetId() string { return v.Id }
+
+// GetName returns listWorkspaceObjectOwnersWorkspaceProjectDatasetsDataset.Name, and is useful for accessing the field via an interface.
+func (v *listWorkspaceObjectOwnersWorkspaceProjectDatasetsDataset) GetName() string { return v.Name }
+
+// GetWorkspaceId returns listWorkspaceObjectOwnersWorkspaceProjectDatasetsDataset.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *listWorkspaceObjectOwnersWorkspaceProjectDatasetsDataset) GetWorkspaceId() string {
+	return v.WorkspaceId
+}
+
+// GetCreatedBy returns listWorkspaceObjectOwnersWorkspaceProjectDatasetsDataset.CreatedBy, and is useful for accessing the field via an interface.
+func (v *listWorkspaceObjectOwnersWorkspaceProjectDatasetsDataset) GetCreatedBy() types.UserIdScalar {
+	return v.CreatedBy
+}
+
+// listWorkspaceObjectOwnersWorkspaceProjectFoldersFolder includes the requested fields of the GraphQL type Folder.
+type listWorkspaceObjectOwnersWorkspaceProjectFoldersFolder struct {
+	Id          string             `json:"id"`
+	Name        string             `json:"name"`
+	WorkspaceId string             `json:"workspaceId"`
+	CreatedBy   types.UserIdScalar `json:"createdBy"`
+}
+
+// GetId returns listWorkspaceObjectOwnersWorkspaceProjectFoldersFolder.Id, and is useful for accessing the field via an interface.
+func (v *listWorkspaceObjectOwnersWorkspaceProjectFoldersFolder) GetId() string { return v.Id }
+
+// GetName returns listWorkspaceObjectOwnersWorkspaceProjectFoldersFolder.Name, and is useful for accessing the field via an interface.
+func (v *listWorkspaceObjectOwnersWorkspaceProjectFoldersFolder) GetName() string { return v.Name }
+
+// GetWorkspaceId returns listWorkspaceObjectOwnersWorkspaceProjectFoldersFolder.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *listWorkspaceObjectOwnersWorkspaceProjectFoldersFolder) GetWorkspaceId() string {
+	return v.WorkspaceId
+}
+
+// GetCreatedBy returns listWorkspaceObjectOwnersWorkspaceProjectFoldersFolder.CreatedBy, and is useful for accessing the field via an interface.
+func (v *listWorkspaceObjectOwnersWorkspaceProjectFoldersFolder) GetCreatedBy() types.UserIdScalar {
+	return v.CreatedBy
+}
+
 // listWorkspacesResponse is returned by listWorkspaces on success.
 type listWorkspacesResponse struct {
 	Workspaces []Workspace `json:"workspaces"`
@@ -11311,57 +14633,172 @@ type lookupWorkspaceResponse struct {
 // GetWorkspace returns lookupWorkspaceResponse.Workspace, and is useful for accessing the field via an interface.
 func (v *lookupWorkspaceResponse) GetWorkspace() *Workspace { return v.Workspace }
 
-// removeCorrelationTagResponse is returned by removeCorrelationTag on success.
-type removeCorrelationTagResponse struct {
-	ResultStatus ResultStatus `json:"resultStatus"`
+// mutateRbacStatementsResponse is returned by mutateRbacStatements on success.
+type mutateRbacStatementsResponse struct {
+	// mutateRbacStatements is delicious dessert topping, and also works great as a floor wax!
+	// It will perform all the mutations requested and commit them as one operation, or it will
+	// return an error and have performed none of the mutations; there are no half-way changes.
+	Response mutateRbacStatementsResponseMutateRbacStatementsResponse `json:"response"`
 }
 
-// GetResultStatus returns removeCorrelationTagResponse.ResultStatus, and is useful for accessing the field via an interface.
-func (v *removeCorrelationTagResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
+// GetResponse returns mutateRbacStatementsResponse.Response, and is useful for accessing the field via an interface.
+func (v *mutateRbacStatementsResponse) GetResponse() mutateRbacStatementsResponseMutateRbacStatementsResponse {
+	return v.Response
+}
 
-// saveActionWithDestinationLinksResponse is returned by saveActionWithDestinationLinks on success.
-type saveActionWithDestinationLinksResponse struct {
-	// saveActionsWithDestinations replaces all action's links to the destinations (MonitorV2) for the provided
-	// shared action. It only allows you to mutate shared actions' relationships with the shared destinations.
-	// Private actions' links can't be mutated through this call, so you will need to use saveMonitorV2Relations to do so.
-	// The purpose of the API is such that the users can create a shared action and make links to the destinations
-	// from the shared actions page or when an action has been shared from within the monitor editing page.
-	MonitorV2Action MonitorV2Action `json:"monitorV2Action"`
+// mutateRbacStatementsResponseMutateRbacStatementsResponse includes the requested fields of the GraphQL type MutateRbacStatementsResponse.
+type mutateRbacStatementsResponseMutateRbacStatementsResponse struct {
+	CreatedStatements []RbacStatement `json:"createdStatements"`
+	UpdatedStatements []RbacStatement `json:"updatedStatements"`
+	DeletedStatements []string        `json:"deletedStatements"`
 }
 
-// GetMonitorV2Action returns saveActionWithDestinationLinksResponse.MonitorV2Action, and is useful for accessing the field via an interface.
-func (v *saveActionWithDestinationLinksResponse) GetMonitorV2Action() MonitorV2Action {
-	return v.MonitorV2Action
+// GetCreatedStatements returns mutateRbacStatementsResponseMutateRbacStatementsResponse.CreatedStatements, and is useful for accessing the field via an interface.
+func (v *mutateRbacStatementsResponseMutateRbacStatementsResponse) GetCreatedStatements() []RbacStatement {
+	return v.CreatedStatements
 }
 
-// saveDashboardResponse is returned by saveDashboard on success.
-type saveDashboardResponse struct {
-	Dashboard Dashboard `json:"dashboard"`
+// GetUpdatedStatements returns mutateRbacStatementsResponseMutateRbacStatementsResponse.UpdatedStatements, and is useful for accessing the field via an interface.
+func (v *mutateRbacStatementsResponseMutateRbacStatementsResponse) GetUpdatedStatements() []RbacStatement {
+	return v.UpdatedStatements
 }
 
-// GetDashboard returns saveDashboardResponse.Dashboard, and is useful for accessing the field via an interface.
-func (v *saveDashboardResponse) GetDashboard() Dashboard { return v.Dashboard }
+// GetDeletedStatements returns mutateRbacStatementsResponseMutateRbacStatementsResponse.DeletedStatements, and is useful for accessing the field via an interface.
+func (v *mutateRbacStatementsResponseMutateRbacStatementsResponse) GetDeletedStatements() []string {
+	return v.DeletedStatements
+}
 
-// saveDatasetDatasetDatasetSaveResult includes the requested fields of the GraphQL type DatasetSaveResult.
-type saveDatasetDatasetDatasetSaveResult struct {
-	// this is what you got out when saving
-	Dataset *Dataset `json:"dataset"`
+// rbacObjectMatchesResponse is returned by rbacObjectMatches on success.
+type rbacObjectMatchesResponse struct {
+	// Given a particular object, return all statements that would affect that object, independent of context.
+	Statements []RbacStatement `json:"statements"`
 }
 
-// GetDataset returns saveDatasetDatasetDatasetSaveResult.Dataset, and is useful for accessing the field via an interface.
-func (v *saveDatasetDatasetDatasetSaveResult) GetDataset() *Dataset { return v.Dataset }
+// GetStatements returns rbacObjectMatchesResponse.Statements, and is useful for accessing the field via an interface.
+func (v *rbacObjectMatchesResponse) GetStatements() []RbacStatement { return v.Statements }
 
-// saveDatasetResponse is returned by saveDataset on success.
-type saveDatasetResponse struct {
-	// saveDataset will create a dataset if you don't provide an input id.
-	// It will also make sure that the provided transform is published with
-	// that dataset. This is the general "update the things" function to use.
-	// If dependencyHandling is not specified, then the default is to apply
-	// changes but ignore downstream datasets or errors therein.
-	Dataset *saveDatasetDatasetDatasetSaveResult `json:"dataset"`
+// rbacTestRequestsResponse is returned by rbacTestRequests on success.
+type rbacTestRequestsResponse struct {
+	// Given a particular user, and many possible objects/requests, return what
+	// would happen to each of them individually.
+	// Note that we assume that the customer owning the object is the current
+	// customer, if the actual owning customer of the object is someone else, the
+	// actual operation will fail.
+	Results []rbacTestRequestsResultsRbacTestRequestResult `json:"results"`
 }
 
-// GetDataset returns saveDatasetResponse.Dataset, and is useful for accessing the field via an interface.
+// GetResults returns rbacTestRequestsResponse.Results, and is useful for accessing the field via an interface.
+func (v *rbacTestRequestsResponse) GetResults() []rbacTestRequestsResultsRbacTestRequestResult {
+	return v.Results
+}
+
+// rbacTestRequestsResultsRbacTestRequestResult includes the requested fields of the GraphQL type RbacTestRequestResult.
+type rbacTestRequestsResultsRbacTestRequestResult struct {
+	Result   bool           `json:"result"`
+	Matching *RbacStatement `json:"matching"`
+}
+
+// GetResult returns rbacTestRequestsResultsRbacTestRequestResult.Result, and is useful for accessing the field via an interface.
+func (v *rbacTestRequestsResultsRbacTestRequestResult) GetResult() bool { return v.Result }
+
+// GetMatching returns rbacTestRequestsResultsRbacTestRequestResult.Matching, and is useful for accessing the field via an interface.
+func (v *rbacTestRequestsResultsRbacTestRequestResult) GetMatching() *RbacStatement {
+	return v.Matching
+}
+
+// rbacUserMatchesResponse is returned by rbacUserMatches on success.
+type rbacUserMatchesResponse struct {
+	// Given a particular user, return all statements that would affect that user, independent of context.
+	// This is the same as User.rbacStatements.
+	Statements []RbacStatement `json:"statements"`
+}
+
+// GetStatements returns rbacUserMatchesResponse.Statements, and is useful for accessing the field via an interface.
+func (v *rbacUserMatchesResponse) GetStatements() []RbacStatement { return v.Statements }
+
+// removeCorrelationTagResponse is returned by removeCorrelationTag on success.
+type removeCorrelationTagResponse struct {
+	ResultStatus ResultStatus `json:"resultStatus"`
+}
+
+// GetResultStatus returns removeCorrelationTagResponse.ResultStatus, and is useful for accessing the field via an interface.
+func (v *removeCorrelationTagResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
+
+// removeIncidentDashboardsResponse is returned by removeIncidentDashboards on success.
+type removeIncidentDashboardsResponse struct {
+	Incident Incident `json:"incident"`
+}
+
+// GetIncident returns removeIncidentDashboardsResponse.Incident, and is useful for accessing the field via an interface.
+func (v *removeIncidentDashboardsResponse) GetIncident() Incident { return v.Incident }
+
+// removeIncidentSlackchannelsResponse is returned by removeIncidentSlackchannels on success.
+type removeIncidentSlackchannelsResponse struct {
+	Incident Incident `json:"incident"`
+}
+
+// GetIncident returns removeIncidentSlackchannelsResponse.Incident, and is useful for accessing the field via an interface.
+func (v *removeIncidentSlackchannelsResponse) GetIncident() Incident { return v.Incident }
+
+// removeIncidentUsersResponse is returned by removeIncidentUsers on success.
+type removeIncidentUsersResponse struct {
+	Incident Incident `json:"incident"`
+}
+
+// GetIncident returns removeIncidentUsersResponse.Incident, and is useful for accessing the field via an interface.
+func (v *removeIncidentUsersResponse) GetIncident() Incident { return v.Incident }
+
+// removeIncidentWorksheetsResponse is returned by removeIncidentWorksheets on success.
+type removeIncidentWorksheetsResponse struct {
+	Incident Incident `json:"incident"`
+}
+
+// GetIncident returns removeIncidentWorksheetsResponse.Incident, and is useful for accessing the field via an interface.
+func (v *removeIncidentWorksheetsResponse) GetIncident() Incident { return v.Incident }
+
+// saveActionWithDestinationLinksResponse is returned by saveActionWithDestinationLinks on success.
+type saveActionWithDestinationLinksResponse struct {
+	// saveActionsWithDestinations replaces all action's links to the destinations (MonitorV2) for the provided
+	// shared action. It only allows you to mutate shared actions' relationships with the shared destinations.
+	// Private actions' links can't be mutated through this call, so you will need to use saveMonitorV2Relations to do so.
+	// The purpose of the API is such that the users can create a shared action and make links to the destinations
+	// from the shared actions page or when an action has been shared from within the monitor editing page.
+	MonitorV2Action MonitorV2Action `json:"monitorV2Action"`
+}
+
+// GetMonitorV2Action returns saveActionWithDestinationLinksResponse.MonitorV2Action, and is useful for accessing the field via an interface.
+func (v *saveActionWithDestinationLinksResponse) GetMonitorV2Action() MonitorV2Action {
+	return v.MonitorV2Action
+}
+
+// saveDashboardResponse is returned by saveDashboard on success.
+type saveDashboardResponse struct {
+	Dashboard Dashboard `json:"dashboard"`
+}
+
+// GetDashboard returns saveDashboardResponse.Dashboard, and is useful for accessing the field via an interface.
+func (v *saveDashboardResponse) GetDashboard() Dashboard { return v.Dashboard }
+
+// saveDatasetDatasetDatasetSaveResult includes the requested fields of the GraphQL type DatasetSaveResult.
+type saveDatasetDatasetDatasetSaveResult struct {
+	// this is what you got out when saving
+	Dataset *Dataset `json:"dataset"`
+}
+
+// GetDataset returns saveDatasetDatasetDatasetSaveResult.Dataset, and is useful for accessing the field via an interface.
+func (v *saveDatasetDatasetDatasetSaveResult) GetDataset() *Dataset { return v.Dataset }
+
+// saveDatasetResponse is returned by saveDataset on success.
+type saveDatasetResponse struct {
+	// saveDataset will create a dataset if you don't provide an input id.
+	// It will also make sure that the provided transform is published with
+	// that dataset. This is the general "update the things" function to use.
+	// If dependencyHandling is not specified, then the default is to apply
+	// changes but ignore downstream datasets or errors therein.
+	Dataset *saveDatasetDatasetDatasetSaveResult `json:"dataset"`
+}
+
+// GetDataset returns saveDatasetResponse.Dataset, and is useful for accessing the field via an interface.
 func (v *saveDatasetResponse) GetDataset() *saveDatasetDatasetDatasetSaveResult { return v.Dataset }
 
 // saveMonitorV2RelationsResponse is returned by saveMonitorV2Relations on success.
@@ -11406,6 +14843,265 @@ type saveWorksheetResponse struct {
 // GetWorksheet returns saveWorksheetResponse.Worksheet, and is useful for accessing the field via an interface.
 func (v *saveWorksheetResponse) GetWorksheet() Worksheet { return v.Worksheet }
 
+// searchDashboardsDashboardSearchDashboardSearchResultWrapper includes the requested fields of the GraphQL type DashboardSearchResultWrapper.
+type searchDashboardsDashboardSearchDashboardSearchResultWrapper struct {
+	Dashboards []searchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResult `json:"dashboards"`
+	Warnings   []string                                                                                     `json:"warnings"`
+}
+
+// GetDashboards returns searchDashboardsDashboardSearchDashboardSearchResultWrapper.Dashboards, and is useful for accessing the field via an interface.
+func (v *searchDashboardsDashboardSearchDashboardSearchResultWrapper) GetDashboards() []searchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResult {
+	return v.Dashboards
+}
+
+// GetWarnings returns searchDashboardsDashboardSearchDashboardSearchResultWrapper.Warnings, and is useful for accessing the field via an interface.
+func (v *searchDashboardsDashboardSearchDashboardSearchResultWrapper) GetWarnings() []string {
+	return v.Warnings
+}
+
+// searchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResult includes the requested fields of the GraphQL type DashboardSearchResult.
+type searchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResult struct {
+	DashboardSearchResult `json:"-"`
+}
+
+// GetScore returns searchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResult.Score, and is useful for accessing the field via an interface.
+func (v *searchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResult) GetScore() types.Int64Scalar {
+	return v.DashboardSearchResult.Score
+}
+
+// GetDashboard returns searchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResult.Dashboard, and is useful for accessing the field via an interface.
+func (v *searchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResult) GetDashboard() DashboardSearchResultDashboard {
+	return v.DashboardSearchResult.Dashboard
+}
+
+func (v *searchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResult) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*searchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResult
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.searchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResult = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.DashboardSearchResult)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalsearchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResult struct {
+	Score types.Int64Scalar `json:"score"`
+
+	Dashboard DashboardSearchResultDashboard `json:"dashboard"`
+}
+
+func (v *searchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResult) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *searchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResult) __premarshalJSON() (*__premarshalsearchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResult, error) {
+	var retval __premarshalsearchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResult
+
+	retval.Score = v.DashboardSearchResult.Score
+	retval.Dashboard = v.DashboardSearchResult.Dashboard
+	return &retval, nil
+}
+
+// searchDashboardsResponse is returned by searchDashboards on success.
+type searchDashboardsResponse struct {
+	DashboardSearch searchDashboardsDashboardSearchDashboardSearchResultWrapper `json:"dashboardSearch"`
+}
+
+// GetDashboardSearch returns searchDashboardsResponse.DashboardSearch, and is useful for accessing the field via an interface.
+func (v *searchDashboardsResponse) GetDashboardSearch() searchDashboardsDashboardSearchDashboardSearchResultWrapper {
+	return v.DashboardSearch
+}
+
+// searchDatasetsDatasetSearchDatasetMatch includes the requested fields of the GraphQL type DatasetMatch.
+type searchDatasetsDatasetSearchDatasetMatch struct {
+	DatasetSearchMatch `json:"-"`
+}
+
+// GetDataset returns searchDatasetsDatasetSearchDatasetMatch.Dataset, and is useful for accessing the field via an interface.
+func (v *searchDatasetsDatasetSearchDatasetMatch) GetDataset() DatasetSearchMatchDataset {
+	return v.DatasetSearchMatch.Dataset
+}
+
+// GetMatchData returns searchDatasetsDatasetSearchDatasetMatch.MatchData, and is useful for accessing the field via an interface.
+func (v *searchDatasetsDatasetSearchDatasetMatch) GetMatchData() DatasetSearchMatchMatchDataDatasetMatchData {
+	return v.DatasetSearchMatch.MatchData
+}
+
+func (v *searchDatasetsDatasetSearchDatasetMatch) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*searchDatasetsDatasetSearchDatasetMatch
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.searchDatasetsDatasetSearchDatasetMatch = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.DatasetSearchMatch)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalsearchDatasetsDatasetSearchDatasetMatch struct {
+	Dataset DatasetSearchMatchDataset `json:"dataset"`
+
+	MatchData DatasetSearchMatchMatchDataDatasetMatchData `json:"matchData"`
+}
+
+func (v *searchDatasetsDatasetSearchDatasetMatch) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *searchDatasetsDatasetSearchDatasetMatch) __premarshalJSON() (*__premarshalsearchDatasetsDatasetSearchDatasetMatch, error) {
+	var retval __premarshalsearchDatasetsDatasetSearchDatasetMatch
+
+	retval.Dataset = v.DatasetSearchMatch.Dataset
+	retval.MatchData = v.DatasetSearchMatch.MatchData
+	return &retval, nil
+}
+
+// searchDatasetsResponse is returned by searchDatasets on success.
+type searchDatasetsResponse struct {
+	// searchMode defaults to InclusiveMode, which means "any matches, counts" sorted by better-scoring.
+	// If you pass in ExclusiveMode, then you get "must match each thing" behavior, which may end up
+	// returning no datasets at all quite easily.
+	DatasetSearch []searchDatasetsDatasetSearchDatasetMatch `json:"datasetSearch"`
+}
+
+// GetDatasetSearch returns searchDatasetsResponse.DatasetSearch, and is useful for accessing the field via an interface.
+func (v *searchDatasetsResponse) GetDatasetSearch() []searchDatasetsDatasetSearchDatasetMatch {
+	return v.DatasetSearch
+}
+
+// searchMetricsMetricSearchMetricSearchResult includes the requested fields of the GraphQL type MetricSearchResult.
+type searchMetricsMetricSearchMetricSearchResult struct {
+	Matches []searchMetricsMetricSearchMetricSearchResultMatchesMetricMatch `json:"matches"`
+	// Shows how many metrics were matched in this search term. Note that it's not necessary
+	// for all of them to be returned in the `matches` field, as the returned matches is limited
+	// by the globalLimit and perDatasetLimit specified in the metricSearch request.
+	NumSearched types.Int64Scalar `json:"numSearched"`
+}
+
+// GetMatches returns searchMetricsMetricSearchMetricSearchResult.Matches, and is useful for accessing the field via an interface.
+func (v *searchMetricsMetricSearchMetricSearchResult) GetMatches() []searchMetricsMetricSearchMetricSearchResultMatchesMetricMatch {
+	return v.Matches
+}
+
+// GetNumSearched returns searchMetricsMetricSearchMetricSearchResult.NumSearched, and is useful for accessing the field via an interface.
+func (v *searchMetricsMetricSearchMetricSearchResult) GetNumSearched() types.Int64Scalar {
+	return v.NumSearched
+}
+
+// searchMetricsMetricSearchMetricSearchResultMatchesMetricMatch includes the requested fields of the GraphQL type MetricMatch.
+type searchMetricsMetricSearchMetricSearchResultMatchesMetricMatch struct {
+	MetricSearchMatch `json:"-"`
+}
+
+// GetDatasetId returns searchMetricsMetricSearchMetricSearchResultMatchesMetricMatch.DatasetId, and is useful for accessing the field via an interface.
+func (v *searchMetricsMetricSearchMetricSearchResultMatchesMetricMatch) GetDatasetId() *string {
+	return v.MetricSearchMatch.DatasetId
+}
+
+// GetMetric returns searchMetricsMetricSearchMetricSearchResultMatchesMetricMatch.Metric, and is useful for accessing the field via an interface.
+func (v *searchMetricsMetricSearchMetricSearchResultMatchesMetricMatch) GetMetric() MetricSearchMatchMetric {
+	return v.MetricSearchMatch.Metric
+}
+
+func (v *searchMetricsMetricSearchMetricSearchResultMatchesMetricMatch) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*searchMetricsMetricSearchMetricSearchResultMatchesMetricMatch
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.searchMetricsMetricSearchMetricSearchResultMatchesMetricMatch = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.MetricSearchMatch)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalsearchMetricsMetricSearchMetricSearchResultMatchesMetricMatch struct {
+	DatasetId *string `json:"datasetId"`
+
+	Metric MetricSearchMatchMetric `json:"metric"`
+}
+
+func (v *searchMetricsMetricSearchMetricSearchResultMatchesMetricMatch) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *searchMetricsMetricSearchMetricSearchResultMatchesMetricMatch) __premarshalJSON() (*__premarshalsearchMetricsMetricSearchMetricSearchResultMatchesMetricMatch, error) {
+	var retval __premarshalsearchMetricsMetricSearchMetricSearchResultMatchesMetricMatch
+
+	retval.DatasetId = v.MetricSearchMatch.DatasetId
+	retval.Metric = v.MetricSearchMatch.Metric
+	return &retval, nil
+}
+
+// searchMetricsResponse is returned by searchMetrics on success.
+type searchMetricsResponse struct {
+	// metricSearch finds all matched metrics:
+	// - inDatasets limits the candidates to only the metrics belonging to any of the provided metric datasets
+	// - linkToDatasets limits the candidates to only the metrics in the metric dataset that has link(s) to any of the provided resource datasets
+	// - match will be used to to match against (case ignored) metric name, label and description
+	// - heuristicsOptions, when provided, expands the search to also include computed metric heuristics
+	MetricSearch searchMetricsMetricSearchMetricSearchResult `json:"metricSearch"`
+}
+
+// GetMetricSearch returns searchMetricsResponse.MetricSearch, and is useful for accessing the field via an interface.
+func (v *searchMetricsResponse) GetMetricSearch() searchMetricsMetricSearchMetricSearchResult {
+	return v.MetricSearch
+}
+
 // searchMonitorActionsResponse is returned by searchMonitorActions on success.
 type searchMonitorActionsResponse struct {
 	MonitorActions []MonitorAction `json:"-"`
@@ -11499,51 +15195,169 @@ func (v *searchMonitorV2ActionResponse) GetMonitorV2Actions() MonitorV2ActionSea
 	return v.MonitorV2Actions
 }
 
-// setChannelsForChannelActionResponse is returned by setChannelsForChannelAction on success.
-type setChannelsForChannelActionResponse struct {
-	ResultStatus ResultStatus `json:"resultStatus"`
+// searchResourceInstancesResponse is returned by searchResourceInstances on success.
+type searchResourceInstancesResponse struct {
+	// Search for resource instances matching all of the given key fragments.
+	// The timeout defaults to 60 seconds if not specified
+	Instances *types.PaginatedResults `json:"instances"`
 }
 
-// GetResultStatus returns setChannelsForChannelActionResponse.ResultStatus, and is useful for accessing the field via an interface.
-func (v *setChannelsForChannelActionResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
+// GetInstances returns searchResourceInstancesResponse.Instances, and is useful for accessing the field via an interface.
+func (v *searchResourceInstancesResponse) GetInstances() *types.PaginatedResults { return v.Instances }
 
-// setDefaultDashboardResponse is returned by setDefaultDashboard on success.
-type setDefaultDashboardResponse struct {
-	ResultStatus ResultStatus `json:"resultStatus"`
+// searchWorksheetsResponse is returned by searchWorksheets on success.
+type searchWorksheetsResponse struct {
+	WorksheetSearch searchWorksheetsWorksheetSearchWorksheetSearchResultWrapper `json:"worksheetSearch"`
 }
 
-// GetResultStatus returns setDefaultDashboardResponse.ResultStatus, and is useful for accessing the field via an interface.
-func (v *setDefaultDashboardResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
-
-// setMonitorsForChannelResponse is returned by setMonitorsForChannel on success.
-type setMonitorsForChannelResponse struct {
-	ResultStatus ResultStatus `json:"resultStatus"`
+// GetWorksheetSearch returns searchWorksheetsResponse.WorksheetSearch, and is useful for accessing the field via an interface.
+func (v *searchWorksheetsResponse) GetWorksheetSearch() searchWorksheetsWorksheetSearchWorksheetSearchResultWrapper {
+	return v.WorksheetSearch
 }
 
-// GetResultStatus returns setMonitorsForChannelResponse.ResultStatus, and is useful for accessing the field via an interface.
-func (v *setMonitorsForChannelResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
+// searchWorksheetsWorksheetSearchWorksheetSearchResultWrapper includes the requested fields of the GraphQL type WorksheetSearchResultWrapper.
+type searchWorksheetsWorksheetSearchWorksheetSearchResultWrapper struct {
+	Worksheets []searchWorksheetsWorksheetSearchWorksheetSearchResultWrapperWorksheetsWorksheetSearchResult `json:"worksheets"`
+	Warnings   []string                                                                                     `json:"warnings"`
+}
 
-// setRbacDefaultGroupResponse is returned by setRbacDefaultGroup on success.
-type setRbacDefaultGroupResponse struct {
-	// Set the default group that users will be assigned to. Must currently be one
-	// of the pre-defined "reader", "writer", or "admin" groups!
-	ResultStatus ResultStatus `json:"resultStatus"`
+// GetWorksheets returns searchWorksheetsWorksheetSearchWorksheetSearchResultWrapper.Worksheets, and is useful for accessing the field via an interface.
+func (v *searchWorksheetsWorksheetSearchWorksheetSearchResultWrapper) GetWorksheets() []searchWorksheetsWorksheetSearchWorksheetSearchResultWrapperWorksheetsWorksheetSearchResult {
+	return v.Worksheets
 }
 
-// GetResultStatus returns setRbacDefaultGroupResponse.ResultStatus, and is useful for accessing the field via an interface.
-func (v *setRbacDefaultGroupResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
+// GetWarnings returns searchWorksheetsWorksheetSearchWorksheetSearchResultWrapper.Warnings, and is useful for accessing the field via an interface.
+func (v *searchWorksheetsWorksheetSearchWorksheetSearchResultWrapper) GetWarnings() []string {
+	return v.Warnings
+}
 
-// unsetRbacDefaultGroupResponse is returned by unsetRbacDefaultGroup on success.
-type unsetRbacDefaultGroupResponse struct {
-	ResultStatus ResultStatus `json:"resultStatus"`
+// searchWorksheetsWorksheetSearchWorksheetSearchResultWrapperWorksheetsWorksheetSearchResult includes the requested fields of the GraphQL type WorksheetSearchResult.
+type searchWorksheetsWorksheetSearchWorksheetSearchResultWrapperWorksheetsWorksheetSearchResult struct {
+	WorksheetSearchResult `json:"-"`
 }
 
-// GetResultStatus returns unsetRbacDefaultGroupResponse.ResultStatus, and is useful for accessing the field via an interface.
-func (v *unsetRbacDefaultGroupResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
+// GetScore returns searchWorksheetsWorksheetSearchWorksheetSearchResultWrapperWorksheetsWorksheetSearchResult.Score, and is useful for accessing the field via an interface.
+func (v *searchWorksheetsWorksheetSearchWorksheetSearchResultWrapperWorksheetsWorksheetSearchResult) GetScore() types.Int64Scalar {
+	return v.WorksheetSearchResult.Score
+}
 
-// updateAppDataSourceResponse is returned by updateAppDataSource on success.
-type updateAppDataSourceResponse struct {
-	Appdatasource AppDataSource `json:"appdatasource"`
+// GetWorksheet returns searchWorksheetsWorksheetSearchWorksheetSearchResultWrapperWorksheetsWorksheetSearchResult.Worksheet, and is useful for accessing the field via an interface.
+func (v *searchWorksheetsWorksheetSearchWorksheetSearchResultWrapperWorksheetsWorksheetSearchResult) GetWorksheet() WorksheetSearchResultWorksheet {
+	return v.WorksheetSearchResult.Worksheet
+}
+
+func (v *searchWorksheetsWorksheetSearchWorksheetSearchResultWrapperWorksheetsWorksheetSearchResult) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*searchWorksheetsWorksheetSearchWorksheetSearchResultWrapperWorksheetsWorksheetSearchResult
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.searchWorksheetsWorksheetSearchWorksheetSearchResultWrapperWorksheetsWorksheetSearchResult = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.WorksheetSearchResult)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalsearchWorksheetsWorksheetSearchWorksheetSearchResultWrapperWorksheetsWorksheetSearchResult struct {
+	Score types.Int64Scalar `json:"score"`
+
+	Worksheet WorksheetSearchResultWorksheet `json:"worksheet"`
+}
+
+func (v *searchWorksheetsWorksheetSearchWorksheetSearchResultWrapperWorksheetsWorksheetSearchResult) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *searchWorksheetsWorksheetSearchWorksheetSearchResultWrapperWorksheetsWorksheetSearchResult) __premarshalJSON() (*__premarshalsearchWorksheetsWorksheetSearchWorksheetSearchResultWrapperWorksheetsWorksheetSearchResult, error) {
+	var retval __premarshalsearchWorksheetsWorksheetSearchWorksheetSearchResultWrapperWorksheetsWorksheetSearchResult
+
+	retval.Score = v.WorksheetSearchResult.Score
+	retval.Worksheet = v.WorksheetSearchResult.Worksheet
+	return &retval, nil
+}
+
+// setChannelsForChannelActionResponse is returned by setChannelsForChannelAction on success.
+type setChannelsForChannelActionResponse struct {
+	ResultStatus ResultStatus `json:"resultStatus"`
+}
+
+// GetResultStatus returns setChannelsForChannelActionResponse.ResultStatus, and is useful for accessing the field via an interface.
+func (v *setChannelsForChannelActionResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
+
+// setDefaultDashboardResponse is returned by setDefaultDashboard on success.
+type setDefaultDashboardResponse struct {
+	ResultStatus ResultStatus `json:"resultStatus"`
+}
+
+// GetResultStatus returns setDefaultDashboardResponse.ResultStatus, and is useful for accessing the field via an interface.
+func (v *setDefaultDashboardResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
+
+// setMonitorsForChannelResponse is returned by setMonitorsForChannel on success.
+type setMonitorsForChannelResponse struct {
+	ResultStatus ResultStatus `json:"resultStatus"`
+}
+
+// GetResultStatus returns setMonitorsForChannelResponse.ResultStatus, and is useful for accessing the field via an interface.
+func (v *setMonitorsForChannelResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
+
+// setRbacDefaultGroupResponse is returned by setRbacDefaultGroup on success.
+type setRbacDefaultGroupResponse struct {
+	// Set the default group that users will be assigned to. Must currently be one
+	// of the pre-defined "reader", "writer", or "admin" groups!
+	ResultStatus ResultStatus `json:"resultStatus"`
+}
+
+// GetResultStatus returns setRbacDefaultGroupResponse.ResultStatus, and is useful for accessing the field via an interface.
+func (v *setRbacDefaultGroupResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
+
+// setRbacGroupmembersResponse is returned by setRbacGroupmembers on success.
+type setRbacGroupmembersResponse struct {
+	// Set all group members of a given group. This will remove any member that is not currently
+	// in the group, as well -- the goal is to make this a complete replacement.
+	RbacGroupmembers []RbacGroupmember `json:"rbacGroupmembers"`
+}
+
+// GetRbacGroupmembers returns setRbacGroupmembersResponse.RbacGroupmembers, and is useful for accessing the field via an interface.
+func (v *setRbacGroupmembersResponse) GetRbacGroupmembers() []RbacGroupmember {
+	return v.RbacGroupmembers
+}
+
+// setWorkspaceObjectOwnerResponse is returned by setWorkspaceObjectOwner on success.
+type setWorkspaceObjectOwnerResponse struct {
+	ResultStatus ResultStatus `json:"resultStatus"`
+}
+
+// GetResultStatus returns setWorkspaceObjectOwnerResponse.ResultStatus, and is useful for accessing the field via an interface.
+func (v *setWorkspaceObjectOwnerResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
+
+// unsetRbacDefaultGroupResponse is returned by unsetRbacDefaultGroup on success.
+type unsetRbacDefaultGroupResponse struct {
+	ResultStatus ResultStatus `json:"resultStatus"`
+}
+
+// GetResultStatus returns unsetRbacDefaultGroupResponse.ResultStatus, and is useful for accessing the field via an interface.
+func (v *unsetRbacDefaultGroupResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
+
+// updateAppDataSourceResponse is returned by updateAppDataSource on success.
+type updateAppDataSourceResponse struct {
+	Appdatasource AppDataSource `json:"appdatasource"`
 }
 
 // GetAppdatasource returns updateAppDataSourceResponse.Appdatasource, and is useful for accessing the field via an interface.
@@ -11557,6 +15371,14 @@ type updateAppResponse struct {
 // GetApp returns updateAppResponse.App, and is useful for accessing the field via an interface.
 func (v *updateAppResponse) GetApp() App { return v.App }
 
+// updateBlobResponse is returned by updateBlob on success.
+type updateBlobResponse struct {
+	Blob Blob `json:"blob"`
+}
+
+// GetBlob returns updateBlobResponse.Blob, and is useful for accessing the field via an interface.
+func (v *updateBlobResponse) GetBlob() Blob { return v.Blob }
+
 // updateBoardResponse is returned by updateBoard on success.
 type updateBoardResponse struct {
 	Board Board `json:"board"`
@@ -11647,6 +15469,14 @@ type updateChannelResponse struct {
 // GetChannel returns updateChannelResponse.Channel, and is useful for accessing the field via an interface.
 func (v *updateChannelResponse) GetChannel() *Channel { return v.Channel }
 
+// updateCurrentCustomerSsoResponse is returned by updateCurrentCustomerSso on success.
+type updateCurrentCustomerSsoResponse struct {
+	Sso CustomerSso `json:"sso"`
+}
+
+// GetSso returns updateCurrentCustomerSsoResponse.Sso, and is useful for accessing the field via an interface.
+func (v *updateCurrentCustomerSsoResponse) GetSso() CustomerSso { return v.Sso }
+
 // updateDashboardLinkResponse is returned by updateDashboardLink on success.
 type updateDashboardLinkResponse struct {
 	DashboardLink DashboardLink `json:"dashboardLink"`
@@ -11693,6 +15523,14 @@ func (v *updateDeferredForeignKeyResponse) GetDeferredForeignKey() *DeferredFore
 	return v.DeferredForeignKey
 }
 
+// updateFeatureFlagForUserResponse is returned by updateFeatureFlagForUser on success.
+type updateFeatureFlagForUserResponse struct {
+	FeatureFlag FeatureFlag `json:"featureFlag"`
+}
+
+// GetFeatureFlag returns updateFeatureFlagForUserResponse.FeatureFlag, and is useful for accessing the field via an interface.
+func (v *updateFeatureFlagForUserResponse) GetFeatureFlag() FeatureFlag { return v.FeatureFlag }
+
 // updateFiledropResponse is returned by updateFiledrop on success.
 type updateFiledropResponse struct {
 	Filedrop *Filedrop `json:"filedrop"`
@@ -11709,6 +15547,14 @@ type updateFolderResponse struct {
 // GetFolder returns updateFolderResponse.Folder, and is useful for accessing the field via an interface.
 func (v *updateFolderResponse) GetFolder() Folder { return v.Folder }
 
+// updateIncidentResponse is returned by updateIncident on success.
+type updateIncidentResponse struct {
+	Incident Incident `json:"incident"`
+}
+
+// GetIncident returns updateIncidentResponse.Incident, and is useful for accessing the field via an interface.
+func (v *updateIncidentResponse) GetIncident() Incident { return v.Incident }
+
 // updateLayeredSettingRecordResponse is returned by updateLayeredSettingRecord on success.
 type updateLayeredSettingRecordResponse struct {
 	LayeredSettingRecord LayeredSettingRecord `json:"layeredSettingRecord"`
@@ -11811,6 +15657,16 @@ type updateMonitorMonitorMonitorUpdateResult struct {
 // GetMonitor returns updateMonitorMonitorMonitorUpdateResult.Monitor, and is useful for accessing the field via an interface.
 func (v *updateMonitorMonitorMonitorUpdateResult) GetMonitor() Monitor { return v.Monitor }
 
+// updateMonitorMuteRuleForMonitorsResponse is returned by updateMonitorMuteRuleForMonitors on success.
+type updateMonitorMuteRuleForMonitorsResponse struct {
+	MonitorMuteRule MonitorMuteRule `json:"monitorMuteRule"`
+}
+
+// GetMonitorMuteRule returns updateMonitorMuteRuleForMonitorsResponse.MonitorMuteRule, and is useful for accessing the field via an interface.
+func (v *updateMonitorMuteRuleForMonitorsResponse) GetMonitorMuteRule() MonitorMuteRule {
+	return v.MonitorMuteRule
+}
+
 // updateMonitorResponse is returned by updateMonitor on success.
 type updateMonitorResponse struct {
 	Monitor *updateMonitorMonitorMonitorUpdateResult `json:"monitor"`
@@ -11904,6 +15760,22 @@ type updateSnowflakeOutboundShareResponse struct {
 // GetShare returns updateSnowflakeOutboundShareResponse.Share, and is useful for accessing the field via an interface.
 func (v *updateSnowflakeOutboundShareResponse) GetShare() SnowflakeOutboundShare { return v.Share }
 
+// updateUserResponse is returned by updateUser on success.
+type updateUserResponse struct {
+	User User `json:"user"`
+}
+
+// GetUser returns updateUserResponse.User, and is useful for accessing the field via an interface.
+func (v *updateUserResponse) GetUser() User { return v.User }
+
+// updateUsersResponse is returned by updateUsers on success.
+type updateUsersResponse struct {
+	Users []User `json:"users"`
+}
+
+// GetUsers returns updateUsersResponse.Users, and is useful for accessing the field via an interface.
+func (v *updateUsersResponse) GetUsers() []User { return v.Users }
+
 // updateWorkspaceResponse is returned by updateWorkspace on success.
 type updateWorkspaceResponse struct {
 	Workspace *Workspace `json:"workspace"`
@@ -11956,35 +15828,52 @@ func addCorrelationTag(
 	return &data, err
 }
 
-// The query or mutation executed by clearDefaultDashboard.
-const clearDefaultDashboard_Operation = `
-mutation clearDefaultDashboard ($dsid: ObjectId!) {
-	resultStatus: clearDefaultDashboard(dsid: $dsid) {
-		... ResultStatus
+// The query or mutation executed by addIncidentDashboards.
+const addIncidentDashboards_Operation = `
+mutation addIncidentDashboards ($id: ObjectId!, $dashboards: [ObjectId!]!) {
+	incident: addIncidentDashboards(i: $id, ds: $dashboards) {
+		... Incident
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment Incident on Incident {
+	id
+	name
+	description
+	iconUrl
+	workspaceId
+	folderId
+	status
+	inactiveTime
+	closedTime
+	users {
+		userId
+	}
+	slackChannels {
+		connectionID
+		slackchannelID
+	}
+	worksheets
+	dashboards
 }
 `
 
-func clearDefaultDashboard(
+func addIncidentDashboards(
 	ctx context.Context,
 	client graphql.Client,
-	dsid string,
-) (*clearDefaultDashboardResponse, error) {
+	id string,
+	dashboards []string,
+) (*addIncidentDashboardsResponse, error) {
 	req := &graphql.Request{
-		OpName: "clearDefaultDashboard",
-		Query:  clearDefaultDashboard_Operation,
-		Variables: &__clearDefaultDashboardInput{
-			Dsid: dsid,
+		OpName: "addIncidentDashboards",
+		Query:  addIncidentDashboards_Operation,
+		Variables: &__addIncidentDashboardsInput{
+			Id:         id,
+			Dashboards: dashboards,
 		},
 	}
 	var err error
 
-	var data clearDefaultDashboardResponse
+	var data addIncidentDashboardsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -11996,49 +15885,52 @@ func clearDefaultDashboard(
 	return &data, err
 }
 
-// The query or mutation executed by createApp.
-const createApp_Operation = `
-mutation createApp ($workspaceId: ObjectId!, $config: AppInput!) {
-	app: createApp(workspaceId: $workspaceId, app: $config) {
-		... App
+// The query or mutation executed by addIncidentSlackchannels.
+const addIncidentSlackchannels_Operation = `
+mutation addIncidentSlackchannels ($id: ObjectId!, $channels: [IncidentSlackchannelInput!]!) {
+	incident: addIncidentSlackchannels(i: $id, cs: $channels) {
+		... Incident
 	}
 }
-fragment App on App {
+fragment Incident on Incident {
 	id
 	name
-	iconUrl
 	description
+	iconUrl
 	workspaceId
 	folderId
-	config {
-		moduleId
-		version
+	status
+	inactiveTime
+	closedTime
+	users {
+		userId
 	}
-	status {
-		state
-		internalError
+	slackChannels {
+		connectionID
+		slackchannelID
 	}
-	outputs
+	worksheets
+	dashboards
 }
 `
 
-func createApp(
+func addIncidentSlackchannels(
 	ctx context.Context,
 	client graphql.Client,
-	workspaceId string,
-	config AppInput,
-) (*createAppResponse, error) {
+	id string,
+	channels []IncidentSlackchannelInput,
+) (*addIncidentSlackchannelsResponse, error) {
 	req := &graphql.Request{
-		OpName: "createApp",
-		Query:  createApp_Operation,
-		Variables: &__createAppInput{
-			WorkspaceId: workspaceId,
-			Config:      config,
+		OpName: "addIncidentSlackchannels",
+		Query:  addIncidentSlackchannels_Operation,
+		Variables: &__addIncidentSlackchannelsInput{
+			Id:       id,
+			Channels: channels,
 		},
 	}
 	var err error
 
-	var data createAppResponse
+	var data addIncidentSlackchannelsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -12050,40 +15942,52 @@ func createApp(
 	return &data, err
 }
 
-// The query or mutation executed by createAppDataSource.
-const createAppDataSource_Operation = `
-mutation createAppDataSource ($config: AppDataSourceInput!) {
-	appdatasource: createAppDataSource(source: $config) {
-		... AppDataSource
+// The query or mutation executed by addIncidentUsers.
+const addIncidentUsers_Operation = `
+mutation addIncidentUsers ($id: ObjectId!, $users: [UserId!]!) {
+	incident: addIncidentUsers(i: $id, us: $users) {
+		... Incident
 	}
 }
-fragment AppDataSource on AppDataSource {
+fragment Incident on Incident {
 	id
 	name
-	iconUrl
 	description
+	iconUrl
 	workspaceId
-	managedById
-	sourceUrl
-	instructions
+	folderId
+	status
+	inactiveTime
+	closedTime
+	users {
+		userId
+	}
+	slackChannels {
+		connectionID
+		slackchannelID
+	}
+	worksheets
+	dashboards
 }
 `
 
-func createAppDataSource(
+func addIncidentUsers(
 	ctx context.Context,
 	client graphql.Client,
-	config AppDataSourceInput,
-) (*createAppDataSourceResponse, error) {
+	id string,
+	users []types.UserIdScalar,
+) (*addIncidentUsersResponse, error) {
 	req := &graphql.Request{
-		OpName: "createAppDataSource",
-		Query:  createAppDataSource_Operation,
-		Variables: &__createAppDataSourceInput{
-			Config: config,
+		OpName: "addIncidentUsers",
+		Query:  addIncidentUsers_Operation,
+		Variables: &__addIncidentUsersInput{
+			Id:    id,
+			Users: users,
 		},
 	}
 	var err error
 
-	var data createAppDataSourceResponse
+	var data addIncidentUsersResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -12095,42 +15999,52 @@ func createAppDataSource(
 	return &data, err
 }
 
-// The query or mutation executed by createBoard.
-const createBoard_Operation = `
-mutation createBoard ($datasetId: ObjectId!, $boardType: BoardType!, $board: BoardInput!) {
-	board: createBoard(datasetId: $datasetId, type: $boardType, board: $board) {
-		... Board
+// The query or mutation executed by addIncidentWorksheets.
+const addIncidentWorksheets_Operation = `
+mutation addIncidentWorksheets ($id: ObjectId!, $worksheets: [ObjectId!]!) {
+	incident: addIncidentWorksheets(i: $id, ws: $worksheets) {
+		... Incident
 	}
 }
-fragment Board on Board {
+fragment Incident on Incident {
 	id
-	datasetId
 	name
-	type
-	boardJson: board
-	source
+	description
+	iconUrl
+	workspaceId
+	folderId
+	status
+	inactiveTime
+	closedTime
+	users {
+		userId
+	}
+	slackChannels {
+		connectionID
+		slackchannelID
+	}
+	worksheets
+	dashboards
 }
 `
 
-func createBoard(
+func addIncidentWorksheets(
 	ctx context.Context,
 	client graphql.Client,
-	datasetId string,
-	boardType BoardType,
-	board BoardInput,
-) (*createBoardResponse, error) {
+	id string,
+	worksheets []string,
+) (*addIncidentWorksheetsResponse, error) {
 	req := &graphql.Request{
-		OpName: "createBoard",
-		Query:  createBoard_Operation,
-		Variables: &__createBoardInput{
-			DatasetId: datasetId,
-			BoardType: boardType,
-			Board:     board,
+		OpName: "addIncidentWorksheets",
+		Query:  addIncidentWorksheets_Operation,
+		Variables: &__addIncidentWorksheetsInput{
+			Id:         id,
+			Worksheets: worksheets,
 		},
 	}
 	var err error
 
-	var data createBoardResponse
+	var data addIncidentWorksheetsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -12142,42 +16056,45 @@ func createBoard(
 	return &data, err
 }
 
-// The query or mutation executed by createChannel.
-const createChannel_Operation = `
-mutation createChannel ($workspaceId: ObjectId!, $channel: ChannelInput!) {
-	channel: createChannel(workspaceId: $workspaceId, channel: $channel) {
-		... Channel
+// The query or mutation executed by cancelAccelerationJob.
+const cancelAccelerationJob_Operation = `
+mutation cancelAccelerationJob ($jobId: String!) {
+	accelerationJob: cancelAccelerationJob(jobId: $jobId) {
+		... AccelerationJob
 	}
 }
-fragment Channel on Channel {
-	id
-	name
-	iconUrl
-	description
-	workspaceId
-	monitors {
-		id
+fragment AccelerationJob on AccelerationJob {
+	jobId
+	context
+	createdDate
+	state
+	stateLastUpdatedDate
+	progress
+	credits
+	datasetStatuses {
+		datasetId
+		isDirect
+		progress
+		credits
 	}
 }
 `
 
-func createChannel(
+func cancelAccelerationJob(
 	ctx context.Context,
 	client graphql.Client,
-	workspaceId string,
-	channel ChannelInput,
-) (*createChannelResponse, error) {
+	jobId string,
+) (*cancelAccelerationJobResponse, error) {
 	req := &graphql.Request{
-		OpName: "createChannel",
-		Query:  createChannel_Operation,
-		Variables: &__createChannelInput{
-			WorkspaceId: workspaceId,
-			Channel:     channel,
+		OpName: "cancelAccelerationJob",
+		Query:  cancelAccelerationJob_Operation,
+		Variables: &__cancelAccelerationJobInput{
+			JobId: jobId,
 		},
 	}
 	var err error
 
-	var data createChannelResponse
+	var data cancelAccelerationJobResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -12189,61 +16106,35 @@ func createChannel(
 	return &data, err
 }
 
-// The query or mutation executed by createChannelAction.
-const createChannelAction_Operation = `
-mutation createChannelAction ($workspaceId: ObjectId!, $action: ActionInput!) {
-	channelAction: createChannelAction(workspaceId: $workspaceId, action: $action) {
-		__typename
-		... ChannelAction
+// The query or mutation executed by clearDefaultDashboard.
+const clearDefaultDashboard_Operation = `
+mutation clearDefaultDashboard ($dsid: ObjectId!) {
+	resultStatus: clearDefaultDashboard(dsid: $dsid) {
+		... ResultStatus
 	}
 }
-fragment ChannelAction on ChannelAction {
-	id
-	name
-	iconUrl
-	description
-	notifyOnClose
-	workspaceId
-	channels {
-		id
-	}
-	rateLimit
-	__typename
-	... on WebhookAction {
-		urlTemplate
-		bodyTemplate
-		method
-		headers {
-			header
-			valueTemplate
-		}
-	}
-	... on EmailAction {
-		targetAddresses
-		subjectTemplate
-		bodyTemplate
-		isHtml
-	}
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func createChannelAction(
+func clearDefaultDashboard(
 	ctx context.Context,
 	client graphql.Client,
-	workspaceId string,
-	action ActionInput,
-) (*createChannelActionResponse, error) {
+	dsid string,
+) (*clearDefaultDashboardResponse, error) {
 	req := &graphql.Request{
-		OpName: "createChannelAction",
-		Query:  createChannelAction_Operation,
-		Variables: &__createChannelActionInput{
-			WorkspaceId: workspaceId,
-			Action:      action,
+		OpName: "clearDefaultDashboard",
+		Query:  clearDefaultDashboard_Operation,
+		Variables: &__clearDefaultDashboardInput{
+			Dsid: dsid,
 		},
 	}
 	var err error
 
-	var data createChannelActionResponse
+	var data clearDefaultDashboardResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -12255,42 +16146,45 @@ func createChannelAction(
 	return &data, err
 }
 
-// The query or mutation executed by createDashboardLink.
-const createDashboardLink_Operation = `
-mutation createDashboardLink ($input: DashboardLinkInput!) {
-	dashboardLink: createDashboardLink(link: $input) {
-		... DashboardLink
+// The query or mutation executed by createAccelerationJob.
+const createAccelerationJob_Operation = `
+mutation createAccelerationJob ($job: AccelerationJobInput!) {
+	accelerationJob: createAccelerationJob(job: $job) {
+		... AccelerationJob
 	}
 }
-fragment DashboardLink on DashboardLink {
-	id
-	name
-	workspaceId
-	folderId
-	managedById
-	description
-	fromDashboard: fromDashboardId
-	toDashboard: toDashboardId
-	fromCard
-	linkLabel
+fragment AccelerationJob on AccelerationJob {
+	jobId
+	context
+	createdDate
+	state
+	stateLastUpdatedDate
+	progress
+	credits
+	datasetStatuses {
+		datasetId
+		isDirect
+		progress
+		credits
+	}
 }
 `
 
-func createDashboardLink(
+func createAccelerationJob(
 	ctx context.Context,
 	client graphql.Client,
-	input DashboardLinkInput,
-) (*createDashboardLinkResponse, error) {
+	job AccelerationJobInput,
+) (*createAccelerationJobResponse, error) {
 	req := &graphql.Request{
-		OpName: "createDashboardLink",
-		Query:  createDashboardLink_Operation,
-		Variables: &__createDashboardLinkInput{
-			Input: input,
+		OpName: "createAccelerationJob",
+		Query:  createAccelerationJob_Operation,
+		Variables: &__createAccelerationJobInput{
+			Job: job,
 		},
 	}
 	var err error
 
-	var data createDashboardLinkResponse
+	var data createAccelerationJobResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -12302,52 +16196,49 @@ func createDashboardLink(
 	return &data, err
 }
 
-// The query or mutation executed by createDatasetOutboundShare.
-const createDatasetOutboundShare_Operation = `
-mutation createDatasetOutboundShare ($workspaceId: ObjectId!, $datasetID: ObjectId!, $outboundShareID: ObjectId!, $input: DatasetOutboundShareInput!) {
-	datasetOutboundShare: createDatasetOutboundShare(workspaceId: $workspaceId, datasetID: $datasetID, outboundShareID: $outboundShareID, input: $input) {
-		... DatasetOutboundShare
+// The query or mutation executed by createApp.
+const createApp_Operation = `
+mutation createApp ($workspaceId: ObjectId!, $config: AppInput!) {
+	app: createApp(workspaceId: $workspaceId, app: $config) {
+		... App
 	}
 }
-fragment DatasetOutboundShare on DatasetOutboundShare {
+fragment App on App {
 	id
 	name
+	iconUrl
 	description
 	workspaceId
 	folderId
-	datasetID
-	outboundShareID
-	schemaName
-	viewName
-	freshnessGoal
+	config {
+		moduleId
+		version
+	}
 	status {
 		state
-		error
+		internalError
 	}
+	outputs
 }
 `
 
-func createDatasetOutboundShare(
+func createApp(
 	ctx context.Context,
 	client graphql.Client,
 	workspaceId string,
-	datasetID string,
-	outboundShareID string,
-	input DatasetOutboundShareInput,
-) (*createDatasetOutboundShareResponse, error) {
+	config AppInput,
+) (*createAppResponse, error) {
 	req := &graphql.Request{
-		OpName: "createDatasetOutboundShare",
-		Query:  createDatasetOutboundShare_Operation,
-		Variables: &__createDatasetOutboundShareInput{
-			WorkspaceId:     workspaceId,
-			DatasetID:       datasetID,
-			OutboundShareID: outboundShareID,
-			Input:           input,
+		OpName: "createApp",
+		Query:  createApp_Operation,
+		Variables: &__createAppInput{
+			WorkspaceId: workspaceId,
+			Config:      config,
 		},
 	}
 	var err error
 
-	var data createDatasetOutboundShareResponse
+	var data createAppResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -12359,40 +16250,40 @@ func createDatasetOutboundShare(
 	return &data, err
 }
 
-// The query or mutation executed by createDatastream.
-const createDatastream_Operation = `
-mutation createDatastream ($workspaceId: ObjectId!, $datastream: DatastreamInput!) {
-	datastream: createDatastream(workspaceId: $workspaceId, datastream: $datastream) {
-		... Datastream
+// The query or mutation executed by createAppDataSource.
+const createAppDataSource_Operation = `
+mutation createAppDataSource ($config: AppDataSourceInput!) {
+	appdatasource: createAppDataSource(source: $config) {
+		... AppDataSource
 	}
 }
-fragment Datastream on Datastream {
+fragment AppDataSource on AppDataSource {
 	id
 	name
 	iconUrl
 	description
 	workspaceId
-	datasetId
+	managedById
+	sourceUrl
+	instructions
 }
 `
 
-func createDatastream(
+func createAppDataSource(
 	ctx context.Context,
 	client graphql.Client,
-	workspaceId string,
-	datastream DatastreamInput,
-) (*createDatastreamResponse, error) {
+	config AppDataSourceInput,
+) (*createAppDataSourceResponse, error) {
 	req := &graphql.Request{
-		OpName: "createDatastream",
-		Query:  createDatastream_Operation,
-		Variables: &__createDatastreamInput{
-			WorkspaceId: workspaceId,
-			Datastream:  datastream,
+		OpName: "createAppDataSource",
+		Query:  createAppDataSource_Operation,
+		Variables: &__createAppDataSourceInput{
+			Config: config,
 		},
 	}
 	var err error
 
-	var data createDatastreamResponse
+	var data createAppDataSourceResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -12404,42 +16295,45 @@ func createDatastream(
 	return &data, err
 }
 
-// The query or mutation executed by createDatastreamToken.
-const createDatastreamToken_Operation = `
-mutation createDatastreamToken ($datastreamId: ObjectId!, $token: DatastreamTokenInput!, $password: String) {
-	datastreamToken: createDatastreamToken(datastreamId: $datastreamId, token: $token, password: $password) {
-		... DatastreamToken
+// The query or mutation executed by createBlob.
+const createBlob_Operation = `
+mutation createBlob ($userId: UserId, $name: String!, $info: BlobInput!, $makePermanent: Boolean) {
+	blob: createBlob(userId: $userId, name: $name, info: $info, makePermanent: $makePermanent) {
+		... Blob
 	}
 }
-fragment DatastreamToken on DatastreamToken {
-	id
+fragment Blob on Blob {
+	userId
 	name
-	description
-	disabled
-	datastreamId
-	secret
+	kind
+	value
+	readable
+	writable
+	updatedDate
 }
 `
 
-func createDatastreamToken(
+func createBlob(
 	ctx context.Context,
 	client graphql.Client,
-	datastreamId string,
-	token DatastreamTokenInput,
-	password *string,
-) (*createDatastreamTokenResponse, error) {
+	userId *types.UserIdScalar,
+	name string,
+	info BlobInput,
+	makePermanent *bool,
+) (*createBlobResponse, error) {
 	req := &graphql.Request{
-		OpName: "createDatastreamToken",
-		Query:  createDatastreamToken_Operation,
-		Variables: &__createDatastreamTokenInput{
-			DatastreamId: datastreamId,
-			Token:        token,
-			Password:     password,
+		OpName: "createBlob",
+		Query:  createBlob_Operation,
+		Variables: &__createBlobInput{
+			UserId:        userId,
+			Name:          name,
+			Info:          info,
+			MakePermanent: makePermanent,
 		},
 	}
 	var err error
 
-	var data createDatastreamTokenResponse
+	var data createBlobResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -12451,52 +16345,42 @@ func createDatastreamToken(
 	return &data, err
 }
 
-// The query or mutation executed by createDeferredForeignKey.
-const createDeferredForeignKey_Operation = `
-mutation createDeferredForeignKey ($workspaceId: ObjectId!, $keyInput: DeferredForeignKeyInput!) {
-	deferredForeignKey: createDeferredForeignKey(workspaceId: $workspaceId, data: $keyInput) {
-		... DeferredForeignKey
+// The query or mutation executed by createBoard.
+const createBoard_Operation = `
+mutation createBoard ($datasetId: ObjectId!, $boardType: BoardType!, $board: BoardInput!) {
+	board: createBoard(datasetId: $datasetId, type: $boardType, board: $board) {
+		... Board
 	}
 }
-fragment DeferredForeignKey on DeferredForeignKey {
+fragment Board on Board {
 	id
-	workspaceId
-	sourceDataset {
-		datasetId
-	}
-	targetDataset {
-		datasetId
-	}
-	srcFields
-	dstFields
-	label
-	resolution {
-		sourceId
-		targetId
-	}
-	status {
-		errorText
-	}
+	datasetId
+	name
+	type
+	boardJson: board
+	source
 }
 `
 
-func createDeferredForeignKey(
+func createBoard(
 	ctx context.Context,
 	client graphql.Client,
-	workspaceId string,
-	keyInput DeferredForeignKeyInput,
-) (*createDeferredForeignKeyResponse, error) {
+	datasetId string,
+	boardType BoardType,
+	board BoardInput,
+) (*createBoardResponse, error) {
 	req := &graphql.Request{
-		OpName: "createDeferredForeignKey",
-		Query:  createDeferredForeignKey_Operation,
-		Variables: &__createDeferredForeignKeyInput{
-			WorkspaceId: workspaceId,
-			KeyInput:    keyInput,
+		OpName: "createBoard",
+		Query:  createBoard_Operation,
+		Variables: &__createBoardInput{
+			DatasetId: datasetId,
+			BoardType: boardType,
+			Board:     board,
 		},
 	}
 	var err error
 
-	var data createDeferredForeignKeyResponse
+	var data createBoardResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -12508,60 +16392,42 @@ func createDeferredForeignKey(
 	return &data, err
 }
 
-// The query or mutation executed by createFiledrop.
-const createFiledrop_Operation = `
-mutation createFiledrop ($workspaceID: ObjectId!, $datastreamID: ObjectId!, $input: FiledropInput!) {
-	filedrop: createFiledrop(workspaceId: $workspaceID, datastreamID: $datastreamID, input: $input) {
-		... Filedrop
-	}
+// The query or mutation executed by createChannel.
+const createChannel_Operation = `
+mutation createChannel ($workspaceId: ObjectId!, $channel: ChannelInput!) {
+	channel: createChannel(workspaceId: $workspaceId, channel: $channel) {
+		... Channel
+	}
 }
-fragment Filedrop on Filedrop {
+fragment Channel on Channel {
 	id
 	name
 	iconUrl
 	description
 	workspaceId
-	status
-	datastreamID
-	config {
-		provider {
-			__typename
-			... on FiledropProviderAwsConfig {
-				region
-				roleArn
-			}
-		}
-	}
-	endpoint {
-		__typename
-		... on FiledropS3Endpoint {
-			arn
-			bucket
-			prefix
-		}
+	monitors {
+		id
 	}
 }
 `
 
-func createFiledrop(
+func createChannel(
 	ctx context.Context,
 	client graphql.Client,
-	workspaceID string,
-	datastreamID string,
-	input FiledropInput,
-) (*createFiledropResponse, error) {
+	workspaceId string,
+	channel ChannelInput,
+) (*createChannelResponse, error) {
 	req := &graphql.Request{
-		OpName: "createFiledrop",
-		Query:  createFiledrop_Operation,
-		Variables: &__createFiledropInput{
-			WorkspaceID:  workspaceID,
-			DatastreamID: datastreamID,
-			Input:        input,
+		OpName: "createChannel",
+		Query:  createChannel_Operation,
+		Variables: &__createChannelInput{
+			WorkspaceId: workspaceId,
+			Channel:     channel,
 		},
 	}
 	var err error
 
-	var data createFiledropResponse
+	var data createChannelResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -12573,39 +16439,61 @@ func createFiledrop(
 	return &data, err
 }
 
-// The query or mutation executed by createFolder.
-const createFolder_Operation = `
-mutation createFolder ($workspaceId: ObjectId!, $config: FolderInput!) {
-	folder: createFolder(workspaceId: $workspaceId, folder: $config) {
-		... Folder
+// The query or mutation executed by createChannelAction.
+const createChannelAction_Operation = `
+mutation createChannelAction ($workspaceId: ObjectId!, $action: ActionInput!) {
+	channelAction: createChannelAction(workspaceId: $workspaceId, action: $action) {
+		__typename
+		... ChannelAction
 	}
 }
-fragment Folder on Folder {
+fragment ChannelAction on ChannelAction {
 	id
 	name
 	iconUrl
 	description
+	notifyOnClose
 	workspaceId
+	channels {
+		id
+	}
+	rateLimit
+	__typename
+	... on WebhookAction {
+		urlTemplate
+		bodyTemplate
+		method
+		headers {
+			header
+			valueTemplate
+		}
+	}
+	... on EmailAction {
+		targetAddresses
+		subjectTemplate
+		bodyTemplate
+		isHtml
+	}
 }
 `
 
-func createFolder(
+func createChannelAction(
 	ctx context.Context,
 	client graphql.Client,
 	workspaceId string,
-	config FolderInput,
-) (*createFolderResponse, error) {
+	action ActionInput,
+) (*createChannelActionResponse, error) {
 	req := &graphql.Request{
-		OpName: "createFolder",
-		Query:  createFolder_Operation,
-		Variables: &__createFolderInput{
+		OpName: "createChannelAction",
+		Query:  createChannelAction_Operation,
+		Variables: &__createChannelActionInput{
 			WorkspaceId: workspaceId,
-			Config:      config,
+			Action:      action,
 		},
 	}
 	var err error
 
-	var data createFolderResponse
+	var data createChannelActionResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -12617,71 +16505,99 @@ func createFolder(
 	return &data, err
 }
 
-// The query or mutation executed by createLayeredSettingRecord.
-const createLayeredSettingRecord_Operation = `
-mutation createLayeredSettingRecord ($settingRecord: LayeredSettingRecordInput!) {
-	layeredSettingRecord: createLayeredSettingRecord(settingRecord: $settingRecord) {
-		... LayeredSettingRecord
+// The query or mutation executed by createDashboardLink.
+const createDashboardLink_Operation = `
+mutation createDashboardLink ($input: DashboardLinkInput!) {
+	dashboardLink: createDashboardLink(link: $input) {
+		... DashboardLink
 	}
 }
-fragment LayeredSettingRecord on LayeredSettingRecord {
-	settingAndTargetScope {
-		... SettingAndTargetScope
-	}
-	value {
-		... PrimitiveValue
-	}
+fragment DashboardLink on DashboardLink {
 	id
 	name
-	description
-	iconUrl
 	workspaceId
-	managedById
 	folderId
+	managedById
+	description
+	fromDashboard: fromDashboardId
+	toDashboard: toDashboardId
+	fromCard
+	linkLabel
 }
-fragment SettingAndTargetScope on SettingAndTargetScope {
-	setting
-	target {
-		... LayeredSettingRecordTarget
+`
+
+func createDashboardLink(
+	ctx context.Context,
+	client graphql.Client,
+	input DashboardLinkInput,
+) (*createDashboardLinkResponse, error) {
+	req := &graphql.Request{
+		OpName: "createDashboardLink",
+		Query:  createDashboardLink_Operation,
+		Variables: &__createDashboardLinkInput{
+			Input: input,
+		},
 	}
+	var err error
+
+	var data createDashboardLinkResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
 }
-fragment PrimitiveValue on PrimitiveValue {
-	bool
-	float64
-	int64
-	string
-	timestamp
-	duration
+
+// The query or mutation executed by createDatasetOutboundShare.
+const createDatasetOutboundShare_Operation = `
+mutation createDatasetOutboundShare ($workspaceId: ObjectId!, $datasetID: ObjectId!, $outboundShareID: ObjectId!, $input: DatasetOutboundShareInput!) {
+	datasetOutboundShare: createDatasetOutboundShare(workspaceId: $workspaceId, datasetID: $datasetID, outboundShareID: $outboundShareID, input: $input) {
+		... DatasetOutboundShare
+	}
 }
-fragment LayeredSettingRecordTarget on LayeredSettingRecordTarget {
-	customerId
+fragment DatasetOutboundShare on DatasetOutboundShare {
+	id
+	name
+	description
 	workspaceId
 	folderId
-	appId
-	monitorId
-	worksheetId
-	dashboardId
-	datasetId
-	datastreamId
-	userId
+	datasetID
+	outboundShareID
+	schemaName
+	viewName
+	freshnessGoal
+	status {
+		state
+		error
+	}
 }
 `
 
-func createLayeredSettingRecord(
+func createDatasetOutboundShare(
 	ctx context.Context,
 	client graphql.Client,
-	settingRecord LayeredSettingRecordInput,
-) (*createLayeredSettingRecordResponse, error) {
+	workspaceId string,
+	datasetID string,
+	outboundShareID string,
+	input DatasetOutboundShareInput,
+) (*createDatasetOutboundShareResponse, error) {
 	req := &graphql.Request{
-		OpName: "createLayeredSettingRecord",
-		Query:  createLayeredSettingRecord_Operation,
-		Variables: &__createLayeredSettingRecordInput{
-			SettingRecord: settingRecord,
+		OpName: "createDatasetOutboundShare",
+		Query:  createDatasetOutboundShare_Operation,
+		Variables: &__createDatasetOutboundShareInput{
+			WorkspaceId:     workspaceId,
+			DatasetID:       datasetID,
+			OutboundShareID: outboundShareID,
+			Input:           input,
 		},
 	}
 	var err error
 
-	var data createLayeredSettingRecordResponse
+	var data createDatasetOutboundShareResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -12693,123 +16609,40 @@ func createLayeredSettingRecord(
 	return &data, err
 }
 
-// The query or mutation executed by createMonitor.
-const createMonitor_Operation = `
-mutation createMonitor ($workspaceId: ObjectId!, $monitor: MonitorInput!) {
-	monitor: createMonitor(workspaceId: $workspaceId, monitor: $monitor) {
-		monitor {
-			... Monitor
-		}
+// The query or mutation executed by createDatastream.
+const createDatastream_Operation = `
+mutation createDatastream ($workspaceId: ObjectId!, $datastream: DatastreamInput!) {
+	datastream: createDatastream(workspaceId: $workspaceId, datastream: $datastream) {
+		... Datastream
 	}
 }
-fragment Monitor on Monitor {
-	workspaceId
+fragment Datastream on Datastream {
 	id
 	name
-	description
-	comment
 	iconUrl
-	isTemplate
-	disabled
-	freshnessGoal
-	useDefaultFreshness
-	source
-	definition
-	managedById
-	query {
-		outputStage
-		stages {
-			... StageQuery
-		}
-	}
-	rule {
-		__typename
-		sourceColumn
-		groupByGroups {
-			groupName
-			columns
-		}
-		... on MonitorRuleCount {
-			compareFunction
-			compareValues
-			lookbackTime
-		}
-		... on MonitorRuleChange {
-			changeType
-			compareFunction
-			compareValues
-			aggregateFunction
-			lookbackTime
-			baselineTime
-		}
-		... on MonitorRuleFacet {
-			facetFunction
-			facetValues
-			timeFunction
-			timeValue
-			lookbackTime
-		}
-		... on MonitorRuleThreshold {
-			compareFunction
-			compareValues
-			lookbackTime
-			thresholdAggFunction
-		}
-		... on MonitorRulePromote {
-			kindField
-			descriptionField
-			primaryKey
-		}
-		... on MonitorRuleLog {
-			compareFunction
-			compareValues
-			lookbackTime
-			expressionSummary
-			logStageId
-			sourceLogDatasetId
-		}
-	}
-	notificationSpec {
-		merge
-		importance
-		notifyOnReminder
-		reminderFrequency
-		notifyOnClose
-	}
-}
-fragment StageQuery on StageQuery {
-	id
-	pipeline
-	params
-	layout
-	input {
-		inputName
-		inputRole
-		datasetId
-		datasetPath
-		stageId
-	}
+	description
+	workspaceId
+	datasetId
 }
 `
 
-// More workarounds for server-side struggles
-func createMonitor(
+func createDatastream(
 	ctx context.Context,
 	client graphql.Client,
 	workspaceId string,
-	monitor MonitorInput,
-) (*createMonitorResponse, error) {
+	datastream DatastreamInput,
+) (*createDatastreamResponse, error) {
 	req := &graphql.Request{
-		OpName: "createMonitor",
-		Query:  createMonitor_Operation,
-		Variables: &__createMonitorInput{
+		OpName: "createDatastream",
+		Query:  createDatastream_Operation,
+		Variables: &__createDatastreamInput{
 			WorkspaceId: workspaceId,
-			Monitor:     monitor,
+			Datastream:  datastream,
 		},
 	}
 	var err error
 
-	var data createMonitorResponse
+	var data createDatastreamResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -12821,57 +16654,99 @@ func createMonitor(
 	return &data, err
 }
 
-// The query or mutation executed by createMonitorAction.
-const createMonitorAction_Operation = `
-mutation createMonitorAction ($monitorAction: MonitorActionInput!) {
-	monitorAction: createMonitorAction(input: $monitorAction) {
-		__typename
-		... MonitorAction
+// The query or mutation executed by createDatastreamToken.
+const createDatastreamToken_Operation = `
+mutation createDatastreamToken ($datastreamId: ObjectId!, $token: DatastreamTokenInput!, $password: String) {
+	datastreamToken: createDatastreamToken(datastreamId: $datastreamId, token: $token, password: $password) {
+		... DatastreamToken
 	}
 }
-fragment MonitorAction on MonitorAction {
+fragment DatastreamToken on DatastreamToken {
 	id
 	name
-	iconUrl
 	description
+	disabled
+	datastreamId
+	secret
+}
+`
+
+func createDatastreamToken(
+	ctx context.Context,
+	client graphql.Client,
+	datastreamId string,
+	token DatastreamTokenInput,
+	password *string,
+) (*createDatastreamTokenResponse, error) {
+	req := &graphql.Request{
+		OpName: "createDatastreamToken",
+		Query:  createDatastreamToken_Operation,
+		Variables: &__createDatastreamTokenInput{
+			DatastreamId: datastreamId,
+			Token:        token,
+			Password:     password,
+		},
+	}
+	var err error
+
+	var data createDatastreamTokenResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createDeferredForeignKey.
+const createDeferredForeignKey_Operation = `
+mutation createDeferredForeignKey ($workspaceId: ObjectId!, $keyInput: DeferredForeignKeyInput!) {
+	deferredForeignKey: createDeferredForeignKey(workspaceId: $workspaceId, data: $keyInput) {
+		... DeferredForeignKey
+	}
+}
+fragment DeferredForeignKey on DeferredForeignKey {
+	id
 	workspaceId
-	rateLimit
-	notifyOnClose
-	isPrivate
-	__typename
-	... on EmailAction {
-		targetAddresses
-		subjectTemplate
-		bodyTemplate
-		isHtml
+	sourceDataset {
+		datasetId
 	}
-	... on WebhookAction {
-		urlTemplate
-		method
-		headers {
-			header
-			valueTemplate
-		}
-		bodyTemplate
+	targetDataset {
+		datasetId
+	}
+	srcFields
+	dstFields
+	label
+	resolution {
+		sourceId
+		targetId
+	}
+	status {
+		errorText
 	}
 }
 `
 
-func createMonitorAction(
+func createDeferredForeignKey(
 	ctx context.Context,
 	client graphql.Client,
-	monitorAction MonitorActionInput,
-) (*createMonitorActionResponse, error) {
+	workspaceId string,
+	keyInput DeferredForeignKeyInput,
+) (*createDeferredForeignKeyResponse, error) {
 	req := &graphql.Request{
-		OpName: "createMonitorAction",
-		Query:  createMonitorAction_Operation,
-		Variables: &__createMonitorActionInput{
-			MonitorAction: monitorAction,
+		OpName: "createDeferredForeignKey",
+		Query:  createDeferredForeignKey_Operation,
+		Variables: &__createDeferredForeignKeyInput{
+			WorkspaceId: workspaceId,
+			KeyInput:    keyInput,
 		},
 	}
 	var err error
 
-	var data createMonitorActionResponse
+	var data createDeferredForeignKeyResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -12883,39 +16758,60 @@ func createMonitorAction(
 	return &data, err
 }
 
-// The query or mutation executed by createMonitorActionAttachment.
-const createMonitorActionAttachment_Operation = `
-mutation createMonitorActionAttachment ($monitorActionAttachment: MonitorActionAttachmentInput!) {
-	monitorActionAttachment: createMonitorActionAttachment(input: $monitorActionAttachment) {
-		... MonitorActionAttachment
+// The query or mutation executed by createFiledrop.
+const createFiledrop_Operation = `
+mutation createFiledrop ($workspaceID: ObjectId!, $datastreamID: ObjectId!, $input: FiledropInput!) {
+	filedrop: createFiledrop(workspaceId: $workspaceID, datastreamID: $datastreamID, input: $input) {
+		... Filedrop
 	}
 }
-fragment MonitorActionAttachment on MonitorActionAttachment {
-	monitorID
-	actionID
+fragment Filedrop on Filedrop {
 	id
-	workspaceId
 	name
 	iconUrl
 	description
+	workspaceId
+	status
+	datastreamID
+	config {
+		provider {
+			__typename
+			... on FiledropProviderAwsConfig {
+				region
+				roleArn
+			}
+		}
+	}
+	endpoint {
+		__typename
+		... on FiledropS3Endpoint {
+			arn
+			bucket
+			prefix
+		}
+	}
 }
 `
 
-func createMonitorActionAttachment(
+func createFiledrop(
 	ctx context.Context,
 	client graphql.Client,
-	monitorActionAttachment MonitorActionAttachmentInput,
-) (*createMonitorActionAttachmentResponse, error) {
+	workspaceID string,
+	datastreamID string,
+	input FiledropInput,
+) (*createFiledropResponse, error) {
 	req := &graphql.Request{
-		OpName: "createMonitorActionAttachment",
-		Query:  createMonitorActionAttachment_Operation,
-		Variables: &__createMonitorActionAttachmentInput{
-			MonitorActionAttachment: monitorActionAttachment,
+		OpName: "createFiledrop",
+		Query:  createFiledrop_Operation,
+		Variables: &__createFiledropInput{
+			WorkspaceID:  workspaceID,
+			DatastreamID: datastreamID,
+			Input:        input,
 		},
 	}
 	var err error
 
-	var data createMonitorActionAttachmentResponse
+	var data createFiledropResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -12927,186 +16823,39 @@ func createMonitorActionAttachment(
 	return &data, err
 }
 
-// The query or mutation executed by createMonitorV2.
-const createMonitorV2_Operation = `
-mutation createMonitorV2 ($workspaceId: ObjectId!, $input: MonitorV2Input!) {
-	monitorV2: createMonitorV2(workspaceId: $workspaceId, input: $input) {
-		... MonitorV2
+// The query or mutation executed by createFolder.
+const createFolder_Operation = `
+mutation createFolder ($workspaceId: ObjectId!, $config: FolderInput!) {
+	folder: createFolder(workspaceId: $workspaceId, folder: $config) {
+		... Folder
 	}
 }
-fragment MonitorV2 on MonitorV2 {
+fragment Folder on Folder {
 	id
-	workspaceId
-	createdBy
-	createdDate
 	name
 	iconUrl
 	description
-	managedById
-	folderId
-	rollupStatus
-	ruleKind
-	definition {
-		... MonitorV2Definition
-	}
-	actionRules {
-		... MonitorV2ActionRule
-	}
-}
-fragment MonitorV2Definition on MonitorV2Definition {
-	inputQuery {
-		outputStage
-		stages {
-			... StageQuery
-		}
-	}
-	rules {
-		... MonitorV2Rule
-	}
-	lookbackTime
-	dataStabilizationDelay
-	groupings {
-		... MonitorV2Column
-	}
-	scheduling {
-		... MonitorV2Scheduling
-	}
-}
-fragment MonitorV2ActionRule on MonitorV2ActionRule {
-	actionID
-	levels
-	sendEndNotifications
-	sendRemindersInterval
-}
-fragment StageQuery on StageQuery {
-	id
-	pipeline
-	params
-	layout
-	input {
-		inputName
-		inputRole
-		datasetId
-		datasetPath
-		stageId
-	}
-}
-fragment MonitorV2Rule on MonitorV2Rule {
-	level
-	count {
-		... MonitorV2CountRule
-	}
-	threshold {
-		... MonitorV2ThresholdRule
-	}
-	promote {
-		... MonitorV2PromoteRule
-	}
-}
-fragment MonitorV2Column on MonitorV2Column {
-	linkColumn {
-		... MonitorV2LinkColumn
-	}
-	columnPath {
-		... MonitorV2ColumnPath
-	}
-}
-fragment MonitorV2Scheduling on MonitorV2Scheduling {
-	interval {
-		... MonitorV2IntervalSchedule
-	}
-	transform {
-		... MonitorV2TransformSchedule
-	}
-}
-fragment MonitorV2CountRule on MonitorV2CountRule {
-	compareValues {
-		... MonitorV2Comparison
-	}
-	compareGroups {
-		... MonitorV2ColumnComparison
-	}
-}
-fragment MonitorV2ThresholdRule on MonitorV2ThresholdRule {
-	compareValues {
-		... MonitorV2Comparison
-	}
-	valueColumnName
-	aggregation
-	compareGroups {
-		... MonitorV2ColumnComparison
-	}
-}
-fragment MonitorV2PromoteRule on MonitorV2PromoteRule {
-	compareColumns {
-		... MonitorV2ColumnComparison
-	}
-}
-fragment MonitorV2LinkColumn on MonitorV2LinkColumn {
-	name
-	meta {
-		... MonitorV2LinkColumnMeta
-	}
-}
-fragment MonitorV2ColumnPath on MonitorV2ColumnPath {
-	name
-	path
-}
-fragment MonitorV2IntervalSchedule on MonitorV2IntervalSchedule {
-	interval
-	randomize
-}
-fragment MonitorV2TransformSchedule on MonitorV2TransformSchedule {
-	freshnessGoal
-}
-fragment MonitorV2Comparison on MonitorV2Comparison {
-	compareFn
-	compareValue {
-		... PrimitiveValue
-	}
-}
-fragment MonitorV2ColumnComparison on MonitorV2ColumnComparison {
-	column {
-		... MonitorV2Column
-	}
-	compareValues {
-		... MonitorV2Comparison
-	}
-}
-fragment MonitorV2LinkColumnMeta on MonitorV2LinkColumnMeta {
-	srcFields {
-		... MonitorV2ColumnPath
-	}
-	dstFields
-	targetDataset
-}
-fragment PrimitiveValue on PrimitiveValue {
-	bool
-	float64
-	int64
-	string
-	timestamp
-	duration
+	workspaceId
 }
 `
 
-func createMonitorV2(
+func createFolder(
 	ctx context.Context,
 	client graphql.Client,
 	workspaceId string,
-	input MonitorV2Input,
-) (*createMonitorV2Response, error) {
+	config FolderInput,
+) (*createFolderResponse, error) {
 	req := &graphql.Request{
-		OpName: "createMonitorV2",
-		Query:  createMonitorV2_Operation,
-		Variables: &__createMonitorV2Input{
+		OpName: "createFolder",
+		Query:  createFolder_Operation,
+		Variables: &__createFolderInput{
 			WorkspaceId: workspaceId,
-			Input:       input,
+			Config:      config,
 		},
 	}
 	var err error
 
-	var data createMonitorV2Response
+	var data createFolderResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -13118,98 +16867,52 @@ func createMonitorV2(
 	return &data, err
 }
 
-// The query or mutation executed by createMonitorV2Action.
-const createMonitorV2Action_Operation = `
-mutation createMonitorV2Action ($workspaceId: ObjectId!, $input: MonitorV2ActionInput!) {
-	monitorV2Action: createMonitorV2Action(workspaceId: $workspaceId, input: $input) {
-		... MonitorV2Action
+// The query or mutation executed by createIncident.
+const createIncident_Operation = `
+mutation createIncident ($workspaceId: ObjectId!, $input: IncidentInput!) {
+	incident: createIncident(workspaceId: $workspaceId, input: $input) {
+		... Incident
 	}
 }
-fragment MonitorV2Action on MonitorV2Action {
-	inline
-	type
-	destinationLinks {
-		... ActionDestinationLink
-	}
-	email {
-		... MonitorV2EmailAction
-	}
-	webhook {
-		... MonitorV2WebhookAction
-	}
+fragment Incident on Incident {
 	id
-	workspaceId
 	name
-	iconUrl
 	description
-	createdBy
-	createdDate
-}
-fragment ActionDestinationLink on ActionDestinationLink {
-	destinationID
-	sendEndNotifications
-	sendRemindersInterval
-	definition {
-		... MonitorV2DestinationDefinition
-	}
-}
-fragment MonitorV2EmailAction on MonitorV2EmailAction {
-	users
-	addresses
-	subject
-	body
-	fragments
-}
-fragment MonitorV2WebhookAction on MonitorV2WebhookAction {
-	headers {
-		... MonitorV2WebhookHeader
-	}
-	body
-	fragments
-	url
-	method
-}
-fragment MonitorV2DestinationDefinition on MonitorV2DestinationDefinition {
-	inline
-	type
-	email {
-		... MonitorV2EmailDestination
+	iconUrl
+	workspaceId
+	folderId
+	status
+	inactiveTime
+	closedTime
+	users {
+		userId
 	}
-	webhook {
-		... MonitorV2WebhookDestination
+	slackChannels {
+		connectionID
+		slackchannelID
 	}
-}
-fragment MonitorV2WebhookHeader on MonitorV2WebhookHeader {
-	header
-	value
-}
-fragment MonitorV2EmailDestination on MonitorV2EmailDestination {
-	users
-	addresses
-}
-fragment MonitorV2WebhookDestination on MonitorV2WebhookDestination {
-	url
-	method
+	worksheets
+	dashboards
 }
 `
 
-func createMonitorV2Action(
+func createIncident(
 	ctx context.Context,
 	client graphql.Client,
 	workspaceId string,
-	input MonitorV2ActionInput,
-) (*createMonitorV2ActionResponse, error) {
+	input IncidentInput,
+) (*createIncidentResponse, error) {
 	req := &graphql.Request{
-		OpName: "createMonitorV2Action",
-		Query:  createMonitorV2Action_Operation,
-		Variables: &__createMonitorV2ActionInput{
+		OpName: "createIncident",
+		Query:  createIncident_Operation,
+		Variables: &__createIncidentInput{
 			WorkspaceId: workspaceId,
 			Input:       input,
 		},
 	}
 	var err error
 
-	var data createMonitorV2ActionResponse
+	var data createIncidentResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -13221,56 +16924,71 @@ func createMonitorV2Action(
 	return &data, err
 }
 
-// The query or mutation executed by createMonitorV2Destination.
-const createMonitorV2Destination_Operation = `
-mutation createMonitorV2Destination ($workspaceId: ObjectId!, $input: MonitorV2DestinationInput!) {
-	monitorV2Destination: createMonitorV2Destination(workspaceId: $workspaceId, input: $input) {
-		... MonitorV2Destination
+// The query or mutation executed by createLayeredSettingRecord.
+const createLayeredSettingRecord_Operation = `
+mutation createLayeredSettingRecord ($settingRecord: LayeredSettingRecordInput!) {
+	layeredSettingRecord: createLayeredSettingRecord(settingRecord: $settingRecord) {
+		... LayeredSettingRecord
 	}
 }
-fragment MonitorV2Destination on MonitorV2Destination {
-	inline
-	type
-	email {
-		... MonitorV2EmailDestination
+fragment LayeredSettingRecord on LayeredSettingRecord {
+	settingAndTargetScope {
+		... SettingAndTargetScope
 	}
-	webhook {
-		... MonitorV2WebhookDestination
+	value {
+		... PrimitiveValue
 	}
 	id
-	workspaceId
 	name
-	iconUrl
 	description
-	createdBy
+	iconUrl
+	workspaceId
+	managedById
+	folderId
 }
-fragment MonitorV2EmailDestination on MonitorV2EmailDestination {
-	users
-	addresses
+fragment SettingAndTargetScope on SettingAndTargetScope {
+	setting
+	target {
+		... LayeredSettingRecordTarget
+	}
 }
-fragment MonitorV2WebhookDestination on MonitorV2WebhookDestination {
-	url
-	method
+fragment PrimitiveValue on PrimitiveValue {
+	bool
+	float64
+	int64
+	string
+	timestamp
+	duration
+}
+fragment LayeredSettingRecordTarget on LayeredSettingRecordTarget {
+	customerId
+	workspaceId
+	folderId
+	appId
+	monitorId
+	worksheetId
+	dashboardId
+	datasetId
+	datastreamId
+	userId
 }
 `
 
-func createMonitorV2Destination(
+func createLayeredSettingRecord(
 	ctx context.Context,
 	client graphql.Client,
-	workspaceId string,
-	input MonitorV2DestinationInput,
-) (*createMonitorV2DestinationResponse, error) {
+	settingRecord LayeredSettingRecordInput,
+) (*createLayeredSettingRecordResponse, error) {
 	req := &graphql.Request{
-		OpName: "createMonitorV2Destination",
-		Query:  createMonitorV2Destination_Operation,
-		Variables: &__createMonitorV2DestinationInput{
-			WorkspaceId: workspaceId,
-			Input:       input,
+		OpName: "createLayeredSettingRecord",
+		Query:  createLayeredSettingRecord_Operation,
+		Variables: &__createLayeredSettingRecordInput{
+			SettingRecord: settingRecord,
 		},
 	}
 	var err error
 
-	var data createMonitorV2DestinationResponse
+	var data createLayeredSettingRecordResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -13282,41 +17000,123 @@ func createMonitorV2Destination(
 	return &data, err
 }
 
-// The query or mutation executed by createOrUpdateBookmark.
-const createOrUpdateBookmark_Operation = `
-mutation createOrUpdateBookmark ($id: ObjectId, $bookmark: BookmarkInput!) {
-	bookmark: createOrUpdateBookmark(id: $id, bookmark: $bookmark) {
-		... Bookmark
+// The query or mutation executed by createMonitor.
+const createMonitor_Operation = `
+mutation createMonitor ($workspaceId: ObjectId!, $monitor: MonitorInput!) {
+	monitor: createMonitor(workspaceId: $workspaceId, monitor: $monitor) {
+		monitor {
+			... Monitor
+		}
 	}
 }
-fragment Bookmark on Bookmark {
+fragment Monitor on Monitor {
+	workspaceId
 	id
 	name
+	description
+	comment
 	iconUrl
-	targetId
-	targetIdKind
-	groupId
-	bookmarkKind
+	isTemplate
+	disabled
+	freshnessGoal
+	useDefaultFreshness
+	source
+	definition
+	managedById
+	query {
+		outputStage
+		stages {
+			... StageQuery
+		}
+	}
+	rule {
+		__typename
+		sourceColumn
+		groupByGroups {
+			groupName
+			columns
+		}
+		... on MonitorRuleCount {
+			compareFunction
+			compareValues
+			lookbackTime
+		}
+		... on MonitorRuleChange {
+			changeType
+			compareFunction
+			compareValues
+			aggregateFunction
+			lookbackTime
+			baselineTime
+		}
+		... on MonitorRuleFacet {
+			facetFunction
+			facetValues
+			timeFunction
+			timeValue
+			lookbackTime
+		}
+		... on MonitorRuleThreshold {
+			compareFunction
+			compareValues
+			lookbackTime
+			thresholdAggFunction
+		}
+		... on MonitorRulePromote {
+			kindField
+			descriptionField
+			primaryKey
+		}
+		... on MonitorRuleLog {
+			compareFunction
+			compareValues
+			lookbackTime
+			expressionSummary
+			logStageId
+			sourceLogDatasetId
+		}
+	}
+	notificationSpec {
+		merge
+		importance
+		notifyOnReminder
+		reminderFrequency
+		notifyOnClose
+	}
+}
+fragment StageQuery on StageQuery {
+	id
+	pipeline
+	params
+	layout
+	input {
+		inputName
+		inputRole
+		datasetId
+		datasetPath
+		stageId
+	}
 }
 `
 
-func createOrUpdateBookmark(
+// More workarounds for server-side struggles
+func createMonitor(
 	ctx context.Context,
 	client graphql.Client,
-	id *string,
-	bookmark BookmarkInput,
-) (*createOrUpdateBookmarkResponse, error) {
+	workspaceId string,
+	monitor MonitorInput,
+) (*createMonitorResponse, error) {
 	req := &graphql.Request{
-		OpName: "createOrUpdateBookmark",
-		Query:  createOrUpdateBookmark_Operation,
-		Variables: &__createOrUpdateBookmarkInput{
-			Id:       id,
-			Bookmark: bookmark,
+		OpName: "createMonitor",
+		Query:  createMonitor_Operation,
+		Variables: &__createMonitorInput{
+			WorkspaceId: workspaceId,
+			Monitor:     monitor,
 		},
 	}
 	var err error
 
-	var data createOrUpdateBookmarkResponse
+	var data createMonitorResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -13328,39 +17128,57 @@ func createOrUpdateBookmark(
 	return &data, err
 }
 
-// The query or mutation executed by createOrUpdateBookmarkGroup.
-const createOrUpdateBookmarkGroup_Operation = `
-mutation createOrUpdateBookmarkGroup ($id: ObjectId, $group: BookmarkGroupInput!) {
-	bookmarkGroup: createOrUpdateBookmarkGroup(id: $id, group: $group) {
-		... BookmarkGroup
+// The query or mutation executed by createMonitorAction.
+const createMonitorAction_Operation = `
+mutation createMonitorAction ($monitorAction: MonitorActionInput!) {
+	monitorAction: createMonitorAction(input: $monitorAction) {
+		__typename
+		... MonitorAction
 	}
 }
-fragment BookmarkGroup on BookmarkGroup {
+fragment MonitorAction on MonitorAction {
 	id
 	name
-	description
 	iconUrl
+	description
 	workspaceId
+	rateLimit
+	notifyOnClose
+	isPrivate
+	__typename
+	... on EmailAction {
+		targetAddresses
+		subjectTemplate
+		bodyTemplate
+		isHtml
+	}
+	... on WebhookAction {
+		urlTemplate
+		method
+		headers {
+			header
+			valueTemplate
+		}
+		bodyTemplate
+	}
 }
 `
 
-func createOrUpdateBookmarkGroup(
+func createMonitorAction(
 	ctx context.Context,
 	client graphql.Client,
-	id *string,
-	group BookmarkGroupInput,
-) (*createOrUpdateBookmarkGroupResponse, error) {
+	monitorAction MonitorActionInput,
+) (*createMonitorActionResponse, error) {
 	req := &graphql.Request{
-		OpName: "createOrUpdateBookmarkGroup",
-		Query:  createOrUpdateBookmarkGroup_Operation,
-		Variables: &__createOrUpdateBookmarkGroupInput{
-			Id:    id,
-			Group: group,
+		OpName: "createMonitorAction",
+		Query:  createMonitorAction_Operation,
+		Variables: &__createMonitorActionInput{
+			MonitorAction: monitorAction,
 		},
 	}
 	var err error
 
-	var data createOrUpdateBookmarkGroupResponse
+	var data createMonitorActionResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -13372,137 +17190,39 @@ func createOrUpdateBookmarkGroup(
 	return &data, err
 }
 
-// The query or mutation executed by createPoller.
-const createPoller_Operation = `
-mutation createPoller ($workspaceId: ObjectId!, $poller: PollerInput!) {
-	poller: createPoller(workspaceId: $workspaceId, poller: $poller) {
-		... Poller
+// The query or mutation executed by createMonitorActionAttachment.
+const createMonitorActionAttachment_Operation = `
+mutation createMonitorActionAttachment ($monitorActionAttachment: MonitorActionAttachmentInput!) {
+	monitorActionAttachment: createMonitorActionAttachment(input: $monitorActionAttachment) {
+		... MonitorActionAttachment
 	}
 }
-fragment Poller on Poller {
+fragment MonitorActionAttachment on MonitorActionAttachment {
+	monitorID
+	actionID
 	id
 	workspaceId
-	customerId
-	datastreamId
-	disabled
-	kind
-	config {
-		__typename
-		name
-		retries
-		interval
-		tags
-		chunk {
-			enabled
-			size
-		}
-		... on PollerPubSubConfig {
-			projectId
-			jsonKey
-			subscriptionId
-		}
-		... on PollerHTTPConfig {
-			method
-			body
-			endpoint
-			contentType
-			headers
-			template {
-				... HttpRequestConfig
-			}
-			requests {
-				... HttpRequestConfig
-			}
-			rules {
-				match {
-					... HttpRequestConfig
-				}
-				follow
-				decoder {
-					type
-				}
-			}
-			timestamps {
-				name
-				source
-				format
-				offset
-				truncate
-			}
-		}
-		... on PollerGCPMonitoringConfig {
-			projectId
-			jsonKey
-			includeMetricTypePrefixes
-			excludeMetricTypePrefixes
-			rateLimit
-			totalLimit
-		}
-		... on PollerMongoDBAtlasConfig {
-			publicKey
-			privateKey
-			includeGroups
-			excludeGroups
-		}
-		... on PollerCloudWatchMetricsConfig {
-			period
-			delay
-			region
-			assumeRoleArn
-			queries {
-				namespace
-				metricNames
-				dimensions {
-					name
-					value
-				}
-				resourceFilter {
-					resourceType
-					pattern
-					dimensionName
-					tagFilters {
-						key
-						values
-					}
-				}
-			}
-		}
-		... on PollerAWSSnapshotConfig {
-			region
-			assumeRoleArn
-			includeActions
-		}
-	}
-}
-fragment HttpRequestConfig on PollerHTTPRequestConfig {
-	url
-	method
-	username
-	password
-	authScheme
-	body
-	headers
-	params
+	name
+	iconUrl
+	description
 }
 `
 
-func createPoller(
+func createMonitorActionAttachment(
 	ctx context.Context,
 	client graphql.Client,
-	workspaceId string,
-	poller PollerInput,
-) (*createPollerResponse, error) {
+	monitorActionAttachment MonitorActionAttachmentInput,
+) (*createMonitorActionAttachmentResponse, error) {
 	req := &graphql.Request{
-		OpName: "createPoller",
-		Query:  createPoller_Operation,
-		Variables: &__createPollerInput{
-			WorkspaceId: workspaceId,
-			Poller:      poller,
+		OpName: "createMonitorActionAttachment",
+		Query:  createMonitorActionAttachment_Operation,
+		Variables: &__createMonitorActionAttachmentInput{
+			MonitorActionAttachment: monitorActionAttachment,
 		},
 	}
 	var err error
 
-	var data createPollerResponse
+	var data createMonitorActionAttachmentResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -13514,54 +17234,59 @@ func createPoller(
 	return &data, err
 }
 
-// The query or mutation executed by createPreferredPath.
-const createPreferredPath_Operation = `
-mutation createPreferredPath ($workspaceId: ObjectId!, $config: PreferredPathInput!) {
-	preferredPathWithStatus: createPreferredPath(workspaceId: $workspaceId, path: $config) {
-		... PreferredPathWithStatus
-	}
-}
-fragment PreferredPathWithStatus on PreferredPathWithStatus {
-	path {
-		... PreferredPath
+// The query or mutation executed by createMonitorMuteRuleForMonitors.
+const createMonitorMuteRuleForMonitors_Operation = `
+mutation createMonitorMuteRuleForMonitors ($input: MonitorMuteRuleInput!, $monitorIds: [ObjectId!]!) {
+	monitorMuteRule: createMonitorMuteRuleForMonitors(input: $input, monitorIds: $monitorIds) {
+		... MonitorMuteRule
 	}
-	error
 }
-fragment PreferredPath on PreferredPath {
+fragment MonitorMuteRule on MonitorMuteRule {
 	id
+	workspaceId
+	folderId
 	name
 	iconUrl
 	description
-	workspaceId
-	folderId
-	sourceDataset
-	path {
-		linkId
-		reverse
-		linkName
-		reverseFromDataset
+	startDate
+	duration
+	filter {
+		__typename
+		filterType
+		... on MonitorMuteRuleFilterPerColumn {
+			columnID
+			values
+		}
+		... on MonitorMuteRuleFilterPerResource {
+			resourceIds {
+				datasetId
+				primaryKeyValue {
+					name
+					value
+				}
+			}
+		}
 	}
 }
 `
 
-// More workarounds for server-side struggles
-func createPreferredPath(
+func createMonitorMuteRuleForMonitors(
 	ctx context.Context,
 	client graphql.Client,
-	workspaceId string,
-	config PreferredPathInput,
-) (*createPreferredPathResponse, error) {
+	input MonitorMuteRuleInput,
+	monitorIds []string,
+) (*createMonitorMuteRuleForMonitorsResponse, error) {
 	req := &graphql.Request{
-		OpName: "createPreferredPath",
-		Query:  createPreferredPath_Operation,
-		Variables: &__createPreferredPathInput{
-			WorkspaceId: workspaceId,
-			Config:      config,
+		OpName: "createMonitorMuteRuleForMonitors",
+		Query:  createMonitorMuteRuleForMonitors_Operation,
+		Variables: &__createMonitorMuteRuleForMonitorsInput{
+			Input:      input,
+			MonitorIds: monitorIds,
 		},
 	}
 	var err error
 
-	var data createPreferredPathResponse
+	var data createMonitorMuteRuleForMonitorsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -13573,131 +17298,186 @@ func createPreferredPath(
 	return &data, err
 }
 
-// The query or mutation executed by createRbacGroup.
-const createRbacGroup_Operation = `
-mutation createRbacGroup ($config: RbacGroupInput!) {
-	rbacGroup: createRbacGroup(input: $config) {
-		... RbacGroup
+// The query or mutation executed by createMonitorV2.
+const createMonitorV2_Operation = `
+mutation createMonitorV2 ($workspaceId: ObjectId!, $input: MonitorV2Input!) {
+	monitorV2: createMonitorV2(workspaceId: $workspaceId, input: $input) {
+		... MonitorV2
 	}
 }
-fragment RbacGroup on RbacGroup {
+fragment MonitorV2 on MonitorV2 {
 	id
+	workspaceId
+	createdBy
+	createdDate
 	name
+	iconUrl
 	description
-}
-`
-
-func createRbacGroup(
-	ctx context.Context,
-	client graphql.Client,
-	config RbacGroupInput,
-) (*createRbacGroupResponse, error) {
-	req := &graphql.Request{
-		OpName: "createRbacGroup",
-		Query:  createRbacGroup_Operation,
-		Variables: &__createRbacGroupInput{
-			Config: config,
-		},
+	managedById
+	folderId
+	rollupStatus
+	ruleKind
+	definition {
+		... MonitorV2Definition
+	}
+	actionRules {
+		... MonitorV2ActionRule
 	}
-	var err error
-
-	var data createRbacGroupResponse
-	resp := &graphql.Response{Data: &data}
-
-	err = client.MakeRequest(
-		ctx,
-		req,
-		resp,
-	)
-
-	return &data, err
 }
-
-// The query or mutation executed by createRbacGroupmember.
-const createRbacGroupmember_Operation = `
-mutation createRbacGroupmember ($config: RbacGroupmemberInput!) {
-	rbacGroupmember: createRbacGroupmember(input: $config) {
-		... RbacGroupmember
+fragment MonitorV2Definition on MonitorV2Definition {
+	inputQuery {
+		outputStage
+		stages {
+			... StageQuery
+		}
+	}
+	rules {
+		... MonitorV2Rule
+	}
+	lookbackTime
+	dataStabilizationDelay
+	groupings {
+		... MonitorV2Column
+	}
+	scheduling {
+		... MonitorV2Scheduling
 	}
 }
-fragment RbacGroupmember on RbacGroupmember {
+fragment MonitorV2ActionRule on MonitorV2ActionRule {
+	actionID
+	levels
+	sendEndNotifications
+	sendRemindersInterval
+}
+fragment StageQuery on StageQuery {
 	id
-	description
-	groupId
-	memberUserId
-	memberGroupId
+	pipeline
+	params
+	layout
+	input {
+		inputName
+		inputRole
+		datasetId
+		datasetPath
+		stageId
+	}
 }
-`
-
-func createRbacGroupmember(
-	ctx context.Context,
-	client graphql.Client,
-	config RbacGroupmemberInput,
-) (*createRbacGroupmemberResponse, error) {
-	req := &graphql.Request{
-		OpName: "createRbacGroupmember",
-		Query:  createRbacGroupmember_Operation,
-		Variables: &__createRbacGroupmemberInput{
-			Config: config,
-		},
+fragment MonitorV2Rule on MonitorV2Rule {
+	level
+	count {
+		... MonitorV2CountRule
+	}
+	threshold {
+		... MonitorV2ThresholdRule
+	}
+	promote {
+		... MonitorV2PromoteRule
 	}
-	var err error
-
-	var data createRbacGroupmemberResponse
-	resp := &graphql.Response{Data: &data}
-
-	err = client.MakeRequest(
-		ctx,
-		req,
-		resp,
-	)
-
-	return &data, err
 }
-
-// The query or mutation executed by createRbacStatement.
-const createRbacStatement_Operation = `
-mutation createRbacStatement ($config: RbacStatementInput!) {
-	rbacStatement: createRbacStatement(input: $config) {
-		... RbacStatement
+fragment MonitorV2Column on MonitorV2Column {
+	linkColumn {
+		... MonitorV2LinkColumn
+	}
+	columnPath {
+		... MonitorV2ColumnPath
 	}
 }
-fragment RbacStatement on RbacStatement {
-	id
-	description
-	subject {
-		userId
-		groupId
-		all
+fragment MonitorV2Scheduling on MonitorV2Scheduling {
+	interval {
+		... MonitorV2IntervalSchedule
 	}
-	object {
-		objectId
-		folderId
-		workspaceId
-		type
-		name
-		owner
-		all
+	transform {
+		... MonitorV2TransformSchedule
 	}
-	role
+}
+fragment MonitorV2CountRule on MonitorV2CountRule {
+	compareValues {
+		... MonitorV2Comparison
+	}
+	compareGroups {
+		... MonitorV2ColumnComparison
+	}
+}
+fragment MonitorV2ThresholdRule on MonitorV2ThresholdRule {
+	compareValues {
+		... MonitorV2Comparison
+	}
+	valueColumnName
+	aggregation
+	compareGroups {
+		... MonitorV2ColumnComparison
+	}
+}
+fragment MonitorV2PromoteRule on MonitorV2PromoteRule {
+	compareColumns {
+		... MonitorV2ColumnComparison
+	}
+}
+fragment MonitorV2LinkColumn on MonitorV2LinkColumn {
+	name
+	meta {
+		... MonitorV2LinkColumnMeta
+	}
+}
+fragment MonitorV2ColumnPath on MonitorV2ColumnPath {
+	name
+	path
+}
+fragment MonitorV2IntervalSchedule on MonitorV2IntervalSchedule {
+	interval
+	randomize
+}
+fragment MonitorV2TransformSchedule on MonitorV2TransformSchedule {
+	freshnessGoal
+}
+fragment MonitorV2Comparison on MonitorV2Comparison {
+	compareFn
+	compareValue {
+		... PrimitiveValue
+	}
+}
+fragment MonitorV2ColumnComparison on MonitorV2ColumnComparison {
+	column {
+		... MonitorV2Column
+	}
+	compareValues {
+		... MonitorV2Comparison
+	}
+}
+fragment MonitorV2LinkColumnMeta on MonitorV2LinkColumnMeta {
+	srcFields {
+		... MonitorV2ColumnPath
+	}
+	dstFields
+	targetDataset
+}
+fragment PrimitiveValue on PrimitiveValue {
+	bool
+	float64
+	int64
+	string
+	timestamp
+	duration
 }
 `
 
-func createRbacStatement(
+func createMonitorV2(
 	ctx context.Context,
 	client graphql.Client,
-	config RbacStatementInput,
-) (*createRbacStatementResponse, error) {
+	workspaceId string,
+	input MonitorV2Input,
+) (*createMonitorV2Response, error) {
 	req := &graphql.Request{
-		OpName: "createRbacStatement",
-		Query:  createRbacStatement_Operation,
-		Variables: &__createRbacStatementInput{
-			Config: config,
+		OpName: "createMonitorV2",
+		Query:  createMonitorV2_Operation,
+		Variables: &__createMonitorV2Input{
+			WorkspaceId: workspaceId,
+			Input:       input,
 		},
 	}
 	var err error
 
-	var data createRbacStatementResponse
+	var data createMonitorV2Response
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -13709,46 +17489,98 @@ func createRbacStatement(
 	return &data, err
 }
 
-// The query or mutation executed by createSnowflakeOutboundShare.
-const createSnowflakeOutboundShare_Operation = `
-mutation createSnowflakeOutboundShare ($workspaceId: ObjectId!, $input: SnowflakeOutboundShareInput!) {
-	share: createSnowflakeOutboundShare(workspaceId: $workspaceId, input: $input) {
-		... SnowflakeOutboundShare
+// The query or mutation executed by createMonitorV2Action.
+const createMonitorV2Action_Operation = `
+mutation createMonitorV2Action ($workspaceId: ObjectId!, $input: MonitorV2ActionInput!) {
+	monitorV2Action: createMonitorV2Action(workspaceId: $workspaceId, input: $input) {
+		... MonitorV2Action
 	}
 }
-fragment SnowflakeOutboundShare on SnowflakeOutboundShare {
+fragment MonitorV2Action on MonitorV2Action {
+	inline
+	type
+	destinationLinks {
+		... ActionDestinationLink
+	}
+	email {
+		... MonitorV2EmailAction
+	}
+	webhook {
+		... MonitorV2WebhookAction
+	}
 	id
+	workspaceId
 	name
+	iconUrl
 	description
-	workspaceId
-	shareName
-	accounts {
-		... SnowflakeAccount
+	createdBy
+	createdDate
+}
+fragment ActionDestinationLink on ActionDestinationLink {
+	destinationID
+	sendEndNotifications
+	sendRemindersInterval
+	definition {
+		... MonitorV2DestinationDefinition
 	}
 }
-fragment SnowflakeAccount on SnowflakeAccount {
-	organization
-	account
+fragment MonitorV2EmailAction on MonitorV2EmailAction {
+	users
+	addresses
+	subject
+	body
+	fragments
+}
+fragment MonitorV2WebhookAction on MonitorV2WebhookAction {
+	headers {
+		... MonitorV2WebhookHeader
+	}
+	body
+	fragments
+	url
+	method
+}
+fragment MonitorV2DestinationDefinition on MonitorV2DestinationDefinition {
+	inline
+	type
+	email {
+		... MonitorV2EmailDestination
+	}
+	webhook {
+		... MonitorV2WebhookDestination
+	}
+}
+fragment MonitorV2WebhookHeader on MonitorV2WebhookHeader {
+	header
+	value
+}
+fragment MonitorV2EmailDestination on MonitorV2EmailDestination {
+	users
+	addresses
+}
+fragment MonitorV2WebhookDestination on MonitorV2WebhookDestination {
+	url
+	method
 }
 `
 
-func createSnowflakeOutboundShare(
+func createMonitorV2Action(
 	ctx context.Context,
 	client graphql.Client,
 	workspaceId string,
-	input SnowflakeOutboundShareInput,
-) (*createSnowflakeOutboundShareResponse, error) {
+	input MonitorV2ActionInput,
+) (*createMonitorV2ActionResponse, error) {
 	req := &graphql.Request{
-		OpName: "createSnowflakeOutboundShare",
-		Query:  createSnowflakeOutboundShare_Operation,
-		Variables: &__createSnowflakeOutboundShareInput{
+		OpName: "createMonitorV2Action",
+		Query:  createMonitorV2Action_Operation,
+		Variables: &__createMonitorV2ActionInput{
 			WorkspaceId: workspaceId,
 			Input:       input,
 		},
 	}
 	var err error
 
-	var data createSnowflakeOutboundShareResponse
+	var data createMonitorV2ActionResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -13760,34 +17592,56 @@ func createSnowflakeOutboundShare(
 	return &data, err
 }
 
-// The query or mutation executed by createWorkspace.
-const createWorkspace_Operation = `
-mutation createWorkspace ($config: WorkspaceInput!) {
-	workspace: createWorkspace(definition: $config) {
-		... Workspace
+// The query or mutation executed by createMonitorV2Destination.
+const createMonitorV2Destination_Operation = `
+mutation createMonitorV2Destination ($workspaceId: ObjectId!, $input: MonitorV2DestinationInput!) {
+	monitorV2Destination: createMonitorV2Destination(workspaceId: $workspaceId, input: $input) {
+		... MonitorV2Destination
 	}
 }
-fragment Workspace on Project {
-	id
-	label
-}
-`
+fragment MonitorV2Destination on MonitorV2Destination {
+	inline
+	type
+	email {
+		... MonitorV2EmailDestination
+	}
+	webhook {
+		... MonitorV2WebhookDestination
+	}
+	id
+	workspaceId
+	name
+	iconUrl
+	description
+	createdBy
+}
+fragment MonitorV2EmailDestination on MonitorV2EmailDestination {
+	users
+	addresses
+}
+fragment MonitorV2WebhookDestination on MonitorV2WebhookDestination {
+	url
+	method
+}
+`
 
-func createWorkspace(
+func createMonitorV2Destination(
 	ctx context.Context,
 	client graphql.Client,
-	config WorkspaceInput,
-) (*createWorkspaceResponse, error) {
+	workspaceId string,
+	input MonitorV2DestinationInput,
+) (*createMonitorV2DestinationResponse, error) {
 	req := &graphql.Request{
-		OpName: "createWorkspace",
-		Query:  createWorkspace_Operation,
-		Variables: &__createWorkspaceInput{
-			Config: config,
+		OpName: "createMonitorV2Destination",
+		Query:  createMonitorV2Destination_Operation,
+		Variables: &__createMonitorV2DestinationInput{
+			WorkspaceId: workspaceId,
+			Input:       input,
 		},
 	}
 	var err error
 
-	var data createWorkspaceResponse
+	var data createMonitorV2DestinationResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -13799,35 +17653,41 @@ func createWorkspace(
 	return &data, err
 }
 
-// The query or mutation executed by deleteApp.
-const deleteApp_Operation = `
-mutation deleteApp ($id: ObjectId!) {
-	resultStatus: deleteApp(id: $id) {
-		... ResultStatus
+// The query or mutation executed by createOrUpdateBookmark.
+const createOrUpdateBookmark_Operation = `
+mutation createOrUpdateBookmark ($id: ObjectId, $bookmark: BookmarkInput!) {
+	bookmark: createOrUpdateBookmark(id: $id, bookmark: $bookmark) {
+		... Bookmark
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment Bookmark on Bookmark {
+	id
+	name
+	iconUrl
+	targetId
+	targetIdKind
+	groupId
+	bookmarkKind
 }
 `
 
-func deleteApp(
+func createOrUpdateBookmark(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*deleteAppResponse, error) {
+	id *string,
+	bookmark BookmarkInput,
+) (*createOrUpdateBookmarkResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteApp",
-		Query:  deleteApp_Operation,
-		Variables: &__deleteAppInput{
-			Id: id,
+		OpName: "createOrUpdateBookmark",
+		Query:  createOrUpdateBookmark_Operation,
+		Variables: &__createOrUpdateBookmarkInput{
+			Id:       id,
+			Bookmark: bookmark,
 		},
 	}
 	var err error
 
-	var data deleteAppResponse
+	var data createOrUpdateBookmarkResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -13839,35 +17699,39 @@ func deleteApp(
 	return &data, err
 }
 
-// The query or mutation executed by deleteAppDataSource.
-const deleteAppDataSource_Operation = `
-mutation deleteAppDataSource ($id: ObjectId!) {
-	resultStatus: deleteAppDataSource(id: $id) {
-		... ResultStatus
+// The query or mutation executed by createOrUpdateBookmarkGroup.
+const createOrUpdateBookmarkGroup_Operation = `
+mutation createOrUpdateBookmarkGroup ($id: ObjectId, $group: BookmarkGroupInput!) {
+	bookmarkGroup: createOrUpdateBookmarkGroup(id: $id, group: $group) {
+		... BookmarkGroup
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment BookmarkGroup on BookmarkGroup {
+	id
+	name
+	description
+	iconUrl
+	workspaceId
 }
 `
 
-func deleteAppDataSource(
+func createOrUpdateBookmarkGroup(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*deleteAppDataSourceResponse, error) {
+	id *string,
+	group BookmarkGroupInput,
+) (*createOrUpdateBookmarkGroupResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteAppDataSource",
-		Query:  deleteAppDataSource_Operation,
-		Variables: &__deleteAppDataSourceInput{
-			Id: id,
+		OpName: "createOrUpdateBookmarkGroup",
+		Query:  createOrUpdateBookmarkGroup_Operation,
+		Variables: &__createOrUpdateBookmarkGroupInput{
+			Id:    id,
+			Group: group,
 		},
 	}
 	var err error
 
-	var data deleteAppDataSourceResponse
+	var data createOrUpdateBookmarkGroupResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -13879,35 +17743,137 @@ func deleteAppDataSource(
 	return &data, err
 }
 
-// The query or mutation executed by deleteBoard.
-const deleteBoard_Operation = `
-mutation deleteBoard ($id: ObjectId!) {
-	resultStatus: deleteBoard(id: $id) {
-		... ResultStatus
+// The query or mutation executed by createPoller.
+const createPoller_Operation = `
+mutation createPoller ($workspaceId: ObjectId!, $poller: PollerInput!) {
+	poller: createPoller(workspaceId: $workspaceId, poller: $poller) {
+		... Poller
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment Poller on Poller {
+	id
+	workspaceId
+	customerId
+	datastreamId
+	disabled
+	kind
+	config {
+		__typename
+		name
+		retries
+		interval
+		tags
+		chunk {
+			enabled
+			size
+		}
+		... on PollerPubSubConfig {
+			projectId
+			jsonKey
+			subscriptionId
+		}
+		... on PollerHTTPConfig {
+			method
+			body
+			endpoint
+			contentType
+			headers
+			template {
+				... HttpRequestConfig
+			}
+			requests {
+				... HttpRequestConfig
+			}
+			rules {
+				match {
+					... HttpRequestConfig
+				}
+				follow
+				decoder {
+					type
+				}
+			}
+			timestamps {
+				name
+				source
+				format
+				offset
+				truncate
+			}
+		}
+		... on PollerGCPMonitoringConfig {
+			projectId
+			jsonKey
+			includeMetricTypePrefixes
+			excludeMetricTypePrefixes
+			rateLimit
+			totalLimit
+		}
+		... on PollerMongoDBAtlasConfig {
+			publicKey
+			privateKey
+			includeGroups
+			excludeGroups
+		}
+		... on PollerCloudWatchMetricsConfig {
+			period
+			delay
+			region
+			assumeRoleArn
+			queries {
+				namespace
+				metricNames
+				dimensions {
+					name
+					value
+				}
+				resourceFilter {
+					resourceType
+					pattern
+					dimensionName
+					tagFilters {
+						key
+						values
+					}
+				}
+			}
+		}
+		... on PollerAWSSnapshotConfig {
+			region
+			assumeRoleArn
+			includeActions
+		}
+	}
+}
+fragment HttpRequestConfig on PollerHTTPRequestConfig {
+	url
+	method
+	username
+	password
+	authScheme
+	body
+	headers
+	params
 }
 `
 
-func deleteBoard(
+func createPoller(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*deleteBoardResponse, error) {
+	workspaceId string,
+	poller PollerInput,
+) (*createPollerResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteBoard",
-		Query:  deleteBoard_Operation,
-		Variables: &__deleteBoardInput{
-			Id: id,
+		OpName: "createPoller",
+		Query:  createPoller_Operation,
+		Variables: &__createPollerInput{
+			WorkspaceId: workspaceId,
+			Poller:      poller,
 		},
 	}
 	var err error
 
-	var data deleteBoardResponse
+	var data createPollerResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -13919,35 +17885,54 @@ func deleteBoard(
 	return &data, err
 }
 
-// The query or mutation executed by deleteBookmark.
-const deleteBookmark_Operation = `
-mutation deleteBookmark ($id: ObjectId!) {
-	resultStatus: deleteBookmark(id: $id) {
-		... ResultStatus
+// The query or mutation executed by createPreferredPath.
+const createPreferredPath_Operation = `
+mutation createPreferredPath ($workspaceId: ObjectId!, $config: PreferredPathInput!) {
+	preferredPathWithStatus: createPreferredPath(workspaceId: $workspaceId, path: $config) {
+		... PreferredPathWithStatus
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment PreferredPathWithStatus on PreferredPathWithStatus {
+	path {
+		... PreferredPath
+	}
+	error
+}
+fragment PreferredPath on PreferredPath {
+	id
+	name
+	iconUrl
+	description
+	workspaceId
+	folderId
+	sourceDataset
+	path {
+		linkId
+		reverse
+		linkName
+		reverseFromDataset
+	}
 }
 `
 
-func deleteBookmark(
+// More workarounds for server-side struggles
+func createPreferredPath(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*deleteBookmarkResponse, error) {
+	workspaceId string,
+	config PreferredPathInput,
+) (*createPreferredPathResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteBookmark",
-		Query:  deleteBookmark_Operation,
-		Variables: &__deleteBookmarkInput{
-			Id: id,
+		OpName: "createPreferredPath",
+		Query:  createPreferredPath_Operation,
+		Variables: &__createPreferredPathInput{
+			WorkspaceId: workspaceId,
+			Config:      config,
 		},
 	}
 	var err error
 
-	var data deleteBookmarkResponse
+	var data createPreferredPathResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -13959,35 +17944,35 @@ func deleteBookmark(
 	return &data, err
 }
 
-// The query or mutation executed by deleteBookmarkGroup.
-const deleteBookmarkGroup_Operation = `
-mutation deleteBookmarkGroup ($id: ObjectId!) {
-	resultStatus: deleteBookmarkGroup(id: $id) {
-		... ResultStatus
+// The query or mutation executed by createRbacGroup.
+const createRbacGroup_Operation = `
+mutation createRbacGroup ($config: RbacGroupInput!) {
+	rbacGroup: createRbacGroup(input: $config) {
+		... RbacGroup
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment RbacGroup on RbacGroup {
+	id
+	name
+	description
 }
 `
 
-func deleteBookmarkGroup(
+func createRbacGroup(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*deleteBookmarkGroupResponse, error) {
+	config RbacGroupInput,
+) (*createRbacGroupResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteBookmarkGroup",
-		Query:  deleteBookmarkGroup_Operation,
-		Variables: &__deleteBookmarkGroupInput{
-			Id: id,
+		OpName: "createRbacGroup",
+		Query:  createRbacGroup_Operation,
+		Variables: &__createRbacGroupInput{
+			Config: config,
 		},
 	}
 	var err error
 
-	var data deleteBookmarkGroupResponse
+	var data createRbacGroupResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -13999,35 +17984,37 @@ func deleteBookmarkGroup(
 	return &data, err
 }
 
-// The query or mutation executed by deleteChannel.
-const deleteChannel_Operation = `
-mutation deleteChannel ($id: ObjectId!) {
-	resultStatus: deleteChannel(id: $id) {
-		... ResultStatus
+// The query or mutation executed by createRbacGroupmember.
+const createRbacGroupmember_Operation = `
+mutation createRbacGroupmember ($config: RbacGroupmemberInput!) {
+	rbacGroupmember: createRbacGroupmember(input: $config) {
+		... RbacGroupmember
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment RbacGroupmember on RbacGroupmember {
+	id
+	description
+	groupId
+	memberUserId
+	memberGroupId
 }
 `
 
-func deleteChannel(
+func createRbacGroupmember(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*deleteChannelResponse, error) {
+	config RbacGroupmemberInput,
+) (*createRbacGroupmemberResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteChannel",
-		Query:  deleteChannel_Operation,
-		Variables: &__deleteChannelInput{
-			Id: id,
+		OpName: "createRbacGroupmember",
+		Query:  createRbacGroupmember_Operation,
+		Variables: &__createRbacGroupmemberInput{
+			Config: config,
 		},
 	}
 	var err error
 
-	var data deleteChannelResponse
+	var data createRbacGroupmemberResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14039,35 +18026,49 @@ func deleteChannel(
 	return &data, err
 }
 
-// The query or mutation executed by deleteChannelAction.
-const deleteChannelAction_Operation = `
-mutation deleteChannelAction ($id: ObjectId!) {
-	resultStatus: deleteChannelAction(id: $id) {
-		... ResultStatus
+// The query or mutation executed by createRbacStatement.
+const createRbacStatement_Operation = `
+mutation createRbacStatement ($config: RbacStatementInput!) {
+	rbacStatement: createRbacStatement(input: $config) {
+		... RbacStatement
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment RbacStatement on RbacStatement {
+	id
+	description
+	subject {
+		userId
+		groupId
+		all
+	}
+	object {
+		objectId
+		folderId
+		workspaceId
+		type
+		name
+		owner
+		all
+	}
+	role
 }
 `
 
-func deleteChannelAction(
+func createRbacStatement(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*deleteChannelActionResponse, error) {
+	config RbacStatementInput,
+) (*createRbacStatementResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteChannelAction",
-		Query:  deleteChannelAction_Operation,
-		Variables: &__deleteChannelActionInput{
-			Id: id,
+		OpName: "createRbacStatement",
+		Query:  createRbacStatement_Operation,
+		Variables: &__createRbacStatementInput{
+			Config: config,
 		},
 	}
 	var err error
 
-	var data deleteChannelActionResponse
+	var data createRbacStatementResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14079,35 +18080,46 @@ func deleteChannelAction(
 	return &data, err
 }
 
-// The query or mutation executed by deleteDashboard.
-const deleteDashboard_Operation = `
-mutation deleteDashboard ($id: ObjectId!) {
-	resultStatus: deleteDashboard(id: $id) {
-		... ResultStatus
+// The query or mutation executed by createSnowflakeOutboundShare.
+const createSnowflakeOutboundShare_Operation = `
+mutation createSnowflakeOutboundShare ($workspaceId: ObjectId!, $input: SnowflakeOutboundShareInput!) {
+	share: createSnowflakeOutboundShare(workspaceId: $workspaceId, input: $input) {
+		... SnowflakeOutboundShare
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment SnowflakeOutboundShare on SnowflakeOutboundShare {
+	id
+	name
+	description
+	workspaceId
+	shareName
+	accounts {
+		... SnowflakeAccount
+	}
+}
+fragment SnowflakeAccount on SnowflakeAccount {
+	organization
+	account
 }
 `
 
-func deleteDashboard(
+func createSnowflakeOutboundShare(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*deleteDashboardResponse, error) {
+	workspaceId string,
+	input SnowflakeOutboundShareInput,
+) (*createSnowflakeOutboundShareResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteDashboard",
-		Query:  deleteDashboard_Operation,
-		Variables: &__deleteDashboardInput{
-			Id: id,
+		OpName: "createSnowflakeOutboundShare",
+		Query:  createSnowflakeOutboundShare_Operation,
+		Variables: &__createSnowflakeOutboundShareInput{
+			WorkspaceId: workspaceId,
+			Input:       input,
 		},
 	}
 	var err error
 
-	var data deleteDashboardResponse
+	var data createSnowflakeOutboundShareResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14119,35 +18131,34 @@ func deleteDashboard(
 	return &data, err
 }
 
-// The query or mutation executed by deleteDashboardLink.
-const deleteDashboardLink_Operation = `
-mutation deleteDashboardLink ($id: ObjectId!) {
-	resultStatus: deleteDashboardLink(id: $id) {
-		... ResultStatus
+// The query or mutation executed by createWorkspace.
+const createWorkspace_Operation = `
+mutation createWorkspace ($config: WorkspaceInput!) {
+	workspace: createWorkspace(definition: $config) {
+		... Workspace
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment Workspace on Project {
+	id
+	label
 }
 `
 
-func deleteDashboardLink(
+func createWorkspace(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*deleteDashboardLinkResponse, error) {
+	config WorkspaceInput,
+) (*createWorkspaceResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteDashboardLink",
-		Query:  deleteDashboardLink_Operation,
-		Variables: &__deleteDashboardLinkInput{
-			Id: id,
+		OpName: "createWorkspace",
+		Query:  createWorkspace_Operation,
+		Variables: &__createWorkspaceInput{
+			Config: config,
 		},
 	}
 	var err error
 
-	var data deleteDashboardLinkResponse
+	var data createWorkspaceResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14159,12 +18170,12 @@ func deleteDashboardLink(
 	return &data, err
 }
 
-// The query or mutation executed by deleteDataset.
-const deleteDataset_Operation = `
-mutation deleteDataset ($id: ObjectId!, $dep: DependencyHandlingInput) {
-	resultStatus: deleteDataset(dsid: $id, dependencyHandling: $dep) {
-		... ResultStatus
-	}
+// The query or mutation executed by deleteApp.
+const deleteApp_Operation = `
+mutation deleteApp ($id: ObjectId!) {
+	resultStatus: deleteApp(id: $id) {
+		... ResultStatus
+	}
 }
 fragment ResultStatus on ResultStatus {
 	success
@@ -14173,23 +18184,21 @@ fragment ResultStatus on ResultStatus {
 }
 `
 
-func deleteDataset(
+func deleteApp(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-	dep *DependencyHandlingInput,
-) (*deleteDatasetResponse, error) {
+) (*deleteAppResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteDataset",
-		Query:  deleteDataset_Operation,
-		Variables: &__deleteDatasetInput{
-			Id:  id,
-			Dep: dep,
+		OpName: "deleteApp",
+		Query:  deleteApp_Operation,
+		Variables: &__deleteAppInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteDatasetResponse
+	var data deleteAppResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14201,10 +18210,10 @@ func deleteDataset(
 	return &data, err
 }
 
-// The query or mutation executed by deleteDatasetOutboundShare.
-const deleteDatasetOutboundShare_Operation = `
-mutation deleteDatasetOutboundShare ($id: ObjectId!) {
-	resultStatus: deleteDatasetOutboundShare(id: $id) {
+// The query or mutation executed by deleteAppDataSource.
+const deleteAppDataSource_Operation = `
+mutation deleteAppDataSource ($id: ObjectId!) {
+	resultStatus: deleteAppDataSource(id: $id) {
 		... ResultStatus
 	}
 }
@@ -14215,21 +18224,21 @@ fragment ResultStatus on ResultStatus {
 }
 `
 
-func deleteDatasetOutboundShare(
+func deleteAppDataSource(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteDatasetOutboundShareResponse, error) {
+) (*deleteAppDataSourceResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteDatasetOutboundShare",
-		Query:  deleteDatasetOutboundShare_Operation,
-		Variables: &__deleteDatasetOutboundShareInput{
+		OpName: "deleteAppDataSource",
+		Query:  deleteAppDataSource_Operation,
+		Variables: &__deleteAppDataSourceInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteDatasetOutboundShareResponse
+	var data deleteAppDataSourceResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14241,10 +18250,10 @@ func deleteDatasetOutboundShare(
 	return &data, err
 }
 
-// The query or mutation executed by deleteDatastream.
-const deleteDatastream_Operation = `
-mutation deleteDatastream ($id: ObjectId!) {
-	resultStatus: deleteDatastream(id: $id) {
+// The query or mutation executed by deleteBlob.
+const deleteBlob_Operation = `
+mutation deleteBlob ($userId: UserId, $name: String!) {
+	resultStatus: deleteBlob(userId: $userId, name: $name) {
 		... ResultStatus
 	}
 }
@@ -14255,21 +18264,23 @@ fragment ResultStatus on ResultStatus {
 }
 `
 
-func deleteDatastream(
+func deleteBlob(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*deleteDatastreamResponse, error) {
+	userId *types.UserIdScalar,
+	name string,
+) (*deleteBlobResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteDatastream",
-		Query:  deleteDatastream_Operation,
-		Variables: &__deleteDatastreamInput{
-			Id: id,
+		OpName: "deleteBlob",
+		Query:  deleteBlob_Operation,
+		Variables: &__deleteBlobInput{
+			UserId: userId,
+			Name:   name,
 		},
 	}
 	var err error
 
-	var data deleteDatastreamResponse
+	var data deleteBlobResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14281,10 +18292,10 @@ func deleteDatastream(
 	return &data, err
 }
 
-// The query or mutation executed by deleteDatastreamToken.
-const deleteDatastreamToken_Operation = `
-mutation deleteDatastreamToken ($id: String!) {
-	resultStatus: deleteDatastreamToken(id: $id) {
+// The query or mutation executed by deleteBoard.
+const deleteBoard_Operation = `
+mutation deleteBoard ($id: ObjectId!) {
+	resultStatus: deleteBoard(id: $id) {
 		... ResultStatus
 	}
 }
@@ -14295,21 +18306,21 @@ fragment ResultStatus on ResultStatus {
 }
 `
 
-func deleteDatastreamToken(
+func deleteBoard(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteDatastreamTokenResponse, error) {
+) (*deleteBoardResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteDatastreamToken",
-		Query:  deleteDatastreamToken_Operation,
-		Variables: &__deleteDatastreamTokenInput{
+		OpName: "deleteBoard",
+		Query:  deleteBoard_Operation,
+		Variables: &__deleteBoardInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteDatastreamTokenResponse
+	var data deleteBoardResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14321,10 +18332,10 @@ func deleteDatastreamToken(
 	return &data, err
 }
 
-// The query or mutation executed by deleteDeferredForeignKey.
-const deleteDeferredForeignKey_Operation = `
-mutation deleteDeferredForeignKey ($id: ObjectId!) {
-	resultStatus: deleteDeferredForeignKey(id: $id) {
+// The query or mutation executed by deleteBookmark.
+const deleteBookmark_Operation = `
+mutation deleteBookmark ($id: ObjectId!) {
+	resultStatus: deleteBookmark(id: $id) {
 		... ResultStatus
 	}
 }
@@ -14335,21 +18346,21 @@ fragment ResultStatus on ResultStatus {
 }
 `
 
-func deleteDeferredForeignKey(
+func deleteBookmark(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteDeferredForeignKeyResponse, error) {
+) (*deleteBookmarkResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteDeferredForeignKey",
-		Query:  deleteDeferredForeignKey_Operation,
-		Variables: &__deleteDeferredForeignKeyInput{
+		OpName: "deleteBookmark",
+		Query:  deleteBookmark_Operation,
+		Variables: &__deleteBookmarkInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteDeferredForeignKeyResponse
+	var data deleteBookmarkResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14361,10 +18372,10 @@ func deleteDeferredForeignKey(
 	return &data, err
 }
 
-// The query or mutation executed by deleteFiledrop.
-const deleteFiledrop_Operation = `
-mutation deleteFiledrop ($id: ObjectId!) {
-	resultStatus: deleteFiledrop(id: $id) {
+// The query or mutation executed by deleteBookmarkGroup.
+const deleteBookmarkGroup_Operation = `
+mutation deleteBookmarkGroup ($id: ObjectId!) {
+	resultStatus: deleteBookmarkGroup(id: $id) {
 		... ResultStatus
 	}
 }
@@ -14375,21 +18386,21 @@ fragment ResultStatus on ResultStatus {
 }
 `
 
-func deleteFiledrop(
+func deleteBookmarkGroup(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteFiledropResponse, error) {
+) (*deleteBookmarkGroupResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteFiledrop",
-		Query:  deleteFiledrop_Operation,
-		Variables: &__deleteFiledropInput{
+		OpName: "deleteBookmarkGroup",
+		Query:  deleteBookmarkGroup_Operation,
+		Variables: &__deleteBookmarkGroupInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteFiledropResponse
+	var data deleteBookmarkGroupResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14401,10 +18412,10 @@ func deleteFiledrop(
 	return &data, err
 }
 
-// The query or mutation executed by deleteFolder.
-const deleteFolder_Operation = `
-mutation deleteFolder ($id: ObjectId!) {
-	resultStatus: deleteFolder(id: $id) {
+// The query or mutation executed by deleteChannel.
+const deleteChannel_Operation = `
+mutation deleteChannel ($id: ObjectId!) {
+	resultStatus: deleteChannel(id: $id) {
 		... ResultStatus
 	}
 }
@@ -14415,21 +18426,21 @@ fragment ResultStatus on ResultStatus {
 }
 `
 
-func deleteFolder(
+func deleteChannel(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteFolderResponse, error) {
+) (*deleteChannelResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteFolder",
-		Query:  deleteFolder_Operation,
-		Variables: &__deleteFolderInput{
+		OpName: "deleteChannel",
+		Query:  deleteChannel_Operation,
+		Variables: &__deleteChannelInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteFolderResponse
+	var data deleteChannelResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14441,13 +18452,11 @@ func deleteFolder(
 	return &data, err
 }
 
-// The query or mutation executed by deleteLayeredSettingRecord.
-const deleteLayeredSettingRecord_Operation = `
-mutation deleteLayeredSettingRecord ($id: ObjectId!) {
-	deleteLayeredSettingRecord(id: $id) {
-		resultStatus: result {
-			... ResultStatus
-		}
+// The query or mutation executed by deleteChannelAction.
+const deleteChannelAction_Operation = `
+mutation deleteChannelAction ($id: ObjectId!) {
+	resultStatus: deleteChannelAction(id: $id) {
+		... ResultStatus
 	}
 }
 fragment ResultStatus on ResultStatus {
@@ -14457,21 +18466,21 @@ fragment ResultStatus on ResultStatus {
 }
 `
 
-func deleteLayeredSettingRecord(
+func deleteChannelAction(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteLayeredSettingRecordResponse, error) {
+) (*deleteChannelActionResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteLayeredSettingRecord",
-		Query:  deleteLayeredSettingRecord_Operation,
-		Variables: &__deleteLayeredSettingRecordInput{
+		OpName: "deleteChannelAction",
+		Query:  deleteChannelAction_Operation,
+		Variables: &__deleteChannelActionInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteLayeredSettingRecordResponse
+	var data deleteChannelActionResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14483,10 +18492,10 @@ func deleteLayeredSettingRecord(
 	return &data, err
 }
 
-// The query or mutation executed by deleteMonitor.
-const deleteMonitor_Operation = `
-mutation deleteMonitor ($id: ObjectId!) {
-	resultStatus: deleteMonitor(id: $id) {
+// The query or mutation executed by deleteDashboard.
+const deleteDashboard_Operation = `
+mutation deleteDashboard ($id: ObjectId!) {
+	resultStatus: deleteDashboard(id: $id) {
 		... ResultStatus
 	}
 }
@@ -14497,21 +18506,21 @@ fragment ResultStatus on ResultStatus {
 }
 `
 
-func deleteMonitor(
+func deleteDashboard(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteMonitorResponse, error) {
+) (*deleteDashboardResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteMonitor",
-		Query:  deleteMonitor_Operation,
-		Variables: &__deleteMonitorInput{
+		OpName: "deleteDashboard",
+		Query:  deleteDashboard_Operation,
+		Variables: &__deleteDashboardInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteMonitorResponse
+	var data deleteDashboardResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14523,10 +18532,10 @@ func deleteMonitor(
 	return &data, err
 }
 
-// The query or mutation executed by deleteMonitorAction.
-const deleteMonitorAction_Operation = `
-mutation deleteMonitorAction ($id: ObjectId!) {
-	resultStatus: deleteMonitorAction(id: $id) {
+// The query or mutation executed by deleteDashboardLink.
+const deleteDashboardLink_Operation = `
+mutation deleteDashboardLink ($id: ObjectId!) {
+	resultStatus: deleteDashboardLink(id: $id) {
 		... ResultStatus
 	}
 }
@@ -14537,21 +18546,21 @@ fragment ResultStatus on ResultStatus {
 }
 `
 
-func deleteMonitorAction(
+func deleteDashboardLink(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteMonitorActionResponse, error) {
+) (*deleteDashboardLinkResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteMonitorAction",
-		Query:  deleteMonitorAction_Operation,
-		Variables: &__deleteMonitorActionInput{
+		OpName: "deleteDashboardLink",
+		Query:  deleteDashboardLink_Operation,
+		Variables: &__deleteDashboardLinkInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteMonitorActionResponse
+	var data deleteDashboardLinkResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14563,10 +18572,10 @@ func deleteMonitorAction(
 	return &data, err
 }
 
-// The query or mutation executed by deleteMonitorActionAttachment.
-const deleteMonitorActionAttachment_Operation = `
-mutation deleteMonitorActionAttachment ($id: ObjectId!) {
-	resultStatus: deleteMonitorActionAttachment(id: $id) {
+// The query or mutation executed by deleteDataset.
+const deleteDataset_Operation = `
+mutation deleteDataset ($id: ObjectId!, $dep: DependencyHandlingInput) {
+	resultStatus: deleteDataset(dsid: $id, dependencyHandling: $dep) {
 		... ResultStatus
 	}
 }
@@ -14577,21 +18586,23 @@ fragment ResultStatus on ResultStatus {
 }
 `
 
-func deleteMonitorActionAttachment(
+func deleteDataset(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteMonitorActionAttachmentResponse, error) {
+	dep *DependencyHandlingInput,
+) (*deleteDatasetResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteMonitorActionAttachment",
-		Query:  deleteMonitorActionAttachment_Operation,
-		Variables: &__deleteMonitorActionAttachmentInput{
-			Id: id,
+		OpName: "deleteDataset",
+		Query:  deleteDataset_Operation,
+		Variables: &__deleteDatasetInput{
+			Id:  id,
+			Dep: dep,
 		},
 	}
 	var err error
 
-	var data deleteMonitorActionAttachmentResponse
+	var data deleteDatasetResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14603,10 +18614,10 @@ func deleteMonitorActionAttachment(
 	return &data, err
 }
 
-// The query or mutation executed by deleteMonitorV2.
-const deleteMonitorV2_Operation = `
-mutation deleteMonitorV2 ($id: ObjectId!) {
-	resultStatus: deleteMonitorV2(id: $id) {
+// The query or mutation executed by deleteDatasetOutboundShare.
+const deleteDatasetOutboundShare_Operation = `
+mutation deleteDatasetOutboundShare ($id: ObjectId!) {
+	resultStatus: deleteDatasetOutboundShare(id: $id) {
 		... ResultStatus
 	}
 }
@@ -14617,21 +18628,21 @@ fragment ResultStatus on ResultStatus {
 }
 `
 
-func deleteMonitorV2(
+func deleteDatasetOutboundShare(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteMonitorV2Response, error) {
+) (*deleteDatasetOutboundShareResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteMonitorV2",
-		Query:  deleteMonitorV2_Operation,
-		Variables: &__deleteMonitorV2Input{
+		OpName: "deleteDatasetOutboundShare",
+		Query:  deleteDatasetOutboundShare_Operation,
+		Variables: &__deleteDatasetOutboundShareInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteMonitorV2Response
+	var data deleteDatasetOutboundShareResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14643,10 +18654,10 @@ func deleteMonitorV2(
 	return &data, err
 }
 
-// The query or mutation executed by deleteMonitorV2Action.
-const deleteMonitorV2Action_Operation = `
-mutation deleteMonitorV2Action ($id: ObjectId!) {
-	resultStatus: deleteMonitorV2Action(id: $id) {
+// The query or mutation executed by deleteDatastream.
+const deleteDatastream_Operation = `
+mutation deleteDatastream ($id: ObjectId!) {
+	resultStatus: deleteDatastream(id: $id) {
 		... ResultStatus
 	}
 }
@@ -14657,21 +18668,21 @@ fragment ResultStatus on ResultStatus {
 }
 `
 
-func deleteMonitorV2Action(
+func deleteDatastream(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteMonitorV2ActionResponse, error) {
+) (*deleteDatastreamResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteMonitorV2Action",
-		Query:  deleteMonitorV2Action_Operation,
-		Variables: &__deleteMonitorV2ActionInput{
+		OpName: "deleteDatastream",
+		Query:  deleteDatastream_Operation,
+		Variables: &__deleteDatastreamInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteMonitorV2ActionResponse
+	var data deleteDatastreamResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14683,10 +18694,10 @@ func deleteMonitorV2Action(
 	return &data, err
 }
 
-// The query or mutation executed by deleteMonitorV2Destination.
-const deleteMonitorV2Destination_Operation = `
-mutation deleteMonitorV2Destination ($id: ObjectId!) {
-	resultStatus: deleteMonitorV2Destination(id: $id) {
+// The query or mutation executed by deleteDatastreamToken.
+const deleteDatastreamToken_Operation = `
+mutation deleteDatastreamToken ($id: String!) {
+	resultStatus: deleteDatastreamToken(id: $id) {
 		... ResultStatus
 	}
 }
@@ -14697,21 +18708,21 @@ fragment ResultStatus on ResultStatus {
 }
 `
 
-func deleteMonitorV2Destination(
+func deleteDatastreamToken(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteMonitorV2DestinationResponse, error) {
+) (*deleteDatastreamTokenResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteMonitorV2Destination",
-		Query:  deleteMonitorV2Destination_Operation,
-		Variables: &__deleteMonitorV2DestinationInput{
+		OpName: "deleteDatastreamToken",
+		Query:  deleteDatastreamToken_Operation,
+		Variables: &__deleteDatastreamTokenInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteMonitorV2DestinationResponse
+	var data deleteDatastreamTokenResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14723,10 +18734,10 @@ func deleteMonitorV2Destination(
 	return &data, err
 }
 
-// The query or mutation executed by deletePoller.
-const deletePoller_Operation = `
-mutation deletePoller ($id: ObjectId!) {
-	resultStatus: deletePoller(id: $id) {
+// The query or mutation executed by deleteDeferredForeignKey.
+const deleteDeferredForeignKey_Operation = `
+mutation deleteDeferredForeignKey ($id: ObjectId!) {
+	resultStatus: deleteDeferredForeignKey(id: $id) {
 		... ResultStatus
 	}
 }
@@ -14737,21 +18748,21 @@ fragment ResultStatus on ResultStatus {
 }
 `
 
-func deletePoller(
+func deleteDeferredForeignKey(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deletePollerResponse, error) {
+) (*deleteDeferredForeignKeyResponse, error) {
 	req := &graphql.Request{
-		OpName: "deletePoller",
-		Query:  deletePoller_Operation,
-		Variables: &__deletePollerInput{
+		OpName: "deleteDeferredForeignKey",
+		Query:  deleteDeferredForeignKey_Operation,
+		Variables: &__deleteDeferredForeignKeyInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deletePollerResponse
+	var data deleteDeferredForeignKeyResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14763,10 +18774,10 @@ func deletePoller(
 	return &data, err
 }
 
-// The query or mutation executed by deletePreferredPath.
-const deletePreferredPath_Operation = `
-mutation deletePreferredPath ($id: ObjectId!) {
-	resultStatus: deletePreferredPath(id: $id) {
+// The query or mutation executed by deleteFiledrop.
+const deleteFiledrop_Operation = `
+mutation deleteFiledrop ($id: ObjectId!) {
+	resultStatus: deleteFiledrop(id: $id) {
 		... ResultStatus
 	}
 }
@@ -14777,21 +18788,21 @@ fragment ResultStatus on ResultStatus {
 }
 `
 
-func deletePreferredPath(
+func deleteFiledrop(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deletePreferredPathResponse, error) {
+) (*deleteFiledropResponse, error) {
 	req := &graphql.Request{
-		OpName: "deletePreferredPath",
-		Query:  deletePreferredPath_Operation,
-		Variables: &__deletePreferredPathInput{
+		OpName: "deleteFiledrop",
+		Query:  deleteFiledrop_Operation,
+		Variables: &__deleteFiledropInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deletePreferredPathResponse
+	var data deleteFiledropResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14803,10 +18814,10 @@ func deletePreferredPath(
 	return &data, err
 }
 
-// The query or mutation executed by deleteRbacGroup.
-const deleteRbacGroup_Operation = `
-mutation deleteRbacGroup ($id: ORN!) {
-	resultStatus: deleteRbacGroup(id: $id) {
+// The query or mutation executed by deleteFolder.
+const deleteFolder_Operation = `
+mutation deleteFolder ($id: ObjectId!) {
+	resultStatus: deleteFolder(id: $id) {
 		... ResultStatus
 	}
 }
@@ -14817,21 +18828,21 @@ fragment ResultStatus on ResultStatus {
 }
 `
 
-func deleteRbacGroup(
+func deleteFolder(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteRbacGroupResponse, error) {
+) (*deleteFolderResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteRbacGroup",
-		Query:  deleteRbacGroup_Operation,
-		Variables: &__deleteRbacGroupInput{
+		OpName: "deleteFolder",
+		Query:  deleteFolder_Operation,
+		Variables: &__deleteFolderInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteRbacGroupResponse
+	var data deleteFolderResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14843,10 +18854,10 @@ func deleteRbacGroup(
 	return &data, err
 }
 
-// The query or mutation executed by deleteRbacGroupmember.
-const deleteRbacGroupmember_Operation = `
-mutation deleteRbacGroupmember ($id: ORN!) {
-	resultStatus: deleteRbacGroupmember(id: $id) {
+// The query or mutation executed by deleteIncident.
+const deleteIncident_Operation = `
+mutation deleteIncident ($id: ObjectId!) {
+	resultStatus: deleteIncident(id: $id) {
 		... ResultStatus
 	}
 }
@@ -14857,21 +18868,21 @@ fragment ResultStatus on ResultStatus {
 }
 `
 
-func deleteRbacGroupmember(
+func deleteIncident(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteRbacGroupmemberResponse, error) {
+) (*deleteIncidentResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteRbacGroupmember",
-		Query:  deleteRbacGroupmember_Operation,
-		Variables: &__deleteRbacGroupmemberInput{
+		OpName: "deleteIncident",
+		Query:  deleteIncident_Operation,
+		Variables: &__deleteIncidentInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteRbacGroupmemberResponse
+	var data deleteIncidentResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14883,11 +18894,13 @@ func deleteRbacGroupmember(
 	return &data, err
 }
 
-// The query or mutation executed by deleteRbacStatement.
-const deleteRbacStatement_Operation = `
-mutation deleteRbacStatement ($id: ORN!) {
-	resultStatus: deleteRbacStatement(id: $id) {
-		... ResultStatus
+// The query or mutation executed by deleteLayeredSettingRecord.
+const deleteLayeredSettingRecord_Operation = `
+mutation deleteLayeredSettingRecord ($id: ObjectId!) {
+	deleteLayeredSettingRecord(id: $id) {
+		resultStatus: result {
+			... ResultStatus
+		}
 	}
 }
 fragment ResultStatus on ResultStatus {
@@ -14897,21 +18910,21 @@ fragment ResultStatus on ResultStatus {
 }
 `
 
-func deleteRbacStatement(
+func deleteLayeredSettingRecord(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteRbacStatementResponse, error) {
+) (*deleteLayeredSettingRecordResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteRbacStatement",
-		Query:  deleteRbacStatement_Operation,
-		Variables: &__deleteRbacStatementInput{
+		OpName: "deleteLayeredSettingRecord",
+		Query:  deleteLayeredSettingRecord_Operation,
+		Variables: &__deleteLayeredSettingRecordInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteRbacStatementResponse
+	var data deleteLayeredSettingRecordResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14923,10 +18936,10 @@ func deleteRbacStatement(
 	return &data, err
 }
 
-// The query or mutation executed by deleteSnowflakeOutboundShare.
-const deleteSnowflakeOutboundShare_Operation = `
-mutation deleteSnowflakeOutboundShare ($id: ObjectId!) {
-	resultStatus: deleteSnowflakeOutboundShare(id: $id) {
+// The query or mutation executed by deleteMonitor.
+const deleteMonitor_Operation = `
+mutation deleteMonitor ($id: ObjectId!) {
+	resultStatus: deleteMonitor(id: $id) {
 		... ResultStatus
 	}
 }
@@ -14937,21 +18950,21 @@ fragment ResultStatus on ResultStatus {
 }
 `
 
-func deleteSnowflakeOutboundShare(
+func deleteMonitor(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteSnowflakeOutboundShareResponse, error) {
+) (*deleteMonitorResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteSnowflakeOutboundShare",
-		Query:  deleteSnowflakeOutboundShare_Operation,
-		Variables: &__deleteSnowflakeOutboundShareInput{
+		OpName: "deleteMonitor",
+		Query:  deleteMonitor_Operation,
+		Variables: &__deleteMonitorInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteSnowflakeOutboundShareResponse
+	var data deleteMonitorResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14963,10 +18976,10 @@ func deleteSnowflakeOutboundShare(
 	return &data, err
 }
 
-// The query or mutation executed by deleteWorksheet.
-const deleteWorksheet_Operation = `
-mutation deleteWorksheet ($id: ObjectId!) {
-	resultStatus: deleteWorksheet(wks: $id) {
+// The query or mutation executed by deleteMonitorAction.
+const deleteMonitorAction_Operation = `
+mutation deleteMonitorAction ($id: ObjectId!) {
+	resultStatus: deleteMonitorAction(id: $id) {
 		... ResultStatus
 	}
 }
@@ -14977,21 +18990,21 @@ fragment ResultStatus on ResultStatus {
 }
 `
 
-func deleteWorksheet(
+func deleteMonitorAction(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteWorksheetResponse, error) {
+) (*deleteMonitorActionResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteWorksheet",
-		Query:  deleteWorksheet_Operation,
-		Variables: &__deleteWorksheetInput{
+		OpName: "deleteMonitorAction",
+		Query:  deleteMonitorAction_Operation,
+		Variables: &__deleteMonitorActionInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteWorksheetResponse
+	var data deleteMonitorActionResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15003,10 +19016,10 @@ func deleteWorksheet(
 	return &data, err
 }
 
-// The query or mutation executed by deleteWorkspace.
-const deleteWorkspace_Operation = `
-mutation deleteWorkspace ($id: ObjectId!) {
-	resultStatus: deleteWorkspace(id: $id) {
+// The query or mutation executed by deleteMonitorActionAttachment.
+const deleteMonitorActionAttachment_Operation = `
+mutation deleteMonitorActionAttachment ($id: ObjectId!) {
+	resultStatus: deleteMonitorActionAttachment(id: $id) {
 		... ResultStatus
 	}
 }
@@ -15017,21 +19030,21 @@ fragment ResultStatus on ResultStatus {
 }
 `
 
-func deleteWorkspace(
+func deleteMonitorActionAttachment(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteWorkspaceResponse, error) {
+) (*deleteMonitorActionAttachmentResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteWorkspace",
-		Query:  deleteWorkspace_Operation,
-		Variables: &__deleteWorkspaceInput{
+		OpName: "deleteMonitorActionAttachment",
+		Query:  deleteMonitorActionAttachment_Operation,
+		Variables: &__deleteMonitorActionAttachmentInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteWorkspaceResponse
+	var data deleteMonitorActionAttachmentResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15043,47 +19056,35 @@ func deleteWorkspace(
 	return &data, err
 }
 
-// The query or mutation executed by getApp.
-const getApp_Operation = `
-query getApp ($id: ObjectId!) {
-	app(id: $id) {
-		... App
+// The query or mutation executed by deleteMonitorMuteRule.
+const deleteMonitorMuteRule_Operation = `
+mutation deleteMonitorMuteRule ($id: ObjectId!) {
+	resultStatus: deleteMonitorMuteRule(id: $id) {
+		... ResultStatus
 	}
 }
-fragment App on App {
-	id
-	name
-	iconUrl
-	description
-	workspaceId
-	folderId
-	config {
-		moduleId
-		version
-	}
-	status {
-		state
-		internalError
-	}
-	outputs
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func getApp(
+func deleteMonitorMuteRule(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getAppResponse, error) {
+) (*deleteMonitorMuteRuleResponse, error) {
 	req := &graphql.Request{
-		OpName: "getApp",
-		Query:  getApp_Operation,
-		Variables: &__getAppInput{
+		OpName: "deleteMonitorMuteRule",
+		Query:  deleteMonitorMuteRule_Operation,
+		Variables: &__deleteMonitorMuteRuleInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data getAppResponse
+	var data deleteMonitorMuteRuleResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15095,40 +19096,35 @@ func getApp(
 	return &data, err
 }
 
-// The query or mutation executed by getAppDataSource.
-const getAppDataSource_Operation = `
-query getAppDataSource ($id: ObjectId!) {
-	appdatasource: appDataSource(id: $id) {
-		... AppDataSource
+// The query or mutation executed by deleteMonitorV2.
+const deleteMonitorV2_Operation = `
+mutation deleteMonitorV2 ($id: ObjectId!) {
+	resultStatus: deleteMonitorV2(id: $id) {
+		... ResultStatus
 	}
 }
-fragment AppDataSource on AppDataSource {
-	id
-	name
-	iconUrl
-	description
-	workspaceId
-	managedById
-	sourceUrl
-	instructions
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func getAppDataSource(
+func deleteMonitorV2(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getAppDataSourceResponse, error) {
+) (*deleteMonitorV2Response, error) {
 	req := &graphql.Request{
-		OpName: "getAppDataSource",
-		Query:  getAppDataSource_Operation,
-		Variables: &__getAppDataSourceInput{
+		OpName: "deleteMonitorV2",
+		Query:  deleteMonitorV2_Operation,
+		Variables: &__deleteMonitorV2Input{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data getAppDataSourceResponse
+	var data deleteMonitorV2Response
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15140,38 +19136,35 @@ func getAppDataSource(
 	return &data, err
 }
 
-// The query or mutation executed by getBoard.
-const getBoard_Operation = `
-query getBoard ($id: ObjectId!) {
-	board: getBoard(id: $id) {
-		... Board
-	}
-}
-fragment Board on Board {
-	id
-	datasetId
-	name
-	type
-	boardJson: board
-	source
+// The query or mutation executed by deleteMonitorV2Action.
+const deleteMonitorV2Action_Operation = `
+mutation deleteMonitorV2Action ($id: ObjectId!) {
+	resultStatus: deleteMonitorV2Action(id: $id) {
+		... ResultStatus
+	}
+}
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func getBoard(
+func deleteMonitorV2Action(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getBoardResponse, error) {
+) (*deleteMonitorV2ActionResponse, error) {
 	req := &graphql.Request{
-		OpName: "getBoard",
-		Query:  getBoard_Operation,
-		Variables: &__getBoardInput{
+		OpName: "deleteMonitorV2Action",
+		Query:  deleteMonitorV2Action_Operation,
+		Variables: &__deleteMonitorV2ActionInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data getBoardResponse
+	var data deleteMonitorV2ActionResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15183,39 +19176,35 @@ func getBoard(
 	return &data, err
 }
 
-// The query or mutation executed by getBookmark.
-const getBookmark_Operation = `
-query getBookmark ($id: ObjectId!) {
-	bookmark(id: $id) {
-		... Bookmark
+// The query or mutation executed by deleteMonitorV2Destination.
+const deleteMonitorV2Destination_Operation = `
+mutation deleteMonitorV2Destination ($id: ObjectId!) {
+	resultStatus: deleteMonitorV2Destination(id: $id) {
+		... ResultStatus
 	}
 }
-fragment Bookmark on Bookmark {
-	id
-	name
-	iconUrl
-	targetId
-	targetIdKind
-	groupId
-	bookmarkKind
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func getBookmark(
+func deleteMonitorV2Destination(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getBookmarkResponse, error) {
+) (*deleteMonitorV2DestinationResponse, error) {
 	req := &graphql.Request{
-		OpName: "getBookmark",
-		Query:  getBookmark_Operation,
-		Variables: &__getBookmarkInput{
+		OpName: "deleteMonitorV2Destination",
+		Query:  deleteMonitorV2Destination_Operation,
+		Variables: &__deleteMonitorV2DestinationInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data getBookmarkResponse
+	var data deleteMonitorV2DestinationResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15227,37 +19216,35 @@ func getBookmark(
 	return &data, err
 }
 
-// The query or mutation executed by getBookmarkGroup.
-const getBookmarkGroup_Operation = `
-query getBookmarkGroup ($id: ObjectId!) {
-	bookmarkGroup(id: $id) {
-		... BookmarkGroup
+// The query or mutation executed by deletePoller.
+const deletePoller_Operation = `
+mutation deletePoller ($id: ObjectId!) {
+	resultStatus: deletePoller(id: $id) {
+		... ResultStatus
 	}
 }
-fragment BookmarkGroup on BookmarkGroup {
-	id
-	name
-	description
-	iconUrl
-	workspaceId
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func getBookmarkGroup(
+func deletePoller(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getBookmarkGroupResponse, error) {
+) (*deletePollerResponse, error) {
 	req := &graphql.Request{
-		OpName: "getBookmarkGroup",
-		Query:  getBookmarkGroup_Operation,
-		Variables: &__getBookmarkGroupInput{
+		OpName: "deletePoller",
+		Query:  deletePoller_Operation,
+		Variables: &__deletePollerInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data getBookmarkGroupResponse
+	var data deletePollerResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15269,40 +19256,35 @@ func getBookmarkGroup(
 	return &data, err
 }
 
-// The query or mutation executed by getChannel.
-const getChannel_Operation = `
-query getChannel ($id: ObjectId!) {
-	channel: getChannel(id: $id) {
-		... Channel
+// The query or mutation executed by deletePreferredPath.
+const deletePreferredPath_Operation = `
+mutation deletePreferredPath ($id: ObjectId!) {
+	resultStatus: deletePreferredPath(id: $id) {
+		... ResultStatus
 	}
 }
-fragment Channel on Channel {
-	id
-	name
-	iconUrl
-	description
-	workspaceId
-	monitors {
-		id
-	}
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func getChannel(
+func deletePreferredPath(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getChannelResponse, error) {
+) (*deletePreferredPathResponse, error) {
 	req := &graphql.Request{
-		OpName: "getChannel",
-		Query:  getChannel_Operation,
-		Variables: &__getChannelInput{
+		OpName: "deletePreferredPath",
+		Query:  deletePreferredPath_Operation,
+		Variables: &__deletePreferredPathInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data getChannelResponse
+	var data deletePreferredPathResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15314,59 +19296,35 @@ func getChannel(
 	return &data, err
 }
 
-// The query or mutation executed by getChannelAction.
-const getChannelAction_Operation = `
-query getChannelAction ($id: ObjectId!) {
-	channelAction: getChannelAction(id: $id) {
-		__typename
-		... ChannelAction
+// The query or mutation executed by deleteRbacGroup.
+const deleteRbacGroup_Operation = `
+mutation deleteRbacGroup ($id: ORN!) {
+	resultStatus: deleteRbacGroup(id: $id) {
+		... ResultStatus
 	}
 }
-fragment ChannelAction on ChannelAction {
-	id
-	name
-	iconUrl
-	description
-	notifyOnClose
-	workspaceId
-	channels {
-		id
-	}
-	rateLimit
-	__typename
-	... on WebhookAction {
-		urlTemplate
-		bodyTemplate
-		method
-		headers {
-			header
-			valueTemplate
-		}
-	}
-	... on EmailAction {
-		targetAddresses
-		subjectTemplate
-		bodyTemplate
-		isHtml
-	}
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func getChannelAction(
+func deleteRbacGroup(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getChannelActionResponse, error) {
+) (*deleteRbacGroupResponse, error) {
 	req := &graphql.Request{
-		OpName: "getChannelAction",
-		Query:  getChannelAction_Operation,
-		Variables: &__getChannelActionInput{
+		OpName: "deleteRbacGroup",
+		Query:  deleteRbacGroup_Operation,
+		Variables: &__deleteRbacGroupInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data getChannelActionResponse
+	var data deleteRbacGroupResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15378,33 +19336,35 @@ func getChannelAction(
 	return &data, err
 }
 
-// The query or mutation executed by getCloudInfo.
-const getCloudInfo_Operation = `
-query getCloudInfo {
-	cloud: currentCustomer {
-		cloudInfo {
-			... CloudInfo
-		}
+// The query or mutation executed by deleteRbacGroupmember.
+const deleteRbacGroupmember_Operation = `
+mutation deleteRbacGroupmember ($id: ORN!) {
+	resultStatus: deleteRbacGroupmember(id: $id) {
+		... ResultStatus
 	}
 }
-fragment CloudInfo on CloudInfo {
-	accountId
-	region
-	provider
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func getCloudInfo(
+func deleteRbacGroupmember(
 	ctx context.Context,
 	client graphql.Client,
-) (*getCloudInfoResponse, error) {
+	id string,
+) (*deleteRbacGroupmemberResponse, error) {
 	req := &graphql.Request{
-		OpName: "getCloudInfo",
-		Query:  getCloudInfo_Operation,
+		OpName: "deleteRbacGroupmember",
+		Query:  deleteRbacGroupmember_Operation,
+		Variables: &__deleteRbacGroupmemberInput{
+			Id: id,
+		},
 	}
 	var err error
 
-	var data getCloudInfoResponse
+	var data deleteRbacGroupmemberResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15416,33 +19376,35 @@ func getCloudInfo(
 	return &data, err
 }
 
-// The query or mutation executed by getCurrentCustomer.
-const getCurrentCustomer_Operation = `
-query getCurrentCustomer {
-	customer: currentCustomer {
-		users {
-			... User
-		}
+// The query or mutation executed by deleteRbacStatement.
+const deleteRbacStatement_Operation = `
+mutation deleteRbacStatement ($id: ORN!) {
+	resultStatus: deleteRbacStatement(id: $id) {
+		... ResultStatus
 	}
 }
-fragment User on User {
-	id
-	email
-	comment
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func getCurrentCustomer(
+func deleteRbacStatement(
 	ctx context.Context,
 	client graphql.Client,
-) (*getCurrentCustomerResponse, error) {
+	id string,
+) (*deleteRbacStatementResponse, error) {
 	req := &graphql.Request{
-		OpName: "getCurrentCustomer",
-		Query:  getCurrentCustomer_Operation,
+		OpName: "deleteRbacStatement",
+		Query:  deleteRbacStatement_Operation,
+		Variables: &__deleteRbacStatementInput{
+			Id: id,
+		},
 	}
 	var err error
 
-	var data getCurrentCustomerResponse
+	var data deleteRbacStatementResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15454,105 +19416,35 @@ func getCurrentCustomer(
 	return &data, err
 }
 
-// The query or mutation executed by getDashboard.
-const getDashboard_Operation = `
-query getDashboard ($id: ObjectId!) {
-	dashboard(id: $id) {
-		... Dashboard
-	}
-}
-fragment Dashboard on Dashboard {
-	id
-	name
-	iconUrl
-	workspaceId
-	managedById
-	folderId
-	layout
-	stages {
-		id
-		input {
-			inputName
-			inputRole
-			datasetId
-			datasetPath
-			stageId
-		}
-		params
-		layout
-		pipeline
-	}
-	parameters {
-		id
-		name
-		defaultValue {
-			... valueFields
-		}
-		valueKind {
-			type
-			keyForDatasetId
-			arrayItemType {
-				type
-				keyForDatasetId
-			}
-		}
-	}
-	parameterValues {
-		id
-		value {
-			... valueFields
-		}
-	}
-}
-fragment valueFields on Value {
-	bool
-	float64
-	int64
-	string
-	array {
-		value {
-			... primitiveValueFields
-		}
-	}
-	link {
-		datasetId
-		primaryKeyValue {
-			name
-			value {
-				... primitiveValueFields
-			}
-		}
-		storedLabel
-	}
-	datasetref {
-		datasetId
-		datasetPath
-		stageId
+// The query or mutation executed by deleteSnowflakeOutboundShare.
+const deleteSnowflakeOutboundShare_Operation = `
+mutation deleteSnowflakeOutboundShare ($id: ObjectId!) {
+	resultStatus: deleteSnowflakeOutboundShare(id: $id) {
+		... ResultStatus
 	}
 }
-fragment primitiveValueFields on PrimitiveValue {
-	bool
-	float64
-	int64
-	string
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func getDashboard(
+func deleteSnowflakeOutboundShare(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getDashboardResponse, error) {
+) (*deleteSnowflakeOutboundShareResponse, error) {
 	req := &graphql.Request{
-		OpName: "getDashboard",
-		Query:  getDashboard_Operation,
-		Variables: &__getDashboardInput{
+		OpName: "deleteSnowflakeOutboundShare",
+		Query:  deleteSnowflakeOutboundShare_Operation,
+		Variables: &__deleteSnowflakeOutboundShareInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data getDashboardResponse
+	var data deleteSnowflakeOutboundShareResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15564,42 +19456,35 @@ func getDashboard(
 	return &data, err
 }
 
-// The query or mutation executed by getDashboardLink.
-const getDashboardLink_Operation = `
-query getDashboardLink ($id: ObjectId!) {
-	dashboardLink(id: $id) {
-		... DashboardLink
+// The query or mutation executed by deleteWorksheet.
+const deleteWorksheet_Operation = `
+mutation deleteWorksheet ($id: ObjectId!) {
+	resultStatus: deleteWorksheet(wks: $id) {
+		... ResultStatus
 	}
 }
-fragment DashboardLink on DashboardLink {
-	id
-	name
-	workspaceId
-	folderId
-	managedById
-	description
-	fromDashboard: fromDashboardId
-	toDashboard: toDashboardId
-	fromCard
-	linkLabel
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func getDashboardLink(
+func deleteWorksheet(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getDashboardLinkResponse, error) {
+) (*deleteWorksheetResponse, error) {
 	req := &graphql.Request{
-		OpName: "getDashboardLink",
-		Query:  getDashboardLink_Operation,
-		Variables: &__getDashboardLinkInput{
+		OpName: "deleteWorksheet",
+		Query:  deleteWorksheet_Operation,
+		Variables: &__deleteWorksheetInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data getDashboardLinkResponse
+	var data deleteWorksheetResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15611,106 +19496,35 @@ func getDashboardLink(
 	return &data, err
 }
 
-// The query or mutation executed by getDataset.
-const getDataset_Operation = `
-query getDataset ($id: ObjectId!) {
-	dataset(id: $id) {
-		... Dataset
-	}
-}
-fragment Dataset on Dataset {
-	workspaceId
-	id
-	name
-	freshnessDesired
-	description
-	iconUrl
-	accelerationDisabled
-	version
-	updatedDate
-	pathCost
-	source
-	managedById
-	onDemandMaterializationLength
-	foreignKeys {
-		label
-		targetDataset
-		srcFields
-		dstFields
-	}
-	transform {
-		current {
-			query {
-				outputStage
-				stages {
-					... StageQuery
-				}
-			}
-		}
-	}
-	typedef {
-		label
-		def {
-			anykey
-			fields {
-				name
-				type {
-					rep
-					nullable
-				}
-				isEnum
-				isSearchable
-				isHidden
-				isConst
-				isMetric
-			}
-		}
-	}
-	sourceTable {
-		schema
-		partitions {
-			name
-		}
-		sourceUpdateTableName
-		isInsertOnly
-		batchSeqField
-		validFromField
-		fields {
-			name
-			sqlType
-		}
+// The query or mutation executed by deleteWorkspace.
+const deleteWorkspace_Operation = `
+mutation deleteWorkspace ($id: ObjectId!) {
+	resultStatus: deleteWorkspace(id: $id) {
+		... ResultStatus
 	}
 }
-fragment StageQuery on StageQuery {
-	id
-	pipeline
-	params
-	layout
-	input {
-		inputName
-		inputRole
-		datasetId
-		datasetPath
-		stageId
-	}
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func getDataset(
+func deleteWorkspace(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getDatasetResponse, error) {
+) (*deleteWorkspaceResponse, error) {
 	req := &graphql.Request{
-		OpName: "getDataset",
-		Query:  getDataset_Operation,
-		Variables: &__getDatasetInput{
+		OpName: "deleteWorkspace",
+		Query:  deleteWorkspace_Operation,
+		Variables: &__deleteWorkspaceInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data getDatasetResponse
+	var data deleteWorkspaceResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15722,36 +19536,37 @@ func getDataset(
 	return &data, err
 }
 
-// The query or mutation executed by getDatasetCorrelationTags.
-const getDatasetCorrelationTags_Operation = `
-query getDatasetCorrelationTags ($datasetId: ObjectId!) {
-	correlationTags: dataset(id: $datasetId) {
-		correlationTagMappings {
-			tag
-			path {
-				column
-				path
-			}
-		}
+// The query or mutation executed by estimateAccelerationJobCost.
+const estimateAccelerationJobCost_Operation = `
+query estimateAccelerationJobCost ($job: AccelerationJobInput!) {
+	estimates: estimateAccelerationJobCost(job: $job) {
+		... DatasetCostEstimate
 	}
 }
+fragment DatasetCostEstimate on DatasetCostEstimate {
+	datasetId
+	absoluteCostEstimate
+	additionalCostEstimate
+	confidenceAbsoluteCostEstimate
+	confidenceAdditionalCostEstimate
+}
 `
 
-func getDatasetCorrelationTags(
+func estimateAccelerationJobCost(
 	ctx context.Context,
 	client graphql.Client,
-	datasetId string,
-) (*getDatasetCorrelationTagsResponse, error) {
+	job AccelerationJobInput,
+) (*estimateAccelerationJobCostResponse, error) {
 	req := &graphql.Request{
-		OpName: "getDatasetCorrelationTags",
-		Query:  getDatasetCorrelationTags_Operation,
-		Variables: &__getDatasetCorrelationTagsInput{
-			DatasetId: datasetId,
+		OpName: "estimateAccelerationJobCost",
+		Query:  estimateAccelerationJobCost_Operation,
+		Variables: &__estimateAccelerationJobCostInput{
+			Job: job,
 		},
 	}
 	var err error
 
-	var data getDatasetCorrelationTagsResponse
+	var data estimateAccelerationJobCostResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15763,46 +19578,44 @@ func getDatasetCorrelationTags(
 	return &data, err
 }
 
-// The query or mutation executed by getDatasetOutboundShare.
-const getDatasetOutboundShare_Operation = `
-query getDatasetOutboundShare ($id: ObjectId!) {
-	datasetOutboundShare(id: $id) {
-		... DatasetOutboundShare
+// The query or mutation executed by exportQuery.
+const exportQuery_Operation = `
+query exportQuery ($query: MultiStageQueryInput!, $params: QueryParams!, $rowCount: Int64, $filename: String, $exportFormat: ExportFileFormat) {
+	export: exportQuery(query: $query, params: $params, rowCount: $rowCount, filename: $filename, exportFormat: $exportFormat) {
+		... ExportCursorResult
 	}
 }
-fragment DatasetOutboundShare on DatasetOutboundShare {
-	id
-	name
-	description
-	workspaceId
-	folderId
-	datasetID
-	outboundShareID
-	schemaName
-	viewName
-	freshnessGoal
-	status {
-		state
-		error
-	}
+fragment ExportCursorResult on ExportCursorResult {
+	exportUrl
+	exportUrlExpiration
+	exportFilename
+	exportFormat
 }
 `
 
-func getDatasetOutboundShare(
+func exportQuery(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*getDatasetOutboundShareResponse, error) {
+	query MultiStageQueryInput,
+	params QueryParams,
+	rowCount *types.Int64Scalar,
+	filename *string,
+	exportFormat *ExportFileFormat,
+) (*exportQueryResponse, error) {
 	req := &graphql.Request{
-		OpName: "getDatasetOutboundShare",
-		Query:  getDatasetOutboundShare_Operation,
-		Variables: &__getDatasetOutboundShareInput{
-			Id: id,
+		OpName: "exportQuery",
+		Query:  exportQuery_Operation,
+		Variables: &__exportQueryInput{
+			Query:        query,
+			Params:       params,
+			RowCount:     rowCount,
+			Filename:     filename,
+			ExportFormat: exportFormat,
 		},
 	}
 	var err error
 
-	var data getDatasetOutboundShareResponse
+	var data exportQueryResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15814,42 +19627,45 @@ func getDatasetOutboundShare(
 	return &data, err
 }
 
-// The query or mutation executed by getDatasetQueryOutput.
-const getDatasetQueryOutput_Operation = `
-query getDatasetQueryOutput ($query: [StageInput!]!, $params: QueryParams!) {
-	taskResult: datasetQueryOutput(query: $query, params: $params) {
-		... TaskResult
+// The query or mutation executed by getAccelerationJob.
+const getAccelerationJob_Operation = `
+query getAccelerationJob ($jobId: String!) {
+	accelerationJob: accelerationJobStatus(jobId: $jobId) {
+		... AccelerationJob
 	}
 }
-fragment TaskResult on TaskResult {
-	queryId
-	stageId
-	startTime
-	endTime
-	resultCursor
-	resultSchema {
-		typedefDefinition
+fragment AccelerationJob on AccelerationJob {
+	jobId
+	context
+	createdDate
+	state
+	stateLastUpdatedDate
+	progress
+	credits
+	datasetStatuses {
+		datasetId
+		isDirect
+		progress
+		credits
 	}
 }
 `
 
-func getDatasetQueryOutput(
+func getAccelerationJob(
 	ctx context.Context,
 	client graphql.Client,
-	query []*StageInput,
-	params QueryParams,
-) (*getDatasetQueryOutputResponse, error) {
+	jobId string,
+) (*getAccelerationJobResponse, error) {
 	req := &graphql.Request{
-		OpName: "getDatasetQueryOutput",
-		Query:  getDatasetQueryOutput_Operation,
-		Variables: &__getDatasetQueryOutputInput{
-			Query:  query,
-			Params: params,
+		OpName: "getAccelerationJob",
+		Query:  getAccelerationJob_Operation,
+		Variables: &__getAccelerationJobInput{
+			JobId: jobId,
 		},
 	}
 	var err error
 
-	var data getDatasetQueryOutputResponse
+	var data getAccelerationJobResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15861,38 +19677,34 @@ func getDatasetQueryOutput(
 	return &data, err
 }
 
-// The query or mutation executed by getDatastream.
-const getDatastream_Operation = `
-query getDatastream ($id: ObjectId!) {
-	datastream(id: $id) {
-		... Datastream
+// The query or mutation executed by getActiveMonitorMuteRules.
+const getActiveMonitorMuteRules_Operation = `
+query getActiveMonitorMuteRules ($monitorId: ObjectId!) {
+	monitor(id: $monitorId) {
+		activeMonitorInfo {
+			muteRules {
+				id
+			}
+		}
 	}
 }
-fragment Datastream on Datastream {
-	id
-	name
-	iconUrl
-	description
-	workspaceId
-	datasetId
-}
 `
 
-func getDatastream(
+func getActiveMonitorMuteRules(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*getDatastreamResponse, error) {
+	monitorId string,
+) (*getActiveMonitorMuteRulesResponse, error) {
 	req := &graphql.Request{
-		OpName: "getDatastream",
-		Query:  getDatastream_Operation,
-		Variables: &__getDatastreamInput{
-			Id: id,
+		OpName: "getActiveMonitorMuteRules",
+		Query:  getActiveMonitorMuteRules_Operation,
+		Variables: &__getActiveMonitorMuteRulesInput{
+			MonitorId: monitorId,
 		},
 	}
 	var err error
 
-	var data getDatastreamResponse
+	var data getActiveMonitorMuteRulesResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15904,38 +19716,47 @@ func getDatastream(
 	return &data, err
 }
 
-// The query or mutation executed by getDatastreamToken.
-const getDatastreamToken_Operation = `
-query getDatastreamToken ($id: String!) {
-	datastreamToken(id: $id) {
-		... DatastreamToken
+// The query or mutation executed by getApp.
+const getApp_Operation = `
+query getApp ($id: ObjectId!) {
+	app(id: $id) {
+		... App
 	}
 }
-fragment DatastreamToken on DatastreamToken {
+fragment App on App {
 	id
 	name
+	iconUrl
 	description
-	disabled
-	datastreamId
-	secret
+	workspaceId
+	folderId
+	config {
+		moduleId
+		version
+	}
+	status {
+		state
+		internalError
+	}
+	outputs
 }
 `
 
-func getDatastreamToken(
+func getApp(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getDatastreamTokenResponse, error) {
+) (*getAppResponse, error) {
 	req := &graphql.Request{
-		OpName: "getDatastreamToken",
-		Query:  getDatastreamToken_Operation,
-		Variables: &__getDatastreamTokenInput{
+		OpName: "getApp",
+		Query:  getApp_Operation,
+		Variables: &__getAppInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data getDatastreamTokenResponse
+	var data getAppResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15947,28 +19768,40 @@ func getDatastreamToken(
 	return &data, err
 }
 
-// The query or mutation executed by getDefaultDashboard.
-const getDefaultDashboard_Operation = `
-query getDefaultDashboard ($dsid: ObjectId!) {
-	defaultDashboard(dsid: $dsid)
+// The query or mutation executed by getAppDataSource.
+const getAppDataSource_Operation = `
+query getAppDataSource ($id: ObjectId!) {
+	appdatasource: appDataSource(id: $id) {
+		... AppDataSource
+	}
+}
+fragment AppDataSource on AppDataSource {
+	id
+	name
+	iconUrl
+	description
+	workspaceId
+	managedById
+	sourceUrl
+	instructions
 }
 `
 
-func getDefaultDashboard(
+func getAppDataSource(
 	ctx context.Context,
 	client graphql.Client,
-	dsid string,
-) (*getDefaultDashboardResponse, error) {
+	id string,
+) (*getAppDataSourceResponse, error) {
 	req := &graphql.Request{
-		OpName: "getDefaultDashboard",
-		Query:  getDefaultDashboard_Operation,
-		Variables: &__getDefaultDashboardInput{
-			Dsid: dsid,
+		OpName: "getAppDataSource",
+		Query:  getAppDataSource_Operation,
+		Variables: &__getAppDataSourceInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data getDefaultDashboardResponse
+	var data getAppDataSourceResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15980,50 +19813,41 @@ func getDefaultDashboard(
 	return &data, err
 }
 
-// The query or mutation executed by getDeferredForeignKey.
-const getDeferredForeignKey_Operation = `
-query getDeferredForeignKey ($id: ObjectId!) {
-	deferredForeignKey(id: $id) {
-		... DeferredForeignKey
+// The query or mutation executed by getBlob.
+const getBlob_Operation = `
+query getBlob ($userId: UserId, $name: String!) {
+	blob(userId: $userId, name: $name) {
+		... Blob
 	}
 }
-fragment DeferredForeignKey on DeferredForeignKey {
-	id
-	workspaceId
-	sourceDataset {
-		datasetId
-	}
-	targetDataset {
-		datasetId
-	}
-	srcFields
-	dstFields
-	label
-	resolution {
-		sourceId
-		targetId
-	}
-	status {
-		errorText
-	}
+fragment Blob on Blob {
+	userId
+	name
+	kind
+	value
+	readable
+	writable
+	updatedDate
 }
 `
 
-func getDeferredForeignKey(
+func getBlob(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*getDeferredForeignKeyResponse, error) {
+	userId *types.UserIdScalar,
+	name string,
+) (*getBlobResponse, error) {
 	req := &graphql.Request{
-		OpName: "getDeferredForeignKey",
-		Query:  getDeferredForeignKey_Operation,
-		Variables: &__getDeferredForeignKeyInput{
-			Id: id,
+		OpName: "getBlob",
+		Query:  getBlob_Operation,
+		Variables: &__getBlobInput{
+			UserId: userId,
+			Name:   name,
 		},
 	}
 	var err error
 
-	var data getDeferredForeignKeyResponse
+	var data getBlobResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -16035,56 +19859,38 @@ func getDeferredForeignKey(
 	return &data, err
 }
 
-// The query or mutation executed by getFiledrop.
-const getFiledrop_Operation = `
-query getFiledrop ($id: ObjectId!) {
-	filedrop(id: $id) {
-		... Filedrop
+// The query or mutation executed by getBoard.
+const getBoard_Operation = `
+query getBoard ($id: ObjectId!) {
+	board: getBoard(id: $id) {
+		... Board
 	}
 }
-fragment Filedrop on Filedrop {
+fragment Board on Board {
 	id
+	datasetId
 	name
-	iconUrl
-	description
-	workspaceId
-	status
-	datastreamID
-	config {
-		provider {
-			__typename
-			... on FiledropProviderAwsConfig {
-				region
-				roleArn
-			}
-		}
-	}
-	endpoint {
-		__typename
-		... on FiledropS3Endpoint {
-			arn
-			bucket
-			prefix
-		}
-	}
+	type
+	boardJson: board
+	source
 }
 `
 
-func getFiledrop(
+func getBoard(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getFiledropResponse, error) {
+) (*getBoardResponse, error) {
 	req := &graphql.Request{
-		OpName: "getFiledrop",
-		Query:  getFiledrop_Operation,
-		Variables: &__getFiledropInput{
+		OpName: "getBoard",
+		Query:  getBoard_Operation,
+		Variables: &__getBoardInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data getFiledropResponse
+	var data getBoardResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -16096,37 +19902,39 @@ func getFiledrop(
 	return &data, err
 }
 
-// The query or mutation executed by getFolder.
-const getFolder_Operation = `
-query getFolder ($id: ObjectId!) {
-	folder(id: $id) {
-		... Folder
+// The query or mutation executed by getBookmark.
+const getBookmark_Operation = `
+query getBookmark ($id: ObjectId!) {
+	bookmark(id: $id) {
+		... Bookmark
 	}
 }
-fragment Folder on Folder {
+fragment Bookmark on Bookmark {
 	id
 	name
 	iconUrl
-	description
-	workspaceId
+	targetId
+	targetIdKind
+	groupId
+	bookmarkKind
 }
 `
 
-func getFolder(
+func getBookmark(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getFolderResponse, error) {
+) (*getBookmarkResponse, error) {
 	req := &graphql.Request{
-		OpName: "getFolder",
-		Query:  getFolder_Operation,
-		Variables: &__getFolderInput{
+		OpName: "getBookmark",
+		Query:  getBookmark_Operation,
+		Variables: &__getBookmarkInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data getFolderResponse
+	var data getBookmarkResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -16138,34 +19946,37 @@ func getFolder(
 	return &data, err
 }
 
-// The query or mutation executed by getIngestInfo.
-const getIngestInfo_Operation = `
-query getIngestInfo {
-	ingest: currentCustomer {
-		ingestInfo {
-			... IngestInfo
-		}
+// The query or mutation executed by getBookmarkGroup.
+const getBookmarkGroup_Operation = `
+query getBookmarkGroup ($id: ObjectId!) {
+	bookmarkGroup(id: $id) {
+		... BookmarkGroup
 	}
 }
-fragment IngestInfo on IngestInfo {
-	collectUrl
-	domain
-	scheme
-	port
+fragment BookmarkGroup on BookmarkGroup {
+	id
+	name
+	description
+	iconUrl
+	workspaceId
 }
 `
 
-func getIngestInfo(
+func getBookmarkGroup(
 	ctx context.Context,
 	client graphql.Client,
-) (*getIngestInfoResponse, error) {
+	id string,
+) (*getBookmarkGroupResponse, error) {
 	req := &graphql.Request{
-		OpName: "getIngestInfo",
-		Query:  getIngestInfo_Operation,
+		OpName: "getBookmarkGroup",
+		Query:  getBookmarkGroup_Operation,
+		Variables: &__getBookmarkGroupInput{
+			Id: id,
+		},
 	}
 	var err error
 
-	var data getIngestInfoResponse
+	var data getBookmarkGroupResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -16177,71 +19988,40 @@ func getIngestInfo(
 	return &data, err
 }
 
-// The query or mutation executed by getLayeredSettingRecord.
-const getLayeredSettingRecord_Operation = `
-query getLayeredSettingRecord ($id: ObjectId!) {
-	layeredSettingRecord(id: $id) {
-		... LayeredSettingRecord
+// The query or mutation executed by getChannel.
+const getChannel_Operation = `
+query getChannel ($id: ObjectId!) {
+	channel: getChannel(id: $id) {
+		... Channel
 	}
 }
-fragment LayeredSettingRecord on LayeredSettingRecord {
-	settingAndTargetScope {
-		... SettingAndTargetScope
-	}
-	value {
-		... PrimitiveValue
-	}
+fragment Channel on Channel {
 	id
 	name
-	description
 	iconUrl
+	description
 	workspaceId
-	managedById
-	folderId
-}
-fragment SettingAndTargetScope on SettingAndTargetScope {
-	setting
-	target {
-		... LayeredSettingRecordTarget
+	monitors {
+		id
 	}
 }
-fragment PrimitiveValue on PrimitiveValue {
-	bool
-	float64
-	int64
-	string
-	timestamp
-	duration
-}
-fragment LayeredSettingRecordTarget on LayeredSettingRecordTarget {
-	customerId
-	workspaceId
-	folderId
-	appId
-	monitorId
-	worksheetId
-	dashboardId
-	datasetId
-	datastreamId
-	userId
-}
 `
 
-func getLayeredSettingRecord(
+func getChannel(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getLayeredSettingRecordResponse, error) {
+) (*getChannelResponse, error) {
 	req := &graphql.Request{
-		OpName: "getLayeredSettingRecord",
-		Query:  getLayeredSettingRecord_Operation,
-		Variables: &__getLayeredSettingRecordInput{
+		OpName: "getChannel",
+		Query:  getChannel_Operation,
+		Variables: &__getChannelInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data getLayeredSettingRecordResponse
+	var data getChannelResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -16253,118 +20033,59 @@ func getLayeredSettingRecord(
 	return &data, err
 }
 
-// The query or mutation executed by getMonitor.
-const getMonitor_Operation = `
-query getMonitor ($id: ObjectId!) {
-	monitor(id: $id) {
-		... Monitor
+// The query or mutation executed by getChannelAction.
+const getChannelAction_Operation = `
+query getChannelAction ($id: ObjectId!) {
+	channelAction: getChannelAction(id: $id) {
+		__typename
+		... ChannelAction
 	}
 }
-fragment Monitor on Monitor {
-	workspaceId
+fragment ChannelAction on ChannelAction {
 	id
 	name
-	description
-	comment
 	iconUrl
-	isTemplate
-	disabled
-	freshnessGoal
-	useDefaultFreshness
-	source
-	definition
-	managedById
-	query {
-		outputStage
-		stages {
-			... StageQuery
-		}
+	description
+	notifyOnClose
+	workspaceId
+	channels {
+		id
 	}
-	rule {
-		__typename
-		sourceColumn
-		groupByGroups {
-			groupName
-			columns
-		}
-		... on MonitorRuleCount {
-			compareFunction
-			compareValues
-			lookbackTime
-		}
-		... on MonitorRuleChange {
-			changeType
-			compareFunction
-			compareValues
-			aggregateFunction
-			lookbackTime
-			baselineTime
-		}
-		... on MonitorRuleFacet {
-			facetFunction
-			facetValues
-			timeFunction
-			timeValue
-			lookbackTime
-		}
-		... on MonitorRuleThreshold {
-			compareFunction
-			compareValues
-			lookbackTime
-			thresholdAggFunction
-		}
-		... on MonitorRulePromote {
-			kindField
-			descriptionField
-			primaryKey
-		}
-		... on MonitorRuleLog {
-			compareFunction
-			compareValues
-			lookbackTime
-			expressionSummary
-			logStageId
-			sourceLogDatasetId
+	rateLimit
+	__typename
+	... on WebhookAction {
+		urlTemplate
+		bodyTemplate
+		method
+		headers {
+			header
+			valueTemplate
 		}
 	}
-	notificationSpec {
-		merge
-		importance
-		notifyOnReminder
-		reminderFrequency
-		notifyOnClose
-	}
-}
-fragment StageQuery on StageQuery {
-	id
-	pipeline
-	params
-	layout
-	input {
-		inputName
-		inputRole
-		datasetId
-		datasetPath
-		stageId
+	... on EmailAction {
+		targetAddresses
+		subjectTemplate
+		bodyTemplate
+		isHtml
 	}
 }
 `
 
-func getMonitor(
+func getChannelAction(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getMonitorResponse, error) {
+) (*getChannelActionResponse, error) {
 	req := &graphql.Request{
-		OpName: "getMonitor",
-		Query:  getMonitor_Operation,
-		Variables: &__getMonitorInput{
+		OpName: "getChannelAction",
+		Query:  getChannelAction_Operation,
+		Variables: &__getChannelActionInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data getMonitorResponse
+	var data getChannelActionResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -16376,57 +20097,33 @@ func getMonitor(
 	return &data, err
 }
 
-// The query or mutation executed by getMonitorAction.
-const getMonitorAction_Operation = `
-query getMonitorAction ($id: ObjectId!) {
-	monitorAction(id: $id) {
-		__typename
-		... MonitorAction
+// The query or mutation executed by getCloudInfo.
+const getCloudInfo_Operation = `
+query getCloudInfo {
+	cloud: currentCustomer {
+		cloudInfo {
+			... CloudInfo
+		}
 	}
 }
-fragment MonitorAction on MonitorAction {
-	id
-	name
-	iconUrl
-	description
-	workspaceId
-	rateLimit
-	notifyOnClose
-	isPrivate
-	__typename
-	... on EmailAction {
-		targetAddresses
-		subjectTemplate
-		bodyTemplate
-		isHtml
-	}
-	... on WebhookAction {
-		urlTemplate
-		method
-		headers {
-			header
-			valueTemplate
-		}
-		bodyTemplate
-	}
+fragment CloudInfo on CloudInfo {
+	accountId
+	region
+	provider
 }
 `
 
-func getMonitorAction(
+func getCloudInfo(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*getMonitorActionResponse, error) {
+) (*getCloudInfoResponse, error) {
 	req := &graphql.Request{
-		OpName: "getMonitorAction",
-		Query:  getMonitorAction_Operation,
-		Variables: &__getMonitorActionInput{
-			Id: id,
-		},
+		OpName: "getCloudInfo",
+		Query:  getCloudInfo_Operation,
 	}
 	var err error
 
-	var data getMonitorActionResponse
+	var data getCloudInfoResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -16438,39 +20135,37 @@ func getMonitorAction(
 	return &data, err
 }
 
-// The query or mutation executed by getMonitorActionAttachment.
-const getMonitorActionAttachment_Operation = `
-query getMonitorActionAttachment ($id: ObjectId!) {
-	monitorActionAttachment(id: $id) {
-		... MonitorActionAttachment
+// The query or mutation executed by getCurrentCustomer.
+const getCurrentCustomer_Operation = `
+query getCurrentCustomer {
+	customer: currentCustomer {
+		users {
+			... User
+		}
 	}
 }
-fragment MonitorActionAttachment on MonitorActionAttachment {
-	monitorID
-	actionID
+fragment User on User {
 	id
-	workspaceId
-	name
-	iconUrl
-	description
+	email
+	label
+	role
+	status
+	type
+	comment
 }
 `
 
-func getMonitorActionAttachment(
+func getCurrentCustomer(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*getMonitorActionAttachmentResponse, error) {
+) (*getCurrentCustomerResponse, error) {
 	req := &graphql.Request{
-		OpName: "getMonitorActionAttachment",
-		Query:  getMonitorActionAttachment_Operation,
-		Variables: &__getMonitorActionAttachmentInput{
-			Id: id,
-		},
+		OpName: "getCurrentCustomer",
+		Query:  getCurrentCustomer_Operation,
 	}
 	var err error
 
-	var data getMonitorActionAttachmentResponse
+	var data getCurrentCustomerResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -16482,184 +20177,36 @@ func getMonitorActionAttachment(
 	return &data, err
 }
 
-// The query or mutation executed by getMonitorV2.
-const getMonitorV2_Operation = `
-query getMonitorV2 ($id: ObjectId!) {
-	monitorV2(id: $id) {
-		... MonitorV2
-	}
-}
-fragment MonitorV2 on MonitorV2 {
-	id
-	workspaceId
-	createdBy
-	createdDate
-	name
-	iconUrl
-	description
-	managedById
-	folderId
-	rollupStatus
-	ruleKind
-	definition {
-		... MonitorV2Definition
-	}
-	actionRules {
-		... MonitorV2ActionRule
-	}
-}
-fragment MonitorV2Definition on MonitorV2Definition {
-	inputQuery {
-		outputStage
-		stages {
-			... StageQuery
+// The query or mutation executed by getCurrentCustomerSso.
+const getCurrentCustomerSso_Operation = `
+query getCurrentCustomerSso {
+	customer: currentCustomer {
+		id
+		sso {
+			... CustomerSso
 		}
 	}
-	rules {
-		... MonitorV2Rule
-	}
-	lookbackTime
-	dataStabilizationDelay
-	groupings {
-		... MonitorV2Column
-	}
-	scheduling {
-		... MonitorV2Scheduling
-	}
-}
-fragment MonitorV2ActionRule on MonitorV2ActionRule {
-	actionID
-	levels
-	sendEndNotifications
-	sendRemindersInterval
-}
-fragment StageQuery on StageQuery {
-	id
-	pipeline
-	params
-	layout
-	input {
-		inputName
-		inputRole
-		datasetId
-		datasetPath
-		stageId
-	}
-}
-fragment MonitorV2Rule on MonitorV2Rule {
-	level
-	count {
-		... MonitorV2CountRule
-	}
-	threshold {
-		... MonitorV2ThresholdRule
-	}
-	promote {
-		... MonitorV2PromoteRule
-	}
-}
-fragment MonitorV2Column on MonitorV2Column {
-	linkColumn {
-		... MonitorV2LinkColumn
-	}
-	columnPath {
-		... MonitorV2ColumnPath
-	}
-}
-fragment MonitorV2Scheduling on MonitorV2Scheduling {
-	interval {
-		... MonitorV2IntervalSchedule
-	}
-	transform {
-		... MonitorV2TransformSchedule
-	}
-}
-fragment MonitorV2CountRule on MonitorV2CountRule {
-	compareValues {
-		... MonitorV2Comparison
-	}
-	compareGroups {
-		... MonitorV2ColumnComparison
-	}
-}
-fragment MonitorV2ThresholdRule on MonitorV2ThresholdRule {
-	compareValues {
-		... MonitorV2Comparison
-	}
-	valueColumnName
-	aggregation
-	compareGroups {
-		... MonitorV2ColumnComparison
-	}
-}
-fragment MonitorV2PromoteRule on MonitorV2PromoteRule {
-	compareColumns {
-		... MonitorV2ColumnComparison
-	}
-}
-fragment MonitorV2LinkColumn on MonitorV2LinkColumn {
-	name
-	meta {
-		... MonitorV2LinkColumnMeta
-	}
 }
-fragment MonitorV2ColumnPath on MonitorV2ColumnPath {
-	name
-	path
-}
-fragment MonitorV2IntervalSchedule on MonitorV2IntervalSchedule {
-	interval
-	randomize
-}
-fragment MonitorV2TransformSchedule on MonitorV2TransformSchedule {
-	freshnessGoal
-}
-fragment MonitorV2Comparison on MonitorV2Comparison {
-	compareFn
-	compareValue {
-		... PrimitiveValue
-	}
-}
-fragment MonitorV2ColumnComparison on MonitorV2ColumnComparison {
-	column {
-		... MonitorV2Column
-	}
-	compareValues {
-		... MonitorV2Comparison
-	}
-}
-fragment MonitorV2LinkColumnMeta on MonitorV2LinkColumnMeta {
-	srcFields {
-		... MonitorV2ColumnPath
-	}
-	dstFields
-	targetDataset
-}
-fragment PrimitiveValue on PrimitiveValue {
-	bool
-	float64
-	int64
-	string
-	timestamp
-	duration
+fragment CustomerSso on CustomerSso {
+	ssoLocalFlag
+	scimFlag
+	samlUrl
+	samlCert
+	samlExpires
 }
 `
 
-func getMonitorV2(
+func getCurrentCustomerSso(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*getMonitorV2Response, error) {
+) (*getCurrentCustomerSsoResponse, error) {
 	req := &graphql.Request{
-		OpName: "getMonitorV2",
-		Query:  getMonitorV2_Operation,
-		Variables: &__getMonitorV2Input{
-			Id: id,
-		},
+		OpName: "getCurrentCustomerSso",
+		Query:  getCurrentCustomerSso_Operation,
 	}
 	var err error
 
-	var data getMonitorV2Response
+	var data getCurrentCustomerSsoResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -16671,96 +20218,145 @@ func getMonitorV2(
 	return &data, err
 }
 
-// The query or mutation executed by getMonitorV2Action.
-const getMonitorV2Action_Operation = `
-query getMonitorV2Action ($id: ObjectId!) {
-	monitorV2Action(id: $id) {
-		... MonitorV2Action
+// The query or mutation executed by getCurrentUser.
+const getCurrentUser_Operation = `
+query getCurrentUser {
+	user: currentUser {
+		... User
 	}
 }
-fragment MonitorV2Action on MonitorV2Action {
-	inline
+fragment User on User {
+	id
+	email
+	label
+	role
+	status
 	type
-	destinationLinks {
-		... ActionDestinationLink
-	}
-	email {
-		... MonitorV2EmailAction
+	comment
+}
+`
+
+func getCurrentUser(
+	ctx context.Context,
+	client graphql.Client,
+) (*getCurrentUserResponse, error) {
+	req := &graphql.Request{
+		OpName: "getCurrentUser",
+		Query:  getCurrentUser_Operation,
 	}
-	webhook {
-		... MonitorV2WebhookAction
+	var err error
+
+	var data getCurrentUserResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getDashboard.
+const getDashboard_Operation = `
+query getDashboard ($id: ObjectId!) {
+	dashboard(id: $id) {
+		... Dashboard
 	}
+}
+fragment Dashboard on Dashboard {
 	id
-	workspaceId
 	name
 	iconUrl
-	description
-	createdBy
-	createdDate
-}
-fragment ActionDestinationLink on ActionDestinationLink {
-	destinationID
-	sendEndNotifications
-	sendRemindersInterval
-	definition {
-		... MonitorV2DestinationDefinition
+	workspaceId
+	managedById
+	folderId
+	layout
+	stages {
+		id
+		input {
+			inputName
+			inputRole
+			datasetId
+			datasetPath
+			stageId
+		}
+		params
+		layout
+		pipeline
 	}
-}
-fragment MonitorV2EmailAction on MonitorV2EmailAction {
-	users
-	addresses
-	subject
-	body
-	fragments
-}
-fragment MonitorV2WebhookAction on MonitorV2WebhookAction {
-	headers {
-		... MonitorV2WebhookHeader
+	parameters {
+		id
+		name
+		defaultValue {
+			... valueFields
+		}
+		valueKind {
+			type
+			keyForDatasetId
+			arrayItemType {
+				type
+				keyForDatasetId
+			}
+		}
+	}
+	parameterValues {
+		id
+		value {
+			... valueFields
+		}
 	}
-	body
-	fragments
-	url
-	method
 }
-fragment MonitorV2DestinationDefinition on MonitorV2DestinationDefinition {
-	inline
-	type
-	email {
-		... MonitorV2EmailDestination
+fragment valueFields on Value {
+	bool
+	float64
+	int64
+	string
+	array {
+		value {
+			... primitiveValueFields
+		}
 	}
-	webhook {
-		... MonitorV2WebhookDestination
+	link {
+		datasetId
+		primaryKeyValue {
+			name
+			value {
+				... primitiveValueFields
+			}
+		}
+		storedLabel
+	}
+	datasetref {
+		datasetId
+		datasetPath
+		stageId
 	}
 }
-fragment MonitorV2WebhookHeader on MonitorV2WebhookHeader {
-	header
-	value
-}
-fragment MonitorV2EmailDestination on MonitorV2EmailDestination {
-	users
-	addresses
-}
-fragment MonitorV2WebhookDestination on MonitorV2WebhookDestination {
-	url
-	method
+fragment primitiveValueFields on PrimitiveValue {
+	bool
+	float64
+	int64
+	string
 }
 `
 
-func getMonitorV2Action(
+func getDashboard(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getMonitorV2ActionResponse, error) {
+) (*getDashboardResponse, error) {
 	req := &graphql.Request{
-		OpName: "getMonitorV2Action",
-		Query:  getMonitorV2Action_Operation,
-		Variables: &__getMonitorV2ActionInput{
+		OpName: "getDashboard",
+		Query:  getDashboard_Operation,
+		Variables: &__getDashboardInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data getMonitorV2ActionResponse
+	var data getDashboardResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -16772,54 +20368,80 @@ func getMonitorV2Action(
 	return &data, err
 }
 
-// The query or mutation executed by getMonitorV2Destination.
-const getMonitorV2Destination_Operation = `
-query getMonitorV2Destination ($id: ObjectId!) {
-	monitorV2Destination(id: $id) {
-		... MonitorV2Destination
+// The query or mutation executed by getDashboardLink.
+const getDashboardLink_Operation = `
+query getDashboardLink ($id: ObjectId!) {
+	dashboardLink(id: $id) {
+		... DashboardLink
 	}
 }
-fragment MonitorV2Destination on MonitorV2Destination {
-	inline
-	type
-	email {
-		... MonitorV2EmailDestination
-	}
-	webhook {
-		... MonitorV2WebhookDestination
-	}
+fragment DashboardLink on DashboardLink {
 	id
-	workspaceId
 	name
-	iconUrl
+	workspaceId
+	folderId
+	managedById
 	description
-	createdBy
+	fromDashboard: fromDashboardId
+	toDashboard: toDashboardId
+	fromCard
+	linkLabel
 }
-fragment MonitorV2EmailDestination on MonitorV2EmailDestination {
-	users
-	addresses
+`
+
+func getDashboardLink(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getDashboardLinkResponse, error) {
+	req := &graphql.Request{
+		OpName: "getDashboardLink",
+		Query:  getDashboardLink_Operation,
+		Variables: &__getDashboardLinkInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getDashboardLinkResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
 }
-fragment MonitorV2WebhookDestination on MonitorV2WebhookDestination {
-	url
-	method
+
+// The query or mutation executed by getDashboardOwner.
+const getDashboardOwner_Operation = `
+query getDashboardOwner ($id: ObjectId!) {
+	object: dashboard(id: $id) {
+		id
+		name
+		workspaceId
+		createdBy
+	}
 }
 `
 
-func getMonitorV2Destination(
+func getDashboardOwner(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getMonitorV2DestinationResponse, error) {
+) (*getDashboardOwnerResponse, error) {
 	req := &graphql.Request{
-		OpName: "getMonitorV2Destination",
-		Query:  getMonitorV2Destination_Operation,
-		Variables: &__getMonitorV2DestinationInput{
+		OpName: "getDashboardOwner",
+		Query:  getDashboardOwner_Operation,
+		Variables: &__getDashboardOwnerInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data getMonitorV2DestinationResponse
+	var data getDashboardOwnerResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -16831,135 +20453,120 @@ func getMonitorV2Destination(
 	return &data, err
 }
 
-// The query or mutation executed by getPoller.
-const getPoller_Operation = `
-query getPoller ($id: ObjectId!) {
-	poller(id: $id) {
-		... Poller
+// The query or mutation executed by getDataset.
+const getDataset_Operation = `
+query getDataset ($id: ObjectId!) {
+	dataset(id: $id) {
+		... Dataset
 	}
 }
-fragment Poller on Poller {
-	id
+fragment Dataset on Dataset {
 	workspaceId
-	customerId
-	datastreamId
-	disabled
-	kind
-	config {
-		__typename
-		name
-		retries
-		interval
-		tags
-		chunk {
-			enabled
-			size
-		}
-		... on PollerPubSubConfig {
-			projectId
-			jsonKey
-			subscriptionId
+	id
+	name
+	freshnessDesired
+	description
+	iconUrl
+	accelerationDisabled
+	version
+	updatedDate
+	pathCost
+	source
+	managedById
+	onDemandMaterializationLength
+	accelerable
+	hibernated
+	compilationError {
+		error
+		errorInDatasetId
+	}
+	accelerationInfo {
+		state
+		stalenessSeconds
+		targetStalenessSeconds
+		errors {
+			errorText
 		}
-		... on PollerHTTPConfig {
-			method
-			body
-			endpoint
-			contentType
-			headers
-			template {
-				... HttpRequestConfig
-			}
-			requests {
-				... HttpRequestConfig
-			}
-			rules {
-				match {
-					... HttpRequestConfig
-				}
-				follow
-				decoder {
-					type
+	}
+	foreignKeys {
+		label
+		targetDataset
+		srcFields
+		dstFields
+	}
+	transform {
+		current {
+			query {
+				outputStage
+				stages {
+					... StageQuery
 				}
 			}
-			timestamps {
-				name
-				source
-				format
-				offset
-				truncate
-			}
-		}
-		... on PollerGCPMonitoringConfig {
-			projectId
-			jsonKey
-			includeMetricTypePrefixes
-			excludeMetricTypePrefixes
-			rateLimit
-			totalLimit
-		}
-		... on PollerMongoDBAtlasConfig {
-			publicKey
-			privateKey
-			includeGroups
-			excludeGroups
 		}
-		... on PollerCloudWatchMetricsConfig {
-			period
-			delay
-			region
-			assumeRoleArn
-			queries {
-				namespace
-				metricNames
-				dimensions {
-					name
-					value
-				}
-				resourceFilter {
-					resourceType
-					pattern
-					dimensionName
-					tagFilters {
-						key
-						values
-					}
+	}
+	typedef {
+		label
+		def {
+			anykey
+			fields {
+				name
+				type {
+					rep
+					nullable
 				}
+				isEnum
+				isSearchable
+				isHidden
+				isConst
+				isMetric
 			}
 		}
-		... on PollerAWSSnapshotConfig {
-			region
-			assumeRoleArn
-			includeActions
+	}
+	sourceTable {
+		schema
+		partitions {
+			name
+		}
+		sourceUpdateTableName
+		isInsertOnly
+		batchSeqField
+		validFromField
+		fields {
+			name
+			sqlType
 		}
 	}
 }
-fragment HttpRequestConfig on PollerHTTPRequestConfig {
-	url
-	method
-	username
-	password
-	authScheme
-	body
-	headers
+fragment StageQuery on StageQuery {
+	id
+	pipeline
 	params
+	layout
+	input {
+		inputName
+		inputRole
+		datasetId
+		datasetPath
+		stageId
+	}
 }
 `
 
-func getPoller(
+func getDataset(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getPollerResponse, error) {
+) (*getDatasetResponse, error) {
 	req := &graphql.Request{
-		OpName: "getPoller",
-		Query:  getPoller_Operation,
-		Variables: &__getPollerInput{
+		OpName: "getDataset",
+		Query:  getDataset_Operation,
+		Variables: &__getDatasetInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data getPollerResponse
+	var data getDatasetResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -16971,51 +20578,38 @@ func getPoller(
 	return &data, err
 }
 
-// The query or mutation executed by getPreferredPath.
-const getPreferredPath_Operation = `
-query getPreferredPath ($id: ObjectId!) {
-	preferredPathWithStatus: preferredPath(id: $id) {
-		... PreferredPathWithStatus
-	}
-}
-fragment PreferredPathWithStatus on PreferredPathWithStatus {
-	path {
-		... PreferredPath
+// The query or mutation executed by getDatasetBillingInfo.
+const getDatasetBillingInfo_Operation = `
+query getDatasetBillingInfo ($workspaceId: ObjectId!) {
+	billingInfo: datasetAndMonitorBillingInfo(workspaceId: $workspaceId) {
+		datasets24h {
+			... ObjectBillingInfo
+		}
 	}
-	error
 }
-fragment PreferredPath on PreferredPath {
+fragment ObjectBillingInfo on ObjectBillingInfo {
 	id
-	name
-	iconUrl
-	description
-	workspaceId
-	folderId
-	sourceDataset
-	path {
-		linkId
-		reverse
-		linkName
-		reverseFromDataset
-	}
+	periodFrom
+	periodTo
+	credits
 }
 `
 
-func getPreferredPath(
+func getDatasetBillingInfo(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*getPreferredPathResponse, error) {
+	workspaceId string,
+) (*getDatasetBillingInfoResponse, error) {
 	req := &graphql.Request{
-		OpName: "getPreferredPath",
-		Query:  getPreferredPath_Operation,
-		Variables: &__getPreferredPathInput{
-			Id: id,
+		OpName: "getDatasetBillingInfo",
+		Query:  getDatasetBillingInfo_Operation,
+		Variables: &__getDatasetBillingInfoInput{
+			WorkspaceId: workspaceId,
 		},
 	}
 	var err error
 
-	var data getPreferredPathResponse
+	var data getDatasetBillingInfoResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -17027,31 +20621,36 @@ func getPreferredPath(
 	return &data, err
 }
 
-// The query or mutation executed by getRbacDefaultGroup.
-const getRbacDefaultGroup_Operation = `
-query getRbacDefaultGroup {
-	rbacDefaultGroup {
-		... RbacGroup
+// The query or mutation executed by getDatasetCorrelationTags.
+const getDatasetCorrelationTags_Operation = `
+query getDatasetCorrelationTags ($datasetId: ObjectId!) {
+	correlationTags: dataset(id: $datasetId) {
+		correlationTagMappings {
+			tag
+			path {
+				column
+				path
+			}
+		}
 	}
 }
-fragment RbacGroup on RbacGroup {
-	id
-	name
-	description
-}
 `
 
-func getRbacDefaultGroup(
+func getDatasetCorrelationTags(
 	ctx context.Context,
 	client graphql.Client,
-) (*getRbacDefaultGroupResponse, error) {
+	datasetId string,
+) (*getDatasetCorrelationTagsResponse, error) {
 	req := &graphql.Request{
-		OpName: "getRbacDefaultGroup",
-		Query:  getRbacDefaultGroup_Operation,
+		OpName: "getDatasetCorrelationTags",
+		Query:  getDatasetCorrelationTags_Operation,
+		Variables: &__getDatasetCorrelationTagsInput{
+			DatasetId: datasetId,
+		},
 	}
 	var err error
 
-	var data getRbacDefaultGroupResponse
+	var data getDatasetCorrelationTagsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -17063,35 +20662,69 @@ func getRbacDefaultGroup(
 	return &data, err
 }
 
-// The query or mutation executed by getRbacGroup.
-const getRbacGroup_Operation = `
-query getRbacGroup ($id: ORN!) {
-	rbacGroup(id: $id) {
-		... RbacGroup
+// The query or mutation executed by getDatasetDoctorReport.
+const getDatasetDoctorReport_Operation = `
+query getDatasetDoctorReport ($id: ObjectId!, $upLevels: Int) {
+	report: datasetDoctor(dsid: $id, upLevels: $upLevels) {
+		doctorForDataset
+		datasets {
+			... DatasetReport
+		}
 	}
 }
-fragment RbacGroup on RbacGroup {
-	id
-	name
-	description
+fragment DatasetReport on DatasetReport {
+	datasetId
+	datasetLabel
+	workspaceId
+	doctorComments
+	inputDatasets
+	stageNotes {
+		stageId
+		errors {
+			comment
+		}
+		warnings {
+			symbol {
+				comment
+			}
+		}
+	}
+	accelerationInfo {
+		state
+		stalenessSeconds
+		targetStalenessSeconds
+		errors {
+			errorText
+		}
+	}
+	ongoingErrorReason {
+		text
+		time
+	}
+	backfillErrorReason {
+		text
+		time
+	}
 }
 `
 
-func getRbacGroup(
+func getDatasetDoctorReport(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getRbacGroupResponse, error) {
+	upLevels *int,
+) (*getDatasetDoctorReportResponse, error) {
 	req := &graphql.Request{
-		OpName: "getRbacGroup",
-		Query:  getRbacGroup_Operation,
-		Variables: &__getRbacGroupInput{
-			Id: id,
+		OpName: "getDatasetDoctorReport",
+		Query:  getDatasetDoctorReport_Operation,
+		Variables: &__getDatasetDoctorReportInput{
+			Id:       id,
+			UpLevels: upLevels,
 		},
 	}
 	var err error
 
-	var data getRbacGroupResponse
+	var data getDatasetDoctorReportResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -17103,37 +20736,46 @@ func getRbacGroup(
 	return &data, err
 }
 
-// The query or mutation executed by getRbacGroupmember.
-const getRbacGroupmember_Operation = `
-query getRbacGroupmember ($id: ORN!) {
-	rbacGroupmember(id: $id) {
-		... RbacGroupmember
+// The query or mutation executed by getDatasetOutboundShare.
+const getDatasetOutboundShare_Operation = `
+query getDatasetOutboundShare ($id: ObjectId!) {
+	datasetOutboundShare(id: $id) {
+		... DatasetOutboundShare
 	}
 }
-fragment RbacGroupmember on RbacGroupmember {
+fragment DatasetOutboundShare on DatasetOutboundShare {
 	id
+	name
 	description
-	groupId
-	memberUserId
-	memberGroupId
+	workspaceId
+	folderId
+	datasetID
+	outboundShareID
+	schemaName
+	viewName
+	freshnessGoal
+	status {
+		state
+		error
+	}
 }
 `
 
-func getRbacGroupmember(
+func getDatasetOutboundShare(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getRbacGroupmemberResponse, error) {
+) (*getDatasetOutboundShareResponse, error) {
 	req := &graphql.Request{
-		OpName: "getRbacGroupmember",
-		Query:  getRbacGroupmember_Operation,
-		Variables: &__getRbacGroupmemberInput{
+		OpName: "getDatasetOutboundShare",
+		Query:  getDatasetOutboundShare_Operation,
+		Variables: &__getDatasetOutboundShareInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data getRbacGroupmemberResponse
+	var data getDatasetOutboundShareResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -17145,31 +20787,33 @@ func getRbacGroupmember(
 	return &data, err
 }
 
-// The query or mutation executed by getRbacGroups.
-const getRbacGroups_Operation = `
-query getRbacGroups {
-	rbacGroups {
-		... RbacGroup
+// The query or mutation executed by getDatasetOwner.
+const getDatasetOwner_Operation = `
+query getDatasetOwner ($id: ObjectId!) {
+	object: dataset(id: $id) {
+		id
+		name
+		workspaceId
+		createdBy
 	}
 }
-fragment RbacGroup on RbacGroup {
-	id
-	name
-	description
-}
 `
 
-func getRbacGroups(
+func getDatasetOwner(
 	ctx context.Context,
 	client graphql.Client,
-) (*getRbacGroupsResponse, error) {
+	id string,
+) (*getDatasetOwnerResponse, error) {
 	req := &graphql.Request{
-		OpName: "getRbacGroups",
-		Query:  getRbacGroups_Operation,
+		OpName: "getDatasetOwner",
+		Query:  getDatasetOwner_Operation,
+		Variables: &__getDatasetOwnerInput{
+			Id: id,
+		},
 	}
 	var err error
 
-	var data getRbacGroupsResponse
+	var data getDatasetOwnerResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -17181,49 +20825,45 @@ func getRbacGroups(
 	return &data, err
 }
 
-// The query or mutation executed by getRbacStatement.
-const getRbacStatement_Operation = `
-query getRbacStatement ($id: ORN!) {
-	rbacStatement(id: $id) {
-		... RbacStatement
+// The query or mutation executed by getDatasetQueryOutput.
+const getDatasetQueryOutput_Operation = `
+query getDatasetQueryOutput ($query: [StageInput!]!, $params: QueryParams!, $parameterValues: [ParameterBindingInput!]) {
+	taskResult: datasetQueryOutput(query: $query, params: $params, parameterValues: $parameterValues) {
+		... TaskResult
 	}
 }
-fragment RbacStatement on RbacStatement {
-	id
-	description
-	subject {
-		userId
-		groupId
-		all
-	}
-	object {
-		objectId
-		folderId
-		workspaceId
-		type
-		name
-		owner
-		all
+fragment TaskResult on TaskResult {
+	queryId
+	stageId
+	startTime
+	endTime
+	resultCursor
+	paginatedResults
+	resultSchema {
+		typedefDefinition
 	}
-	role
 }
 `
 
-func getRbacStatement(
+func getDatasetQueryOutput(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*getRbacStatementResponse, error) {
+	query []*StageInput,
+	params QueryParams,
+	parameterValues []ParameterBindingInput,
+) (*getDatasetQueryOutputResponse, error) {
 	req := &graphql.Request{
-		OpName: "getRbacStatement",
-		Query:  getRbacStatement_Operation,
-		Variables: &__getRbacStatementInput{
-			Id: id,
+		OpName: "getDatasetQueryOutput",
+		Query:  getDatasetQueryOutput_Operation,
+		Variables: &__getDatasetQueryOutputInput{
+			Query:           query,
+			Params:          params,
+			ParameterValues: parameterValues,
 		},
 	}
 	var err error
 
-	var data getRbacStatementResponse
+	var data getDatasetQueryOutputResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -17235,44 +20875,38 @@ func getRbacStatement(
 	return &data, err
 }
 
-// The query or mutation executed by getSnowflakeOutboundShare.
-const getSnowflakeOutboundShare_Operation = `
-query getSnowflakeOutboundShare ($id: ObjectId!) {
-	share: snowflakeOutboundShare(id: $id) {
-		... SnowflakeOutboundShare
+// The query or mutation executed by getDatastream.
+const getDatastream_Operation = `
+query getDatastream ($id: ObjectId!) {
+	datastream(id: $id) {
+		... Datastream
 	}
 }
-fragment SnowflakeOutboundShare on SnowflakeOutboundShare {
+fragment Datastream on Datastream {
 	id
 	name
+	iconUrl
 	description
 	workspaceId
-	shareName
-	accounts {
-		... SnowflakeAccount
-	}
-}
-fragment SnowflakeAccount on SnowflakeAccount {
-	organization
-	account
+	datasetId
 }
 `
 
-func getSnowflakeOutboundShare(
+func getDatastream(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getSnowflakeOutboundShareResponse, error) {
+) (*getDatastreamResponse, error) {
 	req := &graphql.Request{
-		OpName: "getSnowflakeOutboundShare",
-		Query:  getSnowflakeOutboundShare_Operation,
-		Variables: &__getSnowflakeOutboundShareInput{
+		OpName: "getDatastream",
+		Query:  getDatastream_Operation,
+		Variables: &__getDatastreamInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data getSnowflakeOutboundShareResponse
+	var data getDatastreamResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -17284,38 +20918,38 @@ func getSnowflakeOutboundShare(
 	return &data, err
 }
 
-// The query or mutation executed by getTerraform.
-const getTerraform_Operation = `
-query getTerraform ($id: ObjectId!, $ty: TerraformObjectType!) {
-	terraform: getTerraform(id: $id, type: $ty) {
-		... TerraformDefinition
+// The query or mutation executed by getDatastreamToken.
+const getDatastreamToken_Operation = `
+query getDatastreamToken ($id: String!) {
+	datastreamToken(id: $id) {
+		... DatastreamToken
 	}
 }
-fragment TerraformDefinition on TerraformDefinition {
-	dataSource
-	resource
-	importId
-	importName
+fragment DatastreamToken on DatastreamToken {
+	id
+	name
+	description
+	disabled
+	datastreamId
+	secret
 }
 `
 
-func getTerraform(
+func getDatastreamToken(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-	ty TerraformObjectType,
-) (*getTerraformResponse, error) {
+) (*getDatastreamTokenResponse, error) {
 	req := &graphql.Request{
-		OpName: "getTerraform",
-		Query:  getTerraform_Operation,
-		Variables: &__getTerraformInput{
+		OpName: "getDatastreamToken",
+		Query:  getDatastreamToken_Operation,
+		Variables: &__getDatastreamTokenInput{
 			Id: id,
-			Ty: ty,
 		},
 	}
 	var err error
 
-	var data getTerraformResponse
+	var data getDatastreamTokenResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -17327,35 +20961,28 @@ func getTerraform(
 	return &data, err
 }
 
-// The query or mutation executed by getUser.
-const getUser_Operation = `
-query getUser ($id: UserId!) {
-	user(id: $id) {
-		... User
-	}
-}
-fragment User on User {
-	id
-	email
-	comment
+// The query or mutation executed by getDefaultDashboard.
+const getDefaultDashboard_Operation = `
+query getDefaultDashboard ($dsid: ObjectId!) {
+	defaultDashboard(dsid: $dsid)
 }
 `
 
-func getUser(
+func getDefaultDashboard(
 	ctx context.Context,
 	client graphql.Client,
-	id types.UserIdScalar,
-) (*getUserResponse, error) {
+	dsid string,
+) (*getDefaultDashboardResponse, error) {
 	req := &graphql.Request{
-		OpName: "getUser",
-		Query:  getUser_Operation,
-		Variables: &__getUserInput{
-			Id: id,
+		OpName: "getDefaultDashboard",
+		Query:  getDefaultDashboard_Operation,
+		Variables: &__getDefaultDashboardInput{
+			Dsid: dsid,
 		},
 	}
 	var err error
 
-	var data getUserResponse
+	var data getDefaultDashboardResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -17367,53 +20994,50 @@ func getUser(
 	return &data, err
 }
 
-// The query or mutation executed by getWorksheet.
-const getWorksheet_Operation = `
-query getWorksheet ($id: ObjectId!) {
-	worksheet(id: $id) {
-		... Worksheet
+// The query or mutation executed by getDeferredForeignKey.
+const getDeferredForeignKey_Operation = `
+query getDeferredForeignKey ($id: ObjectId!) {
+	deferredForeignKey(id: $id) {
+		... DeferredForeignKey
 	}
 }
-fragment Worksheet on Worksheet {
+fragment DeferredForeignKey on DeferredForeignKey {
 	id
-	label
-	icon
-	managedById
 	workspaceId
-	stages {
-		... StageQuery
+	sourceDataset {
+		datasetId
 	}
-}
-fragment StageQuery on StageQuery {
-	id
-	pipeline
-	params
-	layout
-	input {
-		inputName
-		inputRole
+	targetDataset {
 		datasetId
-		datasetPath
-		stageId
+	}
+	srcFields
+	dstFields
+	label
+	resolution {
+		sourceId
+		targetId
+	}
+	status {
+		errorText
 	}
 }
 `
 
-func getWorksheet(
+func getDeferredForeignKey(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getWorksheetResponse, error) {
+) (*getDeferredForeignKeyResponse, error) {
 	req := &graphql.Request{
-		OpName: "getWorksheet",
-		Query:  getWorksheet_Operation,
-		Variables: &__getWorksheetInput{
-			Id: id,
-		},
+		OpName: "getDeferredForeignKey",
+		Query:  getDeferredForeignKey_Operation,
+		Variables: &__getDeferredForeignKeyInput{
+			Id: id,
+		},
 	}
 	var err error
 
-	var data getWorksheetResponse
+	var data getDeferredForeignKeyResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -17425,34 +21049,97 @@ func getWorksheet(
 	return &data, err
 }
 
-// The query or mutation executed by getWorkspace.
-const getWorkspace_Operation = `
-query getWorkspace ($id: ObjectId!) {
-	workspace(id: $id) {
-		... Workspace
+// The query or mutation executed by getFeatureFlag.
+const getFeatureFlag_Operation = `
+query getFeatureFlag ($name: String!) {
+	featureFlag(name: $name) {
+		... FeatureFlag
 	}
 }
-fragment Workspace on Project {
+fragment FeatureFlag on FeatureFlag {
+	name
+	description
+	isEnabled
+	isSelfServiceEnabled
+}
+`
+
+func getFeatureFlag(
+	ctx context.Context,
+	client graphql.Client,
+	name string,
+) (*getFeatureFlagResponse, error) {
+	req := &graphql.Request{
+		OpName: "getFeatureFlag",
+		Query:  getFeatureFlag_Operation,
+		Variables: &__getFeatureFlagInput{
+			Name: name,
+		},
+	}
+	var err error
+
+	var data getFeatureFlagResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getFiledrop.
+const getFiledrop_Operation = `
+query getFiledrop ($id: ObjectId!) {
+	filedrop(id: $id) {
+		... Filedrop
+	}
+}
+fragment Filedrop on Filedrop {
 	id
-	label
+	name
+	iconUrl
+	description
+	workspaceId
+	status
+	datastreamID
+	config {
+		provider {
+			__typename
+			... on FiledropProviderAwsConfig {
+				region
+				roleArn
+			}
+		}
+	}
+	endpoint {
+		__typename
+		... on FiledropS3Endpoint {
+			arn
+			bucket
+			prefix
+		}
+	}
 }
 `
 
-func getWorkspace(
+func getFiledrop(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getWorkspaceResponse, error) {
+) (*getFiledropResponse, error) {
 	req := &graphql.Request{
-		OpName: "getWorkspace",
-		Query:  getWorkspace_Operation,
-		Variables: &__getWorkspaceInput{
+		OpName: "getFiledrop",
+		Query:  getFiledrop_Operation,
+		Variables: &__getFiledropInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data getWorkspaceResponse
+	var data getFiledropResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -17464,104 +21151,3014 @@ func getWorkspace(
 	return &data, err
 }
 
-// The query or mutation executed by listDatasets.
-const listDatasets_Operation = `
-query listDatasets {
-	datasets: projects {
-		datasets {
-			... Dataset
-		}
+// The query or mutation executed by getFolder.
+const getFolder_Operation = `
+query getFolder ($id: ObjectId!) {
+	folder(id: $id) {
+		... Folder
 	}
 }
-fragment Dataset on Dataset {
+fragment Folder on Folder {
+	id
+	name
+	iconUrl
+	description
+	workspaceId
+}
+`
+
+func getFolder(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getFolderResponse, error) {
+	req := &graphql.Request{
+		OpName: "getFolder",
+		Query:  getFolder_Operation,
+		Variables: &__getFolderInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getFolderResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getFolderOwner.
+const getFolderOwner_Operation = `
+query getFolderOwner ($id: ObjectId!) {
+	object: folder(id: $id) {
+		id
+		name
+		workspaceId
+		createdBy
+	}
+}
+`
+
+func getFolderOwner(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getFolderOwnerResponse, error) {
+	req := &graphql.Request{
+		OpName: "getFolderOwner",
+		Query:  getFolderOwner_Operation,
+		Variables: &__getFolderOwnerInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getFolderOwnerResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getIncident.
+const getIncident_Operation = `
+query getIncident ($id: ObjectId!) {
+	incident(id: $id) {
+		... Incident
+	}
+}
+fragment Incident on Incident {
+	id
+	name
+	description
+	iconUrl
+	workspaceId
+	folderId
+	status
+	inactiveTime
+	closedTime
+	users {
+		userId
+	}
+	slackChannels {
+		connectionID
+		slackchannelID
+	}
+	worksheets
+	dashboards
+}
+`
+
+func getIncident(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getIncidentResponse, error) {
+	req := &graphql.Request{
+		OpName: "getIncident",
+		Query:  getIncident_Operation,
+		Variables: &__getIncidentInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getIncidentResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getIncidentsForStatus.
+const getIncidentsForStatus_Operation = `
+query getIncidentsForStatus ($status: IncidentStatus!, $startingAt: Time, $endingAt: Time) {
+	incidents: getIncidentsForStatus(s: $status, startingAt: $startingAt, endingAt: $endingAt) {
+		... Incident
+	}
+}
+fragment Incident on Incident {
+	id
+	name
+	description
+	iconUrl
 	workspaceId
+	folderId
+	status
+	inactiveTime
+	closedTime
+	users {
+		userId
+	}
+	slackChannels {
+		connectionID
+		slackchannelID
+	}
+	worksheets
+	dashboards
+}
+`
+
+func getIncidentsForStatus(
+	ctx context.Context,
+	client graphql.Client,
+	status IncidentStatus,
+	startingAt *types.TimeScalar,
+	endingAt *types.TimeScalar,
+) (*getIncidentsForStatusResponse, error) {
+	req := &graphql.Request{
+		OpName: "getIncidentsForStatus",
+		Query:  getIncidentsForStatus_Operation,
+		Variables: &__getIncidentsForStatusInput{
+			Status:     status,
+			StartingAt: startingAt,
+			EndingAt:   endingAt,
+		},
+	}
+	var err error
+
+	var data getIncidentsForStatusResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getIncidentsForTextQuery.
+const getIncidentsForTextQuery_Operation = `
+query getIncidentsForTextQuery ($query: IncidentQueryInput!) {
+	incidents: getIncidentsForTextQuery(s: $query) {
+		... Incident
+	}
+}
+fragment Incident on Incident {
 	id
 	name
-	freshnessDesired
 	description
 	iconUrl
-	accelerationDisabled
-	version
-	updatedDate
-	pathCost
-	source
-	managedById
-	onDemandMaterializationLength
-	foreignKeys {
-		label
-		targetDataset
-		srcFields
-		dstFields
+	workspaceId
+	folderId
+	status
+	inactiveTime
+	closedTime
+	users {
+		userId
 	}
-	transform {
-		current {
-			query {
-				outputStage
-				stages {
-					... StageQuery
-				}
-			}
-		}
+	slackChannels {
+		connectionID
+		slackchannelID
+	}
+	worksheets
+	dashboards
+}
+`
+
+func getIncidentsForTextQuery(
+	ctx context.Context,
+	client graphql.Client,
+	query IncidentQueryInput,
+) (*getIncidentsForTextQueryResponse, error) {
+	req := &graphql.Request{
+		OpName: "getIncidentsForTextQuery",
+		Query:  getIncidentsForTextQuery_Operation,
+		Variables: &__getIncidentsForTextQueryInput{
+			Query: query,
+		},
+	}
+	var err error
+
+	var data getIncidentsForTextQueryResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getIngestInfo.
+const getIngestInfo_Operation = `
+query getIngestInfo {
+	ingest: currentCustomer {
+		ingestInfo {
+			... IngestInfo
+		}
+	}
+}
+fragment IngestInfo on IngestInfo {
+	collectUrl
+	domain
+	scheme
+	port
+}
+`
+
+func getIngestInfo(
+	ctx context.Context,
+	client graphql.Client,
+) (*getIngestInfoResponse, error) {
+	req := &graphql.Request{
+		OpName: "getIngestInfo",
+		Query:  getIngestInfo_Operation,
+	}
+	var err error
+
+	var data getIngestInfoResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getLayeredSettingRecord.
+const getLayeredSettingRecord_Operation = `
+query getLayeredSettingRecord ($id: ObjectId!) {
+	layeredSettingRecord(id: $id) {
+		... LayeredSettingRecord
+	}
+}
+fragment LayeredSettingRecord on LayeredSettingRecord {
+	settingAndTargetScope {
+		... SettingAndTargetScope
+	}
+	value {
+		... PrimitiveValue
+	}
+	id
+	name
+	description
+	iconUrl
+	workspaceId
+	managedById
+	folderId
+}
+fragment SettingAndTargetScope on SettingAndTargetScope {
+	setting
+	target {
+		... LayeredSettingRecordTarget
+	}
+}
+fragment PrimitiveValue on PrimitiveValue {
+	bool
+	float64
+	int64
+	string
+	timestamp
+	duration
+}
+fragment LayeredSettingRecordTarget on LayeredSettingRecordTarget {
+	customerId
+	workspaceId
+	folderId
+	appId
+	monitorId
+	worksheetId
+	dashboardId
+	datasetId
+	datastreamId
+	userId
+}
+`
+
+func getLayeredSettingRecord(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getLayeredSettingRecordResponse, error) {
+	req := &graphql.Request{
+		OpName: "getLayeredSettingRecord",
+		Query:  getLayeredSettingRecord_Operation,
+		Variables: &__getLayeredSettingRecordInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getLayeredSettingRecordResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getMonitor.
+const getMonitor_Operation = `
+query getMonitor ($id: ObjectId!) {
+	monitor(id: $id) {
+		... Monitor
+	}
+}
+fragment Monitor on Monitor {
+	workspaceId
+	id
+	name
+	description
+	comment
+	iconUrl
+	isTemplate
+	disabled
+	freshnessGoal
+	useDefaultFreshness
+	source
+	definition
+	managedById
+	query {
+		outputStage
+		stages {
+			... StageQuery
+		}
+	}
+	rule {
+		__typename
+		sourceColumn
+		groupByGroups {
+			groupName
+			columns
+		}
+		... on MonitorRuleCount {
+			compareFunction
+			compareValues
+			lookbackTime
+		}
+		... on MonitorRuleChange {
+			changeType
+			compareFunction
+			compareValues
+			aggregateFunction
+			lookbackTime
+			baselineTime
+		}
+		... on MonitorRuleFacet {
+			facetFunction
+			facetValues
+			timeFunction
+			timeValue
+			lookbackTime
+		}
+		... on MonitorRuleThreshold {
+			compareFunction
+			compareValues
+			lookbackTime
+			thresholdAggFunction
+		}
+		... on MonitorRulePromote {
+			kindField
+			descriptionField
+			primaryKey
+		}
+		... on MonitorRuleLog {
+			compareFunction
+			compareValues
+			lookbackTime
+			expressionSummary
+			logStageId
+			sourceLogDatasetId
+		}
+	}
+	notificationSpec {
+		merge
+		importance
+		notifyOnReminder
+		reminderFrequency
+		notifyOnClose
+	}
+}
+fragment StageQuery on StageQuery {
+	id
+	pipeline
+	params
+	layout
+	input {
+		inputName
+		inputRole
+		datasetId
+		datasetPath
+		stageId
+	}
+}
+`
+
+func getMonitor(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getMonitorResponse, error) {
+	req := &graphql.Request{
+		OpName: "getMonitor",
+		Query:  getMonitor_Operation,
+		Variables: &__getMonitorInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getMonitorResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getMonitorAction.
+const getMonitorAction_Operation = `
+query getMonitorAction ($id: ObjectId!) {
+	monitorAction(id: $id) {
+		__typename
+		... MonitorAction
+	}
+}
+fragment MonitorAction on MonitorAction {
+	id
+	name
+	iconUrl
+	description
+	workspaceId
+	rateLimit
+	notifyOnClose
+	isPrivate
+	__typename
+	... on EmailAction {
+		targetAddresses
+		subjectTemplate
+		bodyTemplate
+		isHtml
+	}
+	... on WebhookAction {
+		urlTemplate
+		method
+		headers {
+			header
+			valueTemplate
+		}
+		bodyTemplate
+	}
+}
+`
+
+func getMonitorAction(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getMonitorActionResponse, error) {
+	req := &graphql.Request{
+		OpName: "getMonitorAction",
+		Query:  getMonitorAction_Operation,
+		Variables: &__getMonitorActionInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getMonitorActionResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getMonitorActionAttachment.
+const getMonitorActionAttachment_Operation = `
+query getMonitorActionAttachment ($id: ObjectId!) {
+	monitorActionAttachment(id: $id) {
+		... MonitorActionAttachment
+	}
+}
+fragment MonitorActionAttachment on MonitorActionAttachment {
+	monitorID
+	actionID
+	id
+	workspaceId
+	name
+	iconUrl
+	description
+}
+`
+
+func getMonitorActionAttachment(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getMonitorActionAttachmentResponse, error) {
+	req := &graphql.Request{
+		OpName: "getMonitorActionAttachment",
+		Query:  getMonitorActionAttachment_Operation,
+		Variables: &__getMonitorActionAttachmentInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getMonitorActionAttachmentResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getMonitorMuteRule.
+const getMonitorMuteRule_Operation = `
+query getMonitorMuteRule ($id: ObjectId!) {
+	monitorMuteRule(id: $id) {
+		... MonitorMuteRule
+	}
+}
+fragment MonitorMuteRule on MonitorMuteRule {
+	id
+	workspaceId
+	folderId
+	name
+	iconUrl
+	description
+	startDate
+	duration
+	filter {
+		__typename
+		filterType
+		... on MonitorMuteRuleFilterPerColumn {
+			columnID
+			values
+		}
+		... on MonitorMuteRuleFilterPerResource {
+			resourceIds {
+				datasetId
+				primaryKeyValue {
+					name
+					value
+				}
+			}
+		}
+	}
+}
+`
+
+func getMonitorMuteRule(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getMonitorMuteRuleResponse, error) {
+	req := &graphql.Request{
+		OpName: "getMonitorMuteRule",
+		Query:  getMonitorMuteRule_Operation,
+		Variables: &__getMonitorMuteRuleInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getMonitorMuteRuleResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getMonitorV2.
+const getMonitorV2_Operation = `
+query getMonitorV2 ($id: ObjectId!) {
+	monitorV2(id: $id) {
+		... MonitorV2
+	}
+}
+fragment MonitorV2 on MonitorV2 {
+	id
+	workspaceId
+	createdBy
+	createdDate
+	name
+	iconUrl
+	description
+	managedById
+	folderId
+	rollupStatus
+	ruleKind
+	definition {
+		... MonitorV2Definition
+	}
+	actionRules {
+		... MonitorV2ActionRule
+	}
+}
+fragment MonitorV2Definition on MonitorV2Definition {
+	inputQuery {
+		outputStage
+		stages {
+			... StageQuery
+		}
+	}
+	rules {
+		... MonitorV2Rule
+	}
+	lookbackTime
+	dataStabilizationDelay
+	groupings {
+		... MonitorV2Column
+	}
+	scheduling {
+		... MonitorV2Scheduling
+	}
+}
+fragment MonitorV2ActionRule on MonitorV2ActionRule {
+	actionID
+	levels
+	sendEndNotifications
+	sendRemindersInterval
+}
+fragment StageQuery on StageQuery {
+	id
+	pipeline
+	params
+	layout
+	input {
+		inputName
+		inputRole
+		datasetId
+		datasetPath
+		stageId
+	}
+}
+fragment MonitorV2Rule on MonitorV2Rule {
+	level
+	count {
+		... MonitorV2CountRule
+	}
+	threshold {
+		... MonitorV2ThresholdRule
+	}
+	promote {
+		... MonitorV2PromoteRule
+	}
+}
+fragment MonitorV2Column on MonitorV2Column {
+	linkColumn {
+		... MonitorV2LinkColumn
+	}
+	columnPath {
+		... MonitorV2ColumnPath
+	}
+}
+fragment MonitorV2Scheduling on MonitorV2Scheduling {
+	interval {
+		... MonitorV2IntervalSchedule
+	}
+	transform {
+		... MonitorV2TransformSchedule
+	}
+}
+fragment MonitorV2CountRule on MonitorV2CountRule {
+	compareValues {
+		... MonitorV2Comparison
+	}
+	compareGroups {
+		... MonitorV2ColumnComparison
+	}
+}
+fragment MonitorV2ThresholdRule on MonitorV2ThresholdRule {
+	compareValues {
+		... MonitorV2Comparison
+	}
+	valueColumnName
+	aggregation
+	compareGroups {
+		... MonitorV2ColumnComparison
+	}
+}
+fragment MonitorV2PromoteRule on MonitorV2PromoteRule {
+	compareColumns {
+		... MonitorV2ColumnComparison
+	}
+}
+fragment MonitorV2LinkColumn on MonitorV2LinkColumn {
+	name
+	meta {
+		... MonitorV2LinkColumnMeta
+	}
+}
+fragment MonitorV2ColumnPath on MonitorV2ColumnPath {
+	name
+	path
+}
+fragment MonitorV2IntervalSchedule on MonitorV2IntervalSchedule {
+	interval
+	randomize
+}
+fragment MonitorV2TransformSchedule on MonitorV2TransformSchedule {
+	freshnessGoal
+}
+fragment MonitorV2Comparison on MonitorV2Comparison {
+	compareFn
+	compareValue {
+		... PrimitiveValue
+	}
+}
+fragment MonitorV2ColumnComparison on MonitorV2ColumnComparison {
+	column {
+		... MonitorV2Column
+	}
+	compareValues {
+		... MonitorV2Comparison
+	}
+}
+fragment MonitorV2LinkColumnMeta on MonitorV2LinkColumnMeta {
+	srcFields {
+		... MonitorV2ColumnPath
+	}
+	dstFields
+	targetDataset
+}
+fragment PrimitiveValue on PrimitiveValue {
+	bool
+	float64
+	int64
+	string
+	timestamp
+	duration
+}
+`
+
+func getMonitorV2(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getMonitorV2Response, error) {
+	req := &graphql.Request{
+		OpName: "getMonitorV2",
+		Query:  getMonitorV2_Operation,
+		Variables: &__getMonitorV2Input{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getMonitorV2Response
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getMonitorV2Action.
+const getMonitorV2Action_Operation = `
+query getMonitorV2Action ($id: ObjectId!) {
+	monitorV2Action(id: $id) {
+		... MonitorV2Action
+	}
+}
+fragment MonitorV2Action on MonitorV2Action {
+	inline
+	type
+	destinationLinks {
+		... ActionDestinationLink
+	}
+	email {
+		... MonitorV2EmailAction
+	}
+	webhook {
+		... MonitorV2WebhookAction
+	}
+	id
+	workspaceId
+	name
+	iconUrl
+	description
+	createdBy
+	createdDate
+}
+fragment ActionDestinationLink on ActionDestinationLink {
+	destinationID
+	sendEndNotifications
+	sendRemindersInterval
+	definition {
+		... MonitorV2DestinationDefinition
+	}
+}
+fragment MonitorV2EmailAction on MonitorV2EmailAction {
+	users
+	addresses
+	subject
+	body
+	fragments
+}
+fragment MonitorV2WebhookAction on MonitorV2WebhookAction {
+	headers {
+		... MonitorV2WebhookHeader
+	}
+	body
+	fragments
+	url
+	method
+}
+fragment MonitorV2DestinationDefinition on MonitorV2DestinationDefinition {
+	inline
+	type
+	email {
+		... MonitorV2EmailDestination
+	}
+	webhook {
+		... MonitorV2WebhookDestination
+	}
+}
+fragment MonitorV2WebhookHeader on MonitorV2WebhookHeader {
+	header
+	value
+}
+fragment MonitorV2EmailDestination on MonitorV2EmailDestination {
+	users
+	addresses
+}
+fragment MonitorV2WebhookDestination on MonitorV2WebhookDestination {
+	url
+	method
+}
+`
+
+func getMonitorV2Action(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getMonitorV2ActionResponse, error) {
+	req := &graphql.Request{
+		OpName: "getMonitorV2Action",
+		Query:  getMonitorV2Action_Operation,
+		Variables: &__getMonitorV2ActionInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getMonitorV2ActionResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getMonitorV2Destination.
+const getMonitorV2Destination_Operation = `
+query getMonitorV2Destination ($id: ObjectId!) {
+	monitorV2Destination(id: $id) {
+		... MonitorV2Destination
+	}
+}
+fragment MonitorV2Destination on MonitorV2Destination {
+	inline
+	type
+	email {
+		... MonitorV2EmailDestination
+	}
+	webhook {
+		... MonitorV2WebhookDestination
+	}
+	id
+	workspaceId
+	name
+	iconUrl
+	description
+	createdBy
+}
+fragment MonitorV2EmailDestination on MonitorV2EmailDestination {
+	users
+	addresses
+}
+fragment MonitorV2WebhookDestination on MonitorV2WebhookDestination {
+	url
+	method
+}
+`
+
+func getMonitorV2Destination(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getMonitorV2DestinationResponse, error) {
+	req := &graphql.Request{
+		OpName: "getMonitorV2Destination",
+		Query:  getMonitorV2Destination_Operation,
+		Variables: &__getMonitorV2DestinationInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getMonitorV2DestinationResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getMonitorV2Owner.
+const getMonitorV2Owner_Operation = `
+query getMonitorV2Owner ($id: ObjectId!) {
+	object: monitorV2(id: $id) {
+		id
+		name
+		workspaceId
+		createdBy
+	}
+}
+`
+
+func getMonitorV2Owner(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getMonitorV2OwnerResponse, error) {
+	req := &graphql.Request{
+		OpName: "getMonitorV2Owner",
+		Query:  getMonitorV2Owner_Operation,
+		Variables: &__getMonitorV2OwnerInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getMonitorV2OwnerResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getPathsBetweenDatasets.
+const getPathsBetweenDatasets_Operation = `
+query getPathsBetweenDatasets ($from: ObjectId!, $to: ObjectId!, $limit: Int64) {
+	paths: pathsBetweenDatasets(from: $from, to: $to, limit: $limit) {
+		... RelationshipPath
+	}
+}
+fragment RelationshipPath on RelationshipPath {
+	fromDatasetId
+	toDatasetId
+	cost
+	path {
+		toDatasetId
+		forwardKey {
+			id
+			label
+		}
+		reverseKey {
+			label
+		}
+	}
+}
+`
+
+func getPathsBetweenDatasets(
+	ctx context.Context,
+	client graphql.Client,
+	from string,
+	to string,
+	limit *types.Int64Scalar,
+) (*getPathsBetweenDatasetsResponse, error) {
+	req := &graphql.Request{
+		OpName: "getPathsBetweenDatasets",
+		Query:  getPathsBetweenDatasets_Operation,
+		Variables: &__getPathsBetweenDatasetsInput{
+			From:  from,
+			To:    to,
+			Limit: limit,
+		},
+	}
+	var err error
+
+	var data getPathsBetweenDatasetsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getPoller.
+const getPoller_Operation = `
+query getPoller ($id: ObjectId!) {
+	poller(id: $id) {
+		... Poller
+	}
+}
+fragment Poller on Poller {
+	id
+	workspaceId
+	customerId
+	datastreamId
+	disabled
+	kind
+	config {
+		__typename
+		name
+		retries
+		interval
+		tags
+		chunk {
+			enabled
+			size
+		}
+		... on PollerPubSubConfig {
+			projectId
+			jsonKey
+			subscriptionId
+		}
+		... on PollerHTTPConfig {
+			method
+			body
+			endpoint
+			contentType
+			headers
+			template {
+				... HttpRequestConfig
+			}
+			requests {
+				... HttpRequestConfig
+			}
+			rules {
+				match {
+					... HttpRequestConfig
+				}
+				follow
+				decoder {
+					type
+				}
+			}
+			timestamps {
+				name
+				source
+				format
+				offset
+				truncate
+			}
+		}
+		... on PollerGCPMonitoringConfig {
+			projectId
+			jsonKey
+			includeMetricTypePrefixes
+			excludeMetricTypePrefixes
+			rateLimit
+			totalLimit
+		}
+		... on PollerMongoDBAtlasConfig {
+			publicKey
+			privateKey
+			includeGroups
+			excludeGroups
+		}
+		... on PollerCloudWatchMetricsConfig {
+			period
+			delay
+			region
+			assumeRoleArn
+			queries {
+				namespace
+				metricNames
+				dimensions {
+					name
+					value
+				}
+				resourceFilter {
+					resourceType
+					pattern
+					dimensionName
+					tagFilters {
+						key
+						values
+					}
+				}
+			}
+		}
+		... on PollerAWSSnapshotConfig {
+			region
+			assumeRoleArn
+			includeActions
+		}
+	}
+}
+fragment HttpRequestConfig on PollerHTTPRequestConfig {
+	url
+	method
+	username
+	password
+	authScheme
+	body
+	headers
+	params
+}
+`
+
+func getPoller(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getPollerResponse, error) {
+	req := &graphql.Request{
+		OpName: "getPoller",
+		Query:  getPoller_Operation,
+		Variables: &__getPollerInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getPollerResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getPreferredPath.
+const getPreferredPath_Operation = `
+query getPreferredPath ($id: ObjectId!) {
+	preferredPathWithStatus: preferredPath(id: $id) {
+		... PreferredPathWithStatus
+	}
+}
+fragment PreferredPathWithStatus on PreferredPathWithStatus {
+	path {
+		... PreferredPath
+	}
+	error
+}
+fragment PreferredPath on PreferredPath {
+	id
+	name
+	iconUrl
+	description
+	workspaceId
+	folderId
+	sourceDataset
+	path {
+		linkId
+		reverse
+		linkName
+		reverseFromDataset
+	}
+}
+`
+
+func getPreferredPath(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getPreferredPathResponse, error) {
+	req := &graphql.Request{
+		OpName: "getPreferredPath",
+		Query:  getPreferredPath_Operation,
+		Variables: &__getPreferredPathInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getPreferredPathResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getQueryCursor.
+const getQueryCursor_Operation = `
+query getQueryCursor ($cursorId: String!, $offset: Int64!, $numRows: Int64!) {
+	page: cursor(cursorId: $cursorId, offset: $offset, numRows: $numRows)
+}
+`
+
+func getQueryCursor(
+	ctx context.Context,
+	client graphql.Client,
+	cursorId string,
+	offset types.Int64Scalar,
+	numRows types.Int64Scalar,
+) (*getQueryCursorResponse, error) {
+	req := &graphql.Request{
+		OpName: "getQueryCursor",
+		Query:  getQueryCursor_Operation,
+		Variables: &__getQueryCursorInput{
+			CursorId: cursorId,
+			Offset:   offset,
+			NumRows:  numRows,
+		},
+	}
+	var err error
+
+	var data getQueryCursorResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getRbacDefaultGroup.
+const getRbacDefaultGroup_Operation = `
+query getRbacDefaultGroup {
+	rbacDefaultGroup {
+		... RbacGroup
+	}
+}
+fragment RbacGroup on RbacGroup {
+	id
+	name
+	description
+}
+`
+
+func getRbacDefaultGroup(
+	ctx context.Context,
+	client graphql.Client,
+) (*getRbacDefaultGroupResponse, error) {
+	req := &graphql.Request{
+		OpName: "getRbacDefaultGroup",
+		Query:  getRbacDefaultGroup_Operation,
+	}
+	var err error
+
+	var data getRbacDefaultGroupResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getRbacGroup.
+const getRbacGroup_Operation = `
+query getRbacGroup ($id: ORN!) {
+	rbacGroup(id: $id) {
+		... RbacGroup
+	}
+}
+fragment RbacGroup on RbacGroup {
+	id
+	name
+	description
+}
+`
+
+func getRbacGroup(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getRbacGroupResponse, error) {
+	req := &graphql.Request{
+		OpName: "getRbacGroup",
+		Query:  getRbacGroup_Operation,
+		Variables: &__getRbacGroupInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getRbacGroupResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getRbacGroupmember.
+const getRbacGroupmember_Operation = `
+query getRbacGroupmember ($id: ORN!) {
+	rbacGroupmember(id: $id) {
+		... RbacGroupmember
+	}
+}
+fragment RbacGroupmember on RbacGroupmember {
+	id
+	description
+	groupId
+	memberUserId
+	memberGroupId
+}
+`
+
+func getRbacGroupmember(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getRbacGroupmemberResponse, error) {
+	req := &graphql.Request{
+		OpName: "getRbacGroupmember",
+		Query:  getRbacGroupmember_Operation,
+		Variables: &__getRbacGroupmemberInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getRbacGroupmemberResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getRbacGroups.
+const getRbacGroups_Operation = `
+query getRbacGroups {
+	rbacGroups {
+		... RbacGroup
+	}
+}
+fragment RbacGroup on RbacGroup {
+	id
+	name
+	description
+}
+`
+
+func getRbacGroups(
+	ctx context.Context,
+	client graphql.Client,
+) (*getRbacGroupsResponse, error) {
+	req := &graphql.Request{
+		OpName: "getRbacGroups",
+		Query:  getRbacGroups_Operation,
+	}
+	var err error
+
+	var data getRbacGroupsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getRbacStatement.
+const getRbacStatement_Operation = `
+query getRbacStatement ($id: ORN!) {
+	rbacStatement(id: $id) {
+		... RbacStatement
+	}
+}
+fragment RbacStatement on RbacStatement {
+	id
+	description
+	subject {
+		userId
+		groupId
+		all
+	}
+	object {
+		objectId
+		folderId
+		workspaceId
+		type
+		name
+		owner
+		all
+	}
+	role
+}
+`
+
+func getRbacStatement(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getRbacStatementResponse, error) {
+	req := &graphql.Request{
+		OpName: "getRbacStatement",
+		Query:  getRbacStatement_Operation,
+		Variables: &__getRbacStatementInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getRbacStatementResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getSnowflakeOutboundShare.
+const getSnowflakeOutboundShare_Operation = `
+query getSnowflakeOutboundShare ($id: ObjectId!) {
+	share: snowflakeOutboundShare(id: $id) {
+		... SnowflakeOutboundShare
+	}
+}
+fragment SnowflakeOutboundShare on SnowflakeOutboundShare {
+	id
+	name
+	description
+	workspaceId
+	shareName
+	accounts {
+		... SnowflakeAccount
+	}
+}
+fragment SnowflakeAccount on SnowflakeAccount {
+	organization
+	account
+}
+`
+
+func getSnowflakeOutboundShare(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getSnowflakeOutboundShareResponse, error) {
+	req := &graphql.Request{
+		OpName: "getSnowflakeOutboundShare",
+		Query:  getSnowflakeOutboundShare_Operation,
+		Variables: &__getSnowflakeOutboundShareInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getSnowflakeOutboundShareResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getTerraform.
+const getTerraform_Operation = `
+query getTerraform ($id: ObjectId!, $ty: TerraformObjectType!) {
+	terraform: getTerraform(id: $id, type: $ty) {
+		... TerraformDefinition
+	}
+}
+fragment TerraformDefinition on TerraformDefinition {
+	dataSource
+	resource
+	importId
+	importName
+}
+`
+
+func getTerraform(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	ty TerraformObjectType,
+) (*getTerraformResponse, error) {
+	req := &graphql.Request{
+		OpName: "getTerraform",
+		Query:  getTerraform_Operation,
+		Variables: &__getTerraformInput{
+			Id: id,
+			Ty: ty,
+		},
+	}
+	var err error
+
+	var data getTerraformResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getUser.
+const getUser_Operation = `
+query getUser ($id: UserId!) {
+	user(id: $id) {
+		... User
+	}
+}
+fragment User on User {
+	id
+	email
+	label
+	role
+	status
+	type
+	comment
+}
+`
+
+func getUser(
+	ctx context.Context,
+	client graphql.Client,
+	id types.UserIdScalar,
+) (*getUserResponse, error) {
+	req := &graphql.Request{
+		OpName: "getUser",
+		Query:  getUser_Operation,
+		Variables: &__getUserInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getUserResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getWorksheet.
+const getWorksheet_Operation = `
+query getWorksheet ($id: ObjectId!) {
+	worksheet(id: $id) {
+		... Worksheet
+	}
+}
+fragment Worksheet on Worksheet {
+	id
+	label
+	icon
+	managedById
+	workspaceId
+	stages {
+		... StageQuery
+	}
+}
+fragment StageQuery on StageQuery {
+	id
+	pipeline
+	params
+	layout
+	input {
+		inputName
+		inputRole
+		datasetId
+		datasetPath
+		stageId
+	}
+}
+`
+
+func getWorksheet(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getWorksheetResponse, error) {
+	req := &graphql.Request{
+		OpName: "getWorksheet",
+		Query:  getWorksheet_Operation,
+		Variables: &__getWorksheetInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getWorksheetResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getWorksheetOwner.
+const getWorksheetOwner_Operation = `
+query getWorksheetOwner ($id: ObjectId!) {
+	object: worksheet(id: $id) {
+		id
+		name
+		workspaceId
+		createdBy
+	}
+}
+`
+
+func getWorksheetOwner(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getWorksheetOwnerResponse, error) {
+	req := &graphql.Request{
+		OpName: "getWorksheetOwner",
+		Query:  getWorksheetOwner_Operation,
+		Variables: &__getWorksheetOwnerInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getWorksheetOwnerResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getWorkspace.
+const getWorkspace_Operation = `
+query getWorkspace ($id: ObjectId!) {
+	workspace(id: $id) {
+		... Workspace
+	}
+}
+fragment Workspace on Project {
+	id
+	label
+}
+`
+
+func getWorkspace(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getWorkspaceResponse, error) {
+	req := &graphql.Request{
+		OpName: "getWorkspace",
+		Query:  getWorkspace_Operation,
+		Variables: &__getWorkspaceInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getWorkspaceResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by inviteUser.
+const inviteUser_Operation = `
+mutation inviteUser ($input: UserInput!) {
+	token: inviteUser(user: $input)
+}
+`
+
+func inviteUser(
+	ctx context.Context,
+	client graphql.Client,
+	input UserInput,
+) (*inviteUserResponse, error) {
+	req := &graphql.Request{
+		OpName: "inviteUser",
+		Query:  inviteUser_Operation,
+		Variables: &__inviteUserInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data inviteUserResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by listBlobs.
+const listBlobs_Operation = `
+query listBlobs ($userId: UserId, $kind: String, $includeWorldReadable: Boolean) {
+	blobs(userId: $userId, kind: $kind, includeWorldReadable: $includeWorldReadable) {
+		... Blob
+	}
+}
+fragment Blob on Blob {
+	userId
+	name
+	kind
+	value
+	readable
+	writable
+	updatedDate
+}
+`
+
+func listBlobs(
+	ctx context.Context,
+	client graphql.Client,
+	userId *types.UserIdScalar,
+	kind *string,
+	includeWorldReadable *bool,
+) (*listBlobsResponse, error) {
+	req := &graphql.Request{
+		OpName: "listBlobs",
+		Query:  listBlobs_Operation,
+		Variables: &__listBlobsInput{
+			UserId:               userId,
+			Kind:                 kind,
+			IncludeWorldReadable: includeWorldReadable,
+		},
+	}
+	var err error
+
+	var data listBlobsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by listDatasetLineage.
+const listDatasetLineage_Operation = `
+query listDatasetLineage {
+	workspaces: projects {
+		datasets {
+			... DatasetLineage
+		}
+	}
+}
+fragment DatasetLineage on Dataset {
+	id
+	name
+	workspaceId
+	inputs {
+		datasetId
+		inputRole
+	}
+}
+`
+
+func listDatasetLineage(
+	ctx context.Context,
+	client graphql.Client,
+) (*listDatasetLineageResponse, error) {
+	req := &graphql.Request{
+		OpName: "listDatasetLineage",
+		Query:  listDatasetLineage_Operation,
+	}
+	var err error
+
+	var data listDatasetLineageResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by listDatasets.
+const listDatasets_Operation = `
+query listDatasets {
+	datasets: projects {
+		datasets {
+			... Dataset
+		}
+	}
+}
+fragment Dataset on Dataset {
+	workspaceId
+	id
+	name
+	freshnessDesired
+	description
+	iconUrl
+	accelerationDisabled
+	version
+	updatedDate
+	pathCost
+	source
+	managedById
+	onDemandMaterializationLength
+	accelerable
+	hibernated
+	compilationError {
+		error
+		errorInDatasetId
+	}
+	accelerationInfo {
+		state
+		stalenessSeconds
+		targetStalenessSeconds
+		errors {
+			errorText
+		}
+	}
+	foreignKeys {
+		label
+		targetDataset
+		srcFields
+		dstFields
+	}
+	transform {
+		current {
+			query {
+				outputStage
+				stages {
+					... StageQuery
+				}
+			}
+		}
+	}
+	typedef {
+		label
+		def {
+			anykey
+			fields {
+				name
+				type {
+					rep
+					nullable
+				}
+				isEnum
+				isSearchable
+				isHidden
+				isConst
+				isMetric
+			}
+		}
+	}
+	sourceTable {
+		schema
+		partitions {
+			name
+		}
+		sourceUpdateTableName
+		isInsertOnly
+		batchSeqField
+		validFromField
+		fields {
+			name
+			sqlType
+		}
+	}
+}
+fragment StageQuery on StageQuery {
+	id
+	pipeline
+	params
+	layout
+	input {
+		inputName
+		inputRole
+		datasetId
+		datasetPath
+		stageId
+	}
+}
+`
+
+func listDatasets(
+	ctx context.Context,
+	client graphql.Client,
+) (*listDatasetsResponse, error) {
+	req := &graphql.Request{
+		OpName: "listDatasets",
+		Query:  listDatasets_Operation,
+	}
+	var err error
+
+	var data listDatasetsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by listFeatureFlags.
+const listFeatureFlags_Operation = `
+query listFeatureFlags {
+	featureFlags {
+		... FeatureFlag
+	}
+}
+fragment FeatureFlag on FeatureFlag {
+	name
+	description
+	isEnabled
+	isSelfServiceEnabled
+}
+`
+
+func listFeatureFlags(
+	ctx context.Context,
+	client graphql.Client,
+) (*listFeatureFlagsResponse, error) {
+	req := &graphql.Request{
+		OpName: "listFeatureFlags",
+		Query:  listFeatureFlags_Operation,
+	}
+	var err error
+
+	var data listFeatureFlagsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by listMonitorsForDataset.
+const listMonitorsForDataset_Operation = `
+query listMonitorsForDataset ($datasetId: ObjectId!) {
+	monitors: monitorsForDataset(datasetId: $datasetId) {
+		... DatasetMonitor
+	}
+}
+fragment DatasetMonitor on Monitor {
+	id
+	name
+	workspaceId
+}
+`
+
+func listMonitorsForDataset(
+	ctx context.Context,
+	client graphql.Client,
+	datasetId string,
+) (*listMonitorsForDatasetResponse, error) {
+	req := &graphql.Request{
+		OpName: "listMonitorsForDataset",
+		Query:  listMonitorsForDataset_Operation,
+		Variables: &__listMonitorsForDatasetInput{
+			DatasetId: datasetId,
+		},
+	}
+	var err error
+
+	var data listMonitorsForDatasetResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by listRbacGroupmembers.
+const listRbacGroupmembers_Operation = `
+query listRbacGroupmembers {
+	rbacGroupmembers {
+		... RbacGroupmember
+	}
+}
+fragment RbacGroupmember on RbacGroupmember {
+	id
+	description
+	groupId
+	memberUserId
+	memberGroupId
+}
+`
+
+func listRbacGroupmembers(
+	ctx context.Context,
+	client graphql.Client,
+) (*listRbacGroupmembersResponse, error) {
+	req := &graphql.Request{
+		OpName: "listRbacGroupmembers",
+		Query:  listRbacGroupmembers_Operation,
+	}
+	var err error
+
+	var data listRbacGroupmembersResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by listRbacStatements.
+const listRbacStatements_Operation = `
+query listRbacStatements {
+	rbacStatements {
+		... RbacStatement
+	}
+}
+fragment RbacStatement on RbacStatement {
+	id
+	description
+	subject {
+		userId
+		groupId
+		all
+	}
+	object {
+		objectId
+		folderId
+		workspaceId
+		type
+		name
+		owner
+		all
+	}
+	role
+}
+`
+
+func listRbacStatements(
+	ctx context.Context,
+	client graphql.Client,
+) (*listRbacStatementsResponse, error) {
+	req := &graphql.Request{
+		OpName: "listRbacStatements",
+		Query:  listRbacStatements_Operation,
+	}
+	var err error
+
+	var data listRbacStatementsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by listWorkspaceDatasetLineage.
+const listWorkspaceDatasetLineage_Operation = `
+query listWorkspaceDatasetLineage ($workspaceId: ObjectId!) {
+	workspace(id: $workspaceId) {
+		datasets {
+			... DatasetLineage
+		}
+	}
+}
+fragment DatasetLineage on Dataset {
+	id
+	name
+	workspaceId
+	inputs {
+		datasetId
+		inputRole
+	}
+}
+`
+
+func listWorkspaceDatasetLineage(
+	ctx context.Context,
+	client graphql.Client,
+	workspaceId string,
+) (*listWorkspaceDatasetLineageResponse, error) {
+	req := &graphql.Request{
+		OpName: "listWorkspaceDatasetLineage",
+		Query:  listWorkspaceDatasetLineage_Operation,
+		Variables: &__listWorkspaceDatasetLineageInput{
+			WorkspaceId: workspaceId,
+		},
+	}
+	var err error
+
+	var data listWorkspaceDatasetLineageResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by listWorkspaceObjectOwners.
+const listWorkspaceObjectOwners_Operation = `
+query listWorkspaceObjectOwners ($workspaceId: ObjectId!, $maxCount: Int64) {
+	workspace: project(projectId: $workspaceId) {
+		datasets {
+			id
+			name
+			workspaceId
+			createdBy
+		}
+		folders {
+			id
+			name
+			workspaceId
+			createdBy
+		}
+	}
+	monitors: searchMonitorV2(workspaceId: $workspaceId) {
+		results {
+			id
+			name
+			workspaceId
+			createdBy
+		}
+	}
+	dashboards: dashboardSearch(terms: {workspaceId:[$workspaceId]}, maxCount: $maxCount) {
+		dashboards {
+			dashboard {
+				id
+				name
+				workspaceId
+				createdBy
+			}
+		}
+	}
+	worksheets: worksheetSearch(terms: {workspaceId:[$workspaceId]}, maxCount: $maxCount) {
+		worksheets {
+			worksheet {
+				id
+				name
+				workspaceId
+				createdBy
+			}
+		}
+	}
+}
+`
+
+func listWorkspaceObjectOwners(
+	ctx context.Context,
+	client graphql.Client,
+	workspaceId string,
+	maxCount *types.Int64Scalar,
+) (*listWorkspaceObjectOwnersResponse, error) {
+	req := &graphql.Request{
+		OpName: "listWorkspaceObjectOwners",
+		Query:  listWorkspaceObjectOwners_Operation,
+		Variables: &__listWorkspaceObjectOwnersInput{
+			WorkspaceId: workspaceId,
+			MaxCount:    maxCount,
+		},
+	}
+	var err error
+
+	var data listWorkspaceObjectOwnersResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by listWorkspaces.
+const listWorkspaces_Operation = `
+query listWorkspaces {
+	workspaces: projects {
+		... Workspace
+	}
+}
+fragment Workspace on Project {
+	id
+	label
+}
+`
+
+func listWorkspaces(
+	ctx context.Context,
+	client graphql.Client,
+) (*listWorkspacesResponse, error) {
+	req := &graphql.Request{
+		OpName: "listWorkspaces",
+		Query:  listWorkspaces_Operation,
+	}
+	var err error
+
+	var data listWorkspacesResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by lookupApp.
+const lookupApp_Operation = `
+query lookupApp ($workspaceId: ObjectId!, $name: String!) {
+	apps(workspaceId: $workspaceId, name: $name) {
+		... App
+	}
+}
+fragment App on App {
+	id
+	name
+	iconUrl
+	description
+	workspaceId
+	folderId
+	config {
+		moduleId
+		version
+	}
+	status {
+		state
+		internalError
+	}
+	outputs
+}
+`
+
+// LookupApp retrieves app by name.
+// TODO: this should be bound to a folderId, not a workspace.
+func lookupApp(
+	ctx context.Context,
+	client graphql.Client,
+	workspaceId string,
+	name string,
+) (*lookupAppResponse, error) {
+	req := &graphql.Request{
+		OpName: "lookupApp",
+		Query:  lookupApp_Operation,
+		Variables: &__lookupAppInput{
+			WorkspaceId: workspaceId,
+			Name:        name,
+		},
+	}
+	var err error
+
+	var data lookupAppResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by lookupDataset.
+const lookupDataset_Operation = `
+query lookupDataset ($workspaceId: ObjectId!, $name: String!) {
+	dataset: workspace(id: $workspaceId) {
+		dataset(label: $name) {
+			... Dataset
+		}
+	}
+}
+fragment Dataset on Dataset {
+	workspaceId
+	id
+	name
+	freshnessDesired
+	description
+	iconUrl
+	accelerationDisabled
+	version
+	updatedDate
+	pathCost
+	source
+	managedById
+	onDemandMaterializationLength
+	accelerable
+	hibernated
+	compilationError {
+		error
+		errorInDatasetId
+	}
+	accelerationInfo {
+		state
+		stalenessSeconds
+		targetStalenessSeconds
+		errors {
+			errorText
+		}
+	}
+	foreignKeys {
+		label
+		targetDataset
+		srcFields
+		dstFields
+	}
+	transform {
+		current {
+			query {
+				outputStage
+				stages {
+					... StageQuery
+				}
+			}
+		}
+	}
+	typedef {
+		label
+		def {
+			anykey
+			fields {
+				name
+				type {
+					rep
+					nullable
+				}
+				isEnum
+				isSearchable
+				isHidden
+				isConst
+				isMetric
+			}
+		}
+	}
+	sourceTable {
+		schema
+		partitions {
+			name
+		}
+		sourceUpdateTableName
+		isInsertOnly
+		batchSeqField
+		validFromField
+		fields {
+			name
+			sqlType
+		}
+	}
+}
+fragment StageQuery on StageQuery {
+	id
+	pipeline
+	params
+	layout
+	input {
+		inputName
+		inputRole
+		datasetId
+		datasetPath
+		stageId
+	}
+}
+`
+
+func lookupDataset(
+	ctx context.Context,
+	client graphql.Client,
+	workspaceId string,
+	name string,
+) (*lookupDatasetResponse, error) {
+	req := &graphql.Request{
+		OpName: "lookupDataset",
+		Query:  lookupDataset_Operation,
+		Variables: &__lookupDatasetInput{
+			WorkspaceId: workspaceId,
+			Name:        name,
+		},
+	}
+	var err error
+
+	var data lookupDatasetResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by lookupDatastream.
+const lookupDatastream_Operation = `
+query lookupDatastream ($workspaceId: ObjectId!, $name: String!) {
+	datastream: workspace(id: $workspaceId) {
+		datastream(name: $name) {
+			... Datastream
+		}
+	}
+}
+fragment Datastream on Datastream {
+	id
+	name
+	iconUrl
+	description
+	workspaceId
+	datasetId
+}
+`
+
+func lookupDatastream(
+	ctx context.Context,
+	client graphql.Client,
+	workspaceId string,
+	name string,
+) (*lookupDatastreamResponse, error) {
+	req := &graphql.Request{
+		OpName: "lookupDatastream",
+		Query:  lookupDatastream_Operation,
+		Variables: &__lookupDatastreamInput{
+			WorkspaceId: workspaceId,
+			Name:        name,
+		},
+	}
+	var err error
+
+	var data lookupDatastreamResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by lookupFolder.
+const lookupFolder_Operation = `
+query lookupFolder ($workspaceId: ObjectId!, $name: String!) {
+	folder: workspace(id: $workspaceId) {
+		folder(name: $name) {
+			... Folder
+		}
+	}
+}
+fragment Folder on Folder {
+	id
+	name
+	iconUrl
+	description
+	workspaceId
+}
+`
+
+func lookupFolder(
+	ctx context.Context,
+	client graphql.Client,
+	workspaceId string,
+	name string,
+) (*lookupFolderResponse, error) {
+	req := &graphql.Request{
+		OpName: "lookupFolder",
+		Query:  lookupFolder_Operation,
+		Variables: &__lookupFolderInput{
+			WorkspaceId: workspaceId,
+			Name:        name,
+		},
+	}
+	var err error
+
+	var data lookupFolderResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by lookupModuleVersions.
+const lookupModuleVersions_Operation = `
+query lookupModuleVersions ($id: String!) {
+	moduleVersions(id: $id) {
+		... ModuleVersion
+	}
+}
+fragment ModuleVersion on ModuleVersion {
+	version
+}
+`
+
+func lookupModuleVersions(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*lookupModuleVersionsResponse, error) {
+	req := &graphql.Request{
+		OpName: "lookupModuleVersions",
+		Query:  lookupModuleVersions_Operation,
+		Variables: &__lookupModuleVersionsInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data lookupModuleVersionsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by lookupMonitor.
+const lookupMonitor_Operation = `
+query lookupMonitor ($workspaceId: ObjectId!, $name: String!) {
+	monitor: workspace(id: $workspaceId) {
+		monitor(name: $name) {
+			... Monitor
+		}
+	}
+}
+fragment Monitor on Monitor {
+	workspaceId
+	id
+	name
+	description
+	comment
+	iconUrl
+	isTemplate
+	disabled
+	freshnessGoal
+	useDefaultFreshness
+	source
+	definition
+	managedById
+	query {
+		outputStage
+		stages {
+			... StageQuery
+		}
+	}
+	rule {
+		__typename
+		sourceColumn
+		groupByGroups {
+			groupName
+			columns
+		}
+		... on MonitorRuleCount {
+			compareFunction
+			compareValues
+			lookbackTime
+		}
+		... on MonitorRuleChange {
+			changeType
+			compareFunction
+			compareValues
+			aggregateFunction
+			lookbackTime
+			baselineTime
+		}
+		... on MonitorRuleFacet {
+			facetFunction
+			facetValues
+			timeFunction
+			timeValue
+			lookbackTime
+		}
+		... on MonitorRuleThreshold {
+			compareFunction
+			compareValues
+			lookbackTime
+			thresholdAggFunction
+		}
+		... on MonitorRulePromote {
+			kindField
+			descriptionField
+			primaryKey
+		}
+		... on MonitorRuleLog {
+			compareFunction
+			compareValues
+			lookbackTime
+			expressionSummary
+			logStageId
+			sourceLogDatasetId
+		}
+	}
+	notificationSpec {
+		merge
+		importance
+		notifyOnReminder
+		reminderFrequency
+		notifyOnClose
+	}
+}
+fragment StageQuery on StageQuery {
+	id
+	pipeline
+	params
+	layout
+	input {
+		inputName
+		inputRole
+		datasetId
+		datasetPath
+		stageId
+	}
+}
+`
+
+func lookupMonitor(
+	ctx context.Context,
+	client graphql.Client,
+	workspaceId string,
+	name string,
+) (*lookupMonitorResponse, error) {
+	req := &graphql.Request{
+		OpName: "lookupMonitor",
+		Query:  lookupMonitor_Operation,
+		Variables: &__lookupMonitorInput{
+			WorkspaceId: workspaceId,
+			Name:        name,
+		},
+	}
+	var err error
+
+	var data lookupMonitorResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by lookupMonitorV2.
+const lookupMonitorV2_Operation = `
+query lookupMonitorV2 ($workspaceId: ObjectId, $folderId: ObjectId, $nameExact: String, $nameSubstring: String) {
+	monitorV2s: searchMonitorV2(workspaceId: $workspaceId, folderId: $folderId, nameExact: $nameExact, nameSubstring: $nameSubstring) {
+		... MonitorV2SearchResult
+	}
+}
+fragment MonitorV2SearchResult on MonitorV2SearchResult {
+	results {
+		... MonitorV2
+	}
+}
+fragment MonitorV2 on MonitorV2 {
+	id
+	workspaceId
+	createdBy
+	createdDate
+	name
+	iconUrl
+	description
+	managedById
+	folderId
+	rollupStatus
+	ruleKind
+	definition {
+		... MonitorV2Definition
+	}
+	actionRules {
+		... MonitorV2ActionRule
+	}
+}
+fragment MonitorV2Definition on MonitorV2Definition {
+	inputQuery {
+		outputStage
+		stages {
+			... StageQuery
+		}
+	}
+	rules {
+		... MonitorV2Rule
+	}
+	lookbackTime
+	dataStabilizationDelay
+	groupings {
+		... MonitorV2Column
+	}
+	scheduling {
+		... MonitorV2Scheduling
+	}
+}
+fragment MonitorV2ActionRule on MonitorV2ActionRule {
+	actionID
+	levels
+	sendEndNotifications
+	sendRemindersInterval
+}
+fragment StageQuery on StageQuery {
+	id
+	pipeline
+	params
+	layout
+	input {
+		inputName
+		inputRole
+		datasetId
+		datasetPath
+		stageId
+	}
+}
+fragment MonitorV2Rule on MonitorV2Rule {
+	level
+	count {
+		... MonitorV2CountRule
+	}
+	threshold {
+		... MonitorV2ThresholdRule
+	}
+	promote {
+		... MonitorV2PromoteRule
+	}
+}
+fragment MonitorV2Column on MonitorV2Column {
+	linkColumn {
+		... MonitorV2LinkColumn
+	}
+	columnPath {
+		... MonitorV2ColumnPath
+	}
+}
+fragment MonitorV2Scheduling on MonitorV2Scheduling {
+	interval {
+		... MonitorV2IntervalSchedule
+	}
+	transform {
+		... MonitorV2TransformSchedule
+	}
+}
+fragment MonitorV2CountRule on MonitorV2CountRule {
+	compareValues {
+		... MonitorV2Comparison
+	}
+	compareGroups {
+		... MonitorV2ColumnComparison
+	}
+}
+fragment MonitorV2ThresholdRule on MonitorV2ThresholdRule {
+	compareValues {
+		... MonitorV2Comparison
+	}
+	valueColumnName
+	aggregation
+	compareGroups {
+		... MonitorV2ColumnComparison
+	}
+}
+fragment MonitorV2PromoteRule on MonitorV2PromoteRule {
+	compareColumns {
+		... MonitorV2ColumnComparison
+	}
+}
+fragment MonitorV2LinkColumn on MonitorV2LinkColumn {
+	name
+	meta {
+		... MonitorV2LinkColumnMeta
 	}
-	typedef {
-		label
-		def {
-			anykey
-			fields {
-				name
-				type {
-					rep
-					nullable
-				}
-				isEnum
-				isSearchable
-				isHidden
-				isConst
-				isMetric
-			}
-		}
+}
+fragment MonitorV2ColumnPath on MonitorV2ColumnPath {
+	name
+	path
+}
+fragment MonitorV2IntervalSchedule on MonitorV2IntervalSchedule {
+	interval
+	randomize
+}
+fragment MonitorV2TransformSchedule on MonitorV2TransformSchedule {
+	freshnessGoal
+}
+fragment MonitorV2Comparison on MonitorV2Comparison {
+	compareFn
+	compareValue {
+		... PrimitiveValue
 	}
-	sourceTable {
-		schema
-		partitions {
-			name
-		}
-		sourceUpdateTableName
-		isInsertOnly
-		batchSeqField
-		validFromField
-		fields {
-			name
-			sqlType
-		}
+}
+fragment MonitorV2ColumnComparison on MonitorV2ColumnComparison {
+	column {
+		... MonitorV2Column
+	}
+	compareValues {
+		... MonitorV2Comparison
 	}
 }
-fragment StageQuery on StageQuery {
-	id
-	pipeline
-	params
-	layout
-	input {
-		inputName
-		inputRole
-		datasetId
-		datasetPath
-		stageId
+fragment MonitorV2LinkColumnMeta on MonitorV2LinkColumnMeta {
+	srcFields {
+		... MonitorV2ColumnPath
 	}
+	dstFields
+	targetDataset
+}
+fragment PrimitiveValue on PrimitiveValue {
+	bool
+	float64
+	int64
+	string
+	timestamp
+	duration
 }
 `
 
-func listDatasets(
+func lookupMonitorV2(
 	ctx context.Context,
 	client graphql.Client,
-) (*listDatasetsResponse, error) {
+	workspaceId *string,
+	folderId *string,
+	nameExact *string,
+	nameSubstring *string,
+) (*lookupMonitorV2Response, error) {
 	req := &graphql.Request{
-		OpName: "listDatasets",
-		Query:  listDatasets_Operation,
+		OpName: "lookupMonitorV2",
+		Query:  lookupMonitorV2_Operation,
+		Variables: &__lookupMonitorV2Input{
+			WorkspaceId:   workspaceId,
+			FolderId:      folderId,
+			NameExact:     nameExact,
+			NameSubstring: nameSubstring,
+		},
 	}
 	var err error
 
-	var data listDatasetsResponse
+	var data lookupMonitorV2Response
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -17573,30 +24170,48 @@ func listDatasets(
 	return &data, err
 }
 
-// The query or mutation executed by listWorkspaces.
-const listWorkspaces_Operation = `
-query listWorkspaces {
-	workspaces: projects {
-		... Workspace
+// The query or mutation executed by lookupSnowflakeOutboundShare.
+const lookupSnowflakeOutboundShare_Operation = `
+query lookupSnowflakeOutboundShare ($name: String!, $workspaceId: ObjectId!) {
+	shares: searchSnowflakeOutboundShare(nameExact: $name, workspaceId: $workspaceId) {
+		results {
+			... SnowflakeOutboundShare
+		}
 	}
 }
-fragment Workspace on Project {
+fragment SnowflakeOutboundShare on SnowflakeOutboundShare {
 	id
-	label
+	name
+	description
+	workspaceId
+	shareName
+	accounts {
+		... SnowflakeAccount
+	}
+}
+fragment SnowflakeAccount on SnowflakeAccount {
+	organization
+	account
 }
 `
 
-func listWorkspaces(
+func lookupSnowflakeOutboundShare(
 	ctx context.Context,
 	client graphql.Client,
-) (*listWorkspacesResponse, error) {
+	name string,
+	workspaceId string,
+) (*lookupSnowflakeOutboundShareResponse, error) {
 	req := &graphql.Request{
-		OpName: "listWorkspaces",
-		Query:  listWorkspaces_Operation,
+		OpName: "lookupSnowflakeOutboundShare",
+		Query:  lookupSnowflakeOutboundShare_Operation,
+		Variables: &__lookupSnowflakeOutboundShareInput{
+			Name:        name,
+			WorkspaceId: workspaceId,
+		},
 	}
 	var err error
 
-	var data listWorkspacesResponse
+	var data lookupSnowflakeOutboundShareResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -17608,51 +24223,34 @@ func listWorkspaces(
 	return &data, err
 }
 
-// The query or mutation executed by lookupApp.
-const lookupApp_Operation = `
-query lookupApp ($workspaceId: ObjectId!, $name: String!) {
-	apps(workspaceId: $workspaceId, name: $name) {
-		... App
+// The query or mutation executed by lookupWorkspace.
+const lookupWorkspace_Operation = `
+query lookupWorkspace ($name: String!) {
+	workspace(label: $name) {
+		... Workspace
 	}
 }
-fragment App on App {
+fragment Workspace on Project {
 	id
-	name
-	iconUrl
-	description
-	workspaceId
-	folderId
-	config {
-		moduleId
-		version
-	}
-	status {
-		state
-		internalError
-	}
-	outputs
+	label
 }
 `
 
-// LookupApp retrieves app by name.
-// TODO: this should be bound to a folderId, not a workspace.
-func lookupApp(
+func lookupWorkspace(
 	ctx context.Context,
 	client graphql.Client,
-	workspaceId string,
 	name string,
-) (*lookupAppResponse, error) {
+) (*lookupWorkspaceResponse, error) {
 	req := &graphql.Request{
-		OpName: "lookupApp",
-		Query:  lookupApp_Operation,
-		Variables: &__lookupAppInput{
-			WorkspaceId: workspaceId,
-			Name:        name,
+		OpName: "lookupWorkspace",
+		Query:  lookupWorkspace_Operation,
+		Variables: &__lookupWorkspaceInput{
+			Name: name,
 		},
 	}
 	var err error
 
-	var data lookupAppResponse
+	var data lookupWorkspaceResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -17664,110 +24262,59 @@ func lookupApp(
 	return &data, err
 }
 
-// The query or mutation executed by lookupDataset.
-const lookupDataset_Operation = `
-query lookupDataset ($workspaceId: ObjectId!, $name: String!) {
-	dataset: workspace(id: $workspaceId) {
-		dataset(label: $name) {
-			... Dataset
-		}
-	}
-}
-fragment Dataset on Dataset {
-	workspaceId
-	id
-	name
-	freshnessDesired
-	description
-	iconUrl
-	accelerationDisabled
-	version
-	updatedDate
-	pathCost
-	source
-	managedById
-	onDemandMaterializationLength
-	foreignKeys {
-		label
-		targetDataset
-		srcFields
-		dstFields
-	}
-	transform {
-		current {
-			query {
-				outputStage
-				stages {
-					... StageQuery
-				}
-			}
-		}
-	}
-	typedef {
-		label
-		def {
-			anykey
-			fields {
-				name
-				type {
-					rep
-					nullable
-				}
-				isEnum
-				isSearchable
-				isHidden
-				isConst
-				isMetric
-			}
-		}
-	}
-	sourceTable {
-		schema
-		partitions {
-			name
+// The query or mutation executed by mutateRbacStatements.
+const mutateRbacStatements_Operation = `
+mutation mutateRbacStatements ($toCreate: [RbacStatementInput!], $toUpdate: [UpdateRbacStatementInput!], $toDelete: [ORN!]) {
+	response: mutateRbacStatements(toCreate: $toCreate, toUpdate: $toUpdate, toDelete: $toDelete) {
+		createdStatements {
+			... RbacStatement
 		}
-		sourceUpdateTableName
-		isInsertOnly
-		batchSeqField
-		validFromField
-		fields {
-			name
-			sqlType
+		updatedStatements {
+			... RbacStatement
 		}
+		deletedStatements
 	}
 }
-fragment StageQuery on StageQuery {
+fragment RbacStatement on RbacStatement {
 	id
-	pipeline
-	params
-	layout
-	input {
-		inputName
-		inputRole
-		datasetId
-		datasetPath
-		stageId
+	description
+	subject {
+		userId
+		groupId
+		all
 	}
+	object {
+		objectId
+		folderId
+		workspaceId
+		type
+		name
+		owner
+		all
+	}
+	role
 }
 `
 
-func lookupDataset(
+func mutateRbacStatements(
 	ctx context.Context,
 	client graphql.Client,
-	workspaceId string,
-	name string,
-) (*lookupDatasetResponse, error) {
+	toCreate []RbacStatementInput,
+	toUpdate []UpdateRbacStatementInput,
+	toDelete []string,
+) (*mutateRbacStatementsResponse, error) {
 	req := &graphql.Request{
-		OpName: "lookupDataset",
-		Query:  lookupDataset_Operation,
-		Variables: &__lookupDatasetInput{
-			WorkspaceId: workspaceId,
-			Name:        name,
+		OpName: "mutateRbacStatements",
+		Query:  mutateRbacStatements_Operation,
+		Variables: &__mutateRbacStatementsInput{
+			ToCreate: toCreate,
+			ToUpdate: toUpdate,
+			ToDelete: toDelete,
 		},
 	}
 	var err error
 
-	var data lookupDatasetResponse
+	var data mutateRbacStatementsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -17779,42 +24326,49 @@ func lookupDataset(
 	return &data, err
 }
 
-// The query or mutation executed by lookupDatastream.
-const lookupDatastream_Operation = `
-query lookupDatastream ($workspaceId: ObjectId!, $name: String!) {
-	datastream: workspace(id: $workspaceId) {
-		datastream(name: $name) {
-			... Datastream
-		}
+// The query or mutation executed by rbacObjectMatches.
+const rbacObjectMatches_Operation = `
+query rbacObjectMatches ($object: RbacRequestObjectInput!) {
+	statements: rbacObjectMatches(o: $object) {
+		... RbacStatement
 	}
 }
-fragment Datastream on Datastream {
+fragment RbacStatement on RbacStatement {
 	id
-	name
-	iconUrl
 	description
-	workspaceId
-	datasetId
+	subject {
+		userId
+		groupId
+		all
+	}
+	object {
+		objectId
+		folderId
+		workspaceId
+		type
+		name
+		owner
+		all
+	}
+	role
 }
 `
 
-func lookupDatastream(
+func rbacObjectMatches(
 	ctx context.Context,
 	client graphql.Client,
-	workspaceId string,
-	name string,
-) (*lookupDatastreamResponse, error) {
+	object RbacRequestObjectInput,
+) (*rbacObjectMatchesResponse, error) {
 	req := &graphql.Request{
-		OpName: "lookupDatastream",
-		Query:  lookupDatastream_Operation,
-		Variables: &__lookupDatastreamInput{
-			WorkspaceId: workspaceId,
-			Name:        name,
+		OpName: "rbacObjectMatches",
+		Query:  rbacObjectMatches_Operation,
+		Variables: &__rbacObjectMatchesInput{
+			Object: object,
 		},
 	}
 	var err error
 
-	var data lookupDatastreamResponse
+	var data rbacObjectMatchesResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -17826,41 +24380,54 @@ func lookupDatastream(
 	return &data, err
 }
 
-// The query or mutation executed by lookupFolder.
-const lookupFolder_Operation = `
-query lookupFolder ($workspaceId: ObjectId!, $name: String!) {
-	folder: workspace(id: $workspaceId) {
-		folder(name: $name) {
-			... Folder
+// The query or mutation executed by rbacTestRequests.
+const rbacTestRequests_Operation = `
+query rbacTestRequests ($user: UserId!, $requests: [RbacRequestInput!]!) {
+	results: rbacTestRequests(u: $user, rs: $requests) {
+		result
+		matching {
+			... RbacStatement
 		}
 	}
 }
-fragment Folder on Folder {
+fragment RbacStatement on RbacStatement {
 	id
-	name
-	iconUrl
 	description
-	workspaceId
+	subject {
+		userId
+		groupId
+		all
+	}
+	object {
+		objectId
+		folderId
+		workspaceId
+		type
+		name
+		owner
+		all
+	}
+	role
 }
 `
 
-func lookupFolder(
+func rbacTestRequests(
 	ctx context.Context,
 	client graphql.Client,
-	workspaceId string,
-	name string,
-) (*lookupFolderResponse, error) {
+	user types.UserIdScalar,
+	requests []RbacRequestInput,
+) (*rbacTestRequestsResponse, error) {
 	req := &graphql.Request{
-		OpName: "lookupFolder",
-		Query:  lookupFolder_Operation,
-		Variables: &__lookupFolderInput{
-			WorkspaceId: workspaceId,
-			Name:        name,
+		OpName: "rbacTestRequests",
+		Query:  rbacTestRequests_Operation,
+		Variables: &__rbacTestRequestsInput{
+			User:     user,
+			Requests: requests,
 		},
 	}
 	var err error
 
-	var data lookupFolderResponse
+	var data rbacTestRequestsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -17872,33 +24439,49 @@ func lookupFolder(
 	return &data, err
 }
 
-// The query or mutation executed by lookupModuleVersions.
-const lookupModuleVersions_Operation = `
-query lookupModuleVersions ($id: String!) {
-	moduleVersions(id: $id) {
-		... ModuleVersion
+// The query or mutation executed by rbacUserMatches.
+const rbacUserMatches_Operation = `
+query rbacUserMatches ($user: UserId!) {
+	statements: rbacUserMatches(u: $user) {
+		... RbacStatement
 	}
 }
-fragment ModuleVersion on ModuleVersion {
-	version
+fragment RbacStatement on RbacStatement {
+	id
+	description
+	subject {
+		userId
+		groupId
+		all
+	}
+	object {
+		objectId
+		folderId
+		workspaceId
+		type
+		name
+		owner
+		all
+	}
+	role
 }
 `
 
-func lookupModuleVersions(
+func rbacUserMatches(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*lookupModuleVersionsResponse, error) {
+	user types.UserIdScalar,
+) (*rbacUserMatchesResponse, error) {
 	req := &graphql.Request{
-		OpName: "lookupModuleVersions",
-		Query:  lookupModuleVersions_Operation,
-		Variables: &__lookupModuleVersionsInput{
-			Id: id,
+		OpName: "rbacUserMatches",
+		Query:  rbacUserMatches_Operation,
+		Variables: &__rbacUserMatchesInput{
+			User: user,
 		},
 	}
 	var err error
 
-	var data lookupModuleVersionsResponse
+	var data rbacUserMatchesResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -17910,122 +24493,39 @@ func lookupModuleVersions(
 	return &data, err
 }
 
-// The query or mutation executed by lookupMonitor.
-const lookupMonitor_Operation = `
-query lookupMonitor ($workspaceId: ObjectId!, $name: String!) {
-	monitor: workspace(id: $workspaceId) {
-		monitor(name: $name) {
-			... Monitor
-		}
-	}
-}
-fragment Monitor on Monitor {
-	workspaceId
-	id
-	name
-	description
-	comment
-	iconUrl
-	isTemplate
-	disabled
-	freshnessGoal
-	useDefaultFreshness
-	source
-	definition
-	managedById
-	query {
-		outputStage
-		stages {
-			... StageQuery
-		}
-	}
-	rule {
-		__typename
-		sourceColumn
-		groupByGroups {
-			groupName
-			columns
-		}
-		... on MonitorRuleCount {
-			compareFunction
-			compareValues
-			lookbackTime
-		}
-		... on MonitorRuleChange {
-			changeType
-			compareFunction
-			compareValues
-			aggregateFunction
-			lookbackTime
-			baselineTime
-		}
-		... on MonitorRuleFacet {
-			facetFunction
-			facetValues
-			timeFunction
-			timeValue
-			lookbackTime
-		}
-		... on MonitorRuleThreshold {
-			compareFunction
-			compareValues
-			lookbackTime
-			thresholdAggFunction
-		}
-		... on MonitorRulePromote {
-			kindField
-			descriptionField
-			primaryKey
-		}
-		... on MonitorRuleLog {
-			compareFunction
-			compareValues
-			lookbackTime
-			expressionSummary
-			logStageId
-			sourceLogDatasetId
-		}
-	}
-	notificationSpec {
-		merge
-		importance
-		notifyOnReminder
-		reminderFrequency
-		notifyOnClose
+// The query or mutation executed by removeCorrelationTag.
+const removeCorrelationTag_Operation = `
+mutation removeCorrelationTag ($datasetId: ObjectId!, $path: LinkFieldInput!, $tag: String!) {
+	resultStatus: removeCorrelationTag(datasetId: $datasetId, path: $path, tag: $tag) {
+		... ResultStatus
 	}
 }
-fragment StageQuery on StageQuery {
-	id
-	pipeline
-	params
-	layout
-	input {
-		inputName
-		inputRole
-		datasetId
-		datasetPath
-		stageId
-	}
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func lookupMonitor(
+func removeCorrelationTag(
 	ctx context.Context,
 	client graphql.Client,
-	workspaceId string,
-	name string,
-) (*lookupMonitorResponse, error) {
+	datasetId string,
+	path LinkFieldInput,
+	tag string,
+) (*removeCorrelationTagResponse, error) {
 	req := &graphql.Request{
-		OpName: "lookupMonitor",
-		Query:  lookupMonitor_Operation,
-		Variables: &__lookupMonitorInput{
-			WorkspaceId: workspaceId,
-			Name:        name,
+		OpName: "removeCorrelationTag",
+		Query:  removeCorrelationTag_Operation,
+		Variables: &__removeCorrelationTagInput{
+			DatasetId: datasetId,
+			Path:      path,
+			Tag:       tag,
 		},
 	}
 	var err error
 
-	var data lookupMonitorResponse
+	var data removeCorrelationTagResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -18037,195 +24537,52 @@ func lookupMonitor(
 	return &data, err
 }
 
-// The query or mutation executed by lookupMonitorV2.
-const lookupMonitorV2_Operation = `
-query lookupMonitorV2 ($workspaceId: ObjectId, $folderId: ObjectId, $nameExact: String, $nameSubstring: String) {
-	monitorV2s: searchMonitorV2(workspaceId: $workspaceId, folderId: $folderId, nameExact: $nameExact, nameSubstring: $nameSubstring) {
-		... MonitorV2SearchResult
-	}
-}
-fragment MonitorV2SearchResult on MonitorV2SearchResult {
-	results {
-		... MonitorV2
+// The query or mutation executed by removeIncidentDashboards.
+const removeIncidentDashboards_Operation = `
+mutation removeIncidentDashboards ($id: ObjectId!, $dashboards: [ObjectId!]!) {
+	incident: removeIncidentDashboards(i: $id, ds: $dashboards) {
+		... Incident
 	}
 }
-fragment MonitorV2 on MonitorV2 {
+fragment Incident on Incident {
 	id
-	workspaceId
-	createdBy
-	createdDate
 	name
-	iconUrl
 	description
-	managedById
+	iconUrl
+	workspaceId
 	folderId
-	rollupStatus
-	ruleKind
-	definition {
-		... MonitorV2Definition
-	}
-	actionRules {
-		... MonitorV2ActionRule
-	}
-}
-fragment MonitorV2Definition on MonitorV2Definition {
-	inputQuery {
-		outputStage
-		stages {
-			... StageQuery
-		}
-	}
-	rules {
-		... MonitorV2Rule
-	}
-	lookbackTime
-	dataStabilizationDelay
-	groupings {
-		... MonitorV2Column
-	}
-	scheduling {
-		... MonitorV2Scheduling
-	}
-}
-fragment MonitorV2ActionRule on MonitorV2ActionRule {
-	actionID
-	levels
-	sendEndNotifications
-	sendRemindersInterval
-}
-fragment StageQuery on StageQuery {
-	id
-	pipeline
-	params
-	layout
-	input {
-		inputName
-		inputRole
-		datasetId
-		datasetPath
-		stageId
-	}
-}
-fragment MonitorV2Rule on MonitorV2Rule {
-	level
-	count {
-		... MonitorV2CountRule
-	}
-	threshold {
-		... MonitorV2ThresholdRule
-	}
-	promote {
-		... MonitorV2PromoteRule
-	}
-}
-fragment MonitorV2Column on MonitorV2Column {
-	linkColumn {
-		... MonitorV2LinkColumn
-	}
-	columnPath {
-		... MonitorV2ColumnPath
-	}
-}
-fragment MonitorV2Scheduling on MonitorV2Scheduling {
-	interval {
-		... MonitorV2IntervalSchedule
-	}
-	transform {
-		... MonitorV2TransformSchedule
-	}
-}
-fragment MonitorV2CountRule on MonitorV2CountRule {
-	compareValues {
-		... MonitorV2Comparison
-	}
-	compareGroups {
-		... MonitorV2ColumnComparison
-	}
-}
-fragment MonitorV2ThresholdRule on MonitorV2ThresholdRule {
-	compareValues {
-		... MonitorV2Comparison
-	}
-	valueColumnName
-	aggregation
-	compareGroups {
-		... MonitorV2ColumnComparison
-	}
-}
-fragment MonitorV2PromoteRule on MonitorV2PromoteRule {
-	compareColumns {
-		... MonitorV2ColumnComparison
-	}
-}
-fragment MonitorV2LinkColumn on MonitorV2LinkColumn {
-	name
-	meta {
-		... MonitorV2LinkColumnMeta
-	}
-}
-fragment MonitorV2ColumnPath on MonitorV2ColumnPath {
-	name
-	path
-}
-fragment MonitorV2IntervalSchedule on MonitorV2IntervalSchedule {
-	interval
-	randomize
-}
-fragment MonitorV2TransformSchedule on MonitorV2TransformSchedule {
-	freshnessGoal
-}
-fragment MonitorV2Comparison on MonitorV2Comparison {
-	compareFn
-	compareValue {
-		... PrimitiveValue
-	}
-}
-fragment MonitorV2ColumnComparison on MonitorV2ColumnComparison {
-	column {
-		... MonitorV2Column
-	}
-	compareValues {
-		... MonitorV2Comparison
+	status
+	inactiveTime
+	closedTime
+	users {
+		userId
 	}
-}
-fragment MonitorV2LinkColumnMeta on MonitorV2LinkColumnMeta {
-	srcFields {
-		... MonitorV2ColumnPath
+	slackChannels {
+		connectionID
+		slackchannelID
 	}
-	dstFields
-	targetDataset
-}
-fragment PrimitiveValue on PrimitiveValue {
-	bool
-	float64
-	int64
-	string
-	timestamp
-	duration
+	worksheets
+	dashboards
 }
 `
 
-func lookupMonitorV2(
+func removeIncidentDashboards(
 	ctx context.Context,
 	client graphql.Client,
-	workspaceId *string,
-	folderId *string,
-	nameExact *string,
-	nameSubstring *string,
-) (*lookupMonitorV2Response, error) {
+	id string,
+	dashboards []string,
+) (*removeIncidentDashboardsResponse, error) {
 	req := &graphql.Request{
-		OpName: "lookupMonitorV2",
-		Query:  lookupMonitorV2_Operation,
-		Variables: &__lookupMonitorV2Input{
-			WorkspaceId:   workspaceId,
-			FolderId:      folderId,
-			NameExact:     nameExact,
-			NameSubstring: nameSubstring,
+		OpName: "removeIncidentDashboards",
+		Query:  removeIncidentDashboards_Operation,
+		Variables: &__removeIncidentDashboardsInput{
+			Id:         id,
+			Dashboards: dashboards,
 		},
 	}
 	var err error
 
-	var data lookupMonitorV2Response
+	var data removeIncidentDashboardsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -18237,48 +24594,52 @@ func lookupMonitorV2(
 	return &data, err
 }
 
-// The query or mutation executed by lookupSnowflakeOutboundShare.
-const lookupSnowflakeOutboundShare_Operation = `
-query lookupSnowflakeOutboundShare ($name: String!, $workspaceId: ObjectId!) {
-	shares: searchSnowflakeOutboundShare(nameExact: $name, workspaceId: $workspaceId) {
-		results {
-			... SnowflakeOutboundShare
-		}
+// The query or mutation executed by removeIncidentSlackchannels.
+const removeIncidentSlackchannels_Operation = `
+mutation removeIncidentSlackchannels ($id: ObjectId!, $channels: [IncidentSlackchannelInput!]!) {
+	incident: removeIncidentSlackchannels(i: $id, cs: $channels) {
+		... Incident
 	}
 }
-fragment SnowflakeOutboundShare on SnowflakeOutboundShare {
+fragment Incident on Incident {
 	id
 	name
 	description
+	iconUrl
 	workspaceId
-	shareName
-	accounts {
-		... SnowflakeAccount
+	folderId
+	status
+	inactiveTime
+	closedTime
+	users {
+		userId
 	}
-}
-fragment SnowflakeAccount on SnowflakeAccount {
-	organization
-	account
+	slackChannels {
+		connectionID
+		slackchannelID
+	}
+	worksheets
+	dashboards
 }
 `
 
-func lookupSnowflakeOutboundShare(
+func removeIncidentSlackchannels(
 	ctx context.Context,
 	client graphql.Client,
-	name string,
-	workspaceId string,
-) (*lookupSnowflakeOutboundShareResponse, error) {
+	id string,
+	channels []IncidentSlackchannelInput,
+) (*removeIncidentSlackchannelsResponse, error) {
 	req := &graphql.Request{
-		OpName: "lookupSnowflakeOutboundShare",
-		Query:  lookupSnowflakeOutboundShare_Operation,
-		Variables: &__lookupSnowflakeOutboundShareInput{
-			Name:        name,
-			WorkspaceId: workspaceId,
+		OpName: "removeIncidentSlackchannels",
+		Query:  removeIncidentSlackchannels_Operation,
+		Variables: &__removeIncidentSlackchannelsInput{
+			Id:       id,
+			Channels: channels,
 		},
 	}
 	var err error
 
-	var data lookupSnowflakeOutboundShareResponse
+	var data removeIncidentSlackchannelsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -18290,34 +24651,52 @@ func lookupSnowflakeOutboundShare(
 	return &data, err
 }
 
-// The query or mutation executed by lookupWorkspace.
-const lookupWorkspace_Operation = `
-query lookupWorkspace ($name: String!) {
-	workspace(label: $name) {
-		... Workspace
+// The query or mutation executed by removeIncidentUsers.
+const removeIncidentUsers_Operation = `
+mutation removeIncidentUsers ($id: ObjectId!, $users: [UserId!]!) {
+	incident: removeIncidentUsers(i: $id, us: $users) {
+		... Incident
 	}
 }
-fragment Workspace on Project {
+fragment Incident on Incident {
 	id
-	label
+	name
+	description
+	iconUrl
+	workspaceId
+	folderId
+	status
+	inactiveTime
+	closedTime
+	users {
+		userId
+	}
+	slackChannels {
+		connectionID
+		slackchannelID
+	}
+	worksheets
+	dashboards
 }
 `
 
-func lookupWorkspace(
+func removeIncidentUsers(
 	ctx context.Context,
 	client graphql.Client,
-	name string,
-) (*lookupWorkspaceResponse, error) {
+	id string,
+	users []types.UserIdScalar,
+) (*removeIncidentUsersResponse, error) {
 	req := &graphql.Request{
-		OpName: "lookupWorkspace",
-		Query:  lookupWorkspace_Operation,
-		Variables: &__lookupWorkspaceInput{
-			Name: name,
+		OpName: "removeIncidentUsers",
+		Query:  removeIncidentUsers_Operation,
+		Variables: &__removeIncidentUsersInput{
+			Id:    id,
+			Users: users,
 		},
 	}
 	var err error
 
-	var data lookupWorkspaceResponse
+	var data removeIncidentUsersResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -18327,41 +24706,54 @@ func lookupWorkspace(
 	)
 
 	return &data, err
-}
-
-// The query or mutation executed by removeCorrelationTag.
-const removeCorrelationTag_Operation = `
-mutation removeCorrelationTag ($datasetId: ObjectId!, $path: LinkFieldInput!, $tag: String!) {
-	resultStatus: removeCorrelationTag(datasetId: $datasetId, path: $path, tag: $tag) {
-		... ResultStatus
+}
+
+// The query or mutation executed by removeIncidentWorksheets.
+const removeIncidentWorksheets_Operation = `
+mutation removeIncidentWorksheets ($id: ObjectId!, $worksheets: [ObjectId!]!) {
+	incident: removeIncidentWorksheets(i: $id, ws: $worksheets) {
+		... Incident
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment Incident on Incident {
+	id
+	name
+	description
+	iconUrl
+	workspaceId
+	folderId
+	status
+	inactiveTime
+	closedTime
+	users {
+		userId
+	}
+	slackChannels {
+		connectionID
+		slackchannelID
+	}
+	worksheets
+	dashboards
 }
 `
 
-func removeCorrelationTag(
+func removeIncidentWorksheets(
 	ctx context.Context,
 	client graphql.Client,
-	datasetId string,
-	path LinkFieldInput,
-	tag string,
-) (*removeCorrelationTagResponse, error) {
+	id string,
+	worksheets []string,
+) (*removeIncidentWorksheetsResponse, error) {
 	req := &graphql.Request{
-		OpName: "removeCorrelationTag",
-		Query:  removeCorrelationTag_Operation,
-		Variables: &__removeCorrelationTagInput{
-			DatasetId: datasetId,
-			Path:      path,
-			Tag:       tag,
+		OpName: "removeIncidentWorksheets",
+		Query:  removeIncidentWorksheets_Operation,
+		Variables: &__removeIncidentWorksheetsInput{
+			Id:         id,
+			Worksheets: worksheets,
 		},
 	}
 	var err error
 
-	var data removeCorrelationTagResponse
+	var data removeIncidentWorksheetsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -18609,6 +25001,20 @@ fragment Dataset on Dataset {
 	source
 	managedById
 	onDemandMaterializationLength
+	accelerable
+	hibernated
+	compilationError {
+		error
+		errorInDatasetId
+	}
+	accelerationInfo {
+		state
+		stalenessSeconds
+		targetStalenessSeconds
+		errors {
+			errorText
+		}
+	}
 	foreignKeys {
 		label
 		targetDataset
@@ -18919,6 +25325,20 @@ fragment Dataset on Dataset {
 	source
 	managedById
 	onDemandMaterializationLength
+	accelerable
+	hibernated
+	compilationError {
+		error
+		errorInDatasetId
+	}
+	accelerationInfo {
+		state
+		stalenessSeconds
+		targetStalenessSeconds
+		errors {
+			errorText
+		}
+	}
 	foreignKeys {
 		label
 		targetDataset
@@ -19073,6 +25493,184 @@ func saveWorksheet(
 	return &data, err
 }
 
+// The query or mutation executed by searchDashboards.
+const searchDashboards_Operation = `
+query searchDashboards ($terms: DWSearchInput!, $maxCount: Int64) {
+	dashboardSearch(terms: $terms, maxCount: $maxCount) {
+		dashboards {
+			... DashboardSearchResult
+		}
+		warnings
+	}
+}
+fragment DashboardSearchResult on DashboardSearchResult {
+	score
+	dashboard {
+		id
+		name
+		description
+		iconUrl
+		workspaceId
+		folderId
+		updatedDate
+	}
+}
+`
+
+func searchDashboards(
+	ctx context.Context,
+	client graphql.Client,
+	terms DWSearchInput,
+	maxCount *types.Int64Scalar,
+) (*searchDashboardsResponse, error) {
+	req := &graphql.Request{
+		OpName: "searchDashboards",
+		Query:  searchDashboards_Operation,
+		Variables: &__searchDashboardsInput{
+			Terms:    terms,
+			MaxCount: maxCount,
+		},
+	}
+	var err error
+
+	var data searchDashboardsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by searchDatasets.
+const searchDatasets_Operation = `
+query searchDatasets ($projects: [ObjectId!], $labelMatches: [String!], $columnMatches: [String!], $correlationTagMatches: [String!], $reachableFromDataset: ObjectId, $implementsInterfaces: [String!], $searchMode: SearchMode) {
+	datasetSearch(projects: $projects, labelMatches: $labelMatches, columnMatches: $columnMatches, correlationTagMatches: $correlationTagMatches, reachableFromDataset: $reachableFromDataset, implementsInterfaces: $implementsInterfaces, searchMode: $searchMode) {
+		... DatasetSearchMatch
+	}
+}
+fragment DatasetSearchMatch on DatasetMatch {
+	dataset {
+		id
+		name
+		path
+		workspaceId
+		interfaces {
+			path
+		}
+	}
+	matchData {
+		score
+		matchedLabel
+		matchedColumn
+		matchedCorrelationTag
+		matchedInterface
+	}
+}
+`
+
+func searchDatasets(
+	ctx context.Context,
+	client graphql.Client,
+	projects []string,
+	labelMatches []string,
+	columnMatches []string,
+	correlationTagMatches []string,
+	reachableFromDataset *string,
+	implementsInterfaces []string,
+	searchMode *SearchMode,
+) (*searchDatasetsResponse, error) {
+	req := &graphql.Request{
+		OpName: "searchDatasets",
+		Query:  searchDatasets_Operation,
+		Variables: &__searchDatasetsInput{
+			Projects:              projects,
+			LabelMatches:          labelMatches,
+			ColumnMatches:         columnMatches,
+			CorrelationTagMatches: correlationTagMatches,
+			ReachableFromDataset:  reachableFromDataset,
+			ImplementsInterfaces:  implementsInterfaces,
+			SearchMode:            searchMode,
+		},
+	}
+	var err error
+
+	var data searchDatasetsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by searchMetrics.
+const searchMetrics_Operation = `
+query searchMetrics ($workspaces: [ObjectId!], $inDatasets: [ObjectId!], $linkToDatasets: [ObjectId!], $correlationTagMatches: [String!], $match: String!) {
+	metricSearch(workspaces: $workspaces, inDatasets: $inDatasets, linkToDatasets: $linkToDatasets, correlationTagMatches: $correlationTagMatches, match: $match) {
+		matches {
+			... MetricSearchMatch
+		}
+		numSearched
+	}
+}
+fragment MetricSearchMatch on MetricMatch {
+	datasetId
+	metric {
+		name
+		nameWithPath
+		type
+		unit
+		description
+		rollup
+		aggregate
+		interval
+		userDefined
+		state
+	}
+}
+`
+
+func searchMetrics(
+	ctx context.Context,
+	client graphql.Client,
+	workspaces []string,
+	inDatasets []string,
+	linkToDatasets []string,
+	correlationTagMatches []string,
+	match string,
+) (*searchMetricsResponse, error) {
+	req := &graphql.Request{
+		OpName: "searchMetrics",
+		Query:  searchMetrics_Operation,
+		Variables: &__searchMetricsInput{
+			Workspaces:            workspaces,
+			InDatasets:            inDatasets,
+			LinkToDatasets:        linkToDatasets,
+			CorrelationTagMatches: correlationTagMatches,
+			Match:                 match,
+		},
+	}
+	var err error
+
+	var data searchMetricsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
 // The query or mutation executed by searchMonitorActions.
 const searchMonitorActions_Operation = `
 query searchMonitorActions ($workspaceId: ObjectId, $name: String) {
@@ -19249,6 +25847,103 @@ func searchMonitorV2Action(
 	return &data, err
 }
 
+// The query or mutation executed by searchResourceInstances.
+const searchResourceInstances_Operation = `
+query searchResourceInstances ($keyFragments: [String!], $datasetIds: [ObjectId!], $startTime: Time, $endTime: Time, $perDatasetLimit: Int64, $globalLimit: Int64, $pagination: PaginationInput) {
+	instances: resourceInstanceSearch(keyFragments: $keyFragments, datasetIds: $datasetIds, startTime: $startTime, endTime: $endTime, perDatasetLimit: $perDatasetLimit, globalLimit: $globalLimit, pagination: $pagination)
+}
+`
+
+func searchResourceInstances(
+	ctx context.Context,
+	client graphql.Client,
+	keyFragments []string,
+	datasetIds []string,
+	startTime *types.TimeScalar,
+	endTime *types.TimeScalar,
+	perDatasetLimit *types.Int64Scalar,
+	globalLimit *types.Int64Scalar,
+	pagination *PaginationInput,
+) (*searchResourceInstancesResponse, error) {
+	req := &graphql.Request{
+		OpName: "searchResourceInstances",
+		Query:  searchResourceInstances_Operation,
+		Variables: &__searchResourceInstancesInput{
+			KeyFragments:    keyFragments,
+			DatasetIds:      datasetIds,
+			StartTime:       startTime,
+			EndTime:         endTime,
+			PerDatasetLimit: perDatasetLimit,
+			GlobalLimit:     globalLimit,
+			Pagination:      pagination,
+		},
+	}
+	var err error
+
+	var data searchResourceInstancesResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by searchWorksheets.
+const searchWorksheets_Operation = `
+query searchWorksheets ($terms: DWSearchInput!, $maxCount: Int64) {
+	worksheetSearch(terms: $terms, maxCount: $maxCount) {
+		worksheets {
+			... WorksheetSearchResult
+		}
+		warnings
+	}
+}
+fragment WorksheetSearchResult on WorksheetSearchResult {
+	score
+	worksheet {
+		id
+		name
+		description
+		iconUrl
+		workspaceId
+		folderId
+		updatedDate
+	}
+}
+`
+
+func searchWorksheets(
+	ctx context.Context,
+	client graphql.Client,
+	terms DWSearchInput,
+	maxCount *types.Int64Scalar,
+) (*searchWorksheetsResponse, error) {
+	req := &graphql.Request{
+		OpName: "searchWorksheets",
+		Query:  searchWorksheets_Operation,
+		Variables: &__searchWorksheetsInput{
+			Terms:    terms,
+			MaxCount: maxCount,
+		},
+	}
+	var err error
+
+	var data searchWorksheetsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
 // The query or mutation executed by setChannelsForChannelAction.
 const setChannelsForChannelAction_Operation = `
 mutation setChannelsForChannelAction ($actionId: ObjectId!, $channelIds: [ObjectId!]!) {
@@ -19340,30 +26035,116 @@ mutation setMonitorsForChannel ($channelId: ObjectId!, $monitorIds: [ObjectId!]!
 		... ResultStatus
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
+}
+`
+
+func setMonitorsForChannel(
+	ctx context.Context,
+	client graphql.Client,
+	channelId string,
+	monitorIds []string,
+) (*setMonitorsForChannelResponse, error) {
+	req := &graphql.Request{
+		OpName: "setMonitorsForChannel",
+		Query:  setMonitorsForChannel_Operation,
+		Variables: &__setMonitorsForChannelInput{
+			ChannelId:  channelId,
+			MonitorIds: monitorIds,
+		},
+	}
+	var err error
+
+	var data setMonitorsForChannelResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by setRbacDefaultGroup.
+const setRbacDefaultGroup_Operation = `
+mutation setRbacDefaultGroup ($id: ORN!) {
+	resultStatus: setRbacDefaultGroup(id: $id) {
+		... ResultStatus
+	}
+}
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
+}
+`
+
+func setRbacDefaultGroup(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*setRbacDefaultGroupResponse, error) {
+	req := &graphql.Request{
+		OpName: "setRbacDefaultGroup",
+		Query:  setRbacDefaultGroup_Operation,
+		Variables: &__setRbacDefaultGroupInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data setRbacDefaultGroupResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by setRbacGroupmembers.
+const setRbacGroupmembers_Operation = `
+mutation setRbacGroupmembers ($groupId: ORN!, $memberUsers: [UserId!], $memberGroups: [ORN!]) {
+	rbacGroupmembers: setRbacGroupmembers(groupId: $groupId, memberUsers: $memberUsers, memberGroups: $memberGroups) {
+		... RbacGroupmember
+	}
+}
+fragment RbacGroupmember on RbacGroupmember {
+	id
+	description
+	groupId
+	memberUserId
+	memberGroupId
 }
 `
 
-func setMonitorsForChannel(
+func setRbacGroupmembers(
 	ctx context.Context,
 	client graphql.Client,
-	channelId string,
-	monitorIds []string,
-) (*setMonitorsForChannelResponse, error) {
+	groupId string,
+	memberUsers []types.UserIdScalar,
+	memberGroups []string,
+) (*setRbacGroupmembersResponse, error) {
 	req := &graphql.Request{
-		OpName: "setMonitorsForChannel",
-		Query:  setMonitorsForChannel_Operation,
-		Variables: &__setMonitorsForChannelInput{
-			ChannelId:  channelId,
-			MonitorIds: monitorIds,
+		OpName: "setRbacGroupmembers",
+		Query:  setRbacGroupmembers_Operation,
+		Variables: &__setRbacGroupmembersInput{
+			GroupId:      groupId,
+			MemberUsers:  memberUsers,
+			MemberGroups: memberGroups,
 		},
 	}
 	var err error
 
-	var data setMonitorsForChannelResponse
+	var data setRbacGroupmembersResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -19375,10 +26156,10 @@ func setMonitorsForChannel(
 	return &data, err
 }
 
-// The query or mutation executed by setRbacDefaultGroup.
-const setRbacDefaultGroup_Operation = `
-mutation setRbacDefaultGroup ($id: ORN!) {
-	resultStatus: setRbacDefaultGroup(id: $id) {
+// The query or mutation executed by setWorkspaceObjectOwner.
+const setWorkspaceObjectOwner_Operation = `
+mutation setWorkspaceObjectOwner ($id: ObjectId!, $owner: UserId!) {
+	resultStatus: setWorkspaceObjectOwner(woid: $id, owner: $owner) {
 		... ResultStatus
 	}
 }
@@ -19389,21 +26170,23 @@ fragment ResultStatus on ResultStatus {
 }
 `
 
-func setRbacDefaultGroup(
+func setWorkspaceObjectOwner(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*setRbacDefaultGroupResponse, error) {
+	owner types.UserIdScalar,
+) (*setWorkspaceObjectOwnerResponse, error) {
 	req := &graphql.Request{
-		OpName: "setRbacDefaultGroup",
-		Query:  setRbacDefaultGroup_Operation,
-		Variables: &__setRbacDefaultGroupInput{
-			Id: id,
+		OpName: "setWorkspaceObjectOwner",
+		Query:  setWorkspaceObjectOwner_Operation,
+		Variables: &__setWorkspaceObjectOwnerInput{
+			Id:    id,
+			Owner: owner,
 		},
 	}
 	var err error
 
-	var data setRbacDefaultGroupResponse
+	var data setWorkspaceObjectOwnerResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -19552,6 +26335,56 @@ func updateAppDataSource(
 	return &data, err
 }
 
+// The query or mutation executed by updateBlob.
+const updateBlob_Operation = `
+mutation updateBlob ($userId: UserId, $name: String!, $info: BlobInput!, $makePermanent: Boolean) {
+	blob: updateBlob(userId: $userId, name: $name, info: $info, makePermanent: $makePermanent) {
+		... Blob
+	}
+}
+fragment Blob on Blob {
+	userId
+	name
+	kind
+	value
+	readable
+	writable
+	updatedDate
+}
+`
+
+func updateBlob(
+	ctx context.Context,
+	client graphql.Client,
+	userId *types.UserIdScalar,
+	name string,
+	info BlobInput,
+	makePermanent *bool,
+) (*updateBlobResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateBlob",
+		Query:  updateBlob_Operation,
+		Variables: &__updateBlobInput{
+			UserId:        userId,
+			Name:          name,
+			Info:          info,
+			MakePermanent: makePermanent,
+		},
+	}
+	var err error
+
+	var data updateBlobResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
 // The query or mutation executed by updateBoard.
 const updateBoard_Operation = `
 mutation updateBoard ($id: ObjectId!, $board: BoardInput!) {
@@ -19710,6 +26543,48 @@ func updateChannelAction(
 	return &data, err
 }
 
+// The query or mutation executed by updateCurrentCustomerSso.
+const updateCurrentCustomerSso_Operation = `
+mutation updateCurrentCustomerSso ($sso: CustomerSsoInput!) {
+	sso: updateCurrentCustomerSso(sso: $sso) {
+		... CustomerSso
+	}
+}
+fragment CustomerSso on CustomerSso {
+	ssoLocalFlag
+	scimFlag
+	samlUrl
+	samlCert
+	samlExpires
+}
+`
+
+func updateCurrentCustomerSso(
+	ctx context.Context,
+	client graphql.Client,
+	sso CustomerSsoInput,
+) (*updateCurrentCustomerSsoResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateCurrentCustomerSso",
+		Query:  updateCurrentCustomerSso_Operation,
+		Variables: &__updateCurrentCustomerSsoInput{
+			Sso: sso,
+		},
+	}
+	var err error
+
+	var data updateCurrentCustomerSsoResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
 // The query or mutation executed by updateDashboardLink.
 const updateDashboardLink_Operation = `
 mutation updateDashboardLink ($id: ObjectId!, $input: DashboardLinkInput!) {
@@ -19959,6 +26834,47 @@ func updateDeferredForeignKey(
 	return &data, err
 }
 
+// The query or mutation executed by updateFeatureFlagForUser.
+const updateFeatureFlagForUser_Operation = `
+mutation updateFeatureFlagForUser ($input: FeatureFlagInput!) {
+	featureFlag: updateFeatureFlagForUser(input: $input) {
+		... FeatureFlag
+	}
+}
+fragment FeatureFlag on FeatureFlag {
+	name
+	description
+	isEnabled
+	isSelfServiceEnabled
+}
+`
+
+func updateFeatureFlagForUser(
+	ctx context.Context,
+	client graphql.Client,
+	input FeatureFlagInput,
+) (*updateFeatureFlagForUserResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateFeatureFlagForUser",
+		Query:  updateFeatureFlagForUser_Operation,
+		Variables: &__updateFeatureFlagForUserInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data updateFeatureFlagForUserResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
 // The query or mutation executed by updateFiledrop.
 const updateFiledrop_Operation = `
 mutation updateFiledrop ($id: ObjectId!, $input: FiledropInput!) {
@@ -20066,6 +26982,63 @@ func updateFolder(
 	return &data, err
 }
 
+// The query or mutation executed by updateIncident.
+const updateIncident_Operation = `
+mutation updateIncident ($id: ObjectId!, $input: IncidentInput!) {
+	incident: updateIncident(id: $id, input: $input) {
+		... Incident
+	}
+}
+fragment Incident on Incident {
+	id
+	name
+	description
+	iconUrl
+	workspaceId
+	folderId
+	status
+	inactiveTime
+	closedTime
+	users {
+		userId
+	}
+	slackChannels {
+		connectionID
+		slackchannelID
+	}
+	worksheets
+	dashboards
+}
+`
+
+func updateIncident(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	input IncidentInput,
+) (*updateIncidentResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateIncident",
+		Query:  updateIncident_Operation,
+		Variables: &__updateIncidentInput{
+			Id:    id,
+			Input: input,
+		},
+	}
+	var err error
+
+	var data updateIncidentResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
 // The query or mutation executed by updateLayeredSettingRecord.
 const updateLayeredSettingRecord_Operation = `
 mutation updateLayeredSettingRecord ($settingRecord: LayeredSettingRecordInput!) {
@@ -20380,6 +27353,72 @@ func updateMonitorActionAttachment(
 	return &data, err
 }
 
+// The query or mutation executed by updateMonitorMuteRuleForMonitors.
+const updateMonitorMuteRuleForMonitors_Operation = `
+mutation updateMonitorMuteRuleForMonitors ($id: ObjectId!, $input: MonitorMuteRuleInput!, $monitorIds: [ObjectId!]!) {
+	monitorMuteRule: updateMonitorMuteRuleForMonitors(id: $id, input: $input, monitorIds: $monitorIds) {
+		... MonitorMuteRule
+	}
+}
+fragment MonitorMuteRule on MonitorMuteRule {
+	id
+	workspaceId
+	folderId
+	name
+	iconUrl
+	description
+	startDate
+	duration
+	filter {
+		__typename
+		filterType
+		... on MonitorMuteRuleFilterPerColumn {
+			columnID
+			values
+		}
+		... on MonitorMuteRuleFilterPerResource {
+			resourceIds {
+				datasetId
+				primaryKeyValue {
+					name
+					value
+				}
+			}
+		}
+	}
+}
+`
+
+func updateMonitorMuteRuleForMonitors(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	input MonitorMuteRuleInput,
+	monitorIds []string,
+) (*updateMonitorMuteRuleForMonitorsResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateMonitorMuteRuleForMonitors",
+		Query:  updateMonitorMuteRuleForMonitors_Operation,
+		Variables: &__updateMonitorMuteRuleForMonitorsInput{
+			Id:         id,
+			Input:      input,
+			MonitorIds: monitorIds,
+		},
+	}
+	var err error
+
+	var data updateMonitorMuteRuleForMonitorsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
 // The query or mutation executed by updateMonitorV2.
 const updateMonitorV2_Operation = `
 mutation updateMonitorV2 ($id: ObjectId!, $input: MonitorV2Input!) {
@@ -21129,6 +28168,98 @@ func updateSnowflakeOutboundShare(
 	return &data, err
 }
 
+// The query or mutation executed by updateUser.
+const updateUser_Operation = `
+mutation updateUser ($id: UserId!, $input: UserInput!) {
+	user: updateUser(id: $id, user: $input) {
+		... User
+	}
+}
+fragment User on User {
+	id
+	email
+	label
+	role
+	status
+	type
+	comment
+}
+`
+
+func updateUser(
+	ctx context.Context,
+	client graphql.Client,
+	id types.UserIdScalar,
+	input UserInput,
+) (*updateUserResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateUser",
+		Query:  updateUser_Operation,
+		Variables: &__updateUserInput{
+			Id:    id,
+			Input: input,
+		},
+	}
+	var err error
+
+	var data updateUserResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by updateUsers.
+const updateUsers_Operation = `
+mutation updateUsers ($ids: [UserId!]!, $input: UserInput!) {
+	users: updateUsers(ids: $ids, user: $input) {
+		... User
+	}
+}
+fragment User on User {
+	id
+	email
+	label
+	role
+	status
+	type
+	comment
+}
+`
+
+func updateUsers(
+	ctx context.Context,
+	client graphql.Client,
+	ids []types.UserIdScalar,
+	input UserInput,
+) (*updateUsersResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateUsers",
+		Query:  updateUsers_Operation,
+		Variables: &__updateUsersInput{
+			Ids:   ids,
+			Input: input,
+		},
+	}
+	var err error
+
+	var data updateUsersResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
 // The query or mutation executed by updateWorkspace.
 const updateWorkspace_Operation = `
 mutation updateWorkspace ($id: ObjectId!, $config: WorkspaceInput!) {
@@ -80,6 +80,18 @@ var AllRbacRoles = []RbacRole{
 	RbacRoleLister,
 }
 
+var AllIncidentStatuses = []IncidentStatus{
+	IncidentStatusActive,
+	IncidentStatusInactive,
+	IncidentStatusClosed,
+}
+
+var AllSimpleScopes = []SimpleScope{
+	SimpleScopeScopeuser,
+	SimpleScopeScopecustomer,
+	SimpleScopeScopeworld,
+}
+
 var AllPollerHTTPRequestAuthSchemes = []PollerHTTPRequestAuthScheme{
 	PollerHTTPRequestAuthSchemeBasic,
 	PollerHTTPRequestAuthSchemeDigest,
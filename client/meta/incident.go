@@ -0,0 +1,105 @@
+package meta
+
+import (
+	"context"
+
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+)
+
+type incidentResponse interface {
+	GetIncident() Incident
+}
+
+func incidentOrError(r incidentResponse, err error) (*Incident, error) {
+	if err != nil {
+		return nil, err
+	}
+	result := r.GetIncident()
+	return &result, nil
+}
+
+func (client *Client) CreateIncident(ctx context.Context, workspaceId string, input *IncidentInput) (*Incident, error) {
+	resp, err := createIncident(ctx, client.Gql, workspaceId, *input)
+	return incidentOrError(resp, err)
+}
+
+func (client *Client) GetIncident(ctx context.Context, id string) (*Incident, error) {
+	resp, err := getIncident(ctx, client.Gql, id)
+	return incidentOrError(resp, err)
+}
+
+func (client *Client) UpdateIncident(ctx context.Context, id string, input *IncidentInput) (*Incident, error) {
+	resp, err := updateIncident(ctx, client.Gql, id, *input)
+	return incidentOrError(resp, err)
+}
+
+func (client *Client) DeleteIncident(ctx context.Context, id string) error {
+	resp, err := deleteIncident(ctx, client.Gql, id)
+	return resultStatusError(resp, err)
+}
+
+func (client *Client) AddIncidentUsers(ctx context.Context, id string, users []types.UserIdScalar) (*Incident, error) {
+	resp, err := addIncidentUsers(ctx, client.Gql, id, users)
+	return incidentOrError(resp, err)
+}
+
+func (client *Client) RemoveIncidentUsers(ctx context.Context, id string, users []types.UserIdScalar) (*Incident, error) {
+	resp, err := removeIncidentUsers(ctx, client.Gql, id, users)
+	return incidentOrError(resp, err)
+}
+
+func (client *Client) AddIncidentSlackchannels(ctx context.Context, id string, channels []IncidentSlackchannelInput) (*Incident, error) {
+	resp, err := addIncidentSlackchannels(ctx, client.Gql, id, channels)
+	return incidentOrError(resp, err)
+}
+
+func (client *Client) RemoveIncidentSlackchannels(ctx context.Context, id string, channels []IncidentSlackchannelInput) (*Incident, error) {
+	resp, err := removeIncidentSlackchannels(ctx, client.Gql, id, channels)
+	return incidentOrError(resp, err)
+}
+
+func (client *Client) AddIncidentWorksheets(ctx context.Context, id string, worksheets []string) (*Incident, error) {
+	resp, err := addIncidentWorksheets(ctx, client.Gql, id, worksheets)
+	return incidentOrError(resp, err)
+}
+
+func (client *Client) RemoveIncidentWorksheets(ctx context.Context, id string, worksheets []string) (*Incident, error) {
+	resp, err := removeIncidentWorksheets(ctx, client.Gql, id, worksheets)
+	return incidentOrError(resp, err)
+}
+
+func (client *Client) AddIncidentDashboards(ctx context.Context, id string, dashboards []string) (*Incident, error) {
+	resp, err := addIncidentDashboards(ctx, client.Gql, id, dashboards)
+	return incidentOrError(resp, err)
+}
+
+func (client *Client) RemoveIncidentDashboards(ctx context.Context, id string, dashboards []string) (*Incident, error) {
+	resp, err := removeIncidentDashboards(ctx, client.Gql, id, dashboards)
+	return incidentOrError(resp, err)
+}
+
+// GetIncidentsForStatus lists incidents with the given status. The time range
+// is optional.
+func (client *Client) GetIncidentsForStatus(ctx context.Context, status IncidentStatus, startingAt, endingAt *types.TimeScalar) ([]Incident, error) {
+	resp, err := getIncidentsForStatus(ctx, client.Gql, status, startingAt, endingAt)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Incidents, nil
+}
+
+// GetIncidentsForTextQuery lists incidents whose summaries are semantically
+// similar to text.
+func (client *Client) GetIncidentsForTextQuery(ctx context.Context, text string) ([]Incident, error) {
+	resp, err := getIncidentsForTextQuery(ctx, client.Gql, IncidentQueryInput{Text: text})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Incidents, nil
+}
+
+func (i *Incident) Oid() *oid.OID {
+	incidentOid := oid.IncidentOid(i.Id)
+	return &incidentOid
+}
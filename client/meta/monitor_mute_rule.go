@@ -0,0 +1,62 @@
+package meta
+
+import (
+	"context"
+
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+)
+
+type monitorMuteRuleResponse interface {
+	GetMonitorMuteRule() MonitorMuteRule
+}
+
+func monitorMuteRuleOrError(r monitorMuteRuleResponse, err error) (*MonitorMuteRule, error) {
+	if err != nil {
+		return nil, err
+	}
+	result := r.GetMonitorMuteRule()
+	return &result, nil
+}
+
+func (client *Client) CreateMonitorMuteRule(ctx context.Context, input *MonitorMuteRuleInput, monitorIds []string) (*MonitorMuteRule, error) {
+	resp, err := createMonitorMuteRuleForMonitors(ctx, client.Gql, *input, monitorIds)
+	return monitorMuteRuleOrError(resp, err)
+}
+
+func (client *Client) GetMonitorMuteRule(ctx context.Context, id string) (*MonitorMuteRule, error) {
+	resp, err := getMonitorMuteRule(ctx, client.Gql, id)
+	return monitorMuteRuleOrError(resp, err)
+}
+
+func (client *Client) UpdateMonitorMuteRule(ctx context.Context, id string, input *MonitorMuteRuleInput, monitorIds []string) (*MonitorMuteRule, error) {
+	resp, err := updateMonitorMuteRuleForMonitors(ctx, client.Gql, id, *input, monitorIds)
+	return monitorMuteRuleOrError(resp, err)
+}
+
+func (client *Client) DeleteMonitorMuteRule(ctx context.Context, id string) error {
+	resp, err := deleteMonitorMuteRule(ctx, client.Gql, id)
+	return resultStatusError(resp, err)
+}
+
+// ListActiveMonitorMuteRuleIds returns the mute rules currently muting a v1
+// monitor. Rules which have not started yet or have expired are not included.
+func (client *Client) ListActiveMonitorMuteRuleIds(ctx context.Context, monitorId string) ([]string, error) {
+	resp, err := getActiveMonitorMuteRules(ctx, client.Gql, monitorId)
+	if err != nil {
+		return nil, err
+	}
+	info := resp.Monitor.ActiveMonitorInfo
+	if info == nil {
+		return nil, nil
+	}
+	ids := make([]string, len(info.MuteRules))
+	for i, r := range info.MuteRules {
+		ids[i] = r.Id
+	}
+	return ids, nil
+}
+
+func (m *MonitorMuteRule) Oid() *oid.OID {
+	muteRuleOid := oid.MonitorMuteRuleOid(m.Id)
+	return &muteRuleOid
+}
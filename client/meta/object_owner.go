@@ -0,0 +1,128 @@
+package meta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+)
+
+// WorkspaceObjectOwner identifies a workspace object and the user which owns it.
+type WorkspaceObjectOwner struct {
+	Oid         oid.OID
+	Name        string
+	WorkspaceId string
+	Owner       types.UserIdScalar
+}
+
+// ownedObject has the same shape as all of the object selections in
+// object_owner.graphql, so the generated types can be converted to it.
+type ownedObject struct {
+	Id          string             `json:"id"`
+	Name        string             `json:"name"`
+	WorkspaceId string             `json:"workspaceId"`
+	CreatedBy   types.UserIdScalar `json:"createdBy"`
+}
+
+func newWorkspaceObjectOwner(t oid.Type, o ownedObject) WorkspaceObjectOwner {
+	id := oid.OID{Type: t, Id: o.Id}
+	if t == oid.TypeFolder {
+		id = oid.FolderOid(o.Id, o.WorkspaceId)
+	}
+	return WorkspaceObjectOwner{Oid: id, Name: o.Name, WorkspaceId: o.WorkspaceId, Owner: o.CreatedBy}
+}
+
+// OwnedObjectTypes lists the object types supported by GetWorkspaceObjectOwner.
+var OwnedObjectTypes = []oid.Type{
+	oid.TypeDataset,
+	oid.TypeMonitorV2,
+	oid.TypeDashboard,
+	oid.TypeWorksheet,
+	oid.TypeFolder,
+}
+
+// GetWorkspaceObjectOwner retrieves the owner of the object with the given
+// type and ID. It returns nil if the object does not exist.
+func (client *Client) GetWorkspaceObjectOwner(ctx context.Context, t oid.Type, id string) (*WorkspaceObjectOwner, error) {
+	var (
+		object *ownedObject
+		err    error
+	)
+	switch t {
+	case oid.TypeDataset:
+		var resp *getDatasetOwnerResponse
+		if resp, err = getDatasetOwner(ctx, client.Gql, id); err == nil && resp.Object != nil {
+			o := ownedObject(*resp.Object)
+			object = &o
+		}
+	case oid.TypeMonitorV2:
+		var resp *getMonitorV2OwnerResponse
+		if resp, err = getMonitorV2Owner(ctx, client.Gql, id); err == nil {
+			o := ownedObject(resp.Object)
+			object = &o
+		}
+	case oid.TypeDashboard:
+		var resp *getDashboardOwnerResponse
+		if resp, err = getDashboardOwner(ctx, client.Gql, id); err == nil {
+			o := ownedObject(resp.Object)
+			object = &o
+		}
+	case oid.TypeWorksheet:
+		var resp *getWorksheetOwnerResponse
+		if resp, err = getWorksheetOwner(ctx, client.Gql, id); err == nil && resp.Object != nil {
+			o := ownedObject(*resp.Object)
+			object = &o
+		}
+	case oid.TypeFolder:
+		var resp *getFolderOwnerResponse
+		if resp, err = getFolderOwner(ctx, client.Gql, id); err == nil {
+			o := ownedObject(resp.Object)
+			object = &o
+		}
+	default:
+		return nil, fmt.Errorf("ownership of %s objects is not supported", t)
+	}
+	if err != nil || object == nil {
+		return nil, err
+	}
+	result := newWorkspaceObjectOwner(t, *object)
+	return &result, nil
+}
+
+// SetWorkspaceObjectOwner transfers ownership of an object to a user.
+func (client *Client) SetWorkspaceObjectOwner(ctx context.Context, id string, owner types.UserIdScalar) error {
+	resp, err := setWorkspaceObjectOwner(ctx, client.Gql, id, owner)
+	return resultStatusError(resp, err)
+}
+
+// ListWorkspaceObjectOwners retrieves the owners of all supported objects in
+// a workspace. Dashboards and worksheets are found through search, which
+// returns at most maxCount results of each type. If either search reached
+// that limit, truncated is set, since further objects may exist.
+func (client *Client) ListWorkspaceObjectOwners(ctx context.Context, workspaceId string, maxCount int64) (result []WorkspaceObjectOwner, truncated bool, err error) {
+	resp, err := listWorkspaceObjectOwners(ctx, client.Gql, workspaceId, types.Int64Scalar(maxCount).Ptr())
+	if err != nil {
+		return nil, false, err
+	}
+
+	if resp.Workspace != nil {
+		for _, o := range resp.Workspace.Datasets {
+			result = append(result, newWorkspaceObjectOwner(oid.TypeDataset, ownedObject(o)))
+		}
+		for _, o := range resp.Workspace.Folders {
+			result = append(result, newWorkspaceObjectOwner(oid.TypeFolder, ownedObject(o)))
+		}
+	}
+	for _, o := range resp.Monitors.Results {
+		result = append(result, newWorkspaceObjectOwner(oid.TypeMonitorV2, ownedObject(o)))
+	}
+	for _, r := range resp.Dashboards.Dashboards {
+		result = append(result, newWorkspaceObjectOwner(oid.TypeDashboard, ownedObject(r.Dashboard)))
+	}
+	for _, r := range resp.Worksheets.Worksheets {
+		result = append(result, newWorkspaceObjectOwner(oid.TypeWorksheet, ownedObject(r.Worksheet)))
+	}
+	truncated = int64(len(resp.Dashboards.Dashboards)) >= maxCount || int64(len(resp.Worksheets.Worksheets)) >= maxCount
+	return result, truncated, nil
+}
@@ -0,0 +1,101 @@
+package meta
+
+import (
+	"context"
+
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+)
+
+// SearchDashboards returns dashboards matching the given terms, ordered by
+// descending score, along with any warnings raised by the search.
+func (client *Client) SearchDashboards(ctx context.Context, terms DWSearchInput, maxCount *types.Int64Scalar) ([]DashboardSearchResult, []string, error) {
+	resp, err := searchDashboards(ctx, client.Gql, terms, maxCount)
+	if err != nil {
+		return nil, nil, err
+	}
+	result := make([]DashboardSearchResult, len(resp.DashboardSearch.Dashboards))
+	for i, d := range resp.DashboardSearch.Dashboards {
+		result[i] = d.DashboardSearchResult
+	}
+	return result, resp.DashboardSearch.Warnings, nil
+}
+
+// SearchWorksheets returns worksheets matching the given terms, ordered by
+// descending score, along with any warnings raised by the search.
+func (client *Client) SearchWorksheets(ctx context.Context, terms DWSearchInput, maxCount *types.Int64Scalar) ([]WorksheetSearchResult, []string, error) {
+	resp, err := searchWorksheets(ctx, client.Gql, terms, maxCount)
+	if err != nil {
+		return nil, nil, err
+	}
+	result := make([]WorksheetSearchResult, len(resp.WorksheetSearch.Worksheets))
+	for i, w := range resp.WorksheetSearch.Worksheets {
+		result[i] = w.WorksheetSearchResult
+	}
+	return result, resp.WorksheetSearch.Warnings, nil
+}
+
+func (d *DashboardSearchResultDashboard) Oid() *oid.OID {
+	return &oid.OID{
+		Id:   d.Id,
+		Type: oid.TypeDashboard,
+	}
+}
+
+func (w *WorksheetSearchResultWorksheet) Oid() *oid.OID {
+	return &oid.OID{
+		Id:   w.Id,
+		Type: oid.TypeWorksheet,
+	}
+}
+
+// SearchMetrics returns metrics matching the given filters, along with the
+// number of metrics searched. The latter counts all matches, including those
+// beyond the limits on returned matches.
+func (client *Client) SearchMetrics(ctx context.Context, workspaces []string, inDatasets []string, linkToDatasets []string, correlationTags []string, match string) ([]MetricSearchMatch, int64, error) {
+	resp, err := searchMetrics(ctx, client.Gql, workspaces, inDatasets, linkToDatasets, correlationTags, match)
+	if err != nil {
+		return nil, 0, err
+	}
+	result := make([]MetricSearchMatch, len(resp.MetricSearch.Matches))
+	for i, m := range resp.MetricSearch.Matches {
+		result[i] = m.MetricSearchMatch
+	}
+	return result, int64(resp.MetricSearch.NumSearched), nil
+}
+
+// SearchResourceInstances returns resource instances matching all of the
+// given key fragments. Rows beyond the first page can be read from the
+// returned cursor.
+func (client *Client) SearchResourceInstances(ctx context.Context, keyFragments []string, datasetIds []string, startTime *types.TimeScalar, endTime *types.TimeScalar, perDatasetLimit *types.Int64Scalar, globalLimit *types.Int64Scalar, pagination *PaginationInput) (*types.PaginatedResults, error) {
+	resp, err := searchResourceInstances(ctx, client.Gql, keyFragments, datasetIds, startTime, endTime, perDatasetLimit, globalLimit, pagination)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Instances == nil {
+		return &types.PaginatedResults{}, nil
+	}
+	return resp.Instances, nil
+}
+
+// SearchDatasets returns datasets matching the given filters, ordered by
+// descending score. In inclusive mode datasets matching any filter are
+// returned, in exclusive mode datasets must match every filter.
+func (client *Client) SearchDatasets(ctx context.Context, projects []string, labels []string, columns []string, correlationTags []string, reachableFrom *string, interfaces []string, mode SearchMode) ([]DatasetSearchMatch, error) {
+	resp, err := searchDatasets(ctx, client.Gql, projects, labels, columns, correlationTags, reachableFrom, interfaces, &mode)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]DatasetSearchMatch, len(resp.DatasetSearch))
+	for i, d := range resp.DatasetSearch {
+		result[i] = d.DatasetSearchMatch
+	}
+	return result, nil
+}
+
+func (d *DatasetSearchMatchDataset) Oid() *oid.OID {
+	return &oid.OID{
+		Id:   d.Id,
+		Type: oid.TypeDataset,
+	}
+}
@@ -2,13 +2,38 @@ package meta
 
 import (
 	"context"
+	"fmt"
+
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
 )
 
 // GetDatasetQueryOutput takes a simplified form: we use StageQueryInput instead of StageInput for now
-func (client *Client) DatasetQueryOutput(ctx context.Context, query []*StageInput, params *QueryParams) ([]*TaskResult, error) {
-	resp, err := getDatasetQueryOutput(ctx, client.Gql, query, *params)
+func (client *Client) DatasetQueryOutput(ctx context.Context, query []*StageInput, params *QueryParams, parameterValues []ParameterBindingInput) ([]*TaskResult, error) {
+	resp, err := getDatasetQueryOutput(ctx, client.Gql, query, *params, parameterValues)
 	if err != nil {
 		return nil, err
 	}
 	return resp.TaskResult, nil
 }
+
+// ExportQuery runs a query and returns a URL from which its results may be
+// downloaded in the requested format.
+func (client *Client) ExportQuery(ctx context.Context, query *MultiStageQueryInput, params *QueryParams, rowCount *types.Int64Scalar, filename *string, format *ExportFileFormat) (*ExportCursorResult, error) {
+	resp, err := exportQuery(ctx, client.Gql, *query, *params, rowCount, filename, format)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.Export, nil
+}
+
+// QueryCursor reads a page of rows from a cursor returned by a previous query.
+func (client *Client) QueryCursor(ctx context.Context, cursorId string, offset int64, numRows int64) (*types.PaginatedResults, error) {
+	resp, err := getQueryCursor(ctx, client.Gql, cursorId, types.Int64Scalar(offset), types.Int64Scalar(numRows))
+	if err != nil {
+		return nil, err
+	}
+	if resp.Page == nil {
+		return nil, fmt.Errorf("cursor %q returned no results", cursorId)
+	}
+	return resp.Page, nil
+}
@@ -0,0 +1,39 @@
+package meta
+
+import (
+	"context"
+
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+)
+
+// RbacTestRequestResult is the outcome of a simulated RBAC request, along
+// with the statement which granted it, if any.
+type RbacTestRequestResult = rbacTestRequestsResultsRbacTestRequestResult
+
+// RbacTestRequests evaluates each request on behalf of user, without
+// performing it. Results are returned in the order of requests.
+func (client *Client) RbacTestRequests(ctx context.Context, user types.UserIdScalar, requests []RbacRequestInput) ([]RbacTestRequestResult, error) {
+	resp, err := rbacTestRequests(ctx, client.Gql, user, requests)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Results, nil
+}
+
+// RbacUserMatches returns all statements which apply to user.
+func (client *Client) RbacUserMatches(ctx context.Context, user types.UserIdScalar) ([]RbacStatement, error) {
+	resp, err := rbacUserMatches(ctx, client.Gql, user)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Statements, nil
+}
+
+// RbacObjectMatches returns all statements which apply to object.
+func (client *Client) RbacObjectMatches(ctx context.Context, object RbacRequestObjectInput) ([]RbacStatement, error) {
+	resp, err := rbacObjectMatches(ctx, client.Gql, object)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Statements, nil
+}
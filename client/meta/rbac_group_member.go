@@ -3,6 +3,7 @@ package meta
 import (
 	"context"
 
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
 	oid "github.com/observeinc/terraform-provider-observe/client/oid"
 )
 
@@ -38,6 +39,30 @@ func (client *Client) DeleteRbacGroupmember(ctx context.Context, id string) erro
 	return resultStatusError(resp, err)
 }
 
+// ListRbacGroupmembers retrieves the direct memberships of a group.
+func (client *Client) ListRbacGroupmembers(ctx context.Context, groupId string) ([]RbacGroupmember, error) {
+	resp, err := listRbacGroupmembers(ctx, client.Gql)
+	if err != nil {
+		return nil, err
+	}
+	var result []RbacGroupmember
+	for _, m := range resp.RbacGroupmembers {
+		if m.GroupId == groupId {
+			result = append(result, m)
+		}
+	}
+	return result, nil
+}
+
+// SetRbacGroupmembers atomically replaces all direct memberships of a group.
+func (client *Client) SetRbacGroupmembers(ctx context.Context, groupId string, users []types.UserIdScalar, groups []string) ([]RbacGroupmember, error) {
+	resp, err := setRbacGroupmembers(ctx, client.Gql, groupId, users, groups)
+	if err != nil {
+		return nil, err
+	}
+	return resp.RbacGroupmembers, nil
+}
+
 func (r *RbacGroupmember) Oid() *oid.OID {
 	rbacGroupmemberOid := oid.RbacGroupmemberOid(r.Id)
 	return &rbacGroupmemberOid
@@ -42,3 +42,24 @@ func (r *RbacStatement) Oid() *oid.OID {
 	rbacStatementOid := oid.RbacStatementOid(r.Id)
 	return &rbacStatementOid
 }
+
+func (client *Client) ListRbacStatements(ctx context.Context) ([]RbacStatement, error) {
+	resp, err := listRbacStatements(ctx, client.Gql)
+	if err != nil {
+		return nil, err
+	}
+	return resp.RbacStatements, nil
+}
+
+// MutateRbacStatementsResult lists the statements affected by MutateRbacStatements.
+type MutateRbacStatementsResult = mutateRbacStatementsResponseMutateRbacStatementsResponse
+
+// MutateRbacStatements applies all creates, updates and deletes as a single
+// transaction. Created statements are returned in the order of toCreate.
+func (client *Client) MutateRbacStatements(ctx context.Context, toCreate []RbacStatementInput, toUpdate []UpdateRbacStatementInput, toDelete []string) (*MutateRbacStatementsResult, error) {
+	resp, err := mutateRbacStatements(ctx, client.Gql, toCreate, toUpdate, toDelete)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.Response, nil
+}
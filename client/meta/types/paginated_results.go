@@ -0,0 +1,82 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// PaginatedResults is a page of rows read from a query cursor. Rows are
+// returned in column-major format, with every column containing exactly
+// NumRows values.
+type PaginatedResults struct {
+	// CursorId identifies the cursor, and can be used to fetch more rows.
+	CursorId *string `json:"cursorId,omitempty"`
+	// TotalRows is the number of rows held by the cursor.
+	TotalRows int64 `json:"totalRows"`
+	// Offset into the cursor from which these rows were produced.
+	Offset int64 `json:"offset"`
+	// NumRows is the number of rows in this page.
+	NumRows int64       `json:"numRows"`
+	Columns [][]*string `json:"columns"`
+}
+
+func (p *PaginatedResults) UnmarshalJSON(b []byte) error {
+	// counts may be encoded either as JSON numbers or as Int64 strings
+	var raw struct {
+		CursorId  *string         `json:"cursorId"`
+		TotalRows json.RawMessage `json:"totalRows"`
+		Offset    json.RawMessage `json:"offset"`
+		NumRows   json.RawMessage `json:"numRows"`
+		Columns   [][]*string     `json:"columns"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	result := PaginatedResults{CursorId: raw.CursorId, Columns: raw.Columns}
+	for _, field := range []struct {
+		name  string
+		raw   json.RawMessage
+		value *int64
+	}{
+		{"totalRows", raw.TotalRows, &result.TotalRows},
+		{"offset", raw.Offset, &result.Offset},
+		{"numRows", raw.NumRows, &result.NumRows},
+	} {
+		n, err := parseFlexibleInt64(field.raw)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", field.name, err)
+		}
+		*field.value = n
+	}
+	*p = result
+	return nil
+}
+
+func parseFlexibleInt64(b json.RawMessage) (int64, error) {
+	if len(b) == 0 || string(b) == "null" {
+		return 0, nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		return strconv.ParseInt(s, 10, 64)
+	}
+	var n int64
+	err := json.Unmarshal(b, &n)
+	return n, err
+}
+
+// Rows converts the page into row-major format.
+func (p *PaginatedResults) Rows() [][]*string {
+	rows := make([][]*string, p.NumRows)
+	for i := range rows {
+		rows[i] = make([]*string, len(p.Columns))
+		for j, column := range p.Columns {
+			if i < len(column) {
+				rows[i][j] = column[i]
+			}
+		}
+	}
+	return rows
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPaginatedResultsJSON(t *testing.T) {
+	t.Parallel()
+
+	for _, input := range []string{
+		`{"cursorId": "c", "totalRows": 3, "offset": 1, "numRows": 2, "columns": [["a", null], ["1", "2"]]}`,
+		`{"cursorId": "c", "totalRows": "3", "offset": "1", "numRows": "2", "columns": [["a", null], ["1", "2"]]}`,
+	} {
+		var p PaginatedResults
+		if err := json.Unmarshal([]byte(input), &p); err != nil {
+			t.Fatal(err)
+		}
+		if p.TotalRows != 3 || p.Offset != 1 || p.NumRows != 2 {
+			t.Fatalf("unexpected counts: %+v", p)
+		}
+
+		a, one, two := "a", "1", "2"
+		expected := [][]*string{{&a, &one}, {nil, &two}}
+		if diff := cmp.Diff(expected, p.Rows()); diff != "" {
+			t.Fatalf("unexpected rows (-want +got):\n%s", diff)
+		}
+	}
+}
@@ -34,3 +34,7 @@ func (t *TimeScalar) UnmarshalJSON(b []byte) error {
 func (t TimeScalar) String() string {
 	return time.Time(t).Format(timeScalarFmt)
 }
+
+func (t TimeScalar) Ptr() *TimeScalar {
+	return &t
+}
@@ -8,6 +8,15 @@ import (
 	oid "github.com/observeinc/terraform-provider-observe/client/oid"
 )
 
+// generated enum names repeat the type name
+const (
+	UserStatusDeleted     = UserStatusUserstatusdeleted
+	UserStatusDisabled    = UserStatusUserstatusdisabled
+	UserStatusIdpDisabled = UserStatusUserstatusidpdisabled
+	UserStatusCreated     = UserStatusUserstatuscreated
+	UserStatusActive      = UserStatusUserstatusactive
+)
+
 type userResponse interface {
 	GetUser() *User
 }
@@ -28,6 +37,18 @@ func (client *Client) GetUser(ctx context.Context, id string) (*User, error) {
 	return userOrError(resp, err)
 }
 
+// GetCurrentUser returns the user the client is authenticated as.
+func (client *Client) GetCurrentUser(ctx context.Context) (*User, error) {
+	resp, err := getCurrentUser(ctx, client.Gql)
+	if err != nil {
+		return nil, err
+	}
+	if resp.User == nil {
+		return nil, fmt.Errorf("not authenticated as a user")
+	}
+	return resp.User, nil
+}
+
 func (client *Client) LookupUser(ctx context.Context, email string) (*User, error) {
 	resp, err := getCurrentCustomer(ctx, client.Gql)
 	if err != nil {
@@ -43,6 +64,59 @@ func (client *Client) LookupUser(ctx context.Context, email string) (*User, erro
 	return nil, fmt.Errorf("user not found")
 }
 
+// InviteUser invites a new user by email. The invited user is looked up by
+// email afterwards, since the invitation only returns a signup token.
+func (client *Client) InviteUser(ctx context.Context, input *UserInput) (*User, error) {
+	if input.Email == nil {
+		return nil, fmt.Errorf("email is required to invite a user")
+	}
+	if _, err := inviteUser(ctx, client.Gql, *input); err != nil {
+		return nil, err
+	}
+	return client.LookupUser(ctx, *input.Email)
+}
+
+func (client *Client) UpdateUser(ctx context.Context, id string, input *UserInput) (*User, error) {
+	uid, err := types.StringToUserIdScalar(id)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := updateUser(ctx, client.Gql, uid, *input)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.User, nil
+}
+
+// UpdateUsers applies the same update to several users at once.
+func (client *Client) UpdateUsers(ctx context.Context, ids []string, input *UserInput) ([]User, error) {
+	uids := make([]types.UserIdScalar, 0, len(ids))
+	for _, id := range ids {
+		uid, err := types.StringToUserIdScalar(id)
+		if err != nil {
+			return nil, err
+		}
+		uids = append(uids, uid)
+	}
+	resp, err := updateUsers(ctx, client.Gql, uids, *input)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Users, nil
+}
+
+// ListUsers retrieves all users of the current customer.
+func (client *Client) ListUsers(ctx context.Context) ([]User, error) {
+	resp, err := getCurrentCustomer(ctx, client.Gql)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Customer == nil {
+		return nil, nil
+	}
+	return resp.Customer.Users, nil
+}
+
 func (u *User) Oid() *oid.OID {
 	userOid := oid.UserOid(u.Id)
 	return &userOid
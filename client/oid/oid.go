@@ -33,6 +33,7 @@ const (
 	TypeDatastreamToken         Type = "datastreamtoken"
 	TypeFiledrop                Type = "filedrop"
 	TypeFolder                  Type = "folder"
+	TypeIncident                Type = "incident"
 	TypeLayeredSettingRecord    Type = "layeredsettingrecord"
 	TypeLink                    Type = "link"
 	TypeMonitor                 Type = "monitor"
@@ -41,6 +42,7 @@ const (
 	TypeMonitorV2Destination    Type = "monitorv2destination"
 	TypeMonitorAction           Type = "monitoraction"
 	TypeMonitorActionAttachment Type = "monitoractionattachment"
+	TypeMonitorMuteRule         Type = "monitormuterule"
 	TypePoller                  Type = "poller"
 	TypePreferredPath           Type = "preferredpath"
 	TypeUser                    Type = "user"
@@ -68,11 +70,13 @@ func (t Type) IsValid() bool {
 	case TypeDatastream:
 	case TypeDatastreamToken:
 	case TypeFolder:
+	case TypeIncident:
 	case TypeLayeredSettingRecord:
 	case TypeLink:
 	case TypeMonitor:
 	case TypeMonitorAction:
 	case TypeMonitorActionAttachment:
+	case TypeMonitorMuteRule:
 	case TypeMonitorV2:
 	case TypeMonitorV2Action:
 	case TypeMonitorV2Destination:
@@ -214,6 +218,10 @@ func FolderOid(id string, wsid string) OID {
 	return OID{Id: wsid, Type: TypeFolder, Version: &id}
 }
 
+func IncidentOid(id string) OID {
+	return OID{Id: id, Type: TypeIncident}
+}
+
 func LayeredSettingRecordOid(id string) OID {
 	return OID{Id: id, Type: TypeLayeredSettingRecord}
 }
@@ -230,6 +238,10 @@ func MonitorActionOid(id string) OID {
 	return OID{Id: id, Type: TypeMonitorAction}
 }
 
+func MonitorMuteRuleOid(id string) OID {
+	return OID{Id: id, Type: TypeMonitorMuteRule}
+}
+
 func MonitorV2Oid(id string) OID {
 	return OID{Id: id, Type: TypeMonitorV2}
 }
@@ -0,0 +1,8 @@
+data "observe_user" "teammate" {
+  email = "teammate@example.com"
+}
+
+data "observe_blobs" "saved_views" {
+  kind = "savedView"
+  user = data.observe_user.teammate.oid
+}
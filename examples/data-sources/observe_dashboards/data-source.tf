@@ -0,0 +1,13 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_dashboards" "oncall" {
+  workspace     = data.observe_workspace.default.oid
+  name_contains = "on-call"
+  max_count     = 10
+}
+
+output "oncall_dashboard" {
+  value = one(data.observe_dashboards.oncall.oids)
+}
@@ -0,0 +1,19 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_dataset" "http_observations" {
+  workspace = data.observe_workspace.default.oid
+  name      = "HTTP observations"
+}
+
+data "observe_dataset_doctor" "http_observations" {
+  dataset   = data.observe_dataset.http_observations.oid
+  up_levels = 2
+}
+
+output "unhealthy_datasets" {
+  value = [
+    for d in data.observe_dataset_doctor.http_observations.datasets : d.name if length(d.errors) > 0
+  ]
+}
@@ -0,0 +1,19 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_dataset" "observation" {
+  workspace = data.observe_workspace.default.oid
+  name      = "Observation"
+}
+
+data "observe_dataset_lineage" "observation" {
+  dataset = data.observe_dataset.observation.oid
+  depth   = 2
+  format  = "dot"
+}
+
+resource "local_file" "lineage" {
+  filename = "${path.module}/lineage.dot"
+  content  = data.observe_dataset_lineage.observation.graph
+}
@@ -0,0 +1,12 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_datasets" "logs" {
+  workspace  = data.observe_workspace.default.oid
+  interfaces = ["log"]
+}
+
+output "log_datasets" {
+  value = { for d in data.observe_datasets.logs.datasets : d.path => d.oid }
+}
@@ -0,0 +1,22 @@
+data "observe_feature_flags" "current" {
+  names = ["monitorV2"]
+}
+
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+# only create monitor v2 actions where the feature is enabled
+resource "observe_monitor_v2_action" "oncall" {
+  count = data.observe_feature_flags.current.enabled["monitorV2"] ? 1 : 0
+
+  workspace = data.observe_workspace.default.oid
+  name      = "Oncall email"
+  type      = "email"
+
+  email {
+    subject   = "Monitor triggered"
+    body      = "See the monitor for details"
+    addresses = ["oncall@example.com"]
+  }
+}
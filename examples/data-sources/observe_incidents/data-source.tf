@@ -0,0 +1,8 @@
+data "observe_incidents" "active" {
+  status      = "Active"
+  starting_at = "2024-01-01T00:00:00Z"
+}
+
+data "observe_incidents" "database" {
+  query = "database failover"
+}
@@ -0,0 +1,18 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_dataset" "checkout" {
+  workspace = data.observe_workspace.default.oid
+  name      = "checkout/Service Metrics"
+}
+
+data "observe_metrics" "checkout" {
+  workspace = data.observe_workspace.default.oid
+  datasets  = [data.observe_dataset.checkout.oid]
+  match     = "latency"
+}
+
+output "checkout_latency_metrics" {
+  value = { for m in data.observe_metrics.checkout.metrics : m.name => m.unit }
+}
@@ -0,0 +1,17 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_user" "example" {
+  email = "user@example.com"
+}
+
+data "observe_owned_objects" "dashboards" {
+  owner     = data.observe_user.example.oid
+  workspace = data.observe_workspace.default.oid
+  types     = ["dashboard", "worksheet"]
+}
+
+output "owned_dashboards" {
+  value = [for o in data.observe_owned_objects.dashboards.objects : o.name]
+}
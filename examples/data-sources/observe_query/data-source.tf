@@ -0,0 +1,86 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_dataset" "logs" {
+  workspace = data.observe_workspace.default.oid
+  name      = "Kubernetes Explorer/Kubernetes Logs"
+}
+
+data "observe_query" "errors" {
+  start     = timeadd(timestamp(), "-1h")
+  max_rows  = 10000
+  page_size = 1000
+
+  # stream rows to disk rather than storing them in state
+  output_file = "${path.module}/errors.ndjson"
+
+  inputs = { "logs" = data.observe_dataset.logs.oid }
+
+  stage {
+    pipeline = <<-EOF
+      filter contains(log, "error")
+    EOF
+  }
+}
+
+output "truncated" {
+  value = data.observe_query.errors.truncated
+}
+
+# fail the plan if error counts per host drift from the golden file
+data "observe_query" "error_counts" {
+  start = timeadd(timestamp(), "-1h")
+
+  inputs = { "logs" = data.observe_dataset.logs.oid }
+
+  stage {
+    pipeline = <<-EOF
+      filter contains(log, "error")
+      statsby count:count(), group_by(host)
+    EOF
+  }
+
+  assert {
+    golden_file = "${path.module}/error_counts.json"
+    sort_by     = ["host"]
+    min_rows    = 1
+
+    tolerance {
+      column   = "count"
+      relative = 0.1
+    }
+  }
+}
+
+# a reusable query, instantiated per service through parameter_values
+data "observe_query" "service_errors" {
+  for_each = toset(["checkout", "cart"])
+
+  start = timeadd(timestamp(), "-1h")
+
+  inputs = { "logs" = data.observe_dataset.logs.oid }
+
+  parameter {
+    id   = "service"
+    type = "STRING"
+  }
+
+  parameter {
+    id      = "min_errors"
+    type    = "INT64"
+    default = "10"
+  }
+
+  parameter_values = {
+    service = each.key
+  }
+
+  stage {
+    pipeline = <<-EOF
+      filter container = $service and contains(log, "error")
+      statsby errors:count(), group_by(container)
+      filter errors >= $min_errors
+    EOF
+  }
+}
@@ -0,0 +1,22 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_dataset" "logs" {
+  workspace = data.observe_workspace.default.oid
+  name      = "Kubernetes Explorer/Kubernetes Logs"
+}
+
+data "observe_query_export" "errors" {
+  start  = timeadd(timestamp(), "-1h")
+  format = "ndjson"
+  path   = "${path.module}/errors.ndjson"
+
+  inputs = { "logs" = data.observe_dataset.logs.oid }
+
+  stage {
+    pipeline = <<-EOF
+      filter contains(log, "error")
+    EOF
+  }
+}
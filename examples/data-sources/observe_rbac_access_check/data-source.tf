@@ -0,0 +1,28 @@
+data "observe_workspace" "prod" {
+  name = "Production"
+}
+
+data "observe_users" "contractors" {
+  email_regex = "@contractor\\.example\\.com$"
+}
+
+data "observe_rbac_access_check" "contractors" {
+  dynamic "request" {
+    for_each = data.observe_users.contractors.oids
+    content {
+      user = request.value
+      object {
+        workspace = data.observe_workspace.prod.id
+        type      = "dataset"
+      }
+      role = "Editor"
+    }
+  }
+}
+
+check "contractors_cannot_edit_prod" {
+  assert {
+    condition     = alltrue([for c in data.observe_rbac_access_check.contractors.request : !c.allowed])
+    error_message = "Contractors must not be able to edit datasets in the production workspace."
+  }
+}
@@ -0,0 +1,18 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_dataset" "pods" {
+  workspace = data.observe_workspace.default.oid
+  name      = "Kubernetes/Pod"
+}
+
+data "observe_resource_instances" "checkout" {
+  key_fragments = ["checkout"]
+  datasets      = [data.observe_dataset.pods.oid]
+  limit         = 20
+}
+
+output "checkout_pods" {
+  value = [for i in data.observe_resource_instances.checkout.instances : join("/", i.key)]
+}
@@ -0,0 +1,17 @@
+data "observe_users" "engineering" {
+  email_regex = "@engineering\\.example\\.com$"
+  status      = ["Active"]
+}
+
+data "observe_rbac_group" "engineering" {
+  name = "engineering"
+}
+
+resource "observe_rbac_group_member" "engineering" {
+  for_each = toset(data.observe_users.engineering.oids)
+
+  group = data.observe_rbac_group.engineering.oid
+  member {
+    user = each.value
+  }
+}
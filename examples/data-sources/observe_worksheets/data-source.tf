@@ -0,0 +1,13 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_dataset" "logs" {
+  workspace = data.observe_workspace.default.oid
+  name      = "Kubernetes Explorer/Kubernetes Logs"
+}
+
+data "observe_worksheets" "logs" {
+  workspace = data.observe_workspace.default.oid
+  datasets  = [data.observe_dataset.logs.oid]
+}
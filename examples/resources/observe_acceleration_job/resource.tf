@@ -0,0 +1,24 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_dataset" "example" {
+  workspace = data.observe_workspace.default.oid
+  name      = "Example"
+}
+
+resource "observe_acceleration_job" "backfill" {
+  context = "rematerialize after OPAL change"
+
+  request {
+    dataset = data.observe_dataset.example.oid
+    interval {
+      start = "2024-01-01T00:00:00Z"
+      end   = "2024-01-08T00:00:00Z"
+    }
+  }
+
+  timeouts {
+    create = "2h"
+  }
+}
@@ -0,0 +1,2 @@
+# blobs are imported by user ID and name
+terraform import observe_blob.saved_view 1414010/team-saved-view
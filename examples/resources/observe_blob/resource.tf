@@ -0,0 +1,11 @@
+resource "observe_blob" "saved_view" {
+  name     = "team-saved-view"
+  kind     = "savedView"
+  readable = "customer"
+  writable = "user"
+
+  value = jsonencode({
+    columns = ["timestamp", "host", "message"]
+    filter  = "severity = 'error'"
+  })
+}
@@ -0,0 +1,2 @@
+# import using the customer ID
+terraform import observe_customer_sso.example 123456789012
@@ -0,0 +1,9 @@
+resource "observe_customer_sso" "example" {
+  saml_url            = "https://idp.example.com/app/observe/sso/saml"
+  saml_cert           = file("${path.module}/idp-signing.pem")
+  local_login_enabled = false
+  scim_enabled        = true
+
+  # warn during plan two weeks before the certificate expires
+  expiry_warning_window = "336h"
+}
@@ -0,0 +1,16 @@
+resource "observe_http_post" "fixtures" {
+  path           = "/fixtures/orders"
+  file           = "${path.module}/fixtures/orders.csv"
+  format         = "csv"
+  max_chunk_size = 524288
+  gzip           = true
+
+  tags = {
+    fixture = "orders"
+  }
+}
+
+resource "observe_http_post" "events" {
+  path    = "/fixtures/events"
+  records = [for i in range(1000) : jsonencode({ "event" = "checkout", "index" = i })]
+}
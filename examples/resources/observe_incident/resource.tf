@@ -0,0 +1,26 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_user" "oncall" {
+  email = "oncall@example.com"
+}
+
+data "observe_dashboard" "service" {
+  id = "41000123"
+}
+
+resource "observe_incident" "migration" {
+  workspace   = data.observe_workspace.default.oid
+  name        = "Database migration game day"
+  description = "Planned failover of the primary database"
+  status      = "Active"
+
+  users      = [data.observe_user.oncall.oid]
+  dashboards = [data.observe_dashboard.service.oid]
+
+  slack_channel {
+    connection_id = "41000456"
+    channel_id    = "C0123456789"
+  }
+}
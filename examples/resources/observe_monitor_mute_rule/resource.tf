@@ -0,0 +1,38 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_dataset" "hosts" {
+  workspace = data.observe_workspace.default.oid
+  name      = "Server/Host"
+}
+
+data "observe_monitor" "cpu" {
+  workspace = data.observe_workspace.default.oid
+  name      = "High CPU"
+}
+
+resource "observe_monitor_mute_rule" "maintenance" {
+  workspace   = data.observe_workspace.default.oid
+  name        = "Host maintenance window"
+  description = "Kernel upgrades"
+  monitors    = [data.observe_monitor.cpu.oid]
+  start_date  = "2024-06-01T02:00:00Z"
+  duration    = "4h"
+
+  filter_resource {
+    dataset = data.observe_dataset.hosts.oid
+
+    resource {
+      primary_key = {
+        host = "db-1"
+      }
+    }
+
+    resource {
+      primary_key = {
+        host = "db-2"
+      }
+    }
+  }
+}
@@ -0,0 +1,2 @@
+# import using the object OID
+terraform import observe_object_owner.example o:::dataset:41000001
@@ -0,0 +1,18 @@
+data "observe_user" "departed" {
+  email = "departed@example.com"
+}
+
+data "observe_user" "successor" {
+  email = "successor@example.com"
+}
+
+data "observe_owned_objects" "departed" {
+  owner = data.observe_user.departed.oid
+}
+
+resource "observe_object_owner" "handover" {
+  for_each = toset(data.observe_owned_objects.departed.oids)
+
+  object = each.value
+  owner  = data.observe_user.successor.oid
+}
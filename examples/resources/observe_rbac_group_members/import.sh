@@ -0,0 +1 @@
+terraform import observe_rbac_group_members.engineering 1414010
@@ -0,0 +1,17 @@
+data "observe_users" "engineering" {
+  email_regex = "@engineering\\.example\\.com$"
+}
+
+data "observe_rbac_group" "reader" {
+  name = "reader"
+}
+
+resource "observe_rbac_group" "engineering" {
+  name = "engineering"
+}
+
+resource "observe_rbac_group_members" "engineering" {
+  group  = observe_rbac_group.engineering.oid
+  users  = data.observe_users.engineering.oids
+  groups = [data.observe_rbac_group.reader.oid]
+}
@@ -0,0 +1 @@
+terraform import observe_rbac_policy.engineering 'subject.group=o:::rbacgroup:8000001234,description=^engineering:'
@@ -0,0 +1,31 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_rbac_group" "engineering" {
+  name = "engineering"
+}
+
+resource "observe_rbac_policy" "engineering" {
+  statement {
+    description = "engineering: list workspace contents"
+    subject {
+      group = data.observe_rbac_group.engineering.oid
+    }
+    object {
+      workspace = data.observe_workspace.default.id
+    }
+    role = "Lister"
+  }
+
+  statement {
+    description = "engineering: edit datasets"
+    subject {
+      group = data.observe_rbac_group.engineering.oid
+    }
+    object {
+      type = "dataset"
+    }
+    role = "Editor"
+  }
+}
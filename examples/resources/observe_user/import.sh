@@ -0,0 +1 @@
+terraform import observe_user.example 1234567
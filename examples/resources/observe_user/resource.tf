@@ -0,0 +1,5 @@
+resource "observe_user" "example" {
+  email   = "jane.doe@example.com"
+  label   = "Jane Doe"
+  comment = "Onboarded via Terraform"
+}
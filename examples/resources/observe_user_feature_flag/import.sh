@@ -0,0 +1 @@
+terraform import observe_user_feature_flag.pilot newQueryBuilder
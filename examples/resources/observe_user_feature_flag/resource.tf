@@ -0,0 +1,14 @@
+# Flags are overridden for the user the provider is authenticated as, so use
+# a provider alias per pilot user.
+provider "observe" {
+  alias         = "pilot"
+  customer      = "123456789012"
+  user_email    = "pilot@example.com"
+  user_password = var.pilot_password
+}
+
+resource "observe_user_feature_flag" "pilot" {
+  provider = observe.pilot
+  name     = "newQueryBuilder"
+  enabled  = true
+}
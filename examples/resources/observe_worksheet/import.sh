@@ -0,0 +1 @@
+terraform import observe_worksheet.example 41000123
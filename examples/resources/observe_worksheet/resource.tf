@@ -0,0 +1,32 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_dataset" "observation" {
+  workspace = data.observe_workspace.default.oid
+  name      = "Observation"
+}
+
+resource "observe_worksheet" "example" {
+  workspace = data.observe_workspace.default.oid
+  name      = "Observation volume"
+
+  inputs = {
+    "observation" = data.observe_dataset.observation.oid
+  }
+
+  stage {
+    alias    = "recent"
+    label    = "Recent observations"
+    pipeline = <<-EOF
+      filter OBSERVATION_KIND = "http"
+    EOF
+  }
+
+  stage {
+    visualization = "timeseries"
+    pipeline      = <<-EOF
+      timechart 5m, count:count()
+    EOF
+  }
+}
@@ -0,0 +1,328 @@
+package observe
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+)
+
+// dashboardLayout is the subset of the dashboard layout JSON which can be
+// authored through section and card blocks.
+type dashboardLayout struct {
+	GridLayout *dashboardGridLayout `json:"gridLayout,omitempty"`
+}
+
+type dashboardGridLayout struct {
+	Sections []dashboardSection `json:"sections"`
+}
+
+type dashboardSection struct {
+	Card  dashboardCard   `json:"card"`
+	Items []dashboardItem `json:"items"`
+}
+
+type dashboardItem struct {
+	Card   dashboardCard       `json:"card"`
+	Layout dashboardItemLayout `json:"layout"`
+}
+
+type dashboardCard struct {
+	CardType      string                      `json:"cardType"`
+	Id            string                      `json:"id"`
+	Title         string                      `json:"title,omitempty"`
+	Closed        *bool                       `json:"closed,omitempty"`
+	StageId       string                      `json:"stageId,omitempty"`
+	Visualization *dashboardCardVisualization `json:"visualization,omitempty"`
+}
+
+type dashboardCardVisualization struct {
+	Type string `json:"type"`
+}
+
+type dashboardItemLayout struct {
+	I string `json:"i"`
+	X int    `json:"x"`
+	Y int    `json:"y"`
+	W int    `json:"w"`
+	H int    `json:"h"`
+}
+
+const (
+	dashboardCardTypeSection = "section"
+	dashboardCardTypeStage   = "stage"
+)
+
+// dashboardSectionId generates IDs for sections which do not set one.
+func dashboardSectionId(i int) string {
+	return fmt.Sprintf("section-%d", i)
+}
+
+// compileDashboardLayout converts section blocks into layout JSON. Cards must
+// reference one of the provided stage IDs.
+func compileDashboardLayout(sections []interface{}, stageIds map[string]bool) (types.JsonObject, error) {
+	grid := &dashboardGridLayout{Sections: make([]dashboardSection, 0, len(sections))}
+	cardIds := make(map[string]bool)
+	for i, v := range sections {
+		s := v.(map[string]interface{})
+
+		id := s["id"].(string)
+		if id == "" {
+			id = dashboardSectionId(i)
+		}
+		section := dashboardSection{
+			Card: dashboardCard{
+				CardType: dashboardCardTypeSection,
+				Id:       id,
+				Title:    s["title"].(string),
+				Closed:   boolPtr(s["collapsed"].(bool)),
+			},
+			Items: make([]dashboardItem, 0),
+		}
+
+		for j, c := range s["card"].([]interface{}) {
+			card := c.(map[string]interface{})
+
+			stage := card["stage"].(string)
+			if !stageIds[stage] {
+				return "", fmt.Errorf("section %d card %d references unknown stage %q", i, j, stage)
+			}
+
+			id := card["id"].(string)
+			if cardIds[id] {
+				return "", fmt.Errorf("section %d card %d reuses card ID %q", i, j, id)
+			}
+			cardIds[id] = true
+
+			item := dashboardItem{
+				Card: dashboardCard{
+					CardType: dashboardCardTypeStage,
+					Id:       id,
+					Title:    card["title"].(string),
+					StageId:  stage,
+				},
+				Layout: dashboardItemLayout{
+					I: id,
+					X: card["x"].(int),
+					Y: card["y"].(int),
+					W: card["width"].(int),
+					H: card["height"].(int),
+				},
+			}
+			if vis := card["visualization"].(string); vis != "" {
+				item.Card.Visualization = &dashboardCardVisualization{Type: vis}
+			}
+			section.Items = append(section.Items, item)
+		}
+		grid.Sections = append(grid.Sections, section)
+	}
+
+	b, err := json.Marshal(dashboardLayout{GridLayout: grid})
+	if err != nil {
+		return "", err
+	}
+	return types.JsonObject(b), nil
+}
+
+// decompileDashboardLayout converts layout JSON into section blocks. Layouts
+// containing cards other than stage cards cannot be represented.
+func decompileDashboardLayout(layout types.JsonObject) ([]interface{}, error) {
+	var l dashboardLayout
+	if err := json.Unmarshal([]byte(layout), &l); err != nil {
+		return nil, fmt.Errorf("failed to parse layout: %w", err)
+	}
+	if l.GridLayout == nil {
+		return nil, nil
+	}
+
+	sections := make([]interface{}, len(l.GridLayout.Sections))
+	for i, s := range l.GridLayout.Sections {
+		cards := make([]interface{}, len(s.Items))
+		for j, item := range s.Items {
+			if item.Card.CardType != dashboardCardTypeStage {
+				return nil, fmt.Errorf("section %d contains a card of type %q, which cannot be represented by card blocks", i, item.Card.CardType)
+			}
+			var vis string
+			if item.Card.Visualization != nil {
+				vis = item.Card.Visualization.Type
+			}
+			cards[j] = map[string]interface{}{
+				"id":            item.Card.Id,
+				"stage":         item.Card.StageId,
+				"title":         item.Card.Title,
+				"visualization": vis,
+				"x":             item.Layout.X,
+				"y":             item.Layout.Y,
+				"width":         item.Layout.W,
+				"height":        item.Layout.H,
+			}
+		}
+		sections[i] = map[string]interface{}{
+			"id":        s.Card.Id,
+			"title":     s.Card.Title,
+			"collapsed": s.Card.Closed != nil && *s.Card.Closed,
+			"card":      cards,
+		}
+	}
+	return sections, nil
+}
+
+// dashboardStageIds returns the IDs of all stages which cards may reference.
+func dashboardStageIds(stages []gql.StageQueryInput) map[string]bool {
+	ids := make(map[string]bool, len(stages))
+	for _, s := range stages {
+		for _, id := range []*string{s.Id, s.StageId, s.StageID} {
+			if id != nil && *id != "" {
+				ids[*id] = true
+			}
+		}
+	}
+	return ids
+}
+
+// dashboardParameterTypes lists the parameter types which can be authored
+// through parameter blocks.
+var dashboardParameterTypes = []string{
+	string(gql.ValueTypeBool),
+	string(gql.ValueTypeFloat64),
+	string(gql.ValueTypeInt64),
+	string(gql.ValueTypeString),
+	string(gql.ValueTypeTimestamp),
+	string(gql.ValueTypeDuration),
+	string(gql.ValueTypeLink),
+}
+
+// compileDashboardParameters converts parameter blocks into parameter specs.
+func compileDashboardParameters(params []interface{}) ([]gql.ParameterSpecInput, error) {
+	result := make([]gql.ParameterSpecInput, 0, len(params))
+	for _, v := range params {
+		p := v.(map[string]interface{})
+
+		spec := gql.ParameterSpecInput{
+			Id:        p["id"].(string),
+			Name:      p["name"].(string),
+			ValueKind: gql.ValueTypeSpecInput{Type: gql.ValueType(p["type"].(string))},
+		}
+
+		var datasetId string
+		if s := p["dataset"].(string); s != "" {
+			dataset, err := oid.NewOID(s)
+			if err != nil {
+				return nil, fmt.Errorf("parameter %q: %w", spec.Id, err)
+			}
+			datasetId = dataset.Id
+			spec.ValueKind.KeyForDatasetId = &datasetId
+		}
+
+		value, err := compileDashboardParameterDefault(spec.ValueKind.Type, p["default_value"].(string), p["default_primary_key"].(map[string]interface{}), datasetId)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %q: %w", spec.Id, err)
+		}
+		spec.DefaultValue = value
+		result = append(result, spec)
+	}
+	return result, nil
+}
+
+func compileDashboardParameterDefault(t gql.ValueType, s string, primaryKey map[string]interface{}, datasetId string) (*types.Value, error) {
+	if t == gql.ValueTypeLink {
+		if s != "" {
+			return nil, fmt.Errorf("default_value is not supported for LINK parameters, use default_primary_key")
+		}
+		if len(primaryKey) == 0 {
+			return nil, nil
+		}
+		if datasetId == "" {
+			return nil, fmt.Errorf("dataset is required for LINK parameters with a default")
+		}
+		link := types.ValueLink{DatasetId: datasetId}
+		for _, name := range sortedKeys(primaryKey) {
+			link.PrimaryKeyValue = append(link.PrimaryKeyValue, &types.ValueKeyValue{
+				Name:  name,
+				Value: types.MustNewValue(primaryKey[name].(string)),
+			})
+		}
+		return types.MustNewValue(link), nil
+	}
+
+	if len(primaryKey) > 0 {
+		return nil, fmt.Errorf("default_primary_key is only supported for LINK parameters")
+	}
+	if s == "" {
+		return nil, nil
+	}
+
+	switch t {
+	case gql.ValueTypeBool, gql.ValueTypeFloat64, gql.ValueTypeInt64, gql.ValueTypeString, gql.ValueTypeTimestamp, gql.ValueTypeDuration:
+		return newParameterValue(t, s)
+	default:
+		return nil, fmt.Errorf("default_value is not supported for %s parameters", t)
+	}
+}
+
+// decompileDashboardParameters converts parameter specs into parameter
+// blocks. Null defaults are treated as absent.
+func decompileDashboardParameters(specs []gql.ParameterSpecInput) ([]interface{}, error) {
+	result := make([]interface{}, len(specs))
+	for i, spec := range specs {
+		if spec.ValueKind.ArrayItemType != nil {
+			return nil, fmt.Errorf("parameter %q: %s parameters cannot be represented by parameter blocks", spec.Id, spec.ValueKind.Type)
+		}
+
+		var dataset string
+		if id := spec.ValueKind.KeyForDatasetId; id != nil && *id != "" {
+			dataset = oid.DatasetOid(*id).String()
+		}
+
+		value, primaryKey, err := decompileDashboardParameterDefault(spec.DefaultValue)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %q: %w", spec.Id, err)
+		}
+
+		result[i] = map[string]interface{}{
+			"id":                  spec.Id,
+			"name":                spec.Name,
+			"type":                string(spec.ValueKind.Type),
+			"dataset":             dataset,
+			"default_value":       value,
+			"default_primary_key": primaryKey,
+		}
+	}
+	return result, nil
+}
+
+func decompileDashboardParameterDefault(v *types.Value) (string, map[string]interface{}, error) {
+	if v == nil {
+		return "", nil, nil
+	}
+	switch {
+	case v.Bool != nil:
+		return strconv.FormatBool(*v.Bool), nil, nil
+	case v.Float64 != nil:
+		return strconv.FormatFloat(float64(*v.Float64), 'f', -1, 64), nil, nil
+	case v.Int64 != nil:
+		return strconv.FormatInt(int64(*v.Int64), 10), nil, nil
+	case v.String != nil:
+		return *v.String, nil, nil
+	case v.Timestamp != nil:
+		return v.Timestamp.String(), nil, nil
+	case v.Duration != nil:
+		return v.Duration.String(), nil, nil
+	case v.Link != nil:
+		primaryKey := make(map[string]interface{}, len(v.Link.PrimaryKeyValue))
+		for _, kv := range v.Link.PrimaryKeyValue {
+			if kv.Value == nil || kv.Value.String == nil {
+				return "", nil, fmt.Errorf("link default for key %q is not a string", kv.Name)
+			}
+			primaryKey[kv.Name] = *kv.Value.String
+		}
+		return "", primaryKey, nil
+	case v.Array != nil || v.Datasetref != nil:
+		return "", nil, fmt.Errorf("default value cannot be represented by parameter blocks")
+	}
+	// typed null
+	return "", nil, nil
+}
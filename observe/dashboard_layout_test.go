@@ -0,0 +1,229 @@
+package observe
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+)
+
+func TestDashboardParametersRoundTrip(t *testing.T) {
+	testcases := []struct {
+		Name     string
+		Input    string
+		Expected string // defaults to Input
+	}{
+		{
+			Name:  "bool and float64",
+			Input: dashboardParametersFixture,
+		},
+		{
+			Name:  "null default",
+			Input: dashboardNullParametersFixture,
+			Expected: `[
+				{"id": "string", "name": "String", "valueKind": {"type": "STRING"}}
+			]`,
+		},
+		{
+			Name:     "ignored null",
+			Input:    dashboardIgnoredNullParametersFixture,
+			Expected: dashboardParametersFixture,
+		},
+		{
+			Name:  "link",
+			Input: dashboardLinkParametersFixture,
+			Expected: `[
+				{"defaultValue": {"bool": true}, "id": "onoff", "name": "On / Off", "valueKind": {"type": "BOOL"}},
+				{"defaultValue": {"float64": 0.5}, "id": "maybe", "name": "Maybe", "valueKind": {"type": "FLOAT64"}},
+				{
+					"defaultValue": {"link": {"datasetId": "${local.kubernetes_dataset_id}", "primaryKeyValue": [{"name": "key", "value": {"string": "the-value"}}]}},
+					"id": "link",
+					"name": "Link",
+					"valueKind": {"keyForDatasetId": "${local.kubernetes_dataset_id}", "type": "LINK"}
+				}
+			]`,
+		},
+		{
+			Name:  "int64",
+			Input: dashboardInt64ParametersFixture,
+		},
+	}
+
+	// fixtures interpolate the dataset ID in acceptance tests
+	datasetId := strings.NewReplacer("${local.kubernetes_dataset_id}", "41042989")
+
+	for _, tt := range testcases {
+		t.Run(tt.Name, func(t *testing.T) {
+			var specs []gql.ParameterSpecInput
+			if err := json.Unmarshal([]byte(datasetId.Replace(tt.Input)), &specs); err != nil {
+				t.Fatal(err)
+			}
+
+			params, err := decompileDashboardParameters(specs)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := compileDashboardParameters(params)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expected := tt.Expected
+			if expected == "" {
+				expected = tt.Input
+			}
+			var want []gql.ParameterSpecInput
+			if err := json.Unmarshal([]byte(datasetId.Replace(expected)), &want); err != nil {
+				t.Fatal(err)
+			}
+
+			// compare as JSON, which normalizes scalar representations
+			wantJSON, _ := json.Marshal(want)
+			gotJSON, _ := json.Marshal(got)
+			if !diffSuppressJSON("parameters", string(wantJSON), string(gotJSON), nil) {
+				t.Fatalf("round trip mismatch:\nexpected %s\ngot      %s", wantJSON, gotJSON)
+			}
+		})
+	}
+}
+
+func TestDashboardParametersUnsupported(t *testing.T) {
+	var specs []gql.ParameterSpecInput
+	input := `[{"id": "list", "name": "List", "valueKind": {"type": "ARRAY", "arrayItemType": {"type": "STRING"}}}]`
+	if err := json.Unmarshal([]byte(input), &specs); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := decompileDashboardParameters(specs); err == nil {
+		t.Fatal("expected error for array parameter")
+	}
+
+	_, err := compileDashboardParameters([]interface{}{
+		map[string]interface{}{
+			"id":                  "link",
+			"name":                "Link",
+			"type":                "LINK",
+			"dataset":             "",
+			"default_value":       "",
+			"default_primary_key": map[string]interface{}{"key": "the-value"},
+		},
+	})
+	if err == nil || !strings.Contains(err.Error(), "dataset is required") {
+		t.Fatalf("expected missing dataset error, got %v", err)
+	}
+
+	_, err = compileDashboardParameters([]interface{}{
+		map[string]interface{}{
+			"id":                  "ds",
+			"name":                "Dataset",
+			"type":                "DATASETREF",
+			"dataset":             "",
+			"default_value":       "o:::dataset:41000001",
+			"default_primary_key": map[string]interface{}{},
+		},
+	})
+	if err == nil || !strings.Contains(err.Error(), "default_value is not supported for DATASETREF parameters") {
+		t.Fatalf("expected unsupported default error, got %v", err)
+	}
+}
+
+func TestDashboardLayoutRoundTrip(t *testing.T) {
+	stageIds := map[string]bool{"stage-logs": true}
+
+	sections, err := decompileDashboardLayout(types.JsonObject(dashboardLayoutFixture))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"id":        "section-overview",
+			"title":     "Overview",
+			"collapsed": false,
+			"card": []interface{}{
+				map[string]interface{}{
+					"id":            "card-logs",
+					"stage":         "stage-logs",
+					"title":         "Logs",
+					"visualization": "table",
+					"x":             0,
+					"y":             0,
+					"width":         6,
+					"height":        4,
+				},
+				map[string]interface{}{
+					"id":            "card-logs-count",
+					"stage":         "stage-logs",
+					"title":         "",
+					"visualization": "",
+					"x":             6,
+					"y":             0,
+					"width":         6,
+					"height":        4,
+				},
+			},
+		},
+		map[string]interface{}{
+			"id":        "section-details",
+			"title":     "Details",
+			"collapsed": true,
+			"card":      []interface{}{},
+		},
+	}
+	if diff := cmp.Diff(expected, sections); diff != "" {
+		t.Fatalf("unexpected sections (-want +got):\n%s", diff)
+	}
+
+	layout, err := compileDashboardLayout(sections, stageIds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !diffSuppressJSON("layout", dashboardLayoutFixture, string(layout), nil) {
+		t.Fatalf("round trip mismatch:\nexpected %s\ngot      %s", dashboardLayoutFixture, layout)
+	}
+}
+
+func TestDashboardLayoutErrors(t *testing.T) {
+	_, err := compileDashboardLayout([]interface{}{
+		map[string]interface{}{
+			"id":        "",
+			"title":     "",
+			"collapsed": false,
+			"card": []interface{}{
+				map[string]interface{}{
+					"id":            "card",
+					"stage":         "stage-missing",
+					"title":         "",
+					"visualization": "",
+					"x":             0,
+					"y":             0,
+					"width":         1,
+					"height":        1,
+				},
+			},
+		},
+	}, map[string]bool{"stage-jag28lhh": true})
+	if err == nil || !strings.Contains(err.Error(), "stage-missing") {
+		t.Fatalf("expected unknown stage error, got %v", err)
+	}
+
+	sections, err := decompileDashboardLayout(types.JsonObject(dashboardLayoutFixture))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cards := sections[0].(map[string]interface{})["card"].([]interface{})
+	cards[1].(map[string]interface{})["id"] = "card-logs"
+	_, err = compileDashboardLayout(sections, map[string]bool{"stage-logs": true})
+	if err == nil || !strings.Contains(err.Error(), `reuses card ID "card-logs"`) {
+		t.Fatalf("expected duplicate card ID error, got %v", err)
+	}
+
+	layout := `{"gridLayout": {"sections": [{"card": {"cardType": "section", "id": "s"}, "items": [{"card": {"cardType": "text", "id": "t"}, "layout": {"i": "t", "x": 0, "y": 0, "w": 1, "h": 1}}]}]}}`
+	if _, err := decompileDashboardLayout(types.JsonObject(layout)); err == nil {
+		t.Fatal("expected error for unsupported card type")
+	}
+}
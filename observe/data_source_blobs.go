@@ -0,0 +1,132 @@
+package observe
+
+import (
+	"context"
+	"hash/crc32"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func dataSourceBlobs() *schema.Resource {
+	return &schema.Resource{
+		Description: descriptions.Get("blobs", "description"),
+		ReadContext: dataSourceBlobsRead,
+		Schema: map[string]*schema.Schema{
+			"kind": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("blobs", "schema", "kind"),
+			},
+			"user": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateOID(oid.TypeUser),
+				Description:      descriptions.Get("blobs", "schema", "user"),
+			},
+			"include_world_readable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions.Get("blobs", "schema", "include_world_readable"),
+			},
+			// computed values
+			"blobs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("blobs", "schema", "blobs", "user"),
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("blobs", "schema", "blobs", "name"),
+						},
+						"kind": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("blobs", "schema", "blobs", "kind"),
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("blobs", "schema", "blobs", "value"),
+						},
+						"readable": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("blobs", "schema", "blobs", "readable"),
+						},
+						"writable": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("blobs", "schema", "blobs", "writable"),
+						},
+						"updated_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("blobs", "schema", "blobs", "updated_date"),
+						},
+					},
+				},
+				Description: descriptions.Get("blobs", "schema", "blobs", "description"),
+			},
+		},
+	}
+}
+
+func dataSourceBlobsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	uid, err := blobUserId(data.Get("user").(string))
+	if err != nil {
+		return diag.Errorf("error parsing user: %s", err.Error())
+	}
+
+	var kind *string
+	if v, ok := data.GetOk("kind"); ok {
+		kind = stringPtr(v.(string))
+	}
+
+	includeWorldReadable := data.Get("include_world_readable").(bool)
+
+	blobs, err := client.ListBlobs(ctx, uid, kind, includeWorldReadable)
+	if err != nil {
+		return diag.Errorf("failed to list blobs: %s", err.Error())
+	}
+	sort.Slice(blobs, func(i, j int) bool {
+		return blobId(blobs[i].UserId, blobs[i].Name) < blobId(blobs[j].UserId, blobs[j].Name)
+	})
+
+	result := make([]interface{}, len(blobs))
+	for i, b := range blobs {
+		result[i] = map[string]interface{}{
+			"user":         oid.UserOid(b.UserId).String(),
+			"name":         b.Name,
+			"kind":         b.Kind,
+			"value":        b.Value.String(),
+			"readable":     blobScopeName(b.Readable),
+			"writable":     blobScopeName(b.Writable),
+			"updated_date": b.UpdatedDate.String(),
+		}
+	}
+
+	if err := data.Set("blobs", result); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	filter := []string{data.Get("user").(string), data.Get("kind").(string), strconv.FormatBool(includeWorldReadable)}
+	data.SetId(strconv.FormatUint(uint64(crc32.ChecksumIEEE([]byte(strings.Join(filter, "/")))), 10))
+	return diags
+}
@@ -0,0 +1,306 @@
+package observe
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+// dwSearchSchema returns the filter attributes shared by the dashboard and
+// worksheet search data sources, which use the same search input.
+func dwSearchSchema(name string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"workspace": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validateOID(oid.TypeWorkspace),
+			Description:      descriptions.Get(name, "schema", "workspace"),
+		},
+		"folder": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validateOID(oid.TypeFolder),
+			Description:      descriptions.Get(name, "schema", "folder"),
+		},
+		"name": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"name_contains"},
+			Description:   descriptions.Get(name, "schema", "name"),
+		},
+		"name_contains": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"name"},
+			Description:   descriptions.Get(name, "schema", "name_contains"),
+		},
+		"datasets": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validateOID(oid.TypeDataset),
+			},
+			Description: descriptions.Get(name, "schema", "datasets"),
+		},
+		"max_count": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Default:          100,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+			Description:      descriptions.Get(name, "schema", "max_count"),
+		},
+	}
+}
+
+// newDWSearchInput builds search terms from the filter attributes, returning
+// a checksum of the filters suitable for use as the data source ID.
+func newDWSearchInput(data *schema.ResourceData) (*gql.DWSearchInput, string, error) {
+	var (
+		terms  gql.DWSearchInput
+		filter []string
+	)
+
+	for _, k := range []string{"workspace", "folder", "name", "name_contains"} {
+		filter = append(filter, data.Get(k).(string))
+	}
+
+	if v, ok := data.GetOk("workspace"); ok {
+		id, err := oid.NewOID(v.(string))
+		if err != nil {
+			return nil, "", err
+		}
+		terms.WorkspaceId = []string{id.Id}
+	}
+
+	if v, ok := data.GetOk("folder"); ok {
+		id, err := oid.NewOID(v.(string))
+		if err != nil {
+			return nil, "", err
+		}
+		// folder OIDs carry the folder ID in the version field
+		folderId := id.Id
+		if id.Version != nil {
+			folderId = *id.Version
+		}
+		terms.FolderId = []string{folderId}
+	}
+
+	if v, ok := data.GetOk("name"); ok {
+		terms.Name = []string{v.(string)}
+	} else if v, ok := data.GetOk("name_contains"); ok {
+		terms.Name = []string{v.(string)}
+	}
+
+	datasetIds, err := oidSetIds(data, "datasets")
+	if err != nil {
+		return nil, "", err
+	}
+	if len(datasetIds) > 0 {
+		terms.Input = []gql.InputSearchInput{{Id: datasetIds}}
+	}
+	filter = append(filter, datasetIds...)
+	filter = append(filter, strconv.Itoa(data.Get("max_count").(int)))
+
+	return &terms, strconv.FormatUint(uint64(crc32.ChecksumIEEE([]byte(strings.Join(filter, "/")))), 10), nil
+}
+
+// dwSearchNameMatches applies the name filters locally, since the search
+// API matches names loosely.
+func dwSearchNameMatches(data *schema.ResourceData, name string) bool {
+	if v, ok := data.GetOk("name"); ok {
+		return name == v.(string)
+	}
+	if v, ok := data.GetOk("name_contains"); ok {
+		return strings.Contains(strings.ToLower(name), strings.ToLower(v.(string)))
+	}
+	return true
+}
+
+// dwSearchMaxPageSize bounds the number of results requested from the search
+// API while looking for name matches.
+const dwSearchMaxPageSize = 10000
+
+// dwSearch runs search and applies the name filters to the results. Since the
+// search API applies maxCount before we filter, the requested page size is
+// grown until max_count results match or the search is exhausted.
+func dwSearch[T any](data *schema.ResourceData, search func(maxCount *types.Int64Scalar) ([]T, []string, error), name func(T) string) ([]T, []string, error) {
+	maxCount := data.Get("max_count").(int)
+	for pageSize := maxCount; ; pageSize *= 4 {
+		if pageSize > dwSearchMaxPageSize && maxCount < dwSearchMaxPageSize {
+			pageSize = dwSearchMaxPageSize
+		}
+
+		results, warnings, err := search(types.Int64Scalar(pageSize).Ptr())
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var matches []T
+		for _, r := range results {
+			if dwSearchNameMatches(data, name(r)) {
+				matches = append(matches, r)
+			}
+		}
+
+		switch {
+		case len(matches) >= maxCount:
+			return matches[:maxCount], warnings, nil
+		case len(results) < pageSize:
+			return matches, warnings, nil
+		case pageSize >= dwSearchMaxPageSize:
+			warnings = append(warnings, fmt.Sprintf("only the first %d search results were filtered by name, some matches may be missing", pageSize))
+			return matches, warnings, nil
+		}
+	}
+}
+
+func dwSearchWarnings(warnings []string) (diags diag.Diagnostics) {
+	for _, w := range warnings {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "search returned a warning",
+			Detail:   w,
+		})
+	}
+	return diags
+}
+
+func dataSourceDashboards() *schema.Resource {
+	s := dwSearchSchema("dashboards")
+	s["dashboards"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: descriptions.Get("dashboards", "schema", "dashboards", "id"),
+				},
+				"oid": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: descriptions.Get("dashboards", "schema", "dashboards", "oid"),
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: descriptions.Get("dashboards", "schema", "dashboards", "name"),
+				},
+				"description": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: descriptions.Get("dashboards", "schema", "dashboards", "dashboard_description"),
+				},
+				"icon_url": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: descriptions.Get("dashboards", "schema", "dashboards", "icon_url"),
+				},
+				"workspace": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: descriptions.Get("dashboards", "schema", "dashboards", "workspace"),
+				},
+				"folder": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: descriptions.Get("dashboards", "schema", "dashboards", "folder"),
+				},
+				"updated_date": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: descriptions.Get("dashboards", "schema", "dashboards", "updated_date"),
+				},
+				"score": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: descriptions.Get("dashboards", "schema", "dashboards", "score"),
+				},
+			},
+		},
+		Description: descriptions.Get("dashboards", "schema", "dashboards", "description"),
+	}
+	s["oids"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: descriptions.Get("dashboards", "schema", "oids"),
+	}
+
+	return &schema.Resource{
+		Description: descriptions.Get("dashboards", "description"),
+		ReadContext: dataSourceDashboardsRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceDashboardsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	terms, id, err := newDWSearchInput(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	matches, warnings, err := dwSearch(data, func(maxCount *types.Int64Scalar) ([]gql.DashboardSearchResult, []string, error) {
+		return client.SearchDashboards(ctx, *terms, maxCount)
+	}, func(m gql.DashboardSearchResult) string {
+		return m.Dashboard.Name
+	})
+	if err != nil {
+		return diag.Errorf("failed to search dashboards: %s", err.Error())
+	}
+	diags = append(diags, dwSearchWarnings(warnings)...)
+
+	var (
+		result = make([]interface{}, 0)
+		oids   = make([]string, 0)
+	)
+	for _, m := range matches {
+		d := m.Dashboard
+		var description, iconUrl string
+		if d.Description != nil {
+			description = *d.Description
+		}
+		if d.IconUrl != nil {
+			iconUrl = *d.IconUrl
+		}
+		result = append(result, map[string]interface{}{
+			"id":           d.Id,
+			"oid":          d.Oid().String(),
+			"name":         d.Name,
+			"description":  description,
+			"icon_url":     iconUrl,
+			"workspace":    oid.WorkspaceOid(d.WorkspaceId).String(),
+			"folder":       oid.FolderOid(d.FolderId, d.WorkspaceId).String(),
+			"updated_date": d.UpdatedDate.String(),
+			"score":        int(m.Score),
+		})
+		oids = append(oids, d.Oid().String())
+	}
+
+	if err := data.Set("dashboards", result); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("oids", oids); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	data.SetId(id)
+	return diags
+}
@@ -0,0 +1,163 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+)
+
+func TestAccObserveSourceDashboards(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+dashboardConfigPreamble+`
+					resource "observe_dashboard" "second" {
+						workspace = data.observe_workspace.default.oid
+						name      = "%[1]s-other"
+						stages    = observe_dashboard.first.stages
+					}
+
+					data "observe_dashboards" "exact" {
+						workspace = data.observe_workspace.default.oid
+						name      = observe_dashboard.first.name
+					}
+
+					data "observe_dashboards" "substring" {
+						workspace     = data.observe_workspace.default.oid
+						name_contains = upper("%[1]s")
+						depends_on    = [observe_dashboard.second]
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.observe_dashboards.exact", "dashboards.#", "1"),
+					resource.TestCheckResourceAttrPair("data.observe_dashboards.exact", "oids.0", "observe_dashboard.first", "oid"),
+					resource.TestCheckResourceAttr("data.observe_dashboards.exact", "dashboards.0.name", randomPrefix),
+					resource.TestCheckResourceAttr("data.observe_dashboards.substring", "dashboards.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestDWSearchNameMatches(t *testing.T) {
+	testcases := []struct {
+		Config  map[string]interface{}
+		Name    string
+		Matches bool
+	}{
+		{
+			Config:  map[string]interface{}{},
+			Name:    "anything",
+			Matches: true,
+		},
+		{
+			Config:  map[string]interface{}{"name": "Service Overview"},
+			Name:    "Service Overview",
+			Matches: true,
+		},
+		{
+			Config:  map[string]interface{}{"name": "Service Overview"},
+			Name:    "Service Overview (copy)",
+			Matches: false,
+		},
+		{
+			Config:  map[string]interface{}{"name": "Service Overview"},
+			Name:    "service overview",
+			Matches: false,
+		},
+		{
+			Config:  map[string]interface{}{"name_contains": "OVERVIEW"},
+			Name:    "Service Overview (copy)",
+			Matches: true,
+		},
+		{
+			Config:  map[string]interface{}{"name_contains": "overview"},
+			Name:    "Service Details",
+			Matches: false,
+		},
+	}
+
+	for i, tt := range testcases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			data := schema.TestResourceDataRaw(t, dwSearchSchema("dashboards"), tt.Config)
+			if got := dwSearchNameMatches(data, tt.Name); got != tt.Matches {
+				t.Fatalf("expected %t for %q with %v, got %t", tt.Matches, tt.Name, tt.Config, got)
+			}
+		})
+	}
+}
+
+func TestDWSearch(t *testing.T) {
+	// the server returns up to maxCount of these, in order
+	var available []string
+	for i := 0; i < 50; i++ {
+		available = append(available, fmt.Sprintf("other-%d", i))
+	}
+	available = append(available, "match-1", "other", "match-2", "match-3")
+
+	testcases := []struct {
+		Config   map[string]interface{}
+		Expected []string
+		Requests []int64
+	}{
+		{
+			Config:   map[string]interface{}{"max_count": 2},
+			Expected: []string{"other-0", "other-1"},
+			Requests: []int64{2},
+		},
+		{
+			Config:   map[string]interface{}{"name_contains": "match", "max_count": 2},
+			Expected: []string{"match-1", "match-2"},
+			Requests: []int64{2, 8, 32, 128},
+		},
+		{
+			Config:   map[string]interface{}{"name_contains": "match", "max_count": 10},
+			Expected: []string{"match-1", "match-2", "match-3"},
+			Requests: []int64{10, 40, 160},
+		},
+		{
+			Config:   map[string]interface{}{"name": "missing"},
+			Expected: nil,
+			Requests: []int64{100},
+		},
+	}
+
+	for i, tt := range testcases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			data := schema.TestResourceDataRaw(t, dwSearchSchema("dashboards"), tt.Config)
+
+			var requests []int64
+			search := func(maxCount *types.Int64Scalar) ([]string, []string, error) {
+				requests = append(requests, int64(*maxCount))
+				if int(*maxCount) < len(available) {
+					return available[:*maxCount], nil, nil
+				}
+				return available, nil, nil
+			}
+
+			got, warnings, err := dwSearch(data, search, func(s string) string { return s })
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(warnings) > 0 {
+				t.Fatalf("unexpected warnings: %v", warnings)
+			}
+			if diff := cmp.Diff(tt.Expected, got); diff != "" {
+				t.Fatalf("unexpected results (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.Requests, requests); diff != "" {
+				t.Fatalf("unexpected requests (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
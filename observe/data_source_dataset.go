@@ -113,6 +113,47 @@ func dataSourceDataset() *schema.Resource {
 					},
 				},
 			},
+			"accelerable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: descriptions.Get("dataset", "schema", "accelerable"),
+			},
+			"acceleration_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("dataset", "schema", "acceleration_state"),
+			},
+			"staleness": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("dataset", "schema", "staleness"),
+			},
+			"target_staleness": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("dataset", "schema", "target_staleness"),
+			},
+			"hibernated": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: descriptions.Get("dataset", "schema", "hibernated"),
+			},
+			"compilation_error": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("dataset", "schema", "compilation_error"),
+			},
+			"acceleration_errors": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions.Get("dataset", "schema", "acceleration_errors"),
+			},
+			"credits_24h": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: descriptions.Get("dataset", "schema", "credits_24h"),
+			},
 		},
 	}
 }
@@ -151,5 +192,10 @@ func dataSourceDatasetRead(ctx context.Context, data *schema.ResourceData, meta
 	}
 	data.SetId(d.Id)
 
-	return datasetToResourceData(d, data)
+	diags = datasetToResourceData(d, data)
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, datasetCreditsToResourceData(ctx, client, d, data)...)
 }
@@ -0,0 +1,217 @@
+package observe
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func dataSourceDatasetDoctor() *schema.Resource {
+	return &schema.Resource{
+		Description: descriptions.Get("dataset_doctor", "description"),
+		ReadContext: dataSourceDatasetDoctorRead,
+		Schema: map[string]*schema.Schema{
+			"dataset": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateOID(oid.TypeDataset),
+				Description:      descriptions.Get("dataset_doctor", "schema", "dataset"),
+			},
+			"up_levels": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				Description:      descriptions.Get("dataset_doctor", "schema", "up_levels"),
+			},
+			// computed values
+			"healthy": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: descriptions.Get("dataset_doctor", "schema", "healthy"),
+			},
+			"datasets": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: descriptions.Get("dataset_doctor", "schema", "datasets", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"oid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("dataset_doctor", "schema", "datasets", "oid"),
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("dataset_doctor", "schema", "datasets", "name"),
+						},
+						"workspace": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("dataset_doctor", "schema", "datasets", "workspace"),
+						},
+						"inputs": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: descriptions.Get("dataset_doctor", "schema", "datasets", "inputs"),
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("dataset_doctor", "schema", "datasets", "status"),
+						},
+						"staleness": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("dataset_doctor", "schema", "datasets", "staleness"),
+						},
+						"target_staleness": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("dataset_doctor", "schema", "datasets", "target_staleness"),
+						},
+						"error": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("dataset_doctor", "schema", "datasets", "error"),
+						},
+						"backfill_error": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("dataset_doctor", "schema", "datasets", "backfill_error"),
+						},
+						"errors": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: descriptions.Get("dataset_doctor", "schema", "datasets", "errors"),
+						},
+						"warnings": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: descriptions.Get("dataset_doctor", "schema", "datasets", "warnings"),
+						},
+						"comments": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: descriptions.Get("dataset_doctor", "schema", "datasets", "comments"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDatasetDoctorRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	id, _ := oid.NewOID(data.Get("dataset").(string))
+
+	// up_levels = 0 is meaningful, so we must use the deprecated GetOkExists
+	var upLevels *int
+	if v, ok := data.GetOkExists("up_levels"); ok {
+		n := v.(int)
+		upLevels = &n
+	}
+
+	reports, err := client.GetDatasetDoctorReport(ctx, id.Id, upLevels)
+	if err != nil {
+		return diag.Errorf("failed to diagnose dataset %s: %s", id.Id, err)
+	}
+
+	healthy := true
+	datasets := make([]interface{}, 0, len(reports))
+	for i := range reports {
+		r := &reports[i]
+		findings := datasetReportFindings(r)
+		if len(findings) > 0 {
+			healthy = false
+		}
+
+		inputs := make([]string, 0, len(r.InputDatasets))
+		for _, input := range r.InputDatasets {
+			inputs = append(inputs, oid.DatasetOid(input).String())
+		}
+
+		var warnings []string
+		for _, note := range r.StageNotes {
+			for _, w := range note.Warnings {
+				warnings = append(warnings, fmt.Sprintf("stage %s: %s", note.StageId, w.Symbol.Comment))
+			}
+		}
+
+		report := map[string]interface{}{
+			"oid":       oid.DatasetOid(r.DatasetId).String(),
+			"name":      r.DatasetLabel,
+			"workspace": oid.WorkspaceOid(r.WorkspaceId).String(),
+			"inputs":    inputs,
+			"errors":    findings,
+			"warnings":  warnings,
+			"comments":  r.DoctorComments,
+		}
+		if r.AccelerationInfo != nil {
+			report["status"] = string(r.AccelerationInfo.State)
+			report["staleness"] = secondsToDurationString(r.AccelerationInfo.StalenessSeconds)
+			report["target_staleness"] = secondsToDurationString(r.AccelerationInfo.TargetStalenessSeconds)
+		}
+		if r.OngoingErrorReason != nil {
+			report["error"] = r.OngoingErrorReason.Text
+		}
+		if r.BackfillErrorReason != nil {
+			report["backfill_error"] = r.BackfillErrorReason.Text
+		}
+		datasets = append(datasets, report)
+	}
+
+	data.SetId(id.Id)
+
+	if err := data.Set("healthy", healthy); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("datasets", datasets); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// datasetReportFindings lists the serious findings in a dataset doctor report,
+// i.e. anything which prevents the dataset from being queried or kept fresh.
+func datasetReportFindings(r *gql.DatasetReport) (findings []string) {
+	for _, note := range r.StageNotes {
+		for _, e := range note.Errors {
+			findings = append(findings, fmt.Sprintf("stage %s: %s", note.StageId, e.Comment))
+		}
+	}
+
+	if info := r.AccelerationInfo; info != nil {
+		for _, e := range info.Errors {
+			findings = append(findings, e.ErrorText)
+		}
+		if info.State == gql.AccelerationStateUnavailable && len(findings) == 0 {
+			findings = append(findings, "acceleration is unavailable")
+		}
+	}
+
+	if r.OngoingErrorReason != nil {
+		findings = append(findings, r.OngoingErrorReason.Text)
+	}
+
+	if r.BackfillErrorReason != nil {
+		findings = append(findings, fmt.Sprintf("backfill: %s", r.BackfillErrorReason.Text))
+	}
+
+	return findings
+}
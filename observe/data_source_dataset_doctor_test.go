@@ -0,0 +1,47 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveDatasetDoctor(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+				resource "observe_dataset" "first" {
+					workspace      = data.observe_workspace.default.oid
+					name           = "%[1]s"
+					doctor_on_save = true
+
+					inputs = {
+					  "test" = observe_datastream.test.dataset
+					}
+
+					stage {}
+				}
+
+				data "observe_dataset_doctor" "first" {
+					dataset   = observe_dataset.first.oid
+					up_levels = 0
+				}`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_dataset.first", "doctor_on_save", "true"),
+					resource.TestCheckResourceAttr("data.observe_dataset_doctor.first", "healthy", "true"),
+					resource.TestCheckResourceAttr("data.observe_dataset_doctor.first", "datasets.#", "1"),
+					resource.TestCheckResourceAttr("data.observe_dataset_doctor.first", "datasets.0.name", randomPrefix),
+					resource.TestCheckResourceAttr("data.observe_dataset_doctor.first", "datasets.0.inputs.#", "1"),
+					resource.TestCheckResourceAttr("data.observe_dataset_doctor.first", "datasets.0.errors.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,199 @@
+package observe
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func dataSourceDatasetLineage() *schema.Resource {
+	return &schema.Resource{
+		Description: descriptions.Get("dataset_lineage", "description"),
+		ReadContext: dataSourceDatasetLineageRead,
+		Schema: map[string]*schema.Schema{
+			"dataset": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateOID(oid.TypeDataset),
+				Description:      descriptions.Get("dataset_lineage", "schema", "dataset"),
+			},
+			"depth": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          1,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				Description:      descriptions.Get("dataset_lineage", "schema", "depth"),
+			},
+			"link_to": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateOID(oid.TypeDataset),
+				Description:      descriptions.Get("dataset_lineage", "schema", "link_to"),
+			},
+			"link_path_limit": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				RequiredWith:     []string{"link_to"},
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Description:      descriptions.Get("dataset_lineage", "schema", "link_path_limit"),
+			},
+			"format": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateStringInSlice([]string{"dot", "json"}, false),
+				Description:      descriptions.Get("dataset_lineage", "schema", "format"),
+			},
+			// computed values
+			"upstream": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions.Get("dataset_lineage", "schema", "upstream"),
+			},
+			"downstream": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions.Get("dataset_lineage", "schema", "downstream"),
+			},
+			"link_paths": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: descriptions.Get("dataset_lineage", "schema", "link_paths", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cost": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: descriptions.Get("dataset_lineage", "schema", "link_paths", "cost"),
+						},
+						"datasets": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: descriptions.Get("dataset_lineage", "schema", "link_paths", "datasets"),
+						},
+						"links": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: descriptions.Get("dataset_lineage", "schema", "link_paths", "links"),
+						},
+					},
+				},
+			},
+			"graph": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("dataset_lineage", "schema", "graph"),
+			},
+		},
+	}
+}
+
+func datasetOidString(id string) string {
+	return oid.DatasetOid(id).String()
+}
+
+func dataSourceDatasetLineageRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	var (
+		client = meta.(*observe.Client)
+		depth  = data.Get("depth").(int)
+		format = data.Get("format").(string)
+	)
+
+	id, _ := oid.NewOID(data.Get("dataset").(string))
+
+	lineage, err := client.ListDatasetLineage(ctx)
+	if err != nil {
+		return diag.Errorf("failed to retrieve dataset lineage: %s", err)
+	}
+
+	graph := newDatasetGraph(lineage)
+	if _, ok := graph.names[id.Id]; !ok {
+		return diag.Errorf("dataset %s not found", id.Id)
+	}
+
+	upstream := graph.Upstream(id.Id, depth)
+	downstream := graph.Downstream(id.Id, depth)
+
+	upstreamOids := make([]string, 0, len(upstream))
+	for _, u := range upstream {
+		upstreamOids = append(upstreamOids, datasetOidString(u))
+	}
+	downstreamOids := make([]string, 0, len(downstream))
+	for _, d := range downstream {
+		downstreamOids = append(downstreamOids, datasetOidString(d))
+	}
+
+	var linkPaths []interface{}
+	if v, ok := data.GetOk("link_to"); ok {
+		to, _ := oid.NewOID(v.(string))
+
+		var limit *types.Int64Scalar
+		if v, ok := data.GetOk("link_path_limit"); ok {
+			limit = types.Int64Scalar(v.(int)).Ptr()
+		}
+
+		paths, err := client.GetPathsBetweenDatasets(ctx, id.Id, to.Id, limit)
+		if err != nil {
+			return diag.Errorf("failed to retrieve paths between datasets: %s", err)
+		}
+
+		for _, p := range paths {
+			datasets := make([]string, 0, len(p.Path))
+			links := make([]string, 0, len(p.Path))
+			for _, elem := range p.Path {
+				datasets = append(datasets, datasetOidString(elem.ToDatasetId))
+				switch {
+				case elem.ForwardKey != nil && elem.ForwardKey.Label != nil:
+					links = append(links, *elem.ForwardKey.Label)
+				case elem.ReverseKey != nil:
+					links = append(links, elem.ReverseKey.Label)
+				default:
+					links = append(links, "")
+				}
+			}
+			linkPaths = append(linkPaths, map[string]interface{}{
+				"cost":     int(p.Cost),
+				"datasets": datasets,
+				"links":    links,
+			})
+		}
+	}
+
+	var rendered string
+	if format != "" {
+		nodes := append(append([]string{id.Id}, upstream...), downstream...)
+		rendered, err = graph.Render(format, nodes, datasetOidString)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	data.SetId(id.Id)
+
+	if err := data.Set("upstream", upstreamOids); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("downstream", downstreamOids); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("link_paths", linkPaths); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("graph", rendered); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
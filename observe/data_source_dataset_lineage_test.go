@@ -0,0 +1,66 @@
+package observe
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveDatasetLineage(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+				resource "observe_dataset" "first" {
+					workspace = data.observe_workspace.default.oid
+					name      = "%[1]s-1"
+
+					inputs = {
+					  "test" = observe_datastream.test.dataset
+					}
+
+					stage {}
+				}
+
+				resource "observe_dataset" "second" {
+					workspace = data.observe_workspace.default.oid
+					name      = "%[1]s-2"
+
+					inputs = {
+					  "first" = observe_dataset.first.oid
+					}
+
+					stage {}
+				}
+
+				data "observe_dataset_lineage" "first" {
+					dataset = observe_dataset.first.oid
+					format  = "json"
+
+					depends_on = [observe_dataset.second]
+				}
+
+				data "observe_dataset_lineage" "second" {
+					dataset = observe_dataset.second.oid
+					depth   = 0
+					format  = "dot"
+				}`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.observe_dataset_lineage.first", "upstream.#", "1"),
+					resource.TestCheckResourceAttr("data.observe_dataset_lineage.first", "downstream.#", "1"),
+					resource.TestMatchResourceAttr("data.observe_dataset_lineage.first", "downstream.0", regexp.MustCompile(`^o:::dataset:\d+$`)),
+					resource.TestCheckResourceAttrSet("data.observe_dataset_lineage.first", "graph"),
+					resource.TestCheckResourceAttr("data.observe_dataset_lineage.second", "upstream.#", "2"),
+					resource.TestCheckResourceAttr("data.observe_dataset_lineage.second", "downstream.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -30,6 +30,7 @@ func TestAccObserveSourceDataset(t *testing.T) {
 			`, randomPrefix),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("data.observe_dataset.a", "name", randomPrefix),
+					resource.TestCheckResourceAttrSet("data.observe_dataset.a", "acceleration_state"),
 				),
 			},
 		},
@@ -0,0 +1,224 @@
+package observe
+
+import (
+	"context"
+	"hash/crc32"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+var datasetSearchModes = map[string]gql.SearchMode{
+	"inclusive": gql.SearchModeInclusivemode,
+	"exclusive": gql.SearchModeExclusivemode,
+}
+
+func dataSourceDatasets() *schema.Resource {
+	return &schema.Resource{
+		Description: descriptions.Get("datasets", "description"),
+		ReadContext: dataSourceDatasetsRead,
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateOID(oid.TypeWorkspace),
+				Description:      descriptions.Get("datasets", "schema", "workspace"),
+			},
+			"labels": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions.Get("datasets", "schema", "labels"),
+			},
+			"columns": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions.Get("datasets", "schema", "columns"),
+			},
+			"interfaces": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions.Get("datasets", "schema", "interfaces"),
+			},
+			"correlation_tags": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions.Get("datasets", "schema", "correlation_tags"),
+			},
+			"reachable_from": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateOID(oid.TypeDataset),
+				Description:      descriptions.Get("datasets", "schema", "reachable_from"),
+			},
+			"mode": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "inclusive",
+				ValidateDiagFunc: validateStringInSlice([]string{"inclusive", "exclusive"}, false),
+				Description:      descriptions.Get("datasets", "schema", "mode"),
+			},
+			// computed values
+			"oids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions.Get("datasets", "schema", "oids"),
+			},
+			"datasets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("datasets", "schema", "datasets", "id"),
+						},
+						"oid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("datasets", "schema", "datasets", "oid"),
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("datasets", "schema", "datasets", "name"),
+						},
+						"path": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("datasets", "schema", "datasets", "path"),
+						},
+						"workspace": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("datasets", "schema", "datasets", "workspace"),
+						},
+						"interfaces": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: descriptions.Get("datasets", "schema", "datasets", "interfaces"),
+						},
+						"matched_labels": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: descriptions.Get("datasets", "schema", "datasets", "matched_labels"),
+						},
+						"matched_columns": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: descriptions.Get("datasets", "schema", "datasets", "matched_columns"),
+						},
+						"matched_interfaces": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: descriptions.Get("datasets", "schema", "datasets", "matched_interfaces"),
+						},
+						"matched_correlation_tags": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: descriptions.Get("datasets", "schema", "datasets", "matched_correlation_tags"),
+						},
+						"score": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: descriptions.Get("datasets", "schema", "datasets", "score"),
+						},
+					},
+				},
+				Description: descriptions.Get("datasets", "schema", "datasets", "description"),
+			},
+		},
+	}
+}
+
+func dataSourceDatasetsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	var projects []string
+	if v, ok := data.GetOk("workspace"); ok {
+		workspace, _ := oid.NewOID(v.(string))
+		projects = append(projects, workspace.Id)
+	}
+
+	var reachableFrom *string
+	if v, ok := data.GetOk("reachable_from"); ok {
+		dataset, _ := oid.NewOID(v.(string))
+		reachableFrom = &dataset.Id
+	}
+
+	var (
+		labels          = stringSetValues(data, "labels")
+		columns         = stringSetValues(data, "columns")
+		interfaces      = stringSetValues(data, "interfaces")
+		correlationTags = stringSetValues(data, "correlation_tags")
+		mode            = data.Get("mode").(string)
+	)
+
+	matches, err := client.SearchDatasets(ctx, projects, labels, columns, correlationTags, reachableFrom, interfaces, datasetSearchModes[mode])
+	if err != nil {
+		return diag.Errorf("failed to search datasets: %s", err.Error())
+	}
+
+	var (
+		result = make([]interface{}, 0, len(matches))
+		oids   = make([]string, 0, len(matches))
+	)
+	for _, m := range matches {
+		d := m.Dataset
+		implemented := make([]string, len(d.Interfaces))
+		for i, iface := range d.Interfaces {
+			implemented[i] = iface.Path
+		}
+		result = append(result, map[string]interface{}{
+			"id":                       d.Id,
+			"oid":                      d.Oid().String(),
+			"name":                     d.Name,
+			"path":                     d.Path,
+			"workspace":                oid.WorkspaceOid(d.WorkspaceId).String(),
+			"interfaces":               implemented,
+			"matched_labels":           m.MatchData.MatchedLabel,
+			"matched_columns":          m.MatchData.MatchedColumn,
+			"matched_interfaces":       m.MatchData.MatchedInterface,
+			"matched_correlation_tags": m.MatchData.MatchedCorrelationTag,
+			"score":                    m.MatchData.Score,
+		})
+		oids = append(oids, d.Oid().String())
+	}
+
+	if err := data.Set("datasets", result); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("oids", oids); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	filter := []string{
+		strings.Join(projects, ","),
+		strings.Join(labels, ","),
+		strings.Join(columns, ","),
+		strings.Join(interfaces, ","),
+		strings.Join(correlationTags, ","),
+		data.Get("reachable_from").(string),
+		mode,
+	}
+	data.SetId(strconv.FormatUint(uint64(crc32.ChecksumIEEE([]byte(strings.Join(filter, "/")))), 10))
+	return diags
+}
@@ -0,0 +1,41 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveSourceDatasets(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+					data "observe_datasets" "label" {
+						workspace = data.observe_workspace.default.oid
+						labels    = [observe_datastream.test.name]
+						mode      = "exclusive"
+					}
+
+					data "observe_datasets" "none" {
+						workspace = data.observe_workspace.default.oid
+						labels    = [observe_datastream.test.name]
+						columns   = ["%[1]s"]
+						mode      = "exclusive"
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.observe_datasets.label", "oids.0", "observe_datastream.test", "dataset"),
+					resource.TestCheckResourceAttrSet("data.observe_datasets.label", "datasets.0.score"),
+					resource.TestCheckResourceAttr("data.observe_datasets.none", "datasets.#", "0"),
+				),
+			},
+		},
+	})
+}
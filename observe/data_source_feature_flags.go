@@ -0,0 +1,133 @@
+package observe
+
+import (
+	"context"
+	"hash/crc32"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func dataSourceFeatureFlags() *schema.Resource {
+	return &schema.Resource{
+		Description: descriptions.Get("feature_flags", "description"),
+		ReadContext: dataSourceFeatureFlagsRead,
+		Schema: map[string]*schema.Schema{
+			"names": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions.Get("feature_flags", "schema", "names"),
+			},
+			// computed values
+			"flags": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("feature_flags", "schema", "flags", "name"),
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("feature_flags", "schema", "flags", "description"),
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: descriptions.Get("feature_flags", "schema", "flags", "enabled"),
+						},
+						"self_service": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: descriptions.Get("feature_flags", "schema", "flags", "self_service"),
+						},
+					},
+				},
+				Description: descriptions.Get("feature_flags", "schema", "flags", "description"),
+			},
+			"enabled": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeBool},
+				Description: descriptions.Get("feature_flags", "schema", "enabled"),
+			},
+		},
+	}
+}
+
+func dataSourceFeatureFlagsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	all, err := client.ListFeatureFlags(ctx)
+	if err != nil {
+		return diag.Errorf("failed to list feature flags: %s", err.Error())
+	}
+
+	var names []string
+	for _, v := range data.Get("names").(*schema.Set).List() {
+		names = append(names, v.(string))
+	}
+	sort.Strings(names)
+
+	flags := filterFeatureFlags(all, names)
+
+	var (
+		result  = make([]interface{}, len(flags))
+		enabled = make(map[string]interface{}, len(flags))
+	)
+	for i, f := range flags {
+		result[i] = map[string]interface{}{
+			"name":         f.Name,
+			"description":  f.Description,
+			"enabled":      f.IsEnabled,
+			"self_service": f.IsSelfServiceEnabled,
+		}
+		enabled[f.Name] = f.IsEnabled
+	}
+	// requested flags unknown to the server are reported as disabled
+	for _, name := range names {
+		if _, ok := enabled[name]; !ok {
+			enabled[name] = false
+		}
+	}
+
+	if err := data.Set("flags", result); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("enabled", enabled); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	data.SetId(strconv.FormatUint(uint64(crc32.ChecksumIEEE([]byte(strings.Join(names, "/")))), 10))
+	return diags
+}
+
+// filterFeatureFlags returns the flags matching the given names, ordered by
+// name. All flags are returned if no names are provided.
+func filterFeatureFlags(flags []gql.FeatureFlag, names []string) []gql.FeatureFlag {
+	want := make(map[string]bool, len(names))
+	for _, name := range names {
+		want[name] = true
+	}
+
+	var result []gql.FeatureFlag
+	for _, f := range flags {
+		if len(want) == 0 || want[f.Name] {
+			result = append(result, f)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
@@ -0,0 +1,58 @@
+package observe
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+)
+
+func TestFilterFeatureFlags(t *testing.T) {
+	flags := []gql.FeatureFlag{{Name: "c"}, {Name: "a"}, {Name: "b"}}
+
+	testcases := []struct {
+		names    []string
+		expected []string
+	}{
+		{names: nil, expected: []string{"a", "b", "c"}},
+		{names: []string{"c", "a"}, expected: []string{"a", "c"}},
+		{names: []string{"missing"}, expected: nil},
+	}
+
+	for i, tc := range testcases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			var got []string
+			for _, f := range filterFeatureFlags(flags, tc.names) {
+				got = append(got, f.Name)
+			}
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestAccObserveFeatureFlags(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "observe_feature_flags" "all" {}
+
+				data "observe_feature_flags" "missing" {
+					names = ["tf-nonexistent-flag"]
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.observe_feature_flags.all", "flags.#"),
+					resource.TestCheckResourceAttr("data.observe_feature_flags.missing", "flags.#", "0"),
+					resource.TestCheckResourceAttr("data.observe_feature_flags.missing", "enabled.tf-nonexistent-flag", "false"),
+				),
+			},
+		},
+	})
+}
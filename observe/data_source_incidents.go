@@ -0,0 +1,160 @@
+package observe
+
+import (
+	"context"
+	"hash/crc32"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func dataSourceIncidents() *schema.Resource {
+	return &schema.Resource{
+		Description: descriptions.Get("incidents", "description"),
+		ReadContext: dataSourceIncidentsRead,
+		Schema: map[string]*schema.Schema{
+			"status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     []string{"status", "query"},
+				ValidateDiagFunc: validateEnums(gql.AllIncidentStatuses),
+				Description:      descriptions.Get("incidents", "schema", "status"),
+			},
+			"starting_at": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				RequiredWith:     []string{"status"},
+				ValidateDiagFunc: validateTimestamp,
+				Description:      descriptions.Get("incidents", "schema", "starting_at"),
+			},
+			"ending_at": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				RequiredWith:     []string{"status"},
+				ValidateDiagFunc: validateTimestamp,
+				Description:      descriptions.Get("incidents", "schema", "ending_at"),
+			},
+			"query": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"status", "query"},
+				Description:  descriptions.Get("incidents", "schema", "query"),
+			},
+			// computed values
+			"incidents": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"oid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("incidents", "schema", "incidents", "oid"),
+						},
+						"workspace": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("incidents", "schema", "incidents", "workspace"),
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("incidents", "schema", "incidents", "name"),
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("incidents", "schema", "incidents", "incident_description"),
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("incidents", "schema", "incidents", "status"),
+						},
+					},
+				},
+				Description: descriptions.Get("incidents", "schema", "incidents", "description"),
+			},
+			"oids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions.Get("incidents", "schema", "oids"),
+			},
+		},
+	}
+}
+
+func incidentTimeFilter(data *schema.ResourceData, key string) *types.TimeScalar {
+	v, ok := data.GetOk(key)
+	if !ok {
+		return nil
+	}
+	t, _ := time.Parse(time.RFC3339, v.(string))
+	return types.TimeScalar(t).Ptr()
+}
+
+func dataSourceIncidentsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	var (
+		incidents []gql.Incident
+		err       error
+	)
+	if v, ok := data.GetOk("status"); ok {
+		incidents, err = client.GetIncidentsForStatus(ctx, gql.IncidentStatus(v.(string)),
+			incidentTimeFilter(data, "starting_at"), incidentTimeFilter(data, "ending_at"))
+	} else {
+		incidents, err = client.GetIncidentsForTextQuery(ctx, data.Get("query").(string))
+	}
+	if err != nil {
+		return diag.Errorf("failed to list incidents: %s", err.Error())
+	}
+
+	var (
+		list = make([]interface{}, len(incidents))
+		oids = make([]string, len(incidents))
+	)
+	for i := range incidents {
+		incident := &incidents[i]
+		oids[i] = incident.Oid().String()
+
+		var description string
+		if incident.Description != nil {
+			description = *incident.Description
+		}
+		list[i] = map[string]interface{}{
+			"oid":         oids[i],
+			"workspace":   oid.WorkspaceOid(incident.WorkspaceId).String(),
+			"name":        incident.Name,
+			"description": description,
+			"status":      string(incident.Status),
+		}
+	}
+
+	if err := data.Set("incidents", list); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("oids", oids); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	filter := strings.Join([]string{
+		data.Get("status").(string),
+		data.Get("starting_at").(string),
+		data.Get("ending_at").(string),
+		data.Get("query").(string),
+	}, "/")
+	data.SetId(strconv.FormatUint(uint64(crc32.ChecksumIEEE([]byte(filter))), 10))
+	return diags
+}
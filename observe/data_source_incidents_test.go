@@ -0,0 +1,74 @@
+package observe
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveSourceIncidents(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+	start := time.Now().UTC().Add(-time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+`
+				resource "observe_incident" "example" {
+				  workspace   = data.observe_workspace.default.oid
+				  name        = "%[1]s"
+				  description = "%[1]s data source test"
+				}
+
+				data "observe_incidents" "active" {
+				  status      = "Active"
+				  starting_at = "%[2]s"
+
+				  depends_on = [observe_incident.example]
+				}
+				`, randomPrefix, start),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair("data.observe_incidents.active", "oids.*", "observe_incident.example", "oid"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.observe_incidents.active", "incidents.*", map[string]string{
+						"name":        randomPrefix,
+						"description": randomPrefix + " data source test",
+						"status":      "Active",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccObserveSourceIncidentsInvalid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				PlanOnly: true,
+				Config: `
+				data "observe_incidents" "both" {
+				  status = "Active"
+				  query  = "database outage"
+				}`,
+				ExpectError: regexp.MustCompile(`only one of .query,status. can be specified`),
+			},
+			{
+				PlanOnly: true,
+				Config: `
+				data "observe_incidents" "range" {
+				  query       = "database outage"
+				  starting_at = "2024-01-01T00:00:00Z"
+				}`,
+				ExpectError: regexp.MustCompile(`all of .starting_at,status. must be specified`),
+			},
+		},
+	})
+}
@@ -0,0 +1,211 @@
+package observe
+
+import (
+	"context"
+	"hash/crc32"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func dataSourceMetrics() *schema.Resource {
+	return &schema.Resource{
+		Description: descriptions.Get("metrics", "description"),
+		ReadContext: dataSourceMetricsRead,
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateOID(oid.TypeWorkspace),
+				Description:      descriptions.Get("metrics", "schema", "workspace"),
+			},
+			"datasets": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateOID(oid.TypeDataset),
+				},
+				Description: descriptions.Get("metrics", "schema", "datasets"),
+			},
+			"linked_datasets": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateOID(oid.TypeDataset),
+				},
+				Description: descriptions.Get("metrics", "schema", "linked_datasets"),
+			},
+			"correlation_tags": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions.Get("metrics", "schema", "correlation_tags"),
+			},
+			"match": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("metrics", "schema", "match"),
+			},
+			// computed values
+			"metrics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("metrics", "schema", "metrics", "name"),
+						},
+						"name_with_path": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("metrics", "schema", "metrics", "name_with_path"),
+						},
+						"dataset": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("metrics", "schema", "metrics", "dataset"),
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("metrics", "schema", "metrics", "type"),
+						},
+						"unit": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("metrics", "schema", "metrics", "unit"),
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("metrics", "schema", "metrics", "metric_description"),
+						},
+						"rollup": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("metrics", "schema", "metrics", "rollup"),
+						},
+						"aggregate": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("metrics", "schema", "metrics", "aggregate"),
+						},
+						"interval": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("metrics", "schema", "metrics", "interval"),
+						},
+						"user_defined": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: descriptions.Get("metrics", "schema", "metrics", "user_defined"),
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("metrics", "schema", "metrics", "state"),
+						},
+					},
+				},
+				Description: descriptions.Get("metrics", "schema", "metrics", "description"),
+			},
+			"num_searched": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: descriptions.Get("metrics", "schema", "num_searched"),
+			},
+		},
+	}
+}
+
+func dataSourceMetricsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	var workspaces []string
+	if v, ok := data.GetOk("workspace"); ok {
+		workspace, _ := oid.NewOID(v.(string))
+		workspaces = append(workspaces, workspace.Id)
+	}
+
+	datasets, err := oidSetIds(data, "datasets")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	linkedDatasets, err := oidSetIds(data, "linked_datasets")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var correlationTags []string
+	for _, v := range data.Get("correlation_tags").(*schema.Set).List() {
+		correlationTags = append(correlationTags, v.(string))
+	}
+	sort.Strings(correlationTags)
+
+	match := data.Get("match").(string)
+
+	matches, numSearched, err := client.SearchMetrics(ctx, workspaces, datasets, linkedDatasets, correlationTags, match)
+	if err != nil {
+		return diag.Errorf("failed to search metrics: %s", err.Error())
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Metric.NameWithPath < matches[j].Metric.NameWithPath
+	})
+
+	metrics := make([]interface{}, len(matches))
+	for i, m := range matches {
+		var dataset string
+		if m.DatasetId != nil {
+			dataset = oid.DatasetOid(*m.DatasetId).String()
+		}
+
+		var interval string
+		if m.Metric.Interval != nil {
+			interval = m.Metric.Interval.String()
+		}
+
+		metrics[i] = map[string]interface{}{
+			"name":           m.Metric.Name,
+			"name_with_path": m.Metric.NameWithPath,
+			"dataset":        dataset,
+			"type":           string(m.Metric.Type),
+			"unit":           m.Metric.Unit,
+			"description":    m.Metric.Description,
+			"rollup":         m.Metric.Rollup,
+			"aggregate":      m.Metric.Aggregate,
+			"interval":       interval,
+			"user_defined":   m.Metric.UserDefined,
+			"state":          string(m.Metric.State),
+		}
+	}
+
+	if err := data.Set("metrics", metrics); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("num_searched", int(numSearched)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	filter := []string{
+		strings.Join(workspaces, ","),
+		strings.Join(datasets, ","),
+		strings.Join(linkedDatasets, ","),
+		strings.Join(correlationTags, ","),
+		match,
+	}
+	data.SetId(strconv.FormatUint(uint64(crc32.ChecksumIEEE([]byte(strings.Join(filter, "/")))), 10))
+	return diags
+}
@@ -0,0 +1,38 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveSourceMetrics(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+					data "observe_metrics" "dataset" {
+						workspace = data.observe_workspace.default.oid
+						datasets  = [observe_datastream.test.dataset]
+					}
+
+					data "observe_metrics" "match" {
+						workspace = data.observe_workspace.default.oid
+						match     = "%[1]s"
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.observe_metrics.dataset", "metrics.#", "0"),
+					resource.TestCheckResourceAttr("data.observe_metrics.match", "metrics.#", "0"),
+					resource.TestCheckResourceAttr("data.observe_metrics.match", "num_searched", "0"),
+				),
+			},
+		},
+	})
+}
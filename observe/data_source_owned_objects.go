@@ -0,0 +1,180 @@
+package observe
+
+import (
+	"context"
+	"hash/crc32"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+// ownedObjectsSearchMaxCount bounds the number of dashboards and worksheets
+// searched per workspace
+const ownedObjectsSearchMaxCount = 1000
+
+func dataSourceOwnedObjects() *schema.Resource {
+	var ownedTypes []string
+	for _, t := range gql.OwnedObjectTypes {
+		ownedTypes = append(ownedTypes, string(t))
+	}
+
+	return &schema.Resource{
+		Description: descriptions.Get("owned_objects", "description"),
+		ReadContext: dataSourceOwnedObjectsRead,
+		Schema: map[string]*schema.Schema{
+			"owner": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateOID(oid.TypeUser),
+				Description:      descriptions.Get("owned_objects", "schema", "owner"),
+			},
+			"workspace": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateOID(oid.TypeWorkspace),
+				Description:      descriptions.Get("owned_objects", "schema", "workspace"),
+			},
+			"types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateStringInSlice(ownedTypes, false),
+				},
+				Description: descriptions.Get("owned_objects", "schema", "types"),
+			},
+			// computed values
+			"objects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"oid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("owned_objects", "schema", "objects", "oid"),
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("owned_objects", "schema", "objects", "type"),
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("owned_objects", "schema", "objects", "name"),
+						},
+						"workspace": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("owned_objects", "schema", "objects", "workspace"),
+						},
+					},
+				},
+				Description: descriptions.Get("owned_objects", "schema", "objects", "description"),
+			},
+			"oids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions.Get("owned_objects", "schema", "oids"),
+			},
+			"truncated": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: descriptions.Get("owned_objects", "schema", "truncated"),
+			},
+		},
+	}
+}
+
+func dataSourceOwnedObjectsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	owner, _ := oid.NewOID(data.Get("owner").(string))
+	uid, err := types.StringToUserIdScalar(owner.Id)
+	if err != nil {
+		return diag.Errorf("error parsing owner: %s", err.Error())
+	}
+
+	var workspaceIds []string
+	if v, ok := data.GetOk("workspace"); ok {
+		ws, _ := oid.NewOID(v.(string))
+		workspaceIds = append(workspaceIds, ws.Id)
+	} else {
+		workspaces, err := client.ListWorkspaces(ctx)
+		if err != nil {
+			return diag.Errorf("failed to list workspaces: %s", err.Error())
+		}
+		for _, ws := range workspaces {
+			workspaceIds = append(workspaceIds, ws.Id)
+		}
+	}
+
+	wantTypes := make(map[oid.Type]bool)
+	for _, v := range data.Get("types").(*schema.Set).List() {
+		wantTypes[oid.Type(v.(string))] = true
+	}
+
+	var (
+		owned     []gql.WorkspaceObjectOwner
+		truncated bool
+	)
+	for _, wsid := range workspaceIds {
+		objects, wsTruncated, err := client.ListWorkspaceObjectOwners(ctx, wsid, ownedObjectsSearchMaxCount)
+		if err != nil {
+			return diag.Errorf("failed to list objects in workspace %s: %s", wsid, err.Error())
+		}
+		truncated = truncated || wsTruncated
+		for _, o := range objects {
+			if o.Owner != uid || (len(wantTypes) > 0 && !wantTypes[o.Oid.Type]) {
+				continue
+			}
+			owned = append(owned, o)
+		}
+	}
+	sort.Slice(owned, func(i, j int) bool { return owned[i].Oid.String() < owned[j].Oid.String() })
+
+	var (
+		objects = make([]interface{}, len(owned))
+		oids    = make([]string, len(owned))
+	)
+	for i, o := range owned {
+		oids[i] = o.Oid.String()
+		objects[i] = map[string]interface{}{
+			"oid":       oids[i],
+			"type":      string(o.Oid.Type),
+			"name":      o.Name,
+			"workspace": oid.WorkspaceOid(o.WorkspaceId).String(),
+		}
+	}
+
+	if err := data.Set("objects", objects); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("oids", oids); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("truncated", truncated); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	filter := []string{owner.String(), data.Get("workspace").(string)}
+	for t := range wantTypes {
+		filter = append(filter, string(t))
+	}
+	sort.Strings(filter[2:])
+	data.SetId(strconv.FormatUint(uint64(crc32.ChecksumIEEE([]byte(strings.Join(filter, "/")))), 10))
+	return diags
+}
@@ -0,0 +1,48 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveOwnedObjects(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+`
+				data "observe_user" "system" {
+				  email = "%[1]s"
+				}
+
+				resource "observe_folder" "example" {
+				  workspace = data.observe_workspace.default.oid
+				  name      = "%[2]s"
+				}
+
+				data "observe_owned_objects" "example" {
+				  owner     = data.observe_user.system.oid
+				  workspace = data.observe_workspace.default.oid
+				  types     = ["folder"]
+
+				  depends_on = [observe_folder.example]
+				}
+				`, systemUser(), randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair("data.observe_owned_objects.example", "oids.*", "observe_folder.example", "oid"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.observe_owned_objects.example", "objects.*", map[string]string{
+						"type": "folder",
+						"name": randomPrefix,
+					}),
+					resource.TestCheckResourceAttr("data.observe_owned_objects.example", "truncated", "false"),
+				),
+			},
+		},
+	})
+}
@@ -2,7 +2,11 @@ package observe
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -10,6 +14,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
 	gql "github.com/observeinc/terraform-provider-observe/client/meta"
 	"github.com/observeinc/terraform-provider-observe/client/meta/types"
 	"github.com/observeinc/terraform-provider-observe/client/oid"
@@ -38,9 +45,31 @@ func dataSourceQuery() *schema.Resource {
 				ValidateDiagFunc: validateTimestamp,
 			},
 			"limit": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Default:  100,
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Deprecated:       "Use max_rows instead.",
+				ConflictsWith:    []string{"max_rows"},
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+			},
+			"max_rows": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          1000,
+				Description:      "Maximum number of rows to read. Any further rows are discarded, and `truncated` is set.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+			},
+			"page_size": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          1000,
+				Description:      "Number of rows to read per request.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+			},
+			"output_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "File to write rows to as newline delimited JSON. If set, rows are streamed to the file as they are read, and `rows` and `result` are left empty.",
+				ConflictsWith: []string{"assert"},
 			},
 			"inputs": {
 				Type:             schema.TypeMap,
@@ -79,6 +108,38 @@ func dataSourceQuery() *schema.Resource {
 					},
 				},
 			},
+			"parameter": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Parameters which may be referenced as `$id` in stage pipelines.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:             schema.TypeString,
+							Required:         true,
+							Description:      "Identifier by which the parameter is referenced in OPAL.",
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`), "must be a valid identifier")),
+						},
+						"type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							Description:      "Type of the parameter. One of `BOOL`, `FLOAT64`, `INT64`, `STRING`, `TIMESTAMP`, `DURATION` or `DATASETREF`.",
+							ValidateDiagFunc: validateStringInSlice(queryParameterTypes, false),
+						},
+						"default": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Value used if the parameter is not bound in `parameter_values`. `DATASETREF` values are dataset OIDs.",
+						},
+					},
+				},
+			},
+			"parameter_values": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Values for declared parameters, keyed by parameter ID. Every parameter referenced in a pipeline must have either a value or a default.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"poll": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -104,25 +165,121 @@ func dataSourceQuery() *schema.Resource {
 				Type:        schema.TypeList,
 				MaxItems:    1,
 				Optional:    true,
-				Description: "Validate expected query output",
+				Description: "Validate expected query output. Fails if the result was truncated by `max_rows`.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"update": {
-							Type:     schema.TypeBool,
-							Optional: true,
-							Default:  false,
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Write query output to `golden_file` instead of comparing against it.",
 						},
 						"golden_file": {
 							Type:        schema.TypeString,
 							Description: "Filename containing expected query output.",
-							Required:    true,
+							Optional:    true,
+						},
+						"schema_only": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Compare only column names and types against `golden_file`, rather than rows.",
+						},
+						"columns": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							Description:   "Columns to compare, in order. Defaults to all columns.",
+							Elem:          &schema.Schema{Type: schema.TypeString},
+							ConflictsWith: []string{"assert.0.ignore_columns"},
+						},
+						"ignore_columns": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Columns to exclude from comparison.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"ignore_timestamps": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Exclude timestamp columns from comparison, unless selected through `columns`.",
+						},
+						"sort_by": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Columns to sort rows by before comparison. Numeric values are sorted numerically.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"tolerance": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Allow numeric values in a column to differ from `golden_file`. A value matches if it is within either the absolute or relative tolerance.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"column": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"absolute": {
+										Type:             schema.TypeFloat,
+										Optional:         true,
+										Default:          0,
+										ValidateDiagFunc: validation.ToDiagFunc(validation.FloatAtLeast(0)),
+									},
+									"relative": {
+										Type:             schema.TypeFloat,
+										Optional:         true,
+										Default:          0,
+										Description:      "Tolerance as a fraction of the expected value.",
+										ValidateDiagFunc: validation.ToDiagFunc(validation.FloatAtLeast(0)),
+									},
+								},
+							},
+						},
+						"min_rows": {
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Description:      "Minimum number of rows expected.",
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+						},
+						"max_rows": {
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Description:      "Maximum number of rows expected.",
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
 						},
 					},
 				},
 			},
 			"result": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Rows read, as a JSON array of objects.",
+			},
+			"rows": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Rows read, keyed by column name. Null values are omitted.",
+				Elem: &schema.Schema{
+					Type: schema.TypeMap,
+					Elem: &schema.Schema{Type: schema.TypeString},
+				},
+			},
+			"columns": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Names of the columns in the result, in order.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"row_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of rows read.",
+			},
+			"truncated": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the result held more than `max_rows` rows.",
 			},
 		},
 	}
@@ -299,10 +456,20 @@ func newQuery(data *schema.ResourceData) (*gql.MultiStageQueryInput, diag.Diagno
 	return &query, nil
 }
 
-func newQueryConfig(data *schema.ResourceData) (query []*gql.StageInput, params *gql.QueryParams, diags diag.Diagnostics) {
+// queryMaxRows returns the maximum number of rows to read, honoring the
+// deprecated limit attribute.
+func queryMaxRows(data *schema.ResourceData) int64 {
+	if v, ok := data.GetOk("limit"); ok {
+		return int64(v.(int))
+	}
+	return int64(data.Get("max_rows").(int))
+}
+
+func newQueryConfig(data *schema.ResourceData) (query []*gql.StageInput, outputStage string, params *gql.QueryParams, diags diag.Diagnostics) {
 	var (
 		start, _ = time.Parse(time.RFC3339, data.Get("start").(string))
-		limit, _ = data.Get("limit").(int)
+		maxRows  = queryMaxRows(data)
+		pageSize = int64(data.Get("page_size").(int))
 	)
 
 	end := time.Now().Truncate(time.Second).UTC()
@@ -312,15 +479,14 @@ func newQueryConfig(data *schema.ResourceData) (query []*gql.StageInput, params
 
 	multiStageQueryInput, diags := newQuery(data)
 	if diags.HasError() {
-		return nil, nil, diags
+		return nil, "", nil, diags
 	}
 
 	// This is insane. StageQueryInput is a subset of StageInput, but differs
 	// in the key of the input field: one has "input", the other "inputs".
 	// Convert here rather than replicating all the conversion logic.
-	stageInputs := make([]gql.StageInput, len(multiStageQueryInput.Stages))
-	for i, s := range multiStageQueryInput.Stages {
-		stageInputs[i] = gql.StageInput{
+	for _, s := range multiStageQueryInput.Stages {
+		stageInput := &gql.StageInput{
 			Inputs:   s.Input,
 			StageId:  *s.Id,
 			Pipeline: s.Pipeline,
@@ -328,140 +494,208 @@ func newQueryConfig(data *schema.ResourceData) (query []*gql.StageInput, params
 				ResultKinds: []gql.ResultKind{gql.ResultKindResultkindsuppress},
 			},
 		}
-	}
 
-	outputStage := stageInputs[len(stageInputs)-1]
-	outputStage.Presentation.ResultKinds = []gql.ResultKind{gql.ResultKindResultkinddata, gql.ResultKindResultkindschema}
-	limitParsed := types.Int64Scalar(limit)
-	outputStage.Presentation.Limit = &limitParsed
+		if *s.Id == multiStageQueryInput.OutputStage {
+			// request one more row than we will read, so that we can tell
+			// whether the result was truncated
+			limit := types.Int64Scalar(maxRows + 1)
+			initialRows := pageSize
+			if initialRows > maxRows {
+				initialRows = maxRows
+			}
+			cacheMode := gql.CursorCacheModeCacheifmoredata
+
+			stageInput.Presentation.ResultKinds = []gql.ResultKind{gql.ResultKindResultkinddata, gql.ResultKindResultkindschema}
+			stageInput.Presentation.Limit = &limit
+			stageInput.Pagination = &gql.PaginationInput{
+				InitialRows:     types.Int64Scalar(initialRows),
+				CursorCacheMode: &cacheMode,
+			}
+		}
+		query = append(query, stageInput)
+	}
 
-	startParsed := types.TimeScalar(start)
-	endParsed := types.TimeScalar(end)
 	params = &gql.QueryParams{
-		StartTime: &startParsed,
-		EndTime:   &endParsed,
+		StartTime: types.TimeScalar(start).Ptr(),
+		EndTime:   types.TimeScalar(end).Ptr(),
 	}
 
-	return query, params, nil
+	return query, multiStageQueryInput.OutputStage, params, nil
 }
 
-func dataSourceQueryRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
-	// TODO (OB-10912): Queries are currently broken
-	return diag.Errorf("this feature is disabled until it can be updated to work with upstream API changes")
-
-	// var (
-	// 	client      = meta.(*observe.Client)
-	// 	queryResult *observe.QueryResult
-	// )
-
-	// stages, params, diags := newQueryConfig(data)
-	// if diags.HasError() {
-	// 	return diags
-	// }
-
-	// var poller Poller
-
-	// // if no interval is set, poller will run exactly once
-	// if v, ok := data.GetOk("poll.0.interval"); ok && v != nil {
-	// 	d, _ := time.ParseDuration(v.(string))
-	// 	poller.Interval = &d
-	// }
-
-	// if v, ok := data.GetOk("poll.0.timeout"); ok && v != nil {
-	// 	d, _ := time.ParseDuration(v.(string))
-	// 	poller.Timeout = &d
-	// }
-
-	// err := poller.Run(ctx, func(ctx context.Context) error {
-	// 	var err error
-
-	// 	if _, ok := data.GetOk("end"); !ok {
-	// 		// reset end time on every subsequent request
-	// 		params.EndTime = types.TimeScalar(time.Now().Truncate(time.Second).UTC())
-	// 	}
-
-	// 	queryResult, err = client.Query(ctx, stages, params)
-	// 	return err
-	// }, func() bool {
-	// 	return queryResult != nil && len(queryResult.Rows) > 0
-	// })
-
-	// if err != nil {
-	// 	diags = diag.FromErr(err)
-	// 	return
-	// }
-
-	// data.SetId(queryResult.ID)
-	// if diags = queryToResourceData(queryResult, data); diags.HasError() {
-	// 	return
-	// }
-
-	// if v, ok := data.GetOk("assert.0.golden_file"); ok {
-	// 	var (
-	// 		filename = v.(string)
-	// 		update   = data.Get("assert.0.update").(bool)
-	// 	)
-
-	// 	if update {
-	// 		// we indent only when writing to golden file, since we want pretty diffs
-	// 		data, err := json.MarshalIndent(queryResult.Rows, "", "  ")
-	// 		if err != nil {
-	// 			return diag.Errorf("failed to marshal rows: %s", err)
-	// 		}
-
-	// 		if err := ioutil.WriteFile(filename, data, os.FileMode(0644)); err != nil {
-	// 			return diag.Errorf("failed to write to golden file: %s", err)
-	// 		}
-	// 	} else {
-	// 		golden_data, err := ioutil.ReadFile(v.(string))
-	// 		if err != nil {
-	// 			return diag.Errorf("failed to read golden file: %s", err)
-	// 		}
-
-	// 		// Unfortunately we need to marshal to JSON in order to compare
-	// 		// correctly with golden file, otherwise types won't match.
-	// 		// Fortunately perf is not an issue for the result sizes we'll be
-	// 		// handling.
-	// 		returned_rows, err := json.Marshal(queryResult.Rows)
-	// 		if err != nil {
-	// 			return diag.Errorf("failed to marshal returned rows: %s", err)
-	// 		}
-
-	// 		// compare JSON strings
-	// 		transformJSON := cmp.FilterValues(func(x, y []byte) bool {
-	// 			return json.Valid(x) && json.Valid(y)
-	// 		}, cmp.Transformer("ParseJSON", func(in []byte) (out interface{}) {
-	// 			_ = json.Unmarshal(in, &out)
-	// 			return out
-	// 		}))
-
-	// 		// ... while ignoring timestamps
-	// 		ignoreTimestamps := cmpopts.IgnoreMapEntries(func(k string, v interface{}) bool {
-	// 			typerep, ok := queryResult.ColTypeRep(k)
-	// 			return ok && typerep == "timestamp"
-	// 		})
-
-	// 		if diff := cmp.Diff(returned_rows, golden_data, transformJSON, ignoreTimestamps); diff != "" {
-	// 			return diag.Errorf("query result does not match golden file: %s", diff)
-	// 		}
-	// 	}
-	// }
-
-	// return
+// queryOutputResult returns the result for the output stage. Data and schema
+// may be split across results.
+func queryOutputResult(results []*gql.TaskResult, outputStage string) (page *types.PaginatedResults, typedef *types.JsonObject, queryId string) {
+	for _, r := range results {
+		if r == nil || r.StageId == nil || *r.StageId != outputStage {
+			continue
+		}
+		queryId = r.QueryId
+		if r.PaginatedResults != nil {
+			page = r.PaginatedResults
+		}
+		if r.ResultSchema != nil && r.ResultSchema.TypedefDefinition != nil {
+			typedef = r.ResultSchema.TypedefDefinition
+		}
+	}
+	return page, typedef, queryId
 }
 
-// func queryToResourceData(q *observe.QueryResult, data *schema.ResourceData) (diags diag.Diagnostics) {
-// 	rows, err := json.Marshal(q.Rows)
-// 	if err != nil {
-// 		return diag.FromErr(err)
-// 	}
+func dataSourceQueryRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	var (
+		client  = meta.(*observe.Client)
+		results []*gql.TaskResult
+	)
+
+	stages, outputStage, params, diags := newQueryConfig(data)
+	if diags.HasError() {
+		return diags
+	}
+
+	parameterValues, err := newQueryParameterValues(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var poller Poller
+
+	// if no interval is set, poller will run exactly once
+	if v, ok := data.GetOk("poll.0.interval"); ok && v != nil {
+		d, _ := time.ParseDuration(v.(string))
+		poller.Interval = &d
+	}
+
+	if v, ok := data.GetOk("poll.0.timeout"); ok && v != nil {
+		d, _ := time.ParseDuration(v.(string))
+		poller.Timeout = &d
+	}
+
+	err = poller.Run(ctx, func(ctx context.Context) error {
+		var err error
+
+		if _, ok := data.GetOk("end"); !ok {
+			// reset end time on every subsequent request
+			params.EndTime = types.TimeScalar(time.Now().Truncate(time.Second).UTC()).Ptr()
+		}
+
+		results, err = client.Query(ctx, stages, params, parameterValues)
+		return err
+	}, func() bool {
+		page, _, _ := queryOutputResult(results, outputStage)
+		return page != nil && page.TotalRows > 0
+	})
+
+	if err != nil {
+		return diag.Errorf("failed to run query: %s", err.Error())
+	}
+
+	page, typedef, queryId := queryOutputResult(results, outputStage)
+	if page == nil {
+		return diag.Errorf("query returned no results for stage %q", outputStage)
+	}
+
+	columns, err := parseQueryColumns(typedef)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var (
+		maxRows  = queryMaxRows(data)
+		pageSize = int64(data.Get("page_size").(int))
+		fetch    = func(ctx context.Context, offset int64, numRows int64) (*types.PaginatedResults, error) {
+			return client.QueryCursor(ctx, *page.CursorId, offset, numRows)
+		}
+		collector *queryRowsCollector
+		read      int64
+		total     int64
+	)
+
+	if v, ok := data.GetOk("output_file"); ok {
+		read, total, err = writeQueryPages(ctx, v.(string), columns, page, fetch, maxRows, pageSize)
+	} else {
+		collector = &queryRowsCollector{columns: columns}
+		read, total, err = readQueryPages(ctx, page, fetch, maxRows, pageSize, collector.add)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(queryId)
+
+	columnNames := make([]string, len(columns))
+	for i, c := range columns {
+		columnNames[i] = c.Name
+	}
+	if err := data.Set("columns", columnNames); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("row_count", int(read)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("truncated", total > read); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if collector == nil {
+		return diags
+	}
+
+	rows := collector.rows
+	if rows == nil {
+		rows = make([]map[string]interface{}, 0)
+	}
+	result, err := json.Marshal(rows)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	if err := data.Set("result", string(result)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
 
-// 	if err := data.Set("result", string(rows)); err != nil {
-// 		diags = append(diags, diag.FromErr(err)...)
-// 	}
+	if err := data.Set("rows", collector.attribute()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	if _, ok := data.GetOk("assert"); ok {
+		if err := newQueryAssertion(data).Check(columns, collector.rows, total); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
+
+// writeQueryPages streams rows to a file, only replacing any existing file
+// once all rows have been read.
+func writeQueryPages(ctx context.Context, path string, columns []queryColumn, first *types.PaginatedResults, fetch queryCursorFetch, maxRows int64, pageSize int64) (read int64, total int64, err error) {
+	f, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to create output file: %w", err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
 
-// 	return diags
-// }
+	w := newQueryRowsWriter(columns, f)
+	if read, total, err = readQueryPages(ctx, first, fetch, maxRows, pageSize, w.add); err != nil {
+		return read, total, err
+	}
+	if err := w.Flush(); err != nil {
+		return read, total, fmt.Errorf("failed to write output file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return read, total, fmt.Errorf("failed to write output file: %w", err)
+	}
+	if err := os.Chmod(f.Name(), 0644); err != nil {
+		return read, total, err
+	}
+	return read, total, os.Rename(f.Name(), path)
+}
 
 func flattenQuery(gqlStages []gql.StageQuery, outputStage string) (*Query, error) {
 	query := &Query{Inputs: make(map[string]*Input)}
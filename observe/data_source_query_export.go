@@ -0,0 +1,322 @@
+package observe
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+// queryExportFormats maps user facing format names to their API representation
+var queryExportFormats = map[string]gql.ExportFileFormat{
+	"csv":    gql.ExportFileFormatCsv,
+	"ndjson": gql.ExportFileFormatNdjson,
+}
+
+var queryExportFormatNames = []string{"csv", "ndjson"}
+
+func dataSourceQueryExport() *schema.Resource {
+	return &schema.Resource{
+		Description: descriptions.Get("query_export", "description"),
+		ReadContext: dataSourceQueryExportRead,
+		Schema: map[string]*schema.Schema{
+			"start": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateTimestamp,
+				Description:      descriptions.Get("query_export", "schema", "start"),
+			},
+			"end": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateTimestamp,
+				Description:      descriptions.Get("query_export", "schema", "end"),
+			},
+			"inputs": {
+				Type:             schema.TypeMap,
+				Required:         true,
+				ValidateDiagFunc: validateMapValues(validateOID()),
+				Description:      descriptions.Get("transform", "schema", "inputs"),
+			},
+			"stage": {
+				Type:        schema.TypeList,
+				MinItems:    1,
+				Required:    true,
+				Description: descriptions.Get("transform", "schema", "stage", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alias": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: descriptions.Get("transform", "schema", "stage", "alias"),
+						},
+						"input": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: descriptions.Get("transform", "schema", "stage", "input"),
+						},
+						"pipeline": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: descriptions.Get("transform", "schema", "stage", "pipeline"),
+						},
+						"output_stage": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: descriptions.Get("transform", "schema", "stage", "output_stage"),
+						},
+					},
+				},
+			},
+			"format": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "csv",
+				ValidateDiagFunc: validateStringInSlice(queryExportFormatNames, false),
+				Description:      descriptions.Get("query_export", "schema", "format"),
+			},
+			"row_count": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Description:      descriptions.Get("query_export", "schema", "row_count"),
+			},
+			"filename": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("query_export", "schema", "filename"),
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("query_export", "schema", "path"),
+			},
+			// computed values
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: descriptions.Get("query_export", "schema", "url"),
+			},
+			"url_expiration": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("query_export", "schema", "url_expiration"),
+			},
+			"export_filename": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("query_export", "schema", "export_filename"),
+			},
+			"rows": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: descriptions.Get("query_export", "schema", "rows"),
+			},
+			"size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: descriptions.Get("query_export", "schema", "size"),
+			},
+			"sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("query_export", "schema", "sha256"),
+			},
+		},
+	}
+}
+
+func dataSourceQueryExportRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	query, diags := newQuery(data)
+	if diags.HasError() {
+		return diags
+	}
+
+	start, _ := time.Parse(time.RFC3339, data.Get("start").(string))
+	end := time.Now().Truncate(time.Second).UTC()
+	if v, ok := data.GetOk("end"); ok {
+		end, _ = time.Parse(time.RFC3339, v.(string))
+	}
+	params := &gql.QueryParams{
+		StartTime: types.TimeScalar(start).Ptr(),
+		EndTime:   types.TimeScalar(end).Ptr(),
+	}
+
+	var rowCount *types.Int64Scalar
+	if v, ok := data.GetOk("row_count"); ok {
+		rowCount = types.Int64Scalar(v.(int)).Ptr()
+	}
+
+	var filename *string
+	if v, ok := data.GetOk("filename"); ok {
+		filename = stringPtr(v.(string))
+	}
+
+	format := queryExportFormats[data.Get("format").(string)]
+
+	result, err := client.ExportQuery(ctx, query, params, rowCount, filename, &format)
+	if err != nil {
+		return diag.Errorf("failed to export query: %s", err.Error())
+	}
+
+	if err := data.Set("url", result.ExportUrl); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	var expiration string
+	if result.ExportUrlExpiration != nil {
+		expiration = result.ExportUrlExpiration.String()
+	}
+	if err := data.Set("url_expiration", expiration); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	var exportFilename string
+	if result.ExportFilename != nil {
+		exportFilename = *result.ExportFilename
+	}
+	if err := data.Set("export_filename", exportFilename); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	id := strconv.FormatUint(uint64(crc32.ChecksumIEEE([]byte(result.ExportUrl))), 10)
+
+	if v, ok := data.GetOk("path"); ok {
+		download, err := downloadQueryExport(ctx, client, result.ExportUrl, v.(string), format)
+		if err != nil {
+			return append(diags, diag.Errorf("failed to download query export: %s", err.Error())...)
+		}
+
+		if err := data.Set("rows", download.Rows); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+
+		if err := data.Set("size", download.Size); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+
+		if err := data.Set("sha256", download.SHA256); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+		id = download.SHA256
+	}
+
+	data.SetId(id)
+	return diags
+}
+
+type queryExportDownload struct {
+	Rows   int
+	Size   int64
+	SHA256 string
+}
+
+// downloadQueryExport writes an export to path, only replacing any existing
+// file once the download has completed.
+func downloadQueryExport(ctx context.Context, client *observe.Client, url string, path string, format gql.ExportFileFormat) (*queryExportDownload, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	hash := sha256.New()
+	if err := client.DownloadExport(ctx, url, io.MultiWriter(f, hash)); err != nil {
+		return nil, err
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	rows, err := countQueryExportRows(f, format)
+	if err != nil {
+		return nil, err
+	}
+
+	size, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(f.Name(), 0644); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(f.Name(), path); err != nil {
+		return nil, err
+	}
+
+	return &queryExportDownload{
+		Rows:   rows,
+		Size:   size,
+		SHA256: hex.EncodeToString(hash.Sum(nil)),
+	}, nil
+}
+
+// countQueryExportRows counts the data rows in an export. CSV exports carry
+// a header row, which is not counted.
+func countQueryExportRows(r io.Reader, format gql.ExportFileFormat) (int, error) {
+	var rows int
+	switch format {
+	case gql.ExportFileFormatCsv:
+		reader := csv.NewReader(r)
+		reader.FieldsPerRecord = -1
+		for {
+			_, err := reader.Read()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return 0, fmt.Errorf("failed to parse CSV: %w", err)
+			}
+			rows++
+		}
+		if rows > 0 {
+			rows--
+		}
+	case gql.ExportFileFormatNdjson:
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+		for scanner.Scan() {
+			if len(bytes.TrimSpace(scanner.Bytes())) > 0 {
+				rows++
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return 0, fmt.Errorf("failed to parse NDJSON: %w", err)
+		}
+	default:
+		return 0, fmt.Errorf("unsupported export format %q", format)
+	}
+	return rows, nil
+}
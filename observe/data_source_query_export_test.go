@@ -0,0 +1,104 @@
+package observe
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+)
+
+func TestCountQueryExportRows(t *testing.T) {
+	testcases := []struct {
+		Name     string
+		Format   gql.ExportFileFormat
+		Input    string
+		Expected int
+		Error    bool
+	}{
+		{
+			Name:     "empty csv",
+			Format:   gql.ExportFileFormatCsv,
+			Input:    "",
+			Expected: 0,
+		},
+		{
+			Name:     "csv header only",
+			Format:   gql.ExportFileFormatCsv,
+			Input:    "timestamp,log\n",
+			Expected: 0,
+		},
+		{
+			Name:     "csv with quoted newlines",
+			Format:   gql.ExportFileFormatCsv,
+			Input:    "timestamp,log\n1,\"first\nline\"\n2,second\n",
+			Expected: 2,
+		},
+		{
+			Name:   "malformed csv",
+			Format: gql.ExportFileFormatCsv,
+			Input:  "timestamp,log\n1,\"unterminated\n",
+			Error:  true,
+		},
+		{
+			Name:     "ndjson",
+			Format:   gql.ExportFileFormatNdjson,
+			Input:    "{\"log\":\"first\"}\n\n{\"log\":\"second\"}",
+			Expected: 2,
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.Name, func(t *testing.T) {
+			got, err := countQueryExportRows(strings.NewReader(tt.Input), tt.Format)
+			if tt.Error {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.Expected {
+				t.Fatalf("expected %d rows, got %d", tt.Expected, got)
+			}
+		})
+	}
+}
+
+func TestAccObserveSourceQueryExport(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+	path := t.TempDir() + "/export.ndjson"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+					data "observe_query_export" "test" {
+					  start     = timeadd(timestamp(), "-10m")
+					  format    = "ndjson"
+					  row_count = 10
+					  path      = "%[2]s"
+
+					  inputs = { "test" = observe_datastream.test.dataset }
+
+					  stage {
+						pipeline = "limit 10"
+					  }
+					}
+				`, randomPrefix, path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.observe_query_export.test", "url"),
+					resource.TestCheckResourceAttrSet("data.observe_query_export.test", "sha256"),
+					resource.TestCheckResourceAttr("data.observe_query_export.test", "rows", "0"),
+				),
+			},
+		},
+	})
+}
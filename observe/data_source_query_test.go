@@ -220,3 +220,116 @@ func TestAccObserveSourceQueryResult(t *testing.T) {
 		},
 	})
 }
+
+func TestAccObserveSourceQueryPagination(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+					data "observe_query" "test" {
+					  start     = timeadd(timestamp(), "-10m")
+					  max_rows  = 5
+					  page_size = 2
+
+					  inputs = { "test" = observe_datastream.test.dataset }
+
+					  stage {}
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.observe_query.test", "row_count", "0"),
+					resource.TestCheckResourceAttr("data.observe_query.test", "truncated", "false"),
+					resource.TestCheckResourceAttr("data.observe_query.test", "result", "[]"),
+					resource.TestCheckResourceAttrSet("data.observe_query.test", "columns.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccObserveSourceQueryAssertRowCount(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	config := fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+		data "observe_query" "test" {
+		  start = timeadd(timestamp(), "-10m")
+
+		  inputs = { "test" = observe_datastream.test.dataset }
+
+		  stage {}
+
+		  assert {
+			min_rows = %%d
+			max_rows = 0
+		  }
+		}
+	`, randomPrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(config, 1),
+				ExpectError: regexp.MustCompile("expected at least 1"),
+			},
+			{
+				Config: fmt.Sprintf(config, 0),
+			},
+		},
+	})
+}
+
+func TestAccObserveSourceQueryParameters(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	config := fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+		data "observe_query" "test" {
+		  start = timeadd(timestamp(), "-10m")
+
+		  inputs = { "test" = observe_datastream.test.dataset }
+
+		  parameter {
+			id   = "service"
+			type = "STRING"
+		  }
+
+		  parameter {
+			id      = "min_count"
+			type    = "INT64"
+			default = "1"
+		  }
+
+		  parameter_values = %%s
+
+		  stage {
+			pipeline = <<-EOF
+			  filter string(FIELDS.service) = $service
+			  statsby count:count(), group_by()
+			  filter count >= $min_count
+			EOF
+		  }
+		}
+	`, randomPrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(config, `{}`),
+				ExpectError: regexp.MustCompile(`parameter \$service has no value`),
+			},
+			{
+				Config: fmt.Sprintf(config, `{ service = "checkout" }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.observe_query.test", "row_count", "0"),
+				),
+			},
+		},
+	})
+}
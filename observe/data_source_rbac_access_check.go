@@ -0,0 +1,269 @@
+package observe
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+)
+
+const (
+	schemaRbacAccessCheckRequestDescription = "Requests to evaluate. Each request is checked independently."
+	schemaRbacAccessCheckUserDescription    = "OID of the user performing the request."
+	schemaRbacAccessCheckObjectDescription  = "Object the request is performed on. " +
+		"Statements scoped to a folder or workspace only match if the corresponding attribute is provided."
+	schemaRbacAccessCheckObjectIdDescription        = "The Observe ID for the object. Defaults to `0`, matching no object ID."
+	schemaRbacAccessCheckObjectFolderDescription    = "The Observe ID for the folder containing the object. Defaults to `0`, for objects not in a folder."
+	schemaRbacAccessCheckObjectWorkspaceDescription = "The Observe ID for the workspace containing the object. Defaults to `0`."
+	schemaRbacAccessCheckObjectTypeDescription      = "The type of object such as dataset."
+	schemaRbacAccessCheckObjectNameDescription      = "The name of the object."
+	schemaRbacAccessCheckObjectOwnerDescription     = "True if the user owns the object."
+	schemaRbacAccessCheckRoleDescription            = "Role required by the action, such as `Viewer` to read or `Editor` to modify the object."
+	schemaRbacAccessCheckAllowedDescription         = "True if the request is allowed."
+	schemaRbacAccessCheckStatementDescription       = "OID of the RBAC statement which allows the request, if any."
+	schemaRbacAccessCheckMatchingDescription        = "OIDs of all RBAC statements which apply to both the user and the object, regardless of role."
+	schemaRbacAccessCheckAllAllowedDescription      = "True if every request is allowed."
+)
+
+// rbacRequestUnknownId is used for object attributes which cannot be
+// determined, such as the folder of an object which is not in a folder.
+const rbacRequestUnknownId = "0"
+
+func dataSourceRbacAccessCheck() *schema.Resource {
+	return &schema.Resource{
+		Description: "Simulates RBAC requests without performing them, reporting whether each is allowed " +
+			"and which statements apply. This can be used in `check` blocks or tests to assert access policies.",
+		ReadContext: dataSourceRbacAccessCheckRead,
+		Schema: map[string]*schema.Schema{
+			"request": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateOID(oid.TypeUser),
+							Description:      schemaRbacAccessCheckUserDescription,
+						},
+						"object": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Default:     rbacRequestUnknownId,
+										Description: schemaRbacAccessCheckObjectIdDescription,
+									},
+									"folder": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Default:     rbacRequestUnknownId,
+										Description: schemaRbacAccessCheckObjectFolderDescription,
+									},
+									"workspace": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Default:     rbacRequestUnknownId,
+										Description: schemaRbacAccessCheckObjectWorkspaceDescription,
+									},
+									"type": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: schemaRbacAccessCheckObjectTypeDescription,
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: schemaRbacAccessCheckObjectNameDescription,
+									},
+									"owner": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Default:     false,
+										Description: schemaRbacAccessCheckObjectOwnerDescription,
+									},
+								},
+							},
+							Description: schemaRbacAccessCheckObjectDescription,
+						},
+						"role": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateEnums(gql.AllRbacRoles),
+							Description:      schemaRbacAccessCheckRoleDescription,
+						},
+						// computed values
+						"allowed": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: schemaRbacAccessCheckAllowedDescription,
+						},
+						"statement": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: schemaRbacAccessCheckStatementDescription,
+						},
+						"matching_statements": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: schemaRbacAccessCheckMatchingDescription,
+						},
+					},
+				},
+				Description: schemaRbacAccessCheckRequestDescription,
+			},
+			"all_allowed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: schemaRbacAccessCheckAllAllowedDescription,
+			},
+		},
+	}
+}
+
+type rbacAccessCheck struct {
+	user    types.UserIdScalar
+	request gql.RbacRequestInput
+}
+
+func newRbacAccessChecks(data *schema.ResourceData) ([]rbacAccessCheck, error) {
+	var checks []rbacAccessCheck
+	for i := range data.Get("request").([]interface{}) {
+		prefix := fmt.Sprintf("request.%d.", i)
+
+		user, _ := oid.NewOID(data.Get(prefix + "user").(string))
+		uid, err := types.StringToUserIdScalar(user.Id)
+		if err != nil {
+			return nil, fmt.Errorf("request %d: error parsing user: %w", i, err)
+		}
+
+		checks = append(checks, rbacAccessCheck{
+			user: uid,
+			request: gql.RbacRequestInput{
+				Object: gql.RbacRequestObjectInput{
+					ObjectId:    data.Get(prefix + "object.0.id").(string),
+					FolderId:    data.Get(prefix + "object.0.folder").(string),
+					WorkspaceId: data.Get(prefix + "object.0.workspace").(string),
+					Type:        data.Get(prefix + "object.0.type").(string),
+					Name:        data.Get(prefix + "object.0.name").(string),
+					IsOwner:     data.Get(prefix + "object.0.owner").(bool),
+				},
+				Role: gql.RbacRole(data.Get(prefix + "role").(string)),
+			},
+		})
+	}
+	return checks, nil
+}
+
+func dataSourceRbacAccessCheckRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	checks, err := newRbacAccessChecks(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// rbacTestRequests evaluates many requests for a single user, so batch
+	// requests by user while preserving their position in the output
+	var (
+		users   []types.UserIdScalar
+		indices = make(map[types.UserIdScalar][]int)
+	)
+	for i, c := range checks {
+		if _, ok := indices[c.user]; !ok {
+			users = append(users, c.user)
+		}
+		indices[c.user] = append(indices[c.user], i)
+	}
+
+	var (
+		results    = make([]interface{}, len(checks))
+		allAllowed = true
+	)
+	for _, user := range users {
+		requests := make([]gql.RbacRequestInput, len(indices[user]))
+		for j, i := range indices[user] {
+			requests[j] = checks[i].request
+		}
+
+		outcomes, err := client.RbacTestRequests(ctx, user, requests)
+		if err != nil {
+			return diag.Errorf("failed to test rbac requests: %s", err.Error())
+		}
+		if len(outcomes) != len(requests) {
+			return diag.Errorf("expected %d rbac request results, got %d", len(requests), len(outcomes))
+		}
+
+		userMatches, err := client.RbacUserMatches(ctx, user)
+		if err != nil {
+			return diag.Errorf("failed to read rbac statements for user: %s", err.Error())
+		}
+		userStatements := make(map[string]bool, len(userMatches))
+		for _, s := range userMatches {
+			userStatements[s.Id] = true
+		}
+
+		for j, i := range indices[user] {
+			objectMatches, err := client.RbacObjectMatches(ctx, checks[i].request.Object)
+			if err != nil {
+				return diag.Errorf("failed to read rbac statements for object: %s", err.Error())
+			}
+			var matching []string
+			for _, s := range objectMatches {
+				if userStatements[s.Id] {
+					matching = append(matching, s.Oid().String())
+				}
+			}
+			sort.Strings(matching)
+
+			outcome := outcomes[j]
+			var statement string
+			if outcome.Matching != nil {
+				statement = outcome.Matching.Oid().String()
+			}
+			allAllowed = allAllowed && outcome.Result
+
+			result := data.Get(fmt.Sprintf("request.%d", i)).(map[string]interface{})
+			result["allowed"] = outcome.Result
+			result["statement"] = statement
+			result["matching_statements"] = matching
+			results[i] = result
+		}
+	}
+
+	if err := data.Set("request", results); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("all_allowed", allAllowed); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	data.SetId(rbacAccessCheckId(checks))
+	return diags
+}
+
+func rbacAccessCheckId(checks []rbacAccessCheck) string {
+	var b strings.Builder
+	for _, c := range checks {
+		o := c.request.Object
+		fmt.Fprintf(&b, "%s/%s/%s/%s/%s/%q/%t/%s;", c.user, o.ObjectId, o.FolderId, o.WorkspaceId, o.Type, o.Name, o.IsOwner, c.request.Role)
+	}
+	return strconv.FormatUint(uint64(crc32.ChecksumIEEE([]byte(b.String()))), 10)
+}
@@ -0,0 +1,57 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveRbacAccessCheck(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+`
+				data "observe_user" "system" {
+				  email = "%[1]s"
+				}
+
+				resource "observe_rbac_statement" "example" {
+				  description = "%[2]s"
+				  subject {
+				    user = data.observe_user.system.oid
+				  }
+				  object {
+				    workspace = data.observe_workspace.default.id
+				  }
+				  role = "Viewer"
+				}
+
+				data "observe_rbac_access_check" "example" {
+				  request {
+				    user = data.observe_user.system.oid
+				    object {
+				      workspace = data.observe_workspace.default.id
+				      type      = "dataset"
+				    }
+				    role = "Viewer"
+				  }
+
+				  depends_on = [observe_rbac_statement.example]
+				}
+				`, systemUser(), randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.observe_rbac_access_check.example", "request.#", "1"),
+					resource.TestCheckResourceAttr("data.observe_rbac_access_check.example", "request.0.allowed", "true"),
+					resource.TestCheckResourceAttr("data.observe_rbac_access_check.example", "all_allowed", "true"),
+					resource.TestCheckTypeSetElemAttrPair("data.observe_rbac_access_check.example", "request.0.matching_statements.*", "observe_rbac_statement.example", "oid"),
+				),
+			},
+		},
+	})
+}
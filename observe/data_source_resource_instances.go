@@ -0,0 +1,229 @@
+package observe
+
+import (
+	"context"
+	"hash/crc32"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func dataSourceResourceInstances() *schema.Resource {
+	return &schema.Resource{
+		Description: descriptions.Get("resource_instances", "description"),
+		ReadContext: dataSourceResourceInstancesRead,
+		Schema: map[string]*schema.Schema{
+			"key_fragments": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions.Get("resource_instances", "schema", "key_fragments"),
+			},
+			"datasets": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateOID(oid.TypeDataset),
+				},
+				Description: descriptions.Get("resource_instances", "schema", "datasets"),
+			},
+			"start": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateTimestamp,
+				Description:      descriptions.Get("resource_instances", "schema", "start"),
+			},
+			"end": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateTimestamp,
+				Description:      descriptions.Get("resource_instances", "schema", "end"),
+			},
+			"per_dataset_limit": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Description:      descriptions.Get("resource_instances", "schema", "per_dataset_limit"),
+			},
+			"limit": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Description:      descriptions.Get("resource_instances", "schema", "limit"),
+			},
+			// computed values
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dataset": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("resource_instances", "schema", "instances", "dataset"),
+						},
+						"key": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: descriptions.Get("resource_instances", "schema", "instances", "key"),
+						},
+					},
+				},
+				Description: descriptions.Get("resource_instances", "schema", "instances", "description"),
+			},
+			"truncated": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: descriptions.Get("resource_instances", "schema", "truncated"),
+			},
+		},
+	}
+}
+
+// resourceInstancesPageSize is the number of rows read per request
+const resourceInstancesPageSize = 1000
+
+// resourceInstanceToMap converts a row of resource instance search results
+// from the given dataset, which is empty if the search spanned all datasets.
+func resourceInstanceToMap(row []*string, datasetId string) map[string]interface{} {
+	values := make([]interface{}, len(row))
+	for i, v := range row {
+		if v != nil {
+			values[i] = *v
+		} else {
+			values[i] = ""
+		}
+	}
+
+	var dataset string
+	if datasetId != "" {
+		dataset = oid.DatasetOid(datasetId).String()
+	}
+	return map[string]interface{}{
+		"dataset": dataset,
+		"key":     values,
+	}
+}
+
+func dataSourceResourceInstancesRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	var keyFragments []string
+	for _, v := range data.Get("key_fragments").([]interface{}) {
+		keyFragments = append(keyFragments, v.(string))
+	}
+
+	datasets, err := oidSetIds(data, "datasets")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var start, end *types.TimeScalar
+	if v, ok := data.GetOk("start"); ok {
+		t, _ := time.Parse(time.RFC3339, v.(string))
+		start = types.TimeScalar(t).Ptr()
+	}
+	if v, ok := data.GetOk("end"); ok {
+		t, _ := time.Parse(time.RFC3339, v.(string))
+		end = types.TimeScalar(t).Ptr()
+	}
+
+	var perDatasetLimit, limit *types.Int64Scalar
+	if v, ok := data.GetOk("per_dataset_limit"); ok {
+		perDatasetLimit = types.Int64Scalar(v.(int)).Ptr()
+	}
+	if v, ok := data.GetOk("limit"); ok {
+		limit = types.Int64Scalar(v.(int)).Ptr()
+	}
+
+	cacheMode := gql.CursorCacheModeCacheifmoredata
+	pagination := &gql.PaginationInput{
+		InitialRows:     types.Int64Scalar(resourceInstancesPageSize),
+		CursorCacheMode: &cacheMode,
+	}
+
+	// Search results carry no column schema, so datasets are searched one at
+	// a time in order to attribute each instance to its dataset.
+	searches := [][]string{nil}
+	if len(datasets) > 0 {
+		searches = nil
+		for _, id := range datasets {
+			searches = append(searches, []string{id})
+		}
+	}
+
+	var (
+		instances = make([]interface{}, 0)
+		truncated bool
+	)
+	for _, searchIds := range searches {
+		if limit != nil && int64(len(instances)) >= int64(*limit) {
+			break
+		}
+
+		var datasetId string
+		if len(searchIds) > 0 {
+			datasetId = searchIds[0]
+		}
+
+		// limit applies across all searched datasets
+		var remaining *types.Int64Scalar
+		if limit != nil {
+			remaining = types.Int64Scalar(int64(*limit) - int64(len(instances))).Ptr()
+		}
+
+		first, err := client.SearchResourceInstances(ctx, keyFragments, searchIds, start, end, perDatasetLimit, remaining, pagination)
+		if err != nil {
+			return diag.Errorf("failed to search resource instances: %s", err.Error())
+		}
+
+		fetch := func(ctx context.Context, offset int64, numRows int64) (*types.PaginatedResults, error) {
+			return client.QueryCursor(ctx, *first.CursorId, offset, numRows)
+		}
+
+		// read every row held by the cursor, since the search is already
+		// bounded by per_dataset_limit and limit
+		read, total, err := readQueryPages(ctx, first, fetch, first.TotalRows, resourceInstancesPageSize, func(rows [][]*string) error {
+			for _, row := range rows {
+				instances = append(instances, resourceInstanceToMap(row, datasetId))
+			}
+			return nil
+		})
+		if err != nil {
+			return diag.Errorf("failed to read resource instances: %s", err.Error())
+		}
+		truncated = truncated || total > read
+	}
+
+	if err := data.Set("instances", instances); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("truncated", truncated); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	filter := []string{
+		strings.Join(keyFragments, ","),
+		strings.Join(datasets, ","),
+		data.Get("start").(string),
+		data.Get("end").(string),
+		strconv.Itoa(data.Get("per_dataset_limit").(int)),
+		strconv.Itoa(data.Get("limit").(int)),
+	}
+	data.SetId(strconv.FormatUint(uint64(crc32.ChecksumIEEE([]byte(strings.Join(filter, "/")))), 10))
+	return diags
+}
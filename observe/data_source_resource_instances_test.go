@@ -0,0 +1,99 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestResourceInstanceToMap(t *testing.T) {
+	testcases := []struct {
+		Name     string
+		Input    []*string
+		Dataset  string
+		Expected map[string]interface{}
+	}{
+		{
+			Name:    "searched dataset",
+			Input:   []*string{stringPtr("us-west-2"), stringPtr("i-0abc")},
+			Dataset: "41000100",
+			Expected: map[string]interface{}{
+				"dataset": "o:::dataset:41000100",
+				"key":     []interface{}{"us-west-2", "i-0abc"},
+			},
+		},
+		{
+			Name:    "null key value",
+			Input:   []*string{stringPtr("i-0abc"), nil},
+			Dataset: "41000100",
+			Expected: map[string]interface{}{
+				"dataset": "o:::dataset:41000100",
+				"key":     []interface{}{"i-0abc", ""},
+			},
+		},
+		{
+			Name:  "all datasets",
+			Input: []*string{stringPtr("pod-a"), stringPtr("default")},
+			Expected: map[string]interface{}{
+				"dataset": "",
+				"key":     []interface{}{"pod-a", "default"},
+			},
+		},
+		{
+			Name:    "numeric key",
+			Input:   []*string{stringPtr("41000100"), stringPtr("web")},
+			Dataset: "41000200",
+			Expected: map[string]interface{}{
+				"dataset": "o:::dataset:41000200",
+				"key":     []interface{}{"41000100", "web"},
+			},
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.Name, func(t *testing.T) {
+			got := resourceInstanceToMap(tt.Input, tt.Dataset)
+			if diff := cmp.Diff(tt.Expected, got); diff != "" {
+				t.Fatalf("unexpected result: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAccObserveSourceResourceInstances(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+					data "observe_resource_instances" "test" {
+						key_fragments = ["%[1]s"]
+						datasets      = [observe_datastream.test.dataset]
+						limit         = 10
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.observe_resource_instances.test", "instances.#", "0"),
+					resource.TestCheckResourceAttr("data.observe_resource_instances.test", "truncated", "false"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+					data "observe_resource_instances" "test" {
+						key_fragments = ["%[1]s"]
+						limit         = 10
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.observe_resource_instances.test", "instances.#", "0"),
+				),
+			},
+		},
+	})
+}
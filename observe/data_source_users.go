@@ -0,0 +1,172 @@
+package observe
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+)
+
+const (
+	schemaUsersEmailRegexDescription = "Only include users whose email matches this regular expression."
+	schemaUsersLabelRegexDescription = "Only include users whose display name matches this regular expression."
+	schemaUsersRoleDescription       = "Only include users with this role."
+	schemaUsersStatusDescription     = "Only include users with one of these statuses. Defaults to all statuses except `Deleted`."
+	schemaUsersUsersDescription      = "Matching users, ordered by email."
+	schemaUsersOidsDescription       = "OIDs of matching users, ordered by email."
+)
+
+func dataSourceUsers() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists existing Observe users, optionally filtered.",
+		ReadContext: dataSourceUsersRead,
+		Schema: map[string]*schema.Schema{
+			"email_regex": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsValidRegExp),
+				Description:      schemaUsersEmailRegexDescription,
+			},
+			"label_regex": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsValidRegExp),
+				Description:      schemaUsersLabelRegexDescription,
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: schemaUsersRoleDescription,
+			},
+			"status": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateDiagFunc: validateStringInSlice([]string{
+						userStatusString(gql.UserStatusCreated),
+						userStatusString(gql.UserStatusActive),
+						userStatusString(gql.UserStatusDisabled),
+						userStatusString(gql.UserStatusIdpDisabled),
+						userStatusString(gql.UserStatusDeleted),
+					}, false),
+				},
+				Description: schemaUsersStatusDescription,
+			},
+			// computed values
+			"users": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: schemaUsersUsersDescription,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"oid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: schemaUserOIDDescription,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"label": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: schemaUserLabelDescription,
+						},
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: schemaUserStatusDescription,
+						},
+					},
+				},
+			},
+			"oids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: schemaUsersOidsDescription,
+			},
+		},
+	}
+}
+
+func dataSourceUsersRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	var (
+		client     = meta.(*observe.Client)
+		emailRegex = regexp.MustCompile(data.Get("email_regex").(string))
+		labelRegex = regexp.MustCompile(data.Get("label_regex").(string))
+		role       = data.Get("role").(string)
+		statuses   = make(map[string]bool)
+	)
+
+	for _, s := range data.Get("status").(*schema.Set).List() {
+		statuses[s.(string)] = true
+	}
+
+	users, err := client.ListUsers(ctx)
+	if err != nil {
+		return diag.Errorf("failed to list users: %s", err.Error())
+	}
+
+	sort.Slice(users, func(i, j int) bool { return users[i].Email < users[j].Email })
+
+	var (
+		result = make([]interface{}, 0)
+		oids   = make([]string, 0)
+	)
+	for _, u := range users {
+		status := userStatusString(u.Status)
+		switch {
+		case len(statuses) == 0 && u.Status == gql.UserStatusDeleted:
+			continue
+		case len(statuses) > 0 && !statuses[status]:
+			continue
+		case role != "" && u.Role != role:
+			continue
+		case !emailRegex.MatchString(u.Email) || !labelRegex.MatchString(u.Label):
+			continue
+		}
+
+		oid := u.Oid().String()
+		result = append(result, map[string]interface{}{
+			"id":     u.Id.String(),
+			"oid":    oid,
+			"email":  u.Email,
+			"label":  u.Label,
+			"role":   u.Role,
+			"status": status,
+		})
+		oids = append(oids, oid)
+	}
+
+	filter := fmt.Sprintf("%s/%s/%s/%v", emailRegex, labelRegex, role, data.Get("status").(*schema.Set).List())
+	data.SetId(strconv.FormatUint(uint64(crc32.ChecksumIEEE([]byte(filter))), 10))
+
+	if err := data.Set("users", result); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("oids", oids); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
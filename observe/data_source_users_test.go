@@ -0,0 +1,33 @@
+package observe
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveUsers(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				data "observe_users" "system" {
+				  email_regex = "^%s$"
+				}
+
+				data "observe_users" "all" {}
+				`, regexp.QuoteMeta(systemUser())),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.observe_users.system", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.observe_users.system", "users.0.email", systemUser()),
+					resource.TestCheckResourceAttrSet("data.observe_users.system", "oids.0"),
+					resource.TestCheckResourceAttrSet("data.observe_users.all", "users.0.email"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,141 @@
+package observe
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func dataSourceWorksheets() *schema.Resource {
+	s := dwSearchSchema("worksheets")
+	s["worksheets"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: descriptions.Get("worksheets", "schema", "worksheets", "id"),
+				},
+				"oid": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: descriptions.Get("worksheets", "schema", "worksheets", "oid"),
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: descriptions.Get("worksheets", "schema", "worksheets", "name"),
+				},
+				"description": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: descriptions.Get("worksheets", "schema", "worksheets", "worksheet_description"),
+				},
+				"icon_url": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: descriptions.Get("worksheets", "schema", "worksheets", "icon_url"),
+				},
+				"workspace": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: descriptions.Get("worksheets", "schema", "worksheets", "workspace"),
+				},
+				"folder": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: descriptions.Get("worksheets", "schema", "worksheets", "folder"),
+				},
+				"updated_date": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: descriptions.Get("worksheets", "schema", "worksheets", "updated_date"),
+				},
+				"score": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: descriptions.Get("worksheets", "schema", "worksheets", "score"),
+				},
+			},
+		},
+		Description: descriptions.Get("worksheets", "schema", "worksheets", "description"),
+	}
+	s["oids"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: descriptions.Get("worksheets", "schema", "oids"),
+	}
+
+	return &schema.Resource{
+		Description: descriptions.Get("worksheets", "description"),
+		ReadContext: dataSourceWorksheetsRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceWorksheetsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	terms, id, err := newDWSearchInput(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	matches, warnings, err := dwSearch(data, func(maxCount *types.Int64Scalar) ([]gql.WorksheetSearchResult, []string, error) {
+		return client.SearchWorksheets(ctx, *terms, maxCount)
+	}, func(m gql.WorksheetSearchResult) string {
+		return m.Worksheet.Name
+	})
+	if err != nil {
+		return diag.Errorf("failed to search worksheets: %s", err.Error())
+	}
+	diags = append(diags, dwSearchWarnings(warnings)...)
+
+	var (
+		result = make([]interface{}, 0)
+		oids   = make([]string, 0)
+	)
+	for _, m := range matches {
+		w := m.Worksheet
+		var description, iconUrl string
+		if w.Description != nil {
+			description = *w.Description
+		}
+		if w.IconUrl != nil {
+			iconUrl = *w.IconUrl
+		}
+		result = append(result, map[string]interface{}{
+			"id":           w.Id,
+			"oid":          w.Oid().String(),
+			"name":         w.Name,
+			"description":  description,
+			"icon_url":     iconUrl,
+			"workspace":    oid.WorkspaceOid(w.WorkspaceId).String(),
+			"folder":       oid.FolderOid(w.FolderId, w.WorkspaceId).String(),
+			"updated_date": w.UpdatedDate.String(),
+			"score":        int(m.Score),
+		})
+		oids = append(oids, w.Oid().String())
+	}
+
+	if err := data.Set("worksheets", result); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("oids", oids); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	data.SetId(id)
+	return diags
+}
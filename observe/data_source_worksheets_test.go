@@ -0,0 +1,47 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveSourceWorksheets(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+`
+					resource "observe_worksheet" "first" {
+						workspace = data.observe_workspace.default.oid
+						name      = "%s"
+						queries = <<-EOF
+						[{
+							"pipeline": "",
+							"input": [{
+							  "inputName": "kubernetes/metrics/Container Metrics",
+							  "inputRole": "Data",
+							  "datasetId": "41042989"
+							}]
+						}]
+						EOF
+					}
+
+					data "observe_worksheets" "lookup" {
+						workspace = data.observe_workspace.default.oid
+						name      = observe_worksheet.first.name
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.observe_worksheets.lookup", "worksheets.#", "1"),
+					resource.TestCheckResourceAttrPair("data.observe_worksheets.lookup", "oids.0", "observe_worksheet.first", "oid"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,234 @@
+package observe
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+)
+
+// datasetGraph is the dependency graph between datasets, assembled from the
+// inputs of every dataset. Edges point from an input to the dataset consuming it.
+type datasetGraph struct {
+	names      map[string]string
+	upstream   map[string][]string
+	downstream map[string][]string
+}
+
+type datasetGraphEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+func newDatasetGraph(datasets []gql.DatasetLineage) *datasetGraph {
+	g := &datasetGraph{
+		names:      make(map[string]string),
+		upstream:   make(map[string][]string),
+		downstream: make(map[string][]string),
+	}
+	for _, d := range datasets {
+		g.names[d.Id] = d.Name
+		for _, input := range d.Inputs {
+			g.upstream[d.Id] = append(g.upstream[d.Id], input.DatasetId)
+			g.downstream[input.DatasetId] = append(g.downstream[input.DatasetId], d.Id)
+		}
+	}
+	return g
+}
+
+// walk returns all datasets reachable from id by following edges, up to depth
+// hops away. A depth of 0 is unbounded. Results are ordered by distance from
+// id, then by ID, and never include id itself.
+func (g *datasetGraph) walk(id string, edges map[string][]string, depth int) []string {
+	var (
+		result   []string
+		visited  = map[string]bool{id: true}
+		frontier = []string{id}
+	)
+	for level := 0; len(frontier) > 0 && (depth == 0 || level < depth); level++ {
+		var next []string
+		for _, cur := range frontier {
+			for _, n := range edges[cur] {
+				if !visited[n] {
+					visited[n] = true
+					next = append(next, n)
+				}
+			}
+		}
+		sort.Strings(next)
+		result = append(result, next...)
+		frontier = next
+	}
+	return result
+}
+
+func (g *datasetGraph) Upstream(id string, depth int) []string {
+	return g.walk(id, g.upstream, depth)
+}
+
+func (g *datasetGraph) Downstream(id string, depth int) []string {
+	return g.walk(id, g.downstream, depth)
+}
+
+// Edges returns all edges between the given datasets, sorted.
+func (g *datasetGraph) Edges(ids []string) (edges []datasetGraphEdge) {
+	include := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		include[id] = true
+	}
+	for _, to := range ids {
+		for _, from := range g.upstream[to] {
+			if include[from] {
+				edges = append(edges, datasetGraphEdge{From: from, To: to})
+			}
+		}
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].From != edges[j].From {
+			return edges[i].From < edges[j].From
+		}
+		return edges[i].To < edges[j].To
+	})
+	return edges
+}
+
+// Render formats the subgraph spanning the given datasets as either "dot" or
+// "json". Node identifiers are formatted using the provided function.
+func (g *datasetGraph) Render(format string, ids []string, nodeID func(string) string) (string, error) {
+	edges := g.Edges(ids)
+
+	switch format {
+	case "dot":
+		var b strings.Builder
+		b.WriteString("digraph lineage {\n")
+		for _, id := range ids {
+			fmt.Fprintf(&b, "  %q [label=%q];\n", nodeID(id), g.names[id])
+		}
+		for _, e := range edges {
+			fmt.Fprintf(&b, "  %q -> %q;\n", nodeID(e.From), nodeID(e.To))
+		}
+		b.WriteString("}\n")
+		return b.String(), nil
+	case "json":
+		type node struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		}
+		out := struct {
+			Nodes []node             `json:"nodes"`
+			Edges []datasetGraphEdge `json:"edges"`
+		}{
+			Nodes: make([]node, 0, len(ids)),
+			Edges: make([]datasetGraphEdge, 0, len(edges)),
+		}
+		for _, id := range ids {
+			out.Nodes = append(out.Nodes, node{ID: nodeID(id), Name: g.names[id]})
+		}
+		for _, e := range edges {
+			out.Edges = append(out.Edges, datasetGraphEdge{From: nodeID(e.From), To: nodeID(e.To)})
+		}
+		data, err := json.Marshal(out)
+		return string(data), err
+	default:
+		return "", fmt.Errorf("unsupported graph format %q", format)
+	}
+}
+
+// datasetDependent is an object which would break if a dataset was deleted.
+type datasetDependent struct {
+	Oid  oid.OID
+	Name string
+}
+
+// datasetDependents lists all datasets downstream of id, followed by all
+// monitors reading from it. Dependents which have already been deleted in
+// the same Terraform run are omitted, along with anything downstream of
+// them, since lineage may briefly lag behind deletions.
+func datasetDependents(id string, lineage []gql.DatasetLineage, monitors []gql.DatasetMonitor, deleted func(oid.OID) bool) []datasetDependent {
+	var remaining []gql.DatasetLineage
+	for _, d := range lineage {
+		if !deleted(oid.DatasetOid(d.Id)) {
+			remaining = append(remaining, d)
+		}
+	}
+	graph := newDatasetGraph(remaining)
+
+	var dependents []datasetDependent
+	for _, d := range graph.Downstream(id, 0) {
+		dependents = append(dependents, datasetDependent{Oid: oid.DatasetOid(d), Name: graph.names[d]})
+	}
+	for _, m := range monitors {
+		if !deleted(oid.MonitorOid(m.Id)) {
+			dependents = append(dependents, datasetDependent{Oid: oid.MonitorOid(m.Id), Name: m.Name})
+		}
+	}
+	return dependents
+}
+
+// datasetDeleteProtection refuses to delete a dataset with delete_protection
+// set while it still has dependents, unless force_delete is also set.
+// Dependents destroyed in the same Terraform run do not count: if any other
+// deletes are still in progress, we wait for them to complete before
+// listing dependents again. Only dependents within the dataset's workspace
+// are considered.
+func datasetDeleteProtection(ctx context.Context, client *observe.Client, data *schema.ResourceData) (diags diag.Diagnostics) {
+	if !data.Get("delete_protection").(bool) || data.Get("force_delete").(bool) {
+		return nil
+	}
+
+	workspace, err := oid.NewOID(data.Get("workspace").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for {
+		lineage, err := client.ListWorkspaceDatasetLineage(ctx, workspace.Id)
+		if err != nil {
+			return diag.Errorf("failed to retrieve dataset lineage: %s", err)
+		}
+
+		monitors, err := client.ListMonitorsForDataset(ctx, data.Id())
+		if err != nil {
+			return diag.Errorf("failed to retrieve monitors for dataset: %s", err)
+		}
+
+		dependents := datasetDependents(data.Id(), lineage, monitors, client.Deleted)
+		if len(dependents) == 0 {
+			return nil
+		}
+
+		waited, err := client.WaitForDeletes(ctx)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if !waited {
+			return datasetDependentsDiagnostics(data.Id(), dependents)
+		}
+	}
+}
+
+func datasetDependentsDiagnostics(id string, dependents []datasetDependent) diag.Diagnostics {
+	if len(dependents) == 0 {
+		return nil
+	}
+
+	lines := make([]string, 0, len(dependents))
+	for _, d := range dependents {
+		lines = append(lines, fmt.Sprintf("- %s (%s)", d.Name, d.Oid.String()))
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("dataset [id=%s] has %d dependents and delete_protection is enabled", id, len(dependents)),
+		Detail: "Deleting this dataset would break the following objects:\n" +
+			strings.Join(lines, "\n") +
+			"\n\nRemove the dependents first, or set force_delete and apply before destroying.",
+	}}
+}
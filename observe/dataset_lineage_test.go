@@ -0,0 +1,175 @@
+package observe
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+)
+
+func testDatasetLineage(id string, inputs ...string) gql.DatasetLineage {
+	d := gql.DatasetLineage{Id: id, Name: "ds" + id}
+	for _, input := range inputs {
+		d.Inputs = append(d.Inputs, gql.DatasetLineageInputsDatasetInputDataset{DatasetId: input})
+	}
+	return d
+}
+
+func TestDatasetGraph(t *testing.T) {
+	// 1 -> 2 -> 3 -> 5
+	//        \-> 4 -/
+	g := newDatasetGraph([]gql.DatasetLineage{
+		testDatasetLineage("1"),
+		testDatasetLineage("2", "1"),
+		testDatasetLineage("3", "2"),
+		testDatasetLineage("4", "2"),
+		testDatasetLineage("5", "3", "4"),
+	})
+
+	testcases := []struct {
+		Name     string
+		Got      []string
+		Expected []string
+	}{
+		{"upstream depth 1", g.Upstream("5", 1), []string{"3", "4"}},
+		{"upstream unbounded", g.Upstream("5", 0), []string{"3", "4", "2", "1"}},
+		{"downstream depth 2", g.Downstream("1", 2), []string{"2", "3", "4"}},
+		{"downstream unbounded", g.Downstream("2", 0), []string{"3", "4", "5"}},
+		{"no downstream", g.Downstream("5", 0), nil},
+	}
+
+	for _, tc := range testcases {
+		if s := cmp.Diff(tc.Got, tc.Expected); s != "" {
+			t.Errorf("%s: %s", tc.Name, s)
+		}
+	}
+}
+
+func TestDatasetGraphRender(t *testing.T) {
+	g := newDatasetGraph([]gql.DatasetLineage{
+		testDatasetLineage("1"),
+		testDatasetLineage("2", "1"),
+	})
+
+	nodeID := func(id string) string { return "n" + id }
+
+	dot, err := g.Render("dot", []string{"2", "1"}, nodeID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "digraph lineage {\n" +
+		"  \"n2\" [label=\"ds2\"];\n" +
+		"  \"n1\" [label=\"ds1\"];\n" +
+		"  \"n1\" -> \"n2\";\n" +
+		"}\n"
+	if s := cmp.Diff(dot, expected); s != "" {
+		t.Error(s)
+	}
+
+	js, err := g.Render("json", []string{"1", "2"}, nodeID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = `{"nodes":[{"id":"n1","name":"ds1"},{"id":"n2","name":"ds2"}],"edges":[{"from":"n1","to":"n2"}]}`
+	if s := cmp.Diff(js, expected); s != "" {
+		t.Error(s)
+	}
+
+	if _, err := g.Render("svg", nil, nodeID); err == nil {
+		t.Error("expected error for unsupported format")
+	}
+}
+
+func TestDatasetDependents(t *testing.T) {
+	monitors := []gql.DatasetMonitor{{Id: "10", Name: "mon10"}}
+
+	testcases := []struct {
+		Name     string
+		Lineage  []gql.DatasetLineage
+		Monitors []gql.DatasetMonitor
+		Deleted  []oid.OID
+		Expected []string
+	}{
+		{
+			Name:    "no dependents",
+			Lineage: []gql.DatasetLineage{testDatasetLineage("1")},
+		},
+		{
+			Name:    "unrelated datasets",
+			Lineage: []gql.DatasetLineage{testDatasetLineage("1"), testDatasetLineage("3")},
+		},
+		{
+			Name: "transitive dependents and monitors",
+			Lineage: []gql.DatasetLineage{
+				testDatasetLineage("1"),
+				testDatasetLineage("2", "1"),
+				testDatasetLineage("3", "2"),
+			},
+			Monitors: monitors,
+			Expected: []string{"o:::dataset:2", "o:::dataset:3", "o:::monitor:10"},
+		},
+		{
+			Name: "direct dependent",
+			Lineage: []gql.DatasetLineage{
+				testDatasetLineage("1"),
+				testDatasetLineage("2", "1"),
+			},
+			Expected: []string{"o:::dataset:2"},
+		},
+		{
+			Name: "dependents in state destroyed in the same run",
+			Lineage: []gql.DatasetLineage{
+				testDatasetLineage("1"),
+				testDatasetLineage("2", "1"),
+				testDatasetLineage("3", "2"),
+			},
+			Monitors: monitors,
+			Deleted:  []oid.OID{oid.DatasetOid("2"), oid.MonitorOid("10")},
+		},
+		{
+			Name: "dependents not in state",
+			Lineage: []gql.DatasetLineage{
+				testDatasetLineage("1"),
+				testDatasetLineage("2", "1"),
+				testDatasetLineage("3", "1"),
+			},
+			Monitors: monitors,
+			Deleted:  []oid.OID{oid.DatasetOid("2")},
+			Expected: []string{"o:::dataset:3", "o:::monitor:10"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			deleted := func(id oid.OID) bool {
+				for _, d := range tc.Deleted {
+					if d.String() == id.String() {
+						return true
+					}
+				}
+				return false
+			}
+			dependents := datasetDependents("1", tc.Lineage, tc.Monitors, deleted)
+
+			var got []string
+			for _, d := range dependents {
+				got = append(got, d.Oid.String())
+			}
+			if s := cmp.Diff(got, tc.Expected); s != "" {
+				t.Fatal(s)
+			}
+
+			diags := datasetDependentsDiagnostics("1", dependents)
+			if diags.HasError() != (len(tc.Expected) > 0) {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			for _, id := range tc.Expected {
+				if !strings.Contains(diags[0].Detail, id) {
+					t.Errorf("expected %s in %q", id, diags[0].Detail)
+				}
+			}
+		})
+	}
+}
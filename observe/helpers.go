@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -289,6 +290,15 @@ func datasetRecomputeOID(d *schema.ResourceDiff) bool {
 	return false
 }
 
+// secondsToDurationString formats a duration expressed in fractional seconds,
+// rounded to the nearest second. Missing values are formatted as "".
+func secondsToDurationString(seconds *float64) string {
+	if seconds == nil {
+		return ""
+	}
+	return time.Duration(*seconds * float64(time.Second)).Round(time.Second).String()
+}
+
 func diffSuppressTimeDuration(k, prv, nxt string, d *schema.ResourceData) bool {
 	o, _ := time.ParseDuration(prv)
 	n, _ := time.ParseDuration(nxt)
@@ -301,6 +311,12 @@ func diffSuppressTimeDurationZeroDistinctFromEmpty(k, prv, nxt string, d *schema
 	return o == n && e1 == e2 // the e1 == e2 check distinguishes "0" from ""
 }
 
+func diffSuppressTimestamp(k, prv, nxt string, d *schema.ResourceData) bool {
+	o, e1 := time.Parse(time.RFC3339, prv)
+	n, e2 := time.Parse(time.RFC3339, nxt)
+	return e1 == nil && e2 == nil && o.Equal(n)
+}
+
 func diffSuppressJSON(k, prv, nxt string, d *schema.ResourceData) bool {
 	var prvValue, nxtValue interface{}
 	if err := json.Unmarshal([]byte(prv), &prvValue); err != nil {
@@ -312,6 +328,17 @@ func diffSuppressJSON(k, prv, nxt string, d *schema.ResourceData) bool {
 	return reflect.DeepEqual(prvValue, nxtValue)
 }
 
+// diffSuppressWhenSet suppresses all differences while the other attribute is
+// set, e.g. when a JSON attribute is generated from structured blocks.
+func diffSuppressWhenSet(other string, f schema.SchemaDiffSuppressFunc) schema.SchemaDiffSuppressFunc {
+	return func(k, prv, nxt string, d *schema.ResourceData) bool {
+		if _, ok := d.GetOk(other); ok {
+			return true
+		}
+		return f(k, prv, nxt, d)
+	}
+}
+
 func diffSuppressStageQueryInput(k, prv, nxt string, d *schema.ResourceData) bool {
 	prvValue := make([]gql.StageQueryInput, 0)
 	nxtValue := make([]gql.StageQueryInput, 0)
@@ -484,3 +511,27 @@ func validateDatasetName() schema.SchemaValidateDiagFunc {
 func validateDatastreamName() schema.SchemaValidateDiagFunc {
 	return validateDatasetName()
 }
+
+// oidSetIds returns the object IDs of a set of OIDs, sorted
+func oidSetIds(data *schema.ResourceData, key string) ([]string, error) {
+	var ids []string
+	for _, v := range data.Get(key).(*schema.Set).List() {
+		id, err := oid.NewOID(v.(string))
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id.Id)
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// stringSetValues returns the sorted contents of a set of strings
+func stringSetValues(data *schema.ResourceData, key string) []string {
+	var result []string
+	for _, v := range data.Get(key).(*schema.Set).List() {
+		result = append(result, v.(string))
+	}
+	sort.Strings(result)
+	return result
+}
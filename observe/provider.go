@@ -119,28 +119,42 @@ func Provider() *schema.Provider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"observe_dataset":           dataSourceDataset(),
-			"observe_link":              dataSourceLink(),
-			"observe_workspace":         dataSourceWorkspace(),
-			"observe_query":             dataSourceQuery(),
-			"observe_board":             dataSourceBoard(),
-			"observe_monitor":           dataSourceMonitor(),
-			"observe_monitor_action":    dataSourceMonitorAction(),
-			"observe_datastream":        dataSourceDatastream(),
-			"observe_worksheet":         dataSourceWorksheet(),
-			"observe_dashboard":         dataSourceDashboard(),
-			"observe_folder":            dataSourceFolder(),
-			"observe_app":               dataSourceApp(),
-			"observe_app_version":       dataSourceAppVersion(),
-			"observe_default_dashboard": dataSourceDefaultDashboard(),
-			"observe_terraform":         dataSourceTerraform(),
-			"observe_oid":               dataSourceOID(),
-			"observe_rbac_group":        dataSourceRbacGroup(),
-			"observe_user":              dataSourceUser(),
-			"observe_ingest_info":       dataSourceIngestInfo(),
-			"observe_cloud_info":        dataSourceCloudInfo(),
-			"observe_monitor_v2":        dataSourceMonitorV2(),
-			"observe_monitor_v2_action": dataSourceMonitorV2Action(),
+			"observe_dataset":            dataSourceDataset(),
+			"observe_dataset_doctor":     dataSourceDatasetDoctor(),
+			"observe_dataset_lineage":    dataSourceDatasetLineage(),
+			"observe_datasets":           dataSourceDatasets(),
+			"observe_link":               dataSourceLink(),
+			"observe_workspace":          dataSourceWorkspace(),
+			"observe_query":              dataSourceQuery(),
+			"observe_board":              dataSourceBoard(),
+			"observe_monitor":            dataSourceMonitor(),
+			"observe_monitor_action":     dataSourceMonitorAction(),
+			"observe_datastream":         dataSourceDatastream(),
+			"observe_worksheet":          dataSourceWorksheet(),
+			"observe_dashboard":          dataSourceDashboard(),
+			"observe_folder":             dataSourceFolder(),
+			"observe_app":                dataSourceApp(),
+			"observe_app_version":        dataSourceAppVersion(),
+			"observe_default_dashboard":  dataSourceDefaultDashboard(),
+			"observe_terraform":          dataSourceTerraform(),
+			"observe_oid":                dataSourceOID(),
+			"observe_rbac_group":         dataSourceRbacGroup(),
+			"observe_user":               dataSourceUser(),
+			"observe_users":              dataSourceUsers(),
+			"observe_rbac_access_check":  dataSourceRbacAccessCheck(),
+			"observe_owned_objects":      dataSourceOwnedObjects(),
+			"observe_incidents":          dataSourceIncidents(),
+			"observe_feature_flags":      dataSourceFeatureFlags(),
+			"observe_blobs":              dataSourceBlobs(),
+			"observe_dashboards":         dataSourceDashboards(),
+			"observe_worksheets":         dataSourceWorksheets(),
+			"observe_query_export":       dataSourceQueryExport(),
+			"observe_metrics":            dataSourceMetrics(),
+			"observe_resource_instances": dataSourceResourceInstances(),
+			"observe_ingest_info":        dataSourceIngestInfo(),
+			"observe_cloud_info":         dataSourceCloudInfo(),
+			"observe_monitor_v2":         dataSourceMonitorV2(),
+			"observe_monitor_v2_action":  dataSourceMonitorV2Action(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"observe_dataset":                   resourceDataset(),
@@ -178,6 +192,16 @@ func Provider() *schema.Provider {
 			"observe_filedrop":                  resourceFiledrop(),
 			"observe_snowflake_outbound_share":  resourceSnowflakeOutboundShare(),
 			"observe_dataset_outbound_share":    resourceDatasetOutboundShare(),
+			"observe_acceleration_job":          resourceAccelerationJob(),
+			"observe_user":                      resourceUser(),
+			"observe_rbac_group_members":        resourceRbacGroupmembers(),
+			"observe_rbac_policy":               resourceRbacPolicy(),
+			"observe_customer_sso":              resourceCustomerSso(),
+			"observe_object_owner":              resourceObjectOwner(),
+			"observe_incident":                  resourceIncident(),
+			"observe_monitor_mute_rule":         resourceMonitorMuteRule(),
+			"observe_user_feature_flag":         resourceUserFeatureFlag(),
+			"observe_blob":                      resourceBlob(),
 		},
 		TerraformVersion: version.ProviderVersion,
 	}
@@ -0,0 +1,472 @@
+package observe
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// queryAssertion validates query output, either against a golden file or
+// against bounds on the number of rows returned.
+type queryAssertion struct {
+	GoldenFile       string
+	Update           bool
+	SchemaOnly       bool
+	Columns          []string
+	IgnoreColumns    []string
+	IgnoreTimestamps bool
+	SortBy           []string
+	Tolerances       map[string]queryTolerance
+	MinRows          *int
+	MaxRows          *int
+}
+
+// queryTolerance allows numeric values to differ from the golden file. A
+// value matches if it is within either bound.
+type queryTolerance struct {
+	Absolute float64
+	Relative float64
+}
+
+func (t queryTolerance) matches(expected, actual float64) bool {
+	delta := math.Abs(expected - actual)
+	return delta <= t.Absolute || delta <= t.Relative*math.Abs(expected)
+}
+
+// queryGoldenColumn is the representation of a column in schema only golden files
+type queryGoldenColumn struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+func newQueryAssertion(data *schema.ResourceData) *queryAssertion {
+	a := &queryAssertion{
+		GoldenFile:       data.Get("assert.0.golden_file").(string),
+		Update:           data.Get("assert.0.update").(bool),
+		SchemaOnly:       data.Get("assert.0.schema_only").(bool),
+		IgnoreTimestamps: data.Get("assert.0.ignore_timestamps").(bool),
+		Tolerances:       make(map[string]queryTolerance),
+	}
+
+	for _, v := range data.Get("assert.0.columns").([]interface{}) {
+		a.Columns = append(a.Columns, v.(string))
+	}
+
+	for _, v := range data.Get("assert.0.ignore_columns").(*schema.Set).List() {
+		a.IgnoreColumns = append(a.IgnoreColumns, v.(string))
+	}
+
+	for _, v := range data.Get("assert.0.sort_by").([]interface{}) {
+		a.SortBy = append(a.SortBy, v.(string))
+	}
+
+	for _, v := range data.Get("assert.0.tolerance").([]interface{}) {
+		t := v.(map[string]interface{})
+		a.Tolerances[t["column"].(string)] = queryTolerance{
+			Absolute: t["absolute"].(float64),
+			Relative: t["relative"].(float64),
+		}
+	}
+
+	// zero is a meaningful bound, so check whether the attribute was set at all
+	if raw := data.GetRawConfig().GetAttr("assert"); raw.IsKnown() && !raw.IsNull() && raw.LengthInt() > 0 {
+		block := raw.Index(cty.NumberIntVal(0))
+		for name, bound := range map[string]**int{"min_rows": &a.MinRows, "max_rows": &a.MaxRows} {
+			if v := block.GetAttr(name); v.IsKnown() && !v.IsNull() {
+				n := data.Get("assert.0." + name).(int)
+				*bound = &n
+			}
+		}
+	}
+	return a
+}
+
+// Check validates rows against the assertion. If Update is set, the golden
+// file is rewritten rather than compared against. Since neither row bounds
+// nor golden files can be checked against a partial result, total must not
+// exceed the number of rows.
+func (a *queryAssertion) Check(columns []queryColumn, rows []map[string]interface{}, total int64) error {
+	if total > int64(len(rows)) {
+		return fmt.Errorf("query result was truncated at %d rows, increase max_rows to assert on the complete result", len(rows))
+	}
+	if a.MinRows != nil && len(rows) < *a.MinRows {
+		return fmt.Errorf("query returned %d rows, expected at least %d", len(rows), *a.MinRows)
+	}
+	if a.MaxRows != nil && len(rows) > *a.MaxRows {
+		return fmt.Errorf("query returned %d rows, expected at most %d", len(rows), *a.MaxRows)
+	}
+
+	if a.GoldenFile == "" {
+		if a.Update {
+			return fmt.Errorf("golden_file must be set to update it")
+		}
+		return nil
+	}
+
+	columns = a.selectColumns(columns)
+
+	var actual interface{}
+	if a.SchemaOnly {
+		actual = queryGoldenSchema(columns)
+	} else {
+		actual = a.normalizeRows(columns, rows)
+	}
+
+	if a.Update {
+		// we indent only when writing to golden file, since we want pretty diffs
+		data, err := json.MarshalIndent(actual, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal rows: %w", err)
+		}
+		if err := os.WriteFile(a.GoldenFile, append(data, '\n'), os.FileMode(0644)); err != nil {
+			return fmt.Errorf("failed to write to golden file: %w", err)
+		}
+		return nil
+	}
+
+	goldenData, err := os.ReadFile(a.GoldenFile)
+	if err != nil {
+		return fmt.Errorf("failed to read golden file: %w", err)
+	}
+
+	var expected interface{}
+	if a.SchemaOnly {
+		var golden []queryGoldenColumn
+		if err := json.Unmarshal(goldenData, &golden); err != nil {
+			return fmt.Errorf("failed to parse golden file: %w", err)
+		}
+		expected = golden
+	} else {
+		var golden []map[string]interface{}
+		if err := json.Unmarshal(goldenData, &golden); err != nil {
+			return fmt.Errorf("failed to parse golden file: %w", err)
+		}
+		expectedRows := a.normalizeRows(columns, golden)
+		a.applyTolerances(expectedRows, actual.([]map[string]interface{}))
+		expected = expectedRows
+	}
+
+	diff, err := queryResultDiff(a.GoldenFile, expected, actual)
+	if err != nil {
+		return err
+	}
+	if diff != "" {
+		return fmt.Errorf("query result does not match golden file:\n%s", diff)
+	}
+	return nil
+}
+
+// selectColumns returns the columns which take part in the comparison
+func (a *queryAssertion) selectColumns(columns []queryColumn) []queryColumn {
+	ignore := make(map[string]bool)
+	for _, name := range a.IgnoreColumns {
+		ignore[name] = true
+	}
+
+	var result []queryColumn
+	if len(a.Columns) > 0 {
+		// explicitly selected columns are retained in the order given
+		byName := make(map[string]queryColumn, len(columns))
+		for _, c := range columns {
+			byName[c.Name] = c
+		}
+		for _, name := range a.Columns {
+			c, ok := byName[name]
+			if !ok {
+				// a missing column is reported as a mismatch rather than an error
+				c = queryColumn{Name: name}
+			}
+			result = append(result, c)
+		}
+		return result
+	}
+
+	for _, c := range columns {
+		if ignore[c.Name] || (a.IgnoreTimestamps && c.Rep == "timestamp") {
+			continue
+		}
+		result = append(result, c)
+	}
+	return result
+}
+
+// normalizeRows restricts rows to the selected columns, converts all values to
+// strings, and sorts rows if requested.
+func (a *queryAssertion) normalizeRows(columns []queryColumn, rows []map[string]interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(rows))
+	for i, row := range rows {
+		m := make(map[string]interface{}, len(columns))
+		for _, c := range columns {
+			m[c.Name] = normalizeQueryValue(row[c.Name])
+		}
+		result[i] = m
+	}
+
+	if len(a.SortBy) > 0 {
+		sort.SliceStable(result, func(i, j int) bool {
+			for _, name := range a.SortBy {
+				x, y := result[i][name], result[j][name]
+				switch {
+				case x == y:
+					continue
+				case x == nil:
+					return true
+				case y == nil:
+					return false
+				}
+				if c := compareQueryValues(x.(string), y.(string)); c != 0 {
+					return c < 0
+				}
+			}
+			return false
+		})
+	}
+	return result
+}
+
+// normalizeQueryValue converts values read from golden files into the string
+// representation used in query results.
+func normalizeQueryValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+}
+
+// compareQueryValues orders numeric values numerically, and all other values
+// lexically.
+func compareQueryValues(x, y string) int {
+	fx, errx := strconv.ParseFloat(x, 64)
+	fy, erry := strconv.ParseFloat(y, 64)
+	if errx == nil && erry == nil {
+		switch {
+		case fx < fy:
+			return -1
+		case fx > fy:
+			return 1
+		}
+		return 0
+	}
+	return strings.Compare(x, y)
+}
+
+// applyTolerances replaces expected values with actual values wherever they
+// are within tolerance, so that only genuine mismatches show up in the diff.
+func (a *queryAssertion) applyTolerances(expected, actual []map[string]interface{}) {
+	for i := 0; i < len(expected) && i < len(actual); i++ {
+		for name, t := range a.Tolerances {
+			e, eok := expected[i][name].(string)
+			v, vok := actual[i][name].(string)
+			if !eok || !vok {
+				continue
+			}
+			fe, erre := strconv.ParseFloat(e, 64)
+			fv, errv := strconv.ParseFloat(v, 64)
+			if erre == nil && errv == nil && t.matches(fe, fv) {
+				expected[i][name] = v
+			}
+		}
+	}
+}
+
+func queryGoldenSchema(columns []queryColumn) []queryGoldenColumn {
+	result := make([]queryGoldenColumn, len(columns))
+	for i, c := range columns {
+		result[i] = queryGoldenColumn{Name: c.Name, Type: c.Rep}
+	}
+	return result
+}
+
+// queryResultDiff returns a unified diff between the indented JSON encodings
+// of expected and actual, or an empty string if they are identical.
+func queryResultDiff(name string, expected, actual interface{}) (string, error) {
+	e, err := json.MarshalIndent(expected, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	a, err := json.MarshalIndent(actual, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	if string(e) == string(a) {
+		return "", nil
+	}
+	return unifiedDiff(name, "query result", strings.Split(string(e), "\n"), strings.Split(string(a), "\n"), 3), nil
+}
+
+// unifiedDiffMaxCells bounds the memory used to compute a line diff. Larger
+// inputs are reported as a single hunk.
+const unifiedDiffMaxCells = 1 << 24
+
+type diffOp struct {
+	Kind byte // ' ', '-' or '+'
+	Line string
+}
+
+// diffLines computes a minimal line based edit script between a and b
+func diffLines(a, b []string) []diffOp {
+	// trim common prefix and suffix, which is usually most of the input
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var ops []diffOp
+	for _, l := range a[:prefix] {
+		ops = append(ops, diffOp{' ', l})
+	}
+
+	ma, mb := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+	if (len(ma)+1)*(len(mb)+1) > unifiedDiffMaxCells {
+		for _, l := range ma {
+			ops = append(ops, diffOp{'-', l})
+		}
+		for _, l := range mb {
+			ops = append(ops, diffOp{'+', l})
+		}
+	} else {
+		// lcs[i][j] holds the length of the longest common subsequence of
+		// ma[i:] and mb[j:]
+		lcs := make([][]int, len(ma)+1)
+		for i := range lcs {
+			lcs[i] = make([]int, len(mb)+1)
+		}
+		for i := len(ma) - 1; i >= 0; i-- {
+			for j := len(mb) - 1; j >= 0; j-- {
+				if ma[i] == mb[j] {
+					lcs[i][j] = lcs[i+1][j+1] + 1
+				} else if lcs[i+1][j] >= lcs[i][j+1] {
+					lcs[i][j] = lcs[i+1][j]
+				} else {
+					lcs[i][j] = lcs[i][j+1]
+				}
+			}
+		}
+
+		i, j := 0, 0
+		for i < len(ma) || j < len(mb) {
+			switch {
+			case i < len(ma) && j < len(mb) && ma[i] == mb[j]:
+				ops = append(ops, diffOp{' ', ma[i]})
+				i++
+				j++
+			case j == len(mb) || (i < len(ma) && lcs[i+1][j] >= lcs[i][j+1]):
+				ops = append(ops, diffOp{'-', ma[i]})
+				i++
+			default:
+				ops = append(ops, diffOp{'+', mb[j]})
+				j++
+			}
+		}
+	}
+
+	for _, l := range a[len(a)-suffix:] {
+		ops = append(ops, diffOp{' ', l})
+	}
+	return ops
+}
+
+// unifiedDiff formats the differences between a and b in unified diff format,
+// with the given number of lines of context around each change.
+func unifiedDiff(nameA, nameB string, a, b []string, context int) string {
+	ops := diffLines(a, b)
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", nameA, nameB)
+
+	for start := 0; start < len(ops); {
+		// find the next change
+		for start < len(ops) && ops[start].Kind == ' ' {
+			start++
+		}
+		if start == len(ops) {
+			break
+		}
+
+		// extend the hunk until a run of unchanged lines long enough to
+		// separate it from the next change
+		end := start
+		for end < len(ops) {
+			if ops[end].Kind != ' ' {
+				end++
+				continue
+			}
+			run := end
+			for run < len(ops) && ops[run].Kind == ' ' {
+				run++
+			}
+			if run == len(ops) || run-end > 2*context {
+				break
+			}
+			end = run
+		}
+
+		from := start - context
+		if from < 0 {
+			from = 0
+		}
+		to := end + context
+		if to > len(ops) {
+			to = len(ops)
+		}
+
+		// line numbers are 1-based positions in a and b
+		lineA, lineB := 1, 1
+		for _, op := range ops[:from] {
+			if op.Kind != '+' {
+				lineA++
+			}
+			if op.Kind != '-' {
+				lineB++
+			}
+		}
+		var countA, countB int
+		for _, op := range ops[from:to] {
+			if op.Kind != '+' {
+				countA++
+			}
+			if op.Kind != '-' {
+				countB++
+			}
+		}
+		fmt.Fprintf(&sb, "@@ -%s +%s @@\n", unifiedDiffRange(lineA, countA), unifiedDiffRange(lineB, countB))
+		for _, op := range ops[from:to] {
+			sb.WriteByte(op.Kind)
+			sb.WriteString(op.Line)
+			sb.WriteByte('\n')
+		}
+		start = to
+	}
+	return sb.String()
+}
+
+func unifiedDiffRange(line, count int) string {
+	switch count {
+	case 0:
+		// an empty range refers to the line preceding it
+		return fmt.Sprintf("%d,0", line-1)
+	case 1:
+		return strconv.Itoa(line)
+	}
+	return fmt.Sprintf("%d,%d", line, count)
+}
@@ -0,0 +1,157 @@
+package observe
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	a := strings.Split("a\nb\nc\nd\ne\nf\ng\nh\ni\nj", "\n")
+	b := strings.Split("a\nB\nc\nd\ne\nf\ng\nh\ni\nj\nk", "\n")
+
+	expected := `--- expected
++++ actual
+@@ -1,5 +1,5 @@
+ a
+-b
++B
+ c
+ d
+ e
+@@ -8,3 +8,4 @@
+ h
+ i
+ j
++k
+`
+	if diff := cmp.Diff(expected, unifiedDiff("expected", "actual", a, b, 3)); diff != "" {
+		t.Fatalf("unexpected diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestQueryAssertion(t *testing.T) {
+	columns := []queryColumn{
+		{Name: "timestamp", Rep: "timestamp"},
+		{Name: "host", Rep: "string"},
+		{Name: "count", Rep: "int64"},
+	}
+	rows := []map[string]interface{}{
+		{"timestamp": "1700000000000000000", "host": "b", "count": "98"},
+		{"timestamp": "1700000000000000000", "host": "a", "count": "10"},
+		{"timestamp": "1700000000000000000", "host": "c", "count": nil},
+	}
+
+	testcases := []struct {
+		Name      string
+		Assertion queryAssertion
+		Golden    string
+		Total     int64
+		Error     string
+	}{
+		{
+			Name:      "sorted with tolerance",
+			Assertion: queryAssertion{IgnoreTimestamps: true, SortBy: []string{"host"}, Tolerances: map[string]queryTolerance{"count": {Relative: 0.05}}},
+			Golden:    `[{"host": "a", "count": 10}, {"host": "b", "count": 100}, {"host": "c", "count": null}]`,
+		},
+		{
+			Name:      "outside tolerance",
+			Assertion: queryAssertion{IgnoreTimestamps: true, SortBy: []string{"host"}, Tolerances: map[string]queryTolerance{"count": {Absolute: 1}}},
+			Golden:    `[{"host": "a", "count": 10}, {"host": "b", "count": 100}, {"host": "c", "count": null}]`,
+			Error:     "-    \"count\": \"100\",\n+    \"count\": \"98\",",
+		},
+		{
+			Name:      "unsorted",
+			Assertion: queryAssertion{IgnoreTimestamps: true},
+			Golden:    `[{"host": "a", "count": "10"}, {"host": "b", "count": "98"}, {"host": "c", "count": null}]`,
+			Error:     "does not match golden file",
+		},
+		{
+			Name:      "selected columns",
+			Assertion: queryAssertion{Columns: []string{"host"}, SortBy: []string{"host"}},
+			Golden:    `[{"host": "a"}, {"host": "b"}, {"host": "c"}]`,
+		},
+		{
+			Name:      "ignored columns",
+			Assertion: queryAssertion{IgnoreColumns: []string{"timestamp", "count"}},
+			Golden:    `[{"host": "b"}, {"host": "a"}, {"host": "c"}]`,
+		},
+		{
+			Name:      "schema only",
+			Assertion: queryAssertion{SchemaOnly: true},
+			Golden:    `[{"name": "timestamp", "type": "timestamp"}, {"name": "host", "type": "string"}, {"name": "count", "type": "int64"}]`,
+		},
+		{
+			Name:      "schema mismatch",
+			Assertion: queryAssertion{SchemaOnly: true, IgnoreTimestamps: true},
+			Golden:    `[{"name": "host", "type": "string"}, {"name": "count", "type": "float64"}]`,
+			Error:     "+    \"type\": \"int64\"",
+		},
+		{
+			Name:      "too few rows",
+			Assertion: queryAssertion{MinRows: intPtr(4)},
+			Error:     "expected at least 4",
+		},
+		{
+			Name:      "too many rows",
+			Assertion: queryAssertion{MaxRows: intPtr(0)},
+			Error:     "expected at most 0",
+		},
+		{
+			Name:      "truncated result within bounds",
+			Assertion: queryAssertion{MinRows: intPtr(1), MaxRows: intPtr(10)},
+			Total:     4,
+			Error:     "truncated at 3 rows",
+		},
+		{
+			Name:      "truncated result with golden file",
+			Assertion: queryAssertion{IgnoreTimestamps: true},
+			Golden:    `[{"host": "b", "count": "98"}, {"host": "a", "count": "10"}, {"host": "c", "count": null}]`,
+			Total:     4,
+			Error:     "truncated at 3 rows",
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.Name, func(t *testing.T) {
+			if tt.Golden != "" {
+				tt.Assertion.GoldenFile = filepath.Join(t.TempDir(), "golden.json")
+				if err := os.WriteFile(tt.Assertion.GoldenFile, []byte(tt.Golden), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			total := tt.Total
+			if total == 0 {
+				total = int64(len(rows))
+			}
+			err := tt.Assertion.Check(columns, rows, total)
+			switch {
+			case tt.Error == "" && err != nil:
+				t.Fatal(err)
+			case tt.Error != "" && err == nil:
+				t.Fatalf("expected error containing %q", tt.Error)
+			case tt.Error != "" && !strings.Contains(err.Error(), tt.Error):
+				t.Fatalf("expected error containing %q, got:\n%s", tt.Error, err)
+			}
+		})
+	}
+}
+
+func TestQueryAssertionUpdate(t *testing.T) {
+	columns := []queryColumn{{Name: "host", Rep: "string"}, {Name: "count", Rep: "int64"}}
+	rows := []map[string]interface{}{{"host": "b", "count": "2"}, {"host": "a", "count": "1"}}
+
+	a := queryAssertion{GoldenFile: filepath.Join(t.TempDir(), "golden.json"), Update: true, SortBy: []string{"host"}}
+	if err := a.Check(columns, rows, int64(len(rows))); err != nil {
+		t.Fatal(err)
+	}
+
+	a.Update = false
+	if err := a.Check(columns, rows, int64(len(rows))); err != nil {
+		t.Fatalf("golden file does not match after update: %s", err)
+	}
+}
@@ -0,0 +1,191 @@
+package observe
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+)
+
+// queryParameterTypes lists the parameter types which can be declared on a
+// query. DATASETREF parameters are bound to a dataset OID.
+var queryParameterTypes = []string{
+	string(gql.ValueTypeBool),
+	string(gql.ValueTypeFloat64),
+	string(gql.ValueTypeInt64),
+	string(gql.ValueTypeString),
+	string(gql.ValueTypeTimestamp),
+	string(gql.ValueTypeDuration),
+	string(gql.ValueTypeDatasetref),
+}
+
+// newParameterValue parses the string representation of a parameter value
+func newParameterValue(t gql.ValueType, s string) (*types.Value, error) {
+	switch t {
+	case gql.ValueTypeBool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, err
+		}
+		return types.MustNewValue(b), nil
+	case gql.ValueTypeFloat64:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, err
+		}
+		return types.MustNewValue(f), nil
+	case gql.ValueTypeInt64:
+		i, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		return types.MustNewValue(i), nil
+	case gql.ValueTypeString:
+		return types.MustNewValue(s), nil
+	case gql.ValueTypeTimestamp:
+		ts, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return nil, err
+		}
+		return types.MustNewValue(types.TimeScalar(ts)), nil
+	case gql.ValueTypeDuration:
+		d, err := types.ParseDurationScalar(s)
+		if err != nil {
+			return nil, err
+		}
+		return types.MustNewValue(*d), nil
+	case gql.ValueTypeDatasetref:
+		id, err := oid.NewOID(s)
+		if err != nil {
+			return nil, err
+		}
+		if id.Type != oid.TypeDataset {
+			return nil, fmt.Errorf("expected a dataset OID, got %q", s)
+		}
+		return types.MustNewValue(types.ValueDatasetref{DatasetId: &id.Id}), nil
+	default:
+		return nil, fmt.Errorf("values of type %s are not supported", t)
+	}
+}
+
+// queryParameterReferences returns the names of all parameters referenced as
+// $name in a pipeline. String literals and comments are skipped.
+func queryParameterReferences(pipeline string) []string {
+	var (
+		refs  []string
+		seen  = make(map[string]bool)
+		quote byte
+	)
+	for i := 0; i < len(pipeline); i++ {
+		c := pipeline[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '/' && i+1 < len(pipeline) && pipeline[i+1] == '/':
+			for i < len(pipeline) && pipeline[i] != '\n' {
+				i++
+			}
+		case c == '$':
+			j := i + 1
+			for j < len(pipeline) && isParameterIdentChar(pipeline[j], j == i+1) {
+				j++
+			}
+			if name := pipeline[i+1 : j]; name != "" && !seen[name] {
+				seen[name] = true
+				refs = append(refs, name)
+			}
+			i = j - 1
+		}
+	}
+	return refs
+}
+
+func isParameterIdentChar(c byte, first bool) bool {
+	switch {
+	case c == '_', c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z':
+		return true
+	case c >= '0' && c <= '9':
+		return !first
+	}
+	return false
+}
+
+// newQueryParameterValues binds declared parameters to their values, falling
+// back to defaults. Every parameter referenced in a stage pipeline must be
+// declared and bound.
+func newQueryParameterValues(data *schema.ResourceData) ([]gql.ParameterBindingInput, error) {
+	type parameter struct {
+		Type  gql.ValueType
+		Value string
+		Bound bool
+	}
+
+	var (
+		ids        []string
+		parameters = make(map[string]*parameter)
+	)
+	for _, v := range data.Get("parameter").([]interface{}) {
+		p := v.(map[string]interface{})
+		id := p["id"].(string)
+		if _, ok := parameters[id]; ok {
+			return nil, fmt.Errorf("parameter %q is declared more than once", id)
+		}
+		parameters[id] = &parameter{
+			Type:  gql.ValueType(p["type"].(string)),
+			Value: p["default"].(string),
+			Bound: p["default"].(string) != "",
+		}
+		ids = append(ids, id)
+	}
+
+	values := data.Get("parameter_values").(map[string]interface{})
+	for _, id := range sortedKeys(values) {
+		p, ok := parameters[id]
+		if !ok {
+			return nil, fmt.Errorf("parameter_values: %q is not a declared parameter", id)
+		}
+		p.Value = values[id].(string)
+		p.Bound = true
+	}
+
+	for i, v := range data.Get("stage").([]interface{}) {
+		var pipeline string
+		if stage, ok := v.(map[string]interface{}); ok {
+			pipeline, _ = stage["pipeline"].(string)
+		}
+		for _, name := range queryParameterReferences(pipeline) {
+			p, ok := parameters[name]
+			if !ok {
+				return nil, fmt.Errorf("stage-%d: references undeclared parameter $%s", i, name)
+			}
+			if !p.Bound {
+				return nil, fmt.Errorf("stage-%d: parameter $%s has no value, set a default or bind it in parameter_values", i, name)
+			}
+		}
+	}
+
+	var bindings []gql.ParameterBindingInput
+	for _, id := range ids {
+		p := parameters[id]
+		if !p.Bound {
+			continue
+		}
+		value, err := newParameterValue(p.Type, p.Value)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %q: %w", id, err)
+		}
+		bindings = append(bindings, gql.ParameterBindingInput{Id: id, Value: *value})
+	}
+	return bindings, nil
+}
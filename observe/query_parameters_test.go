@@ -0,0 +1,116 @@
+package observe
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestQueryParameterReferences(t *testing.T) {
+	testcases := []struct {
+		Pipeline string
+		Expected []string
+	}{
+		{
+			Pipeline: `filter service = $service and duration > $threshold_ms`,
+			Expected: []string{"service", "threshold_ms"},
+		},
+		{
+			Pipeline: "filter log ~ \"costs $5\" or log ~ 'ends$'\n// uses $commented\nfilter x = $x",
+			Expected: []string{"x"},
+		},
+		{
+			Pipeline: `filter a = $a or b = $a or c = "\"$quoted"`,
+			Expected: []string{"a"},
+		},
+		{
+			Pipeline: `filter match_regex(log, /error$/)`,
+		},
+	}
+
+	for _, tt := range testcases {
+		if diff := cmp.Diff(tt.Expected, queryParameterReferences(tt.Pipeline)); diff != "" {
+			t.Errorf("%s: unexpected references (-want +got):\n%s", tt.Pipeline, diff)
+		}
+	}
+}
+
+func TestNewQueryParameterValues(t *testing.T) {
+	testcases := []struct {
+		Name     string
+		Config   map[string]interface{}
+		Expected string
+		Error    string
+	}{
+		{
+			Name: "defaults and bindings",
+			Config: map[string]interface{}{
+				"stage": []interface{}{map[string]interface{}{"pipeline": "filter service = $service and count > $min"}},
+				"parameter": []interface{}{
+					map[string]interface{}{"id": "service", "type": "STRING", "default": "checkout"},
+					map[string]interface{}{"id": "min", "type": "INT64", "default": "1"},
+					map[string]interface{}{"id": "logs", "type": "DATASETREF"},
+				},
+				"parameter_values": map[string]interface{}{"service": "cart", "logs": "o:::dataset:41042989"},
+			},
+			Expected: `[{"id":"service","value":{"string":"cart"}},{"id":"min","value":{"int64":"1"}},{"id":"logs","value":{"datasetref":{"datasetId":"41042989"}}}]`,
+		},
+		{
+			Name: "undeclared",
+			Config: map[string]interface{}{
+				"stage": []interface{}{map[string]interface{}{"pipeline": "filter service = $service"}},
+			},
+			Error: "undeclared parameter $service",
+		},
+		{
+			Name: "unbound",
+			Config: map[string]interface{}{
+				"stage":     []interface{}{map[string]interface{}{"pipeline": "filter service = $service"}},
+				"parameter": []interface{}{map[string]interface{}{"id": "service", "type": "STRING"}},
+			},
+			Error: "parameter $service has no value",
+		},
+		{
+			Name: "unknown binding",
+			Config: map[string]interface{}{
+				"stage":            []interface{}{map[string]interface{}{"pipeline": ""}},
+				"parameter_values": map[string]interface{}{"service": "cart"},
+			},
+			Error: `"service" is not a declared parameter`,
+		},
+		{
+			Name: "invalid value",
+			Config: map[string]interface{}{
+				"stage":     []interface{}{map[string]interface{}{"pipeline": ""}},
+				"parameter": []interface{}{map[string]interface{}{"id": "min", "type": "INT64", "default": "one"}},
+			},
+			Error: `parameter "min"`,
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.Name, func(t *testing.T) {
+			data := schema.TestResourceDataRaw(t, dataSourceQuery().Schema, tt.Config)
+			got, err := newQueryParameterValues(data)
+			if tt.Error != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.Error) {
+					t.Fatalf("expected error containing %q, got %v", tt.Error, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, err := json.Marshal(got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.Expected, string(b)); diff != "" {
+				t.Fatalf("unexpected bindings (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package observe
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+)
+
+// queryColumn describes a column of a query result
+type queryColumn struct {
+	Name string
+	Rep  string
+}
+
+// parseQueryColumns reads column names and types from a result schema, in the
+// order in which columns are returned in paginated results.
+func parseQueryColumns(typedef *types.JsonObject) ([]queryColumn, error) {
+	if typedef == nil {
+		return nil, fmt.Errorf("query result has no schema")
+	}
+	var def struct {
+		Fields []struct {
+			Name string `json:"name"`
+			Type struct {
+				Rep string `json:"rep"`
+			} `json:"type"`
+		} `json:"fields"`
+	}
+	if err := json.Unmarshal([]byte(*typedef), &def); err != nil {
+		return nil, fmt.Errorf("failed to parse result schema: %w", err)
+	}
+	columns := make([]queryColumn, len(def.Fields))
+	for i, f := range def.Fields {
+		columns[i] = queryColumn{Name: f.Name, Rep: f.Type.Rep}
+	}
+	return columns, nil
+}
+
+// queryCursorFetch reads numRows rows from a query cursor, starting at offset
+type queryCursorFetch func(ctx context.Context, offset int64, numRows int64) (*types.PaginatedResults, error)
+
+// queryPageFunc is called for every page of rows read from a query cursor
+type queryPageFunc func(rows [][]*string) error
+
+// readQueryPages calls fn for the rows in the first page of results, and then
+// for every subsequent page fetched from the cursor, until either maxRows rows
+// have been read or the cursor is exhausted. It returns the number of rows
+// read and the total number of rows held by the cursor.
+func readQueryPages(ctx context.Context, first *types.PaginatedResults, fetch queryCursorFetch, maxRows int64, pageSize int64, fn queryPageFunc) (read int64, total int64, err error) {
+	page := first
+	total = first.TotalRows
+	for {
+		rows := page.Rows()
+		if remaining := maxRows - read; int64(len(rows)) > remaining {
+			rows = rows[:remaining]
+		}
+		if err := fn(rows); err != nil {
+			return read, total, err
+		}
+		read += int64(len(rows))
+
+		offset := page.Offset + page.NumRows
+		if read >= maxRows || page.NumRows == 0 || offset >= total || first.CursorId == nil {
+			return read, total, nil
+		}
+
+		numRows := pageSize
+		if remaining := maxRows - read; numRows > remaining {
+			numRows = remaining
+		}
+		if page, err = fetch(ctx, offset, numRows); err != nil {
+			return read, total, fmt.Errorf("failed to read rows at offset %d: %w", offset, err)
+		}
+	}
+}
+
+// queryRowObject converts a row into an object keyed by column name
+func queryRowObject(columns []queryColumn, row []*string) map[string]interface{} {
+	obj := make(map[string]interface{}, len(columns))
+	for i, c := range columns {
+		if i < len(row) && row[i] != nil {
+			obj[c.Name] = *row[i]
+		} else {
+			obj[c.Name] = nil
+		}
+	}
+	return obj
+}
+
+// queryRowsCollector retains all rows read in memory
+type queryRowsCollector struct {
+	columns []queryColumn
+	rows    []map[string]interface{}
+}
+
+func (c *queryRowsCollector) add(rows [][]*string) error {
+	for _, row := range rows {
+		c.rows = append(c.rows, queryRowObject(c.columns, row))
+	}
+	return nil
+}
+
+// attribute returns rows in a form suitable for a list of string maps. Null
+// values cannot be represented, and are omitted.
+func (c *queryRowsCollector) attribute() []interface{} {
+	result := make([]interface{}, len(c.rows))
+	for i, row := range c.rows {
+		m := make(map[string]interface{}, len(row))
+		for k, v := range row {
+			if v != nil {
+				m[k] = v
+			}
+		}
+		result[i] = m
+	}
+	return result
+}
+
+// queryRowsWriter streams rows as newline delimited JSON, so that memory use
+// is bounded by the page size rather than the result size.
+type queryRowsWriter struct {
+	columns []queryColumn
+	w       *bufio.Writer
+	enc     *json.Encoder
+}
+
+func newQueryRowsWriter(columns []queryColumn, w io.Writer) *queryRowsWriter {
+	buf := bufio.NewWriter(w)
+	return &queryRowsWriter{columns: columns, w: buf, enc: json.NewEncoder(buf)}
+}
+
+func (q *queryRowsWriter) add(rows [][]*string) error {
+	for _, row := range rows {
+		if err := q.enc.Encode(queryRowObject(q.columns, row)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (q *queryRowsWriter) Flush() error {
+	return q.w.Flush()
+}
@@ -0,0 +1,131 @@
+package observe
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+)
+
+// testQueryCursor holds a single column of numbered rows
+func testQueryCursor(total int64) (*types.PaginatedResults, queryCursorFetch, *[]int64) {
+	cursorId := "cursor"
+	page := func(offset, numRows int64) *types.PaginatedResults {
+		if offset+numRows > total {
+			numRows = total - offset
+		}
+		column := make([]*string, numRows)
+		for i := range column {
+			s := fmt.Sprintf("%d", offset+int64(i))
+			column[i] = &s
+		}
+		return &types.PaginatedResults{CursorId: &cursorId, TotalRows: total, Offset: offset, NumRows: numRows, Columns: [][]*string{column}}
+	}
+
+	var requests []int64
+	fetch := func(ctx context.Context, offset int64, numRows int64) (*types.PaginatedResults, error) {
+		requests = append(requests, numRows)
+		return page(offset, numRows), nil
+	}
+	return page(0, 3), fetch, &requests
+}
+
+func TestReadQueryPages(t *testing.T) {
+	testcases := []struct {
+		Name     string
+		Total    int64
+		MaxRows  int64
+		PageSize int64
+		Read     int64
+		Requests []int64
+	}{
+		{
+			Name:     "single page",
+			Total:    2,
+			MaxRows:  10,
+			PageSize: 3,
+			Read:     2,
+		},
+		{
+			Name:     "multiple pages",
+			Total:    8,
+			MaxRows:  10,
+			PageSize: 3,
+			Read:     8,
+			Requests: []int64{3, 3},
+		},
+		{
+			Name:     "truncated within first page",
+			Total:    8,
+			MaxRows:  2,
+			PageSize: 3,
+			Read:     2,
+		},
+		{
+			Name:     "truncated on last page",
+			Total:    8,
+			MaxRows:  5,
+			PageSize: 3,
+			Read:     5,
+			Requests: []int64{2},
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.Name, func(t *testing.T) {
+			first, fetch, requests := testQueryCursor(tt.Total)
+
+			var got []string
+			read, total, err := readQueryPages(context.Background(), first, fetch, tt.MaxRows, tt.PageSize, func(rows [][]*string) error {
+				for _, row := range rows {
+					got = append(got, *row[0])
+				}
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if read != tt.Read || int64(len(got)) != tt.Read {
+				t.Fatalf("expected %d rows, read %d: %v", tt.Read, read, got)
+			}
+			for i, v := range got {
+				if v != fmt.Sprintf("%d", i) {
+					t.Fatalf("row %d out of order: %v", i, got)
+				}
+			}
+			if total != tt.Total {
+				t.Fatalf("expected total %d, got %d", tt.Total, total)
+			}
+			if diff := cmp.Diff(tt.Requests, *requests); diff != "" {
+				t.Fatalf("unexpected cursor requests (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestQueryRowsWriter(t *testing.T) {
+	typedef := types.JsonObject(`{"fields": [{"name": "a", "type": {"rep": "string"}}, {"name": "b", "type": {"rep": "int64"}}]}`)
+	columns, err := parseQueryColumns(&typedef)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	one, two := "1", "2"
+	var buf bytes.Buffer
+	w := newQueryRowsWriter(columns, &buf)
+	if err := w.add([][]*string{{&one, &two}, {&one, nil}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "{\"a\":\"1\",\"b\":\"2\"}\n{\"a\":\"1\",\"b\":null}\n"
+	if diff := cmp.Diff(expected, buf.String()); diff != "" {
+		t.Fatalf("unexpected output (-want +got):\n%s", diff)
+	}
+}
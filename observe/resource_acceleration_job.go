@@ -0,0 +1,438 @@
+package observe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func resourceAccelerationJob() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("acceleration_job", "description"),
+		CreateContext: resourceAccelerationJobCreate,
+		ReadContext:   resourceAccelerationJobRead,
+		// only wait_for_completion can be updated, and it has no effect on
+		// a job which has already been submitted
+		UpdateContext: resourceNoop,
+		DeleteContext: resourceAccelerationJobDelete,
+		CustomizeDiff: resourceAccelerationJobCustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"context": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: descriptions.Get("acceleration_job", "schema", "context"),
+			},
+			"request": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Description: descriptions.Get("acceleration_job", "schema", "request", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dataset": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: validateOID(oid.TypeDataset),
+							DiffSuppressFunc: diffSuppressOIDVersion,
+							Description:      descriptions.Get("acceleration_job", "schema", "request", "dataset"),
+						},
+						"interval": {
+							Type:        schema.TypeList,
+							Required:    true,
+							ForceNew:    true,
+							MinItems:    1,
+							Description: descriptions.Get("acceleration_job", "schema", "request", "interval", "description"),
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"start": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: validateTimestamp,
+										Description:      descriptions.Get("acceleration_job", "schema", "request", "interval", "start"),
+									},
+									"end": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: validateTimestamp,
+										Description:      descriptions.Get("acceleration_job", "schema", "request", "interval", "end"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"wait_for_completion": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: descriptions.Get("acceleration_job", "schema", "wait_for_completion"),
+			},
+			// computed values
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("acceleration_job", "schema", "state"),
+			},
+			"progress": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: descriptions.Get("acceleration_job", "schema", "progress"),
+			},
+			"credits": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: descriptions.Get("acceleration_job", "schema", "credits"),
+			},
+			"datasets": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions.Get("acceleration_job", "schema", "datasets"),
+			},
+			"estimated_cost": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: descriptions.Get("acceleration_job", "schema", "estimated_cost"),
+			},
+			"estimate": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: descriptions.Get("acceleration_job", "schema", "estimate", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dataset": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("acceleration_job", "schema", "estimate", "dataset"),
+						},
+						"absolute_cost": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: descriptions.Get("acceleration_job", "schema", "estimate", "absolute_cost"),
+						},
+						"additional_cost": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: descriptions.Get("acceleration_job", "schema", "estimate", "additional_cost"),
+						},
+						"confidence": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("acceleration_job", "schema", "estimate", "confidence"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// newAccelerationJobConfig builds a job from the raw "request" list. It is
+// shared between plan and apply, so it must cope with values which are not
+// yet known and report them as an error rather than panic.
+func newAccelerationJobConfig(requests []interface{}, jobContext string) (*gql.AccelerationJobInput, error) {
+	input := &gql.AccelerationJobInput{}
+	if jobContext != "" {
+		input.Context = &jobContext
+	}
+
+	for i, r := range requests {
+		request, ok := r.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("request %d is not set", i)
+		}
+
+		id, err := oid.NewOID(request["dataset"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("request %d: %w", i, err)
+		}
+
+		req := gql.AccelerationRequestInput{DatasetId: id.Id}
+		for j, v := range request["interval"].([]interface{}) {
+			interval, ok := v.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("request %d interval %d is not set", i, j)
+			}
+			start, err := time.Parse(time.RFC3339, interval["start"].(string))
+			if err != nil {
+				return nil, fmt.Errorf("request %d interval %d: %w", i, j, err)
+			}
+			end, err := time.Parse(time.RFC3339, interval["end"].(string))
+			if err != nil {
+				return nil, fmt.Errorf("request %d interval %d: %w", i, j, err)
+			}
+			if !end.After(start) {
+				return nil, fmt.Errorf("request %d interval %d: end must be after start", i, j)
+			}
+			req.Intervals = append(req.Intervals, gql.TimeRangeInput{
+				Start: types.TimeScalar(start.UTC()).Ptr(),
+				End:   types.TimeScalar(end.UTC()).Ptr(),
+			})
+		}
+		input.Requests = append(input.Requests, req)
+	}
+	return input, nil
+}
+
+// accelerationJobRequestKnown returns whether requests can be submitted at
+// plan time. Values nested within a request, such as the OID of a dataset
+// created in the same apply, may be unknown even though the request list
+// itself is known, and are then read as empty strings.
+func accelerationJobRequestKnown(config cty.Value, requests []interface{}) bool {
+	if !config.IsNull() && config.IsKnown() {
+		if !config.GetAttr("request").IsWhollyKnown() || !config.GetAttr("context").IsWhollyKnown() {
+			return false
+		}
+	}
+	for _, r := range requests {
+		request, ok := r.(map[string]interface{})
+		if !ok || request["dataset"] == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// resourceAccelerationJobCustomizeDiff reports the datasets affected by a new
+// job and its estimated cost as part of the plan.
+func resourceAccelerationJobCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChanges("request", "context") {
+		return nil
+	}
+
+	keys := []string{"datasets", "estimated_cost", "estimate", "state", "progress", "credits"}
+
+	requests := d.Get("request").([]interface{})
+	if !accelerationJobRequestKnown(d.GetRawConfig(), requests) {
+		// request depends on values not known until apply
+		for _, k := range keys {
+			if err := d.SetNewComputed(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	input, err := newAccelerationJobConfig(requests, d.Get("context").(string))
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*observe.Client)
+
+	input.DryRun = boolPtr(true)
+	dryRun, err := client.CreateAccelerationJob(ctx, input)
+	if err != nil {
+		return fmt.Errorf("failed to validate acceleration job: %w", err)
+	}
+	input.DryRun = nil
+
+	estimates, err := client.EstimateAccelerationJobCost(ctx, input)
+	if err != nil {
+		return fmt.Errorf("failed to estimate acceleration job cost: %w", err)
+	}
+
+	datasets := make([]interface{}, 0, len(dryRun.DatasetStatuses))
+	for _, status := range dryRun.DatasetStatuses {
+		datasets = append(datasets, oid.DatasetOid(status.DatasetId).String())
+	}
+	if err := d.SetNew("datasets", datasets); err != nil {
+		return err
+	}
+
+	estimatedCost, estimate := flattenDatasetCostEstimates(estimates)
+	if err := d.SetNew("estimated_cost", estimatedCost); err != nil {
+		return err
+	}
+	if err := d.SetNew("estimate", estimate); err != nil {
+		return err
+	}
+
+	for _, k := range []string{"state", "progress", "credits"} {
+		if err := d.SetNewComputed(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func flattenDatasetCostEstimates(estimates []gql.DatasetCostEstimate) (total float64, result []interface{}) {
+	sort.Slice(estimates, func(i, j int) bool {
+		return estimates[i].DatasetId < estimates[j].DatasetId
+	})
+
+	for _, e := range estimates {
+		total += e.AdditionalCostEstimate
+		result = append(result, map[string]interface{}{
+			"dataset":         oid.DatasetOid(e.DatasetId).String(),
+			"absolute_cost":   e.AbsoluteCostEstimate,
+			"additional_cost": e.AdditionalCostEstimate,
+			"confidence":      string(e.ConfidenceAdditionalCostEstimate),
+		})
+	}
+	return total, result
+}
+
+func resourceAccelerationJobCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	input, err := newAccelerationJobConfig(data.Get("request").([]interface{}), data.Get("context").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	result, err := client.CreateAccelerationJob(ctx, input)
+	if err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "failed to create acceleration job",
+			Detail:   err.Error(),
+		})
+	}
+
+	data.SetId(result.JobId)
+
+	if data.Get("wait_for_completion").(bool) {
+		if wd := waitAccelerationJobCompleted(ctx, result, data.Timeout(schema.TimeoutCreate)-time.Minute, client); wd.HasError() {
+			return append(diags, wd...)
+		}
+	}
+
+	return append(diags, resourceAccelerationJobRead(ctx, data, meta)...)
+}
+
+func resourceAccelerationJobRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	result, err := client.GetAccelerationJob(ctx, data.Id())
+	if err != nil {
+		if gql.HasErrorCode(err, "NOT_FOUND") {
+			data.SetId("")
+			return nil
+		}
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("failed to retrieve acceleration job [id=%s]", data.Id()),
+			Detail:   err.Error(),
+		})
+	}
+
+	return accelerationJobToResourceData(result, data)
+}
+
+func resourceAccelerationJobDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	result, err := client.GetAccelerationJob(ctx, data.Id())
+	if err != nil {
+		if gql.HasErrorCode(err, "NOT_FOUND") {
+			return diags
+		}
+		return diag.Errorf("failed to retrieve acceleration job: %s", err)
+	}
+
+	// nothing left to cancel
+	if result.State != gql.AccelerationJobStateRunning {
+		return diags
+	}
+
+	if _, err := client.CancelAccelerationJob(ctx, data.Id()); err != nil {
+		return diag.Errorf("failed to cancel acceleration job: %s", err)
+	}
+	return diags
+}
+
+func accelerationJobToResourceData(j *gql.AccelerationJob, data *schema.ResourceData) (diags diag.Diagnostics) {
+	if j.Context != nil {
+		if err := data.Set("context", *j.Context); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	if err := data.Set("state", string(j.State)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("progress", j.Progress); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if j.Credits != nil {
+		if err := data.Set("credits", *j.Credits); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	datasets := make([]interface{}, 0, len(j.DatasetStatuses))
+	for _, status := range j.DatasetStatuses {
+		datasets = append(datasets, oid.DatasetOid(status.DatasetId).String())
+	}
+	if err := data.Set("datasets", datasets); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func waitAccelerationJobCompleted(ctx context.Context, j *gql.AccelerationJob, timeout time.Duration, client *observe.Client) (diags diag.Diagnostics) {
+	if j.State == gql.AccelerationJobStateCompleted {
+		return diags
+	}
+
+	c := &retry.StateChangeConf{
+		Pending: []string{
+			string(gql.AccelerationJobStateRunning),
+		},
+		Target: []string{
+			string(gql.AccelerationJobStateCompleted),
+		},
+		Refresh: func() (any, string, error) {
+			resp, err := client.GetAccelerationJob(ctx, j.JobId)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if resp.State == gql.AccelerationJobStateCancelled {
+				return nil, string(resp.State), errors.New("acceleration job was cancelled")
+			}
+
+			return resp, string(resp.State), nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	_, err := c.WaitForStateContext(ctx)
+	if err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Error while waiting for acceleration job to complete",
+			Detail:   err.Error(),
+		})
+	}
+
+	return diags
+}
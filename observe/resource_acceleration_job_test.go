@@ -0,0 +1,171 @@
+package observe
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccelerationJobRequestKnown(t *testing.T) {
+	config := func(dataset cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"context": cty.NullVal(cty.String),
+			"request": cty.ListVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"dataset": dataset,
+					"interval": cty.ListVal([]cty.Value{
+						cty.ObjectVal(map[string]cty.Value{
+							"start": cty.StringVal("2024-01-01T00:00:00Z"),
+							"end":   cty.StringVal("2024-01-02T00:00:00Z"),
+						}),
+					}),
+				}),
+			}),
+		})
+	}
+
+	testcases := []struct {
+		Name     string
+		Config   cty.Value
+		Requests []interface{}
+		Known    bool
+	}{
+		{
+			Name:     "known dataset",
+			Config:   config(cty.StringVal("o:::dataset:41000001")),
+			Requests: []interface{}{map[string]interface{}{"dataset": "o:::dataset:41000001"}},
+			Known:    true,
+		},
+		{
+			Name:     "unknown nested dataset",
+			Config:   config(cty.UnknownVal(cty.String)),
+			Requests: []interface{}{map[string]interface{}{"dataset": ""}},
+		},
+		{
+			Name:     "empty dataset without config",
+			Config:   cty.NullVal(config(cty.UnknownVal(cty.String)).Type()),
+			Requests: []interface{}{map[string]interface{}{"dataset": ""}},
+		},
+		{
+			Name:     "unset request",
+			Config:   cty.NullVal(config(cty.UnknownVal(cty.String)).Type()),
+			Requests: []interface{}{nil},
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.Name, func(t *testing.T) {
+			if got := accelerationJobRequestKnown(tt.Config, tt.Requests); got != tt.Known {
+				t.Fatalf("expected %t, got %t", tt.Known, got)
+			}
+		})
+	}
+}
+
+func TestAccObserveAccelerationJob(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+	end := time.Now().UTC().Truncate(time.Hour)
+	start := end.Add(-time.Hour)
+
+	config := configPreamble + datastreamConfigPreamble + `
+				resource "observe_dataset" "first" {
+					workspace = data.observe_workspace.default.oid
+					name      = "%[1]s"
+
+					inputs = {
+					  "test" = observe_datastream.test.dataset
+					}
+
+					stage {}
+				}
+
+				resource "observe_acceleration_job" "backfill" {
+					context             = "%[1]s"
+					wait_for_completion = %[4]t
+
+					request {
+						dataset = observe_dataset.first.oid
+						interval {
+							start = "%[2]s"
+							end   = "%[3]s"
+						}
+					}
+				}`
+
+	var jobId string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(config, randomPrefix, start.Format(time.RFC3339), end.Format(time.RFC3339), true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_acceleration_job.backfill", "context", randomPrefix),
+					resource.TestCheckResourceAttr("observe_acceleration_job.backfill", "state", "COMPLETED"),
+					resource.TestCheckResourceAttr("observe_acceleration_job.backfill", "datasets.#", "1"),
+					resource.TestCheckResourceAttrSet("observe_acceleration_job.backfill", "estimated_cost"),
+					resource.TestCheckResourceAttrWith("observe_acceleration_job.backfill", "id", func(id string) error {
+						jobId = id
+						return nil
+					}),
+				),
+			},
+			{
+				// toggling wait_for_completion must not resubmit the job
+				Config: fmt.Sprintf(config, randomPrefix, start.Format(time.RFC3339), end.Format(time.RFC3339), false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_acceleration_job.backfill", "wait_for_completion", "false"),
+					resource.TestCheckResourceAttrWith("observe_acceleration_job.backfill", "id", func(id string) error {
+						if id != jobId {
+							return fmt.Errorf("job was resubmitted: %s != %s", id, jobId)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccObserveAccelerationJobInvalidInterval(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				PlanOnly: true,
+				Config: `
+				resource "observe_acceleration_job" "backfill" {
+					request {
+						dataset = "o:::dataset:41000000"
+						interval {
+							start = "yesterday"
+							end   = "2024-01-01T00:00:00Z"
+						}
+					}
+				}`,
+				ExpectError: regexp.MustCompile("cannot parse"),
+			},
+			{
+				PlanOnly: true,
+				Config: `
+				resource "observe_acceleration_job" "backfill" {
+					request {
+						dataset = "o:::dataset:41000000"
+						interval {
+							start = "2024-01-02T00:00:00Z"
+							end   = "2024-01-01T00:00:00Z"
+						}
+					}
+				}`,
+				ExpectError: regexp.MustCompile("end must be after start"),
+			},
+		},
+	})
+}
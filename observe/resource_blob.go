@@ -0,0 +1,313 @@
+package observe
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+// blobScopes maps user facing scope names to their API representation
+var blobScopes = map[string]gql.SimpleScope{
+	"user":     gql.SimpleScopeScopeuser,
+	"customer": gql.SimpleScopeScopecustomer,
+	"world":    gql.SimpleScopeScopeworld,
+}
+
+var blobScopeNames = []string{"user", "customer", "world"}
+
+func blobScopeName(s gql.SimpleScope) string {
+	for name, scope := range blobScopes {
+		if scope == s {
+			return name
+		}
+	}
+	return string(s)
+}
+
+func resourceBlob() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("blob", "description"),
+		CreateContext: resourceBlobCreate,
+		UpdateContext: resourceBlobUpdate,
+		ReadContext:   resourceBlobRead,
+		DeleteContext: resourceBlobDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: resourceBlobCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateIsString(),
+				Description:      descriptions.Get("blob", "schema", "name"),
+			},
+			"user": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateOID(oid.TypeUser),
+				Description:      descriptions.Get("blob", "schema", "user"),
+			},
+			"kind": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: descriptions.Get("blob", "schema", "kind"),
+			},
+			"value": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateBlobValue,
+				DiffSuppressFunc: diffSuppressJSON,
+				Description:      descriptions.Get("blob", "schema", "value"),
+			},
+			"readable": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateStringInSlice(blobScopeNames, false),
+				Description:      descriptions.Get("blob", "schema", "readable"),
+			},
+			"writable": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateStringInSlice(blobScopeNames, false),
+				Description:      descriptions.Get("blob", "schema", "writable"),
+			},
+			"make_permanent": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: descriptions.Get("blob", "schema", "make_permanent"),
+			},
+			// computed values
+			"updated_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("blob", "schema", "updated_date"),
+			},
+		},
+	}
+}
+
+// normalizeBlobValue re-encodes a JSON object compactly with sorted keys, which
+// is also the representation whose size is subject to gql.BlobMaxSize.
+func normalizeBlobValue(s string) (string, error) {
+	var v map[string]interface{}
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return "", fmt.Errorf("expected a JSON object: %w", err)
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	if len(b) > gql.BlobMaxSize {
+		return "", fmt.Errorf("blob value is %d bytes, exceeding the limit of %d bytes", len(b), gql.BlobMaxSize)
+	}
+	return string(b), nil
+}
+
+func validateBlobValue(i interface{}, path cty.Path) diag.Diagnostics {
+	if _, err := normalizeBlobValue(i.(string)); err != nil {
+		return diag.Diagnostics{diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid field",
+			Detail:        err.Error(),
+			AttributePath: path,
+		}}
+	}
+	return nil
+}
+
+// resourceBlobCustomizeDiff checks values which are only known once other
+// resources have been planned, e.g. when built with jsonencode.
+func resourceBlobCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("value") {
+		return nil
+	}
+	_, err := normalizeBlobValue(d.Get("value").(string))
+	return err
+}
+
+func blobUserId(s string) (*types.UserIdScalar, error) {
+	if s == "" {
+		return nil, nil
+	}
+	user, err := oid.NewOID(s)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := types.StringToUserIdScalar(user.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &uid, nil
+}
+
+// blobId returns the resource ID for a blob, which is unique per user and name
+func blobId(uid types.UserIdScalar, name string) string {
+	return fmt.Sprintf("%d/%s", uid, name)
+}
+
+func parseBlobId(id string) (*types.UserIdScalar, string, error) {
+	user, name, ok := strings.Cut(id, "/")
+	if !ok || name == "" {
+		return nil, "", fmt.Errorf("expected blob ID of the form <user id>/<name>, got %q", id)
+	}
+	uid, err := types.StringToUserIdScalar(user)
+	if err != nil {
+		return nil, "", fmt.Errorf("error parsing blob user: %w", err)
+	}
+	return &uid, name, nil
+}
+
+func newBlobConfig(data *schema.ResourceData) (input *gql.BlobInput, diags diag.Diagnostics) {
+	value, err := normalizeBlobValue(data.Get("value").(string))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	input = &gql.BlobInput{
+		Value: types.JsonObject(value).Ptr(),
+	}
+
+	if v, ok := data.GetOk("kind"); ok {
+		input.Kind = stringPtr(v.(string))
+	}
+
+	if v, ok := data.GetOk("readable"); ok {
+		scope := blobScopes[v.(string)]
+		input.Readable = &scope
+	}
+
+	if v, ok := data.GetOk("writable"); ok {
+		scope := blobScopes[v.(string)]
+		input.Writable = &scope
+	}
+	return input, nil
+}
+
+func resourceBlobCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	config, diags := newBlobConfig(data)
+	if diags.HasError() {
+		return diags
+	}
+
+	uid, err := blobUserId(data.Get("user").(string))
+	if err != nil {
+		return diag.Errorf("error parsing user: %s", err.Error())
+	}
+
+	result, err := client.CreateBlob(ctx, uid, data.Get("name").(string), config, data.Get("make_permanent").(bool))
+	if err != nil {
+		return diag.Errorf("failed to create blob: %s", err.Error())
+	}
+
+	data.SetId(blobId(result.UserId, result.Name))
+	return append(diags, resourceBlobRead(ctx, data, meta)...)
+}
+
+func resourceBlobUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	config, diags := newBlobConfig(data)
+	if diags.HasError() {
+		return diags
+	}
+
+	uid, name, err := parseBlobId(data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := client.UpdateBlob(ctx, uid, name, config, data.Get("make_permanent").(bool)); err != nil {
+		return diag.Errorf("failed to update blob: %s", err.Error())
+	}
+	return append(diags, resourceBlobRead(ctx, data, meta)...)
+}
+
+func resourceBlobRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	uid, name, err := parseBlobId(data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	blob, err := client.GetBlob(ctx, uid, name)
+	if err != nil {
+		if gql.HasErrorCode(err, "NOT_FOUND") {
+			data.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to read blob: %s", err.Error())
+	}
+	return blobToResourceData(blob, data)
+}
+
+func resourceBlobDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	uid, name, err := parseBlobId(data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.DeleteBlob(ctx, uid, name); err != nil {
+		return diag.Errorf("failed to delete blob: %s", err.Error())
+	}
+	return diags
+}
+
+func blobToResourceData(b *gql.Blob, data *schema.ResourceData) (diags diag.Diagnostics) {
+	if err := data.Set("name", b.Name); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("user", oid.UserOid(b.UserId).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("kind", b.Kind); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	// retain the configured formatting if the value is semantically unchanged
+	value := b.Value.String()
+	if prv := data.Get("value").(string); diffSuppressJSON("value", prv, value, data) {
+		value = prv
+	}
+	if err := data.Set("value", value); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("readable", blobScopeName(b.Readable)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("writable", blobScopeName(b.Writable)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("updated_date", b.UpdatedDate.String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	return diags
+}
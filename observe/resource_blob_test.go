@@ -0,0 +1,103 @@
+package observe
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestNormalizeBlobValue(t *testing.T) {
+	got, err := normalizeBlobValue("{\n  \"b\": [1, 2],\n  \"a\": {\"c\": null}\n}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `{"a":{"c":null},"b":[1,2]}`; got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+
+	if _, err := normalizeBlobValue(`[1, 2]`); err == nil {
+		t.Error("expected error for non-object value")
+	}
+
+	large := fmt.Sprintf(`{"a": %q}`, strings.Repeat("x", 128*1024))
+	if _, err := normalizeBlobValue(large); err == nil || !strings.Contains(err.Error(), "exceeding the limit") {
+		t.Errorf("expected size error, got %v", err)
+	}
+}
+
+func TestParseBlobId(t *testing.T) {
+	uid, name, err := parseBlobId("12345/team/saved-view")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if blobId(*uid, name) != "12345/team/saved-view" {
+		t.Errorf("unexpected user %d and name %s", *uid, name)
+	}
+
+	if _, _, err := parseBlobId("saved-view"); err == nil {
+		t.Error("expected error for ID without user")
+	}
+}
+
+func TestAccObserveBlob(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "observe_blob" "example" {
+					name     = "%[1]s"
+					kind     = "%[1]s"
+					readable = "customer"
+					value    = jsonencode({
+						columns = ["a", "b"]
+						width   = 10
+					})
+				}
+
+				data "observe_blobs" "example" {
+					kind       = "%[1]s"
+					depends_on = [observe_blob.example]
+				}`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_blob.example", "name", randomPrefix),
+					resource.TestCheckResourceAttr("observe_blob.example", "readable", "customer"),
+					resource.TestCheckResourceAttrSet("observe_blob.example", "user"),
+					resource.TestCheckResourceAttr("data.observe_blobs.example", "blobs.#", "1"),
+					resource.TestCheckResourceAttr("data.observe_blobs.example", "blobs.0.value", `{"columns":["a","b"],"width":10}`),
+				),
+			},
+			{
+				// reformatting the value must not produce a diff
+				PlanOnly: true,
+				Config: fmt.Sprintf(`
+				resource "observe_blob" "example" {
+					name     = "%[1]s"
+					kind     = "%[1]s"
+					readable = "customer"
+					value    = <<-EOT
+					{
+						"width": 10,
+						"columns": ["a", "b"]
+					}
+					EOT
+				}`, randomPrefix),
+			},
+			{
+				ResourceName:      "observe_blob.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"make_permanent",
+					"value",
+				},
+			},
+		},
+	})
+}
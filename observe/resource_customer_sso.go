@@ -0,0 +1,246 @@
+package observe
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func resourceCustomerSso() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("customer_sso", "description"),
+		CreateContext: resourceCustomerSsoSet,
+		UpdateContext: resourceCustomerSsoSet,
+		ReadContext:   resourceCustomerSsoRead,
+		DeleteContext: resourceCustomerSsoDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"saml_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: descriptions.Get("customer_sso", "schema", "saml_url"),
+			},
+			"saml_cert": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validatePEM,
+				DiffSuppressFunc: diffSuppressPEM,
+				Description:      descriptions.Get("customer_sso", "schema", "saml_cert"),
+			},
+			"local_login_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: descriptions.Get("customer_sso", "schema", "local_login_enabled"),
+			},
+			"scim_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: descriptions.Get("customer_sso", "schema", "scim_enabled"),
+			},
+			"expiry_warning_window": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "720h",
+				ValidateDiagFunc: validateTimeDuration,
+				DiffSuppressFunc: diffSuppressTimeDuration,
+				Description:      descriptions.Get("customer_sso", "schema", "expiry_warning_window"),
+			},
+			// computed values
+			"saml_expires": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("customer_sso", "schema", "saml_expires"),
+			},
+		},
+	}
+}
+
+// normalizePEM re-encodes all PEM blocks in s, discarding differences in line
+// wrapping and surrounding whitespace. Bare base64 is treated as the body of
+// a certificate. Input which cannot be decoded is returned trimmed.
+func normalizePEM(s string) string {
+	var (
+		out  strings.Builder
+		rest = []byte(strings.TrimSpace(s))
+	)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		out.Write(pem.EncodeToMemory(block))
+	}
+	if out.Len() > 0 {
+		return out.String()
+	}
+
+	bare := strings.Join(strings.Fields(s), "")
+	if der, err := base64.StdEncoding.DecodeString(bare); err == nil && len(der) > 0 {
+		return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	}
+	return strings.TrimSpace(s)
+}
+
+func diffSuppressPEM(k, prv, nxt string, d *schema.ResourceData) bool {
+	return normalizePEM(prv) == normalizePEM(nxt)
+}
+
+func validatePEM(i interface{}, path cty.Path) diag.Diagnostics {
+	s := i.(string)
+	if s == "" {
+		return nil
+	}
+	invalid := func(detail string) diag.Diagnostics {
+		return diag.Diagnostics{diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid field",
+			Detail:        detail,
+			AttributePath: path,
+		}}
+	}
+
+	// accept anything normalizePEM can turn into a PEM block, including bare
+	// base64, as long as every block holds a certificate
+	block, rest := pem.Decode([]byte(normalizePEM(s)))
+	if block == nil {
+		return invalid("expected a PEM or base64 encoded certificate")
+	}
+	for ; block != nil; block, rest = pem.Decode(rest) {
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return invalid(fmt.Sprintf("failed to parse certificate: %s", err))
+		}
+	}
+	return nil
+}
+
+func newCustomerSsoConfig(data *schema.ResourceData) *gql.CustomerSsoInput {
+	input := &gql.CustomerSsoInput{}
+	if v, ok := data.GetOk("saml_url"); ok {
+		input.SamlUrl = stringPtr(v.(string))
+	}
+	if v, ok := data.GetOk("saml_cert"); ok {
+		input.SamlCert = stringPtr(normalizePEM(v.(string)))
+	}
+	// flags are optional booleans where false is meaningful, so we must use
+	// the deprecated GetOkExists
+	if v, ok := data.GetOkExists("local_login_enabled"); ok {
+		input.SsoLocalFlag = boolPtr(v.(bool))
+	}
+	if v, ok := data.GetOkExists("scim_enabled"); ok {
+		input.ScimFlag = boolPtr(v.(bool))
+	}
+	return input
+}
+
+func resourceCustomerSsoSet(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	if _, err := client.UpdateCurrentCustomerSso(ctx, newCustomerSsoConfig(data)); err != nil {
+		return diag.Errorf("failed to update customer sso: %s", err.Error())
+	}
+
+	if data.Id() == "" {
+		customerId, _, err := client.GetCurrentCustomerSso(ctx)
+		if err != nil {
+			return diag.Errorf("failed to read customer sso: %s", err.Error())
+		}
+		data.SetId(customerId)
+	}
+	return append(diags, resourceCustomerSsoRead(ctx, data, meta)...)
+}
+
+func resourceCustomerSsoRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	customerId, sso, err := client.GetCurrentCustomerSso(ctx)
+	if err != nil {
+		return diag.Errorf("failed to read customer sso: %s", err.Error())
+	}
+	if data.Id() != customerId {
+		// configuration of another customer, e.g. after switching credentials
+		data.SetId("")
+		return diags
+	}
+
+	if err := data.Set("saml_url", sso.SamlUrl); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	// preserve the configured formatting if the certificate is unchanged
+	cert := sso.SamlCert
+	if v := data.Get("saml_cert").(string); normalizePEM(v) == normalizePEM(cert) {
+		cert = v
+	}
+	if err := data.Set("saml_cert", cert); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("local_login_enabled", sso.SsoLocalFlag); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("scim_enabled", sso.ScimFlag); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	var expires string
+	if sso.SamlCert != "" {
+		expires = sso.SamlExpires.String()
+	}
+	if err := data.Set("saml_expires", expires); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	window, _ := time.ParseDuration(data.Get("expiry_warning_window").(string))
+	return append(diags, customerSsoExpiryDiagnostics(sso, window, time.Now())...)
+}
+
+// customerSsoExpiryDiagnostics warns if the SAML certificate expires within
+// window of now.
+func customerSsoExpiryDiagnostics(sso *gql.CustomerSso, window time.Duration, now time.Time) diag.Diagnostics {
+	if sso.SamlCert == "" || window <= 0 {
+		return nil
+	}
+
+	expires := time.Time(sso.SamlExpires)
+	if expires.IsZero() || expires.After(now.Add(window)) {
+		return nil
+	}
+
+	summary := fmt.Sprintf("SAML certificate expires in %s", expires.Sub(now).Round(time.Hour))
+	if !expires.After(now) {
+		summary = "SAML certificate has expired"
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  summary,
+		Detail: fmt.Sprintf("The SAML certificate configured for SSO expires at %s. "+
+			"Rotate it by updating saml_cert before then, or users will be unable to log in through SSO.",
+			expires.Format(time.RFC3339)),
+	}}
+}
+
+func resourceCustomerSsoDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	// the configuration cannot be removed, and resetting it could lock users
+	// out, so it is only dropped from state
+	return diags
+}
@@ -0,0 +1,115 @@
+package observe
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+)
+
+func TestNormalizePEM(t *testing.T) {
+	const body = "MIIBszCCAVmgAwIBAgIUQ2VydGlmaWNhdGUgZm9yIHRlc3Rpbmc="
+	canonical := "-----BEGIN CERTIFICATE-----\n" + body + "\n-----END CERTIFICATE-----\n"
+
+	testcases := []string{
+		canonical,
+		"\n  " + canonical + "\n\n",
+		"-----BEGIN CERTIFICATE-----\n" + body[:20] + "\n" + body[20:] + "\n-----END CERTIFICATE-----",
+		body,
+		strings.Join([]string{body[:30], body[30:]}, "\r\n"),
+	}
+	for _, tc := range testcases {
+		if got := normalizePEM(tc); got != canonical {
+			t.Errorf("normalizePEM(%q) = %q, expected %q", tc, got, canonical)
+		}
+	}
+
+	if got := normalizePEM(" not-a-cert "); got != "not-a-cert" {
+		t.Errorf("expected undecodable input to be trimmed, got %q", got)
+	}
+}
+
+// testCertificate returns the base64 encoded DER of a self-signed certificate
+func testCertificate(t *testing.T) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return base64.StdEncoding.EncodeToString(der)
+}
+
+func TestValidatePEM(t *testing.T) {
+	body := testCertificate(t)
+	pemBlock := "-----BEGIN CERTIFICATE-----\n" + body + "\n-----END CERTIFICATE-----\n"
+
+	testcases := []struct {
+		Input string
+		Valid bool
+	}{
+		{Input: "", Valid: true},
+		{Input: pemBlock, Valid: true},
+		{Input: pemBlock + pemBlock, Valid: true},
+		{Input: body, Valid: true},
+		{Input: body[:30] + "\n" + body[30:], Valid: true},
+		{Input: "not-a-cert"},
+		{Input: "-----BEGIN CERTIFICATE-----\nnot base64\n"},
+		// valid base64, but not a certificate
+		{Input: "abcd"},
+		{Input: "-----BEGIN CERTIFICATE-----\nabcd\n-----END CERTIFICATE-----\n"},
+		{Input: pemBlock + "-----BEGIN CERTIFICATE-----\nabcd\n-----END CERTIFICATE-----\n"},
+	}
+	for _, tc := range testcases {
+		diags := validatePEM(tc.Input, nil)
+		if diags.HasError() == tc.Valid {
+			t.Errorf("validatePEM(%q): expected valid=%t, got %v", tc.Input, tc.Valid, diags)
+		}
+	}
+}
+
+func TestCustomerSsoExpiryDiagnostics(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	sso := func(expires time.Time) *gql.CustomerSso {
+		return &gql.CustomerSso{SamlCert: "cert", SamlExpires: types.TimeScalar(expires)}
+	}
+	window := 30 * 24 * time.Hour
+
+	testcases := []struct {
+		sso    *gql.CustomerSso
+		window time.Duration
+		expect string
+	}{
+		{sso: sso(now.Add(60 * 24 * time.Hour)), window: window},
+		{sso: sso(now.Add(10 * 24 * time.Hour)), window: window, expect: "SAML certificate expires in 240h0m0s"},
+		{sso: sso(now.Add(-time.Hour)), window: window, expect: "SAML certificate has expired"},
+		{sso: sso(now.Add(-time.Hour)), window: 0},
+		{sso: &gql.CustomerSso{}, window: window},
+	}
+	for i, tc := range testcases {
+		diags := customerSsoExpiryDiagnostics(tc.sso, tc.window, now)
+		switch {
+		case tc.expect == "" && len(diags) > 0:
+			t.Errorf("%d: expected no warning, got %q", i, diags[0].Summary)
+		case tc.expect != "" && (len(diags) != 1 || diags[0].Summary != tc.expect):
+			t.Errorf("%d: expected warning %q, got %v", i, tc.expect, diags)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	observe "github.com/observeinc/terraform-provider-observe/client"
 	gql "github.com/observeinc/terraform-provider-observe/client/meta"
@@ -24,6 +25,27 @@ const (
 	schemaDashboardOIDDescription             = "The Observe ID for dashboard."
 	schemaDashboardParametersDescription      = "Dashboard parameters in JSON format."
 	schemaDashboardParameterValuesDescription = "Dashboard parameter values in JSON format."
+
+	schemaDashboardSectionDescription                    = "Dashboard sections, as an alternative to `layout`. Each section contains a grid of cards."
+	schemaDashboardSectionIdDescription                  = "Section ID. Generated if not set."
+	schemaDashboardSectionTitleDescription               = "Section title."
+	schemaDashboardSectionCollapsedDescription           = "Whether the section is collapsed by default."
+	schemaDashboardCardDescription                       = "Cards within the section."
+	schemaDashboardCardIdDescription                     = "Card ID, unique within the dashboard. May be referenced by `observe_dashboard_link.from_card`."
+	schemaDashboardCardStageDescription                  = "ID of the stage in `stages` which the card visualizes."
+	schemaDashboardCardTitleDescription                  = "Card title."
+	schemaDashboardCardVisualizationDescription          = "Visualization type, e.g. `table`, `timeseries` or `singleValue`."
+	schemaDashboardCardXDescription                      = "Column of the card within the section grid."
+	schemaDashboardCardYDescription                      = "Row of the card within the section grid."
+	schemaDashboardCardWidthDescription                  = "Width of the card in grid columns."
+	schemaDashboardCardHeightDescription                 = "Height of the card in grid rows."
+	schemaDashboardParameterDescription                  = "Dashboard parameters, as an alternative to `parameters`."
+	schemaDashboardParameterIdDescription                = "Parameter ID, used to reference the parameter from stages."
+	schemaDashboardParameterNameDescription              = "Parameter display name."
+	schemaDashboardParameterTypeDescription              = "Parameter type. One of `BOOL`, `FLOAT64`, `INT64`, `STRING`, `TIMESTAMP`, `DURATION` or `LINK`."
+	schemaDashboardParameterDatasetDescription           = "OID of the dataset whose primary key the parameter refers to. Required for `LINK` parameters with a default."
+	schemaDashboardParameterDefaultValueDescription      = "Default value. Timestamps use RFC3339 and durations use Go duration syntax. Not supported for `LINK` parameters."
+	schemaDashboardParameterDefaultPrimaryKeyDescription = "Default primary key values for `LINK` parameters, keyed by column name."
 )
 
 func resourceDashboard() *schema.Resource {
@@ -69,16 +91,147 @@ func resourceDashboard() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				ValidateDiagFunc: validateStringIsJSON,
-				DiffSuppressFunc: diffSuppressJSON,
+				DiffSuppressFunc: diffSuppressWhenSet("section", diffSuppressJSON),
+				ConflictsWith:    []string{"section"},
 				Description:      schemaDashboardLayoutDescription,
 			},
+			"section": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"layout"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: schemaDashboardSectionIdDescription,
+						},
+						"title": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: schemaDashboardSectionTitleDescription,
+						},
+						"collapsed": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: schemaDashboardSectionCollapsedDescription,
+						},
+						"card": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: validateIsString(),
+										Description:      schemaDashboardCardIdDescription,
+									},
+									"stage": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: validateIsString(),
+										Description:      schemaDashboardCardStageDescription,
+									},
+									"title": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: schemaDashboardCardTitleDescription,
+									},
+									"visualization": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: schemaDashboardCardVisualizationDescription,
+									},
+									"x": {
+										Type:             schema.TypeInt,
+										Optional:         true,
+										Default:          0,
+										ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+										Description:      schemaDashboardCardXDescription,
+									},
+									"y": {
+										Type:             schema.TypeInt,
+										Optional:         true,
+										Default:          0,
+										ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+										Description:      schemaDashboardCardYDescription,
+									},
+									"width": {
+										Type:             schema.TypeInt,
+										Required:         true,
+										ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+										Description:      schemaDashboardCardWidthDescription,
+									},
+									"height": {
+										Type:             schema.TypeInt,
+										Required:         true,
+										ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+										Description:      schemaDashboardCardHeightDescription,
+									},
+								},
+							},
+							Description: schemaDashboardCardDescription,
+						},
+					},
+				},
+				Description: schemaDashboardSectionDescription,
+			},
 			"parameters": {
 				Type:             schema.TypeString,
 				Optional:         true,
 				ValidateDiagFunc: validateStringIsJSON,
-				DiffSuppressFunc: diffSuppressParameters,
+				DiffSuppressFunc: diffSuppressWhenSet("parameter", diffSuppressParameters),
+				ConflictsWith:    []string{"parameter"},
 				Description:      schemaDashboardParametersDescription,
 			},
+			"parameter": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"parameters"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateIsString(),
+							Description:      schemaDashboardParameterIdDescription,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: schemaDashboardParameterNameDescription,
+						},
+						"type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateStringInSlice(dashboardParameterTypes, false),
+							Description:      schemaDashboardParameterTypeDescription,
+						},
+						"dataset": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validateOID(oid.TypeDataset),
+							DiffSuppressFunc: diffSuppressOIDVersion,
+							Description:      schemaDashboardParameterDatasetDescription,
+						},
+						"default_value": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: schemaDashboardParameterDefaultValueDescription,
+						},
+						"default_primary_key": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: schemaDashboardParameterDefaultPrimaryKeyDescription,
+						},
+					},
+				},
+				Description: schemaDashboardParameterDescription,
+			},
 			"parameter_values": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -118,11 +271,27 @@ func newDashboardConfig(data *schema.ResourceData) (input *gql.DashboardInput, d
 		}
 	}
 
-	if v, ok := data.GetOk("layout"); ok {
+	if v, ok := data.GetOk("section"); ok {
+		layout, err := compileDashboardLayout(v.([]interface{}), dashboardStageIds(input.Stages))
+		if err != nil {
+			diagErr := fmt.Errorf("failed to compile 'section' blocks: %w", err)
+			diags = append(diags, diag.FromErr(diagErr)...)
+		} else {
+			input.Layout = layout.Ptr()
+		}
+	} else if v, ok := data.GetOk("layout"); ok {
 		input.Layout = types.JsonObject(v.(string)).Ptr()
 	}
 
-	if v, ok := data.GetOk("parameters"); ok {
+	if v, ok := data.GetOk("parameter"); ok {
+		parameters, err := compileDashboardParameters(v.([]interface{}))
+		if err != nil {
+			diagErr := fmt.Errorf("failed to compile 'parameter' blocks: %w", err)
+			diags = append(diags, diag.FromErr(diagErr)...)
+		} else {
+			input.Parameters = parameters
+		}
+	} else if v, ok := data.GetOk("parameters"); ok {
 		data := v.(string)
 		if err := json.Unmarshal([]byte(data), &input.Parameters); err != nil {
 			diagErr := fmt.Errorf("failed to parse 'parameters' request field: %w", err)
@@ -185,6 +354,21 @@ func dashboardToResourceData(d *gql.Dashboard, data *schema.ResourceData) (diags
 		if err := data.Set("layout", d.Layout); err != nil {
 			diags = append(diags, diag.FromErr(err)...)
 		}
+
+		// only decompile when authoring through blocks, so that imported and
+		// JSON-managed dashboards are left untouched. The data source shares
+		// this function but has no blocks.
+		if v, ok := data.Get("section").([]interface{}); ok && len(v) > 0 {
+			if sections, err := decompileDashboardLayout(*d.Layout); err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "dashboard layout cannot be represented by 'section' blocks",
+					Detail:   err.Error(),
+				})
+			} else if err := data.Set("section", sections); err != nil {
+				diags = append(diags, diag.FromErr(err)...)
+			}
+		}
 	}
 
 	if d.Parameters != nil {
@@ -193,6 +377,20 @@ func dashboardToResourceData(d *gql.Dashboard, data *schema.ResourceData) (diags
 			diags = append(diags, diag.FromErr(diagErr)...)
 		} else if err := data.Set("parameters", string(parametersRaw)); err != nil {
 			diags = append(diags, diag.FromErr(err)...)
+		} else if v, ok := data.Get("parameter").([]interface{}); ok && len(v) > 0 {
+			var specs []gql.ParameterSpecInput
+			if err := json.Unmarshal(parametersRaw, &specs); err != nil {
+				diagErr := fmt.Errorf("failed to parse 'parameters' response field: %w", err)
+				diags = append(diags, diag.FromErr(diagErr)...)
+			} else if params, err := decompileDashboardParameters(specs); err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "dashboard parameters cannot be represented by 'parameter' blocks",
+					Detail:   err.Error(),
+				})
+			} else if err := data.Set("parameter", params); err != nil {
+				diags = append(diags, diag.FromErr(err)...)
+			}
 		}
 	}
 
@@ -26,6 +26,65 @@ var (
 			EOF
 		}
 		`
+
+	// parameter fixtures for the acceptance tests below, which are also
+	// round tripped through parameter blocks in dashboard_layout_test.go
+	dashboardParametersFixture = `[
+		{"defaultValue": {"bool": true}, "id": "onoff", "name": "On / Off", "valueKind": {"type": "BOOL"}},
+		{"defaultValue": {"float64": 0.5}, "id": "maybe", "name": "Maybe", "valueKind": {"type": "FLOAT64"}}
+	]`
+
+	dashboardNullParametersFixture = `[
+		{"defaultValue": {"string": null}, "id": "string", "name": "String", "valueKind": {"type": "STRING"}}
+	]`
+
+	// the null bool default should be ignored
+	dashboardIgnoredNullParametersFixture = `[
+		{"defaultValue": {"bool": true}, "id": "onoff", "name": "On / Off", "valueKind": {"type": "BOOL"}},
+		{"defaultValue": {"bool": null, "float64": 0.5}, "id": "maybe", "name": "Maybe", "valueKind": {"type": "FLOAT64"}}
+	]`
+
+	dashboardLinkParametersFixture = `[
+		{"defaultValue": {"bool": true}, "id": "onoff", "name": "On / Off", "valueKind": {"type": "BOOL"}},
+		{"defaultValue": {"bool": null, "float64": 0.5}, "id": "maybe", "name": "Maybe", "valueKind": {"type": "FLOAT64"}},
+		{
+			"defaultValue": {"link": {"datasetId": "${local.kubernetes_dataset_id}", "primaryKeyValue": [{"name": "key", "value": {"string": "the-value"}}]}},
+			"id": "link",
+			"name": "Link",
+			"valueKind": {"keyForDatasetId": "${local.kubernetes_dataset_id}", "type": "LINK"}
+		}
+	]`
+
+	// int64 values are rendered as strings by the API
+	dashboardInt64ParametersFixture = `[
+		{"defaultValue": {"int64": "100"}, "id": "int", "name": "Int", "valueKind": {"type": "INT64"}}
+	]`
+
+	// dashboardLayoutFixture is a layout which can be represented by section
+	// blocks, visualizing the stage of TestAccObserveDashboardStructured
+	dashboardLayoutFixture = `{
+		"gridLayout": {
+			"sections": [
+				{
+					"card": {"cardType": "section", "id": "section-overview", "title": "Overview", "closed": false},
+					"items": [
+						{
+							"card": {"cardType": "stage", "id": "card-logs", "title": "Logs", "stageId": "stage-logs", "visualization": {"type": "table"}},
+							"layout": {"i": "card-logs", "x": 0, "y": 0, "w": 6, "h": 4}
+						},
+						{
+							"card": {"cardType": "stage", "id": "card-logs-count", "stageId": "stage-logs"},
+							"layout": {"i": "card-logs-count", "x": 6, "y": 0, "w": 6, "h": 4}
+						}
+					]
+				},
+				{
+					"card": {"cardType": "section", "id": "section-details", "title": "Details", "closed": true},
+					"items": []
+				}
+			]
+		}
+	}`
 )
 
 // Verify we can create dashboards
@@ -68,30 +127,9 @@ func TestAccObserveDashboardCreate(t *testing.T) {
 							},
 						]
 					)
-					parameters       = jsonencode(
-						[
-							{
-								defaultValue = {
-									bool = true
-								}
-								id           = "onoff"
-								name         = "On / Off"
-								valueKind    = {
-									type = "BOOL"
-								}
-							},
-							{
-								defaultValue = {
-									float64 = 0.5
-								}
-								id           = "maybe"
-								name         = "Maybe"
-								valueKind    = {
-									type = "FLOAT64"
-								}
-							},
-						]
-					)
+					parameters       = <<-EOF
+					%[2]s
+					EOF
 					stages = <<-EOF
 					[
 						{
@@ -639,7 +677,7 @@ func TestAccObserveDashboardCreate(t *testing.T) {
 					  ]
 					EOF
 				}
-				`, randomPrefix),
+				`, randomPrefix, dashboardParametersFixture),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("observe_dashboard.first", "name", randomPrefix),
 					resource.TestCheckResourceAttr("observe_dashboard.first", "icon_url", "test"),
@@ -689,20 +727,9 @@ func TestAccObserveDashboardNullParameterDefaults(t *testing.T) {
 							},
 						]
 					)
-					parameters       = jsonencode(
-						[
-							{
-								defaultValue = {
-									string = null
-								}
-								id           = "string"
-								name         = "String"
-								valueKind    = {
-									type = "STRING"
-								}
-							},
-						]
-					)
+					parameters       = <<-EOF
+					%[2]s
+					EOF
 					stages = <<-EOF
 					[
 						{
@@ -1250,7 +1277,7 @@ func TestAccObserveDashboardNullParameterDefaults(t *testing.T) {
 					  ]
 					EOF
 				}
-				`, randomPrefix),
+				`, randomPrefix, dashboardNullParametersFixture),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("observe_dashboard.first", "name", randomPrefix),
 					resource.TestCheckResourceAttr("observe_dashboard.first", "icon_url", "test"),
@@ -1300,31 +1327,9 @@ func TestAccObserveDashboarIgnoredNullParameterDefaults(t *testing.T) {
 							},
 						]
 					)
-					parameters       = jsonencode(
-						[
-							{
-								defaultValue = {
-									bool = true
-								}
-								id           = "onoff"
-								name         = "On / Off"
-								valueKind    = {
-									type = "BOOL"
-								}
-							},
-							{
-								defaultValue = {
-									bool    = null # should be ignored
-									float64 = 0.5
-								}
-								id           = "maybe"
-								name         = "Maybe"
-								valueKind    = {
-									type = "FLOAT64"
-								}
-							},
-						]
-					)
+					parameters       = <<-EOF
+					%[2]s
+					EOF
 					stages = <<-EOF
 					[
 						{
@@ -1872,7 +1877,7 @@ func TestAccObserveDashboarIgnoredNullParameterDefaults(t *testing.T) {
 					  ]
 					EOF
 				}
-				`, randomPrefix),
+				`, randomPrefix, dashboardIgnoredNullParametersFixture),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("observe_dashboard.first", "name", randomPrefix),
 					resource.TestCheckResourceAttr("observe_dashboard.first", "icon_url", "test"),
@@ -1923,53 +1928,9 @@ func TestAccObserveDashboard_DefaultValuePrimaryKeyValue(t *testing.T) {
 							},
 						]
 					)
-					parameters       = jsonencode(
-						[
-							{
-								defaultValue = {
-									bool = true
-								}
-								id           = "onoff"
-								name         = "On / Off"
-								valueKind    = {
-									type = "BOOL"
-								}
-							},
-							{
-								defaultValue = {
-									bool    = null # should be ignored
-									float64 = 0.5
-								}
-								id           = "maybe"
-								name         = "Maybe"
-								valueKind    = {
-									type = "FLOAT64"
-								}
-							},
-							{
-								defaultValue = {
-									link = {
-										datasetId = local.kubernetes_dataset_id
-
-										primaryKeyValue = [
-											{
-												name = "key"
-												value = {
-													string = "the-value"
-												}
-											}
-										]
-									}
-								}
-								id           = "link"
-								name         = "Link"
-								valueKind    = {
-									keyForDatasetId = local.kubernetes_dataset_id
-									type = "LINK"
-								}
-							},
-						]
-					)
+					parameters       = <<-EOF
+					%[2]s
+					EOF
 					stages = <<-EOF
 					[
 						{
@@ -2517,7 +2478,7 @@ func TestAccObserveDashboard_DefaultValuePrimaryKeyValue(t *testing.T) {
 					  ]
 					EOF
 				}
-				`, randomPrefix),
+				`, randomPrefix, dashboardLinkParametersFixture),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("observe_dashboard.first", "name", randomPrefix),
 					resource.TestCheckResourceAttr("observe_dashboard.first", "icon_url", "test"),
@@ -2568,20 +2529,9 @@ func TestAccObserveDashboard_DefaultValueInt64(t *testing.T) {
 							},
 						]
 					)
-					parameters       = jsonencode(
-						[
-							{
-								defaultValue = {
-									int64 = "100" # Rendered as strings by the API
-								}
-								id           = "int"
-								name         = "Int"
-								valueKind    = {
-									type = "INT64"
-								}
-							},
-						]
-					)
+					parameters       = <<-EOF
+					%[2]s
+					EOF
 					stages = <<-EOF
 					[
 						{
@@ -3129,7 +3079,7 @@ func TestAccObserveDashboard_DefaultValueInt64(t *testing.T) {
 					  ]
 					EOF
 				}
-				`, randomPrefix),
+				`, randomPrefix, dashboardInt64ParametersFixture),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("observe_dashboard.first", "name", randomPrefix),
 					resource.TestCheckResourceAttr("observe_dashboard.first", "icon_url", "test"),
@@ -3139,3 +3089,106 @@ func TestAccObserveDashboard_DefaultValueInt64(t *testing.T) {
 		},
 	})
 }
+
+func TestAccObserveDashboardStructured(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+`
+				resource "observe_datastream" "kubernetes" {
+					workspace = data.observe_workspace.default.oid
+					name      = "%[1]s-kubernetes"
+				}
+
+				resource "observe_dashboard" "first" {
+					workspace = data.observe_workspace.default.oid
+					name      = "%[1]s"
+					stages = jsonencode([
+						{
+							id       = "stage-logs"
+							pipeline = "filter true"
+							input = [{
+								inputName = "logs"
+								inputRole = "Data"
+								datasetId = regex("^o:::dataset:(\\d+)$", observe_datastream.kubernetes.dataset)[0]
+							}]
+						},
+					])
+
+					section {
+						title = "Overview"
+
+						card {
+							id            = "card-logs"
+							stage         = "stage-logs"
+							title         = "Logs"
+							visualization = "table"
+							width         = 6
+							height        = 4
+						}
+					}
+
+					parameter {
+						id            = "onoff"
+						name          = "On / Off"
+						type          = "BOOL"
+						default_value = "true"
+					}
+
+					parameter {
+						id            = "int"
+						name          = "Int"
+						type          = "INT64"
+						default_value = "100"
+					}
+				}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_dashboard.first", "section.0.title", "Overview"),
+					resource.TestCheckResourceAttr("observe_dashboard.first", "section.0.card.0.stage", "stage-logs"),
+					resource.TestCheckResourceAttr("observe_dashboard.first", "section.0.card.0.id", "card-logs"),
+					resource.TestCheckResourceAttr("observe_dashboard.first", "section.0.card.0.width", "6"),
+					resource.TestCheckResourceAttr("observe_dashboard.first", "parameter.0.default_value", "true"),
+					resource.TestCheckResourceAttr("observe_dashboard.first", "parameter.1.default_value", "100"),
+					resource.TestCheckResourceAttrSet("observe_dashboard.first", "layout"),
+					resource.TestCheckResourceAttrSet("observe_dashboard.first", "parameters"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(configPreamble+`
+				resource "observe_datastream" "kubernetes" {
+					workspace = data.observe_workspace.default.oid
+					name      = "%[1]s-kubernetes"
+				}
+
+				resource "observe_dashboard" "first" {
+					workspace = data.observe_workspace.default.oid
+					name      = "%[1]s"
+					stages = jsonencode([
+						{
+							id       = "stage-logs"
+							pipeline = "filter true"
+							input = [{
+								inputName = "logs"
+								inputRole = "Data"
+								datasetId = regex("^o:::dataset:(\\d+)$", observe_datastream.kubernetes.dataset)[0]
+							}]
+						},
+					])
+					layout = <<-EOF
+					%[2]s
+					EOF
+				}
+				`, randomPrefix, dashboardLayoutFixture),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("observe_dashboard.first", "layout"),
+					resource.TestCheckNoResourceAttr("observe_dashboard.first", "section.0.title"),
+				),
+			},
+		},
+	})
+}
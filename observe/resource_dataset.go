@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -16,6 +17,18 @@ import (
 	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
 )
 
+// datasetStatusKeys are computed attributes which reflect the runtime status
+// of a dataset, and are expected to change whenever the dataset is saved.
+var datasetStatusKeys = []string{
+	"accelerable",
+	"acceleration_state",
+	"staleness",
+	"target_staleness",
+	"hibernated",
+	"compilation_error",
+	"acceleration_errors",
+}
+
 const (
 	schemaDatasetWorkspaceDescription   = "OID of workspace dataset is contained in."
 	schemaDatasetNameDescription        = "Dataset name. Must be unique within workspace."
@@ -36,7 +49,11 @@ func resourceDataset() *schema.Resource {
 		},
 		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			if datasetRecomputeOID(d) {
-				return d.SetNewComputed("oid")
+				for _, k := range append([]string{"oid"}, datasetStatusKeys...) {
+					if err := d.SetNewComputed(k); err != nil {
+						return err
+					}
+				}
 			}
 			return nil
 		},
@@ -93,6 +110,21 @@ func resourceDataset() *schema.Resource {
 				Default:     false,
 				Description: descriptions.Get("dataset", "schema", "acceleration_disabled"),
 			},
+			"doctor_on_save": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: descriptions.Get("dataset", "schema", "doctor_on_save"),
+			},
+			"delete_protection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: descriptions.Get("dataset", "schema", "delete_protection"),
+			},
+			"force_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: descriptions.Get("dataset", "schema", "force_delete"),
+			},
 			"inputs": {
 				Type:             schema.TypeMap,
 				Required:         true,
@@ -136,6 +168,48 @@ func resourceDataset() *schema.Resource {
 					},
 				},
 			},
+			// computed values
+			"accelerable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: descriptions.Get("dataset", "schema", "accelerable"),
+			},
+			"acceleration_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("dataset", "schema", "acceleration_state"),
+			},
+			"staleness": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("dataset", "schema", "staleness"),
+			},
+			"target_staleness": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("dataset", "schema", "target_staleness"),
+			},
+			"hibernated": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: descriptions.Get("dataset", "schema", "hibernated"),
+			},
+			"compilation_error": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("dataset", "schema", "compilation_error"),
+			},
+			"acceleration_errors": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions.Get("dataset", "schema", "acceleration_errors"),
+			},
+			"credits_24h": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: descriptions.Get("dataset", "schema", "credits_24h"),
+			},
 		},
 	}
 }
@@ -258,6 +332,70 @@ func datasetToResourceData(d *gql.Dataset, data *schema.ResourceData) (diags dia
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
+	return append(diags, datasetStatusToResourceData(d, data)...)
+}
+
+func datasetStatusToResourceData(d *gql.Dataset, data *schema.ResourceData) (diags diag.Diagnostics) {
+	if err := data.Set("accelerable", d.Accelerable); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("acceleration_state", string(d.AccelerationInfo.State)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("staleness", secondsToDurationString(d.AccelerationInfo.StalenessSeconds)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("target_staleness", secondsToDurationString(d.AccelerationInfo.TargetStalenessSeconds)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("hibernated", d.Hibernated); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	var compilationError string
+	if d.CompilationError != nil {
+		compilationError = d.CompilationError.Error
+	}
+	if err := data.Set("compilation_error", compilationError); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	accelerationErrors := make([]string, 0, len(d.AccelerationInfo.Errors))
+	for _, e := range d.AccelerationInfo.Errors {
+		accelerationErrors = append(accelerationErrors, e.ErrorText)
+	}
+	if err := data.Set("acceleration_errors", accelerationErrors); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// datasetCreditsToResourceData sets recent credit usage for dataset. Billing
+// information may not be available to every user, so failing to retrieve it
+// is not fatal.
+func datasetCreditsToResourceData(ctx context.Context, client *observe.Client, d *gql.Dataset, data *schema.ResourceData) (diags diag.Diagnostics) {
+	billingInfo, err := client.GetDatasetBillingInfo(ctx, d.WorkspaceId)
+	if err != nil {
+		log.Printf("[WARN] failed to retrieve billing info for dataset %s: %s\n", d.Id, err)
+		return diags
+	}
+
+	var credits float64
+	for _, info := range billingInfo {
+		if info.Id == d.Id {
+			credits = info.Credits
+			break
+		}
+	}
+
+	if err := data.Set("credits_24h", credits); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
 	return diags
 }
 
@@ -271,24 +409,7 @@ func flattenAndSetQuery(data *schema.ResourceData, gqlstages []gql.StageQuery, o
 		return nil, err
 	}
 
-	inputs := make(map[string]interface{}, 0)
-	for name, input := range queryData.Inputs {
-		id := oid.OID{
-			Type: oid.TypeDataset,
-			Id:   *input.Dataset,
-		}
-
-		// check for existing version timestamp we can maintain
-		if v, ok := data.GetOk(fmt.Sprintf("inputs.%s", name)); ok {
-			prv, err := oid.NewOID(v.(string))
-			if err == nil && id.Id == prv.Id {
-				id.Version = prv.Version
-			}
-		}
-		inputs[name] = id.String()
-	}
-
-	if err := data.Set("inputs", inputs); err != nil {
+	if err := data.Set("inputs", flattenQueryInputs(data, queryData)); err != nil {
 		return nil, err
 	}
 
@@ -316,6 +437,28 @@ func flattenAndSetQuery(data *schema.ResourceData, gqlstages []gql.StageQuery, o
 	return queryData.StageIds, nil
 }
 
+// flattenQueryInputs converts query inputs to dataset OIDs, retaining any
+// version already present in state.
+func flattenQueryInputs(data *schema.ResourceData, queryData *Query) map[string]interface{} {
+	inputs := make(map[string]interface{}, 0)
+	for name, input := range queryData.Inputs {
+		id := oid.OID{
+			Type: oid.TypeDataset,
+			Id:   *input.Dataset,
+		}
+
+		// check for existing version timestamp we can maintain
+		if v, ok := data.GetOk(fmt.Sprintf("inputs.%s", name)); ok {
+			prv, err := oid.NewOID(v.(string))
+			if err == nil && id.Id == prv.Id {
+				id.Version = prv.Version
+			}
+		}
+		inputs[name] = id.String()
+	}
+	return inputs
+}
+
 func resourceDatasetCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	client := meta.(*observe.Client)
 	input, queryInput, diags := newDatasetConfig(data)
@@ -335,7 +478,11 @@ func resourceDatasetCreate(ctx context.Context, data *schema.ResourceData, meta
 	}
 
 	data.SetId(result.Id)
-	return append(diags, resourceDatasetRead(ctx, data, meta)...)
+	diags = append(diags, resourceDatasetRead(ctx, data, meta)...)
+	if !diags.HasError() && data.Get("doctor_on_save").(bool) {
+		diags = append(diags, datasetDoctorDiagnostics(ctx, client, result.Id)...)
+	}
+	return diags
 }
 
 func resourceDatasetRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
@@ -349,7 +496,12 @@ func resourceDatasetRead(ctx context.Context, data *schema.ResourceData, meta in
 		})
 	}
 
-	return datasetToResourceData(result, data)
+	diags = datasetToResourceData(result, data)
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, datasetCreditsToResourceData(ctx, client, result, data)...)
 }
 
 func resourceDatasetUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
@@ -373,14 +525,52 @@ func resourceDatasetUpdate(ctx context.Context, data *schema.ResourceData, meta
 		return diags
 	}
 
-	return datasetToResourceData(result, data)
+	diags = datasetToResourceData(result, data)
+	if !diags.HasError() && data.Get("doctor_on_save").(bool) {
+		diags = append(diags, datasetDoctorDiagnostics(ctx, client, result.Id)...)
+	}
+	return diags
+}
+
+// datasetDoctorDiagnostics diagnoses a freshly saved dataset and its upstream
+// datasets, reporting serious findings as warnings. The dataset has already
+// been saved at this point, so failing to diagnose it is not an error either.
+func datasetDoctorDiagnostics(ctx context.Context, client *observe.Client, id string) (diags diag.Diagnostics) {
+	reports, err := client.GetDatasetDoctorReport(ctx, id, nil)
+	if err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("failed to diagnose dataset [id=%s]", id),
+			Detail:   err.Error(),
+		})
+	}
+
+	for i := range reports {
+		findings := datasetReportFindings(&reports[i])
+		if len(findings) == 0 {
+			continue
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("dataset %q [id=%s] is unhealthy", reports[i].DatasetLabel, reports[i].DatasetId),
+			Detail:   strings.Join(findings, "\n"),
+		})
+	}
+	return diags
 }
 
 func resourceDatasetDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	client := meta.(*observe.Client)
+	end := client.BeginDelete(oid.DatasetOid(data.Id()))
+	if diags := datasetDeleteProtection(ctx, client, data); diags.HasError() {
+		end(false)
+		return diags
+	}
 	if err := client.DeleteDataset(ctx, data.Id()); err != nil {
+		end(false)
 		return diag.Errorf("failed to delete dataset: %s", err)
 	}
+	end(true)
 	return diags
 }
 
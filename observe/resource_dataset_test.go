@@ -109,6 +109,9 @@ func TestAccObserveDatasetUpdate(t *testing.T) {
 					resource.TestCheckResourceAttr("observe_dataset.first", "stage.0.input", ""),
 					resource.TestCheckResourceAttr("observe_dataset.first", "stage.0.pipeline", ""),
 					resource.TestCheckResourceAttr("observe_dataset.first", "acceleration_disabled", "false"),
+					resource.TestCheckResourceAttr("observe_dataset.first", "hibernated", "false"),
+					resource.TestCheckResourceAttr("observe_dataset.first", "compilation_error", ""),
+					resource.TestCheckResourceAttrSet("observe_dataset.first", "acceleration_state"),
 				),
 			},
 			{
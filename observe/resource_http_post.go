@@ -1,21 +1,38 @@
 package observe
 
 import (
+	"bytes"
 	"context"
 	"crypto/rand"
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"os"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	observe "github.com/observeinc/terraform-provider-observe/client"
 )
 
+const (
+	httpPostDefaultMaxChunkSize = 1 << 20
+	httpPostDefaultRetries      = 3
+	httpPostRetryInterval       = time.Second
+)
+
+var httpPostFormatContentTypes = map[string]string{
+	"ndjson": "application/x-ndjson",
+	"csv":    "text/csv",
+}
+
 func resourceHTTPPost() *schema.Resource {
 	return &schema.Resource{
-		Description:   "Submits a single observation to Observe. This resource should be considered experimental.",
+		Description:   "Submits observations to Observe, either as a single payload or as a batch of records split into size-bounded chunks. This resource should be considered experimental.",
 		CreateContext: resourceHTTPPostCreate,
 		ReadContext:   resourceNoop,
 		DeleteContext: resourceNoop,
@@ -29,10 +46,58 @@ func resourceHTTPPost() *schema.Resource {
 				Description:      "Path under which to submit observations",
 			},
 			"data": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"data", "records", "file"},
+				Description:  "Data to submit to Observe collector in a single request",
+			},
+			"records": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Records to submit in chunks, encoded according to `format`. For CSV, the first record is the header row.",
+			},
+			"file": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Path of a local file containing records to submit in chunks, encoded according to `format`. Changes to the contents of the file do not force a new submission.",
+			},
+			"format": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateStringInSlice([]string{"ndjson", "csv"}, false),
+				Description:      "Format of `records` or `file`, either `ndjson` or `csv`. NDJSON records are compacted onto a single line. The CSV header row is repeated at the start of every chunk. Defaults to `ndjson`.",
+			},
+			"max_chunk_size": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Description:      "Maximum uncompressed size of a chunk in bytes. Defaults to 1 MiB.",
+			},
+			"gzip": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Compress requests with gzip content encoding",
+			},
+			"retries": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				Description:      "Number of times a request is retried after a connection failure or a `408` or `429` response. Defaults to 3 for `records` and `file`, and to 0 for `data`.",
+			},
+			"retry_server_errors": {
+				Type:        schema.TypeBool,
+				Optional:    true,
 				ForceNew:    true,
-				Description: "Data to submit to Observe collector",
+				Description: "Also retry requests failing with a `5xx` response. The collector may have accepted a request despite the error, so retrying it may submit observations more than once.",
 			},
 			"tags": {
 				Type: schema.TypeMap,
@@ -62,7 +127,7 @@ func resourceHTTPPost() *schema.Resource {
 				Default:     "application/json",
 				Optional:    true,
 				ForceNew:    true,
-				Description: "Content Type for HTTP POST request",
+				Description: "Content Type for HTTP POST request. Only applies to `data`, chunks use the content type of `format`.",
 			},
 			"headers": {
 				Type: schema.TypeMap,
@@ -81,6 +146,11 @@ func resourceHTTPPost() *schema.Resource {
 				ForceNew:    true,
 				Description: "Timestamp of submission",
 			},
+			"observations": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of records accepted by the collector, when submitting `records` or `file`. If a chunk fails after earlier chunks were accepted, the resource is still created with the number of records accepted so far, and a warning is raised, since resubmitting would duplicate them.",
+			},
 		},
 	}
 }
@@ -90,7 +160,6 @@ func resourceHTTPPostCreate(ctx context.Context, data *schema.ResourceData, meta
 
 	var (
 		path        = data.Get("path").(string)
-		rawData     = strings.NewReader(data.Get("data").(string))
 		rawTags     = data.Get("tags").(map[string]interface{})
 		rawHeaders  = data.Get("headers").(map[string]interface{})
 		idTag       = data.Get("id_tag").(string)
@@ -113,11 +182,26 @@ func resourceHTTPPostCreate(ctx context.Context, data *schema.ResourceData, meta
 		tags[idTag] = id
 	}
 
-	var requestOptions []func(*http.Request)
-
-	requestOptions = append(requestOptions, func(req *http.Request) {
-		req.Header.Set("Content-Type", contentType)
+	options := observe.BatchOptions{
+		Tags:              tags,
+		Gzip:              data.Get("gzip").(bool),
+		RetryInterval:     httpPostRetryInterval,
+		RetryServerErrors: data.Get("retry_server_errors").(bool),
+		MaxChunkSize:      httpPostDefaultMaxChunkSize,
+	}
+	// retries = 0 is meaningful, so we must use the deprecated GetOkExists
+	if v, ok := data.GetOkExists("retries"); ok {
+		options.Retries = v.(int)
+	} else if _, ok := data.GetOk("data"); !ok {
+		// data was submitted exactly once before retries were supported, so
+		// only chunked submissions are retried by default
+		options.Retries = httpPostDefaultRetries
+	}
+	if v, ok := data.GetOk("max_chunk_size"); ok {
+		options.MaxChunkSize = v.(int)
+	}
 
+	options.RequestOptions = append(options.RequestOptions, func(req *http.Request) {
 		for k, v := range rawHeaders {
 			// Note: we allow users to override content-type in custom headers.
 			// While not recommended, this opens an escape hatch for testing
@@ -126,13 +210,134 @@ func resourceHTTPPostCreate(ctx context.Context, data *schema.ResourceData, meta
 		}
 	})
 
-	if err := client.Observe(ctx, path, rawData, tags, requestOptions...); err != nil {
-		return diag.Errorf("failed to submit observations: %s", err)
+	if v, ok := data.GetOk("data"); ok {
+		options.ContentType = contentType
+		if err := client.ObserveChunk(ctx, path, []byte(v.(string)), options); err != nil {
+			return diag.Errorf("failed to submit observations: %s", err)
+		}
+	} else {
+		accepted, err := resourceHTTPPostBatch(ctx, client, data, path, options)
+		if err != nil && accepted == 0 {
+			return diag.Errorf("failed to submit observations: %s", err)
+		} else if err != nil {
+			// accepted observations cannot be withdrawn, so record them in
+			// state rather than have them resubmitted on the next apply
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "observations were only partially submitted",
+				Detail:   fmt.Sprintf("%d observations were accepted before the failure: %s", accepted, err),
+			})
+		}
+		data.Set("observations", accepted)
 	}
 
 	data.Set("acked", time.Now().UTC().Format(time.RFC3339))
 	data.SetId(id)
-	return nil
+	return diags
+}
+
+// resourceHTTPPostBatch submits records or the contents of a file in chunks,
+// returning the number of records accepted.
+func resourceHTTPPostBatch(ctx context.Context, client *observe.Client, data *schema.ResourceData, path string, options observe.BatchOptions) (int, error) {
+	format := "ndjson"
+	if v, ok := data.GetOk("format"); ok {
+		format = v.(string)
+	}
+	options.ContentType = httpPostFormatContentTypes[format]
+
+	var input io.Reader
+	if v, ok := data.GetOk("file"); ok {
+		f, err := os.Open(v.(string))
+		if err != nil {
+			return 0, err
+		}
+		defer f.Close()
+		input = f
+	} else {
+		var records []string
+		for _, v := range data.Get("records").([]interface{}) {
+			s, _ := v.(string)
+			records = append(records, s)
+		}
+		input = strings.NewReader(strings.Join(records, "\n"))
+	}
+
+	reader, err := newHTTPPostRecordReader(input, format)
+	if err != nil {
+		return 0, err
+	}
+	options.Header = reader.header
+
+	w := client.NewBatchWriter(ctx, path, options)
+	for i := 1; ; i++ {
+		record, err := reader.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return w.Accepted(), fmt.Errorf("failed to read record %d: %w", i, err)
+		}
+		if err := w.Write(record); err != nil {
+			return w.Accepted(), err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return w.Accepted(), err
+	}
+	return w.Accepted(), nil
+}
+
+// httpPostRecordReader splits input into records. NDJSON input may contain
+// any sequence of JSON values, each of which is compacted onto a single
+// line. The first row of CSV input is retained as the header.
+type httpPostRecordReader struct {
+	json   *json.Decoder
+	csv    *csv.Reader
+	header []byte
+}
+
+func newHTTPPostRecordReader(r io.Reader, format string) (*httpPostRecordReader, error) {
+	if format != "csv" {
+		return &httpPostRecordReader{json: json.NewDecoder(r)}, nil
+	}
+
+	reader := &httpPostRecordReader{csv: csv.NewReader(r)}
+	header, err := reader.Next()
+	if err == io.EOF {
+		return nil, fmt.Errorf("csv input has no header row")
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read header row: %w", err)
+	}
+	reader.header = header
+	return reader, nil
+}
+
+// Next returns the next record, or io.EOF once all input has been read
+func (r *httpPostRecordReader) Next() ([]byte, error) {
+	var buf bytes.Buffer
+	if r.csv != nil {
+		fields, err := r.csv.Read()
+		if err != nil {
+			return nil, err
+		}
+		w := csv.NewWriter(&buf)
+		if err := w.Write(fields); err != nil {
+			return nil, err
+		}
+		w.Flush()
+		if err := w.Error(); err != nil {
+			return nil, err
+		}
+		return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+	}
+
+	var raw json.RawMessage
+	if err := r.json.Decode(&raw); err != nil {
+		return nil, err
+	}
+	if err := json.Compact(&buf, raw); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
 }
 
 func resourceNoop(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
@@ -2,9 +2,15 @@ package observe
 
 import (
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
 	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -91,3 +97,121 @@ func TestAccObserveHTTPPostCreateContentType(t *testing.T) {
 		},
 	})
 }
+
+func TestHTTPPostRecordReader(t *testing.T) {
+	testcases := []struct {
+		Name     string
+		Format   string
+		Input    string
+		Header   string
+		Expected []string
+		Error    bool
+	}{
+		{
+			Name:     "ndjson",
+			Format:   "ndjson",
+			Input:    "{\"a\": 1}\n\n{\"a\": 2}\n",
+			Expected: []string{`{"a":1}`, `{"a":2}`},
+		},
+		{
+			Name:     "ndjson compacts multi-line values",
+			Format:   "ndjson",
+			Input:    "{\n  \"a\": [1, 2]\n}\n\"b\"",
+			Expected: []string{`{"a":[1,2]}`, `"b"`},
+		},
+		{
+			Name:   "invalid ndjson",
+			Format: "ndjson",
+			Input:  "{\"a\": 1}\nnot json",
+			Error:  true,
+		},
+		{
+			Name:     "csv",
+			Format:   "csv",
+			Input:    "a,b\n1,\"two\nlines\"\n3,4\n",
+			Header:   "a,b",
+			Expected: []string{"1,\"two\nlines\"", "3,4"},
+		},
+		{
+			Name:   "csv with inconsistent fields",
+			Format: "csv",
+			Input:  "a,b\n1,2,3\n",
+			Header: "a,b",
+			Error:  true,
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.Name, func(t *testing.T) {
+			reader, err := newHTTPPostRecordReader(strings.NewReader(tt.Input), tt.Format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := string(reader.header); got != tt.Header {
+				t.Fatalf("expected header %q, got %q", tt.Header, got)
+			}
+
+			var got []string
+			for {
+				record, err := reader.Next()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					if tt.Error {
+						return
+					}
+					t.Fatal(err)
+				}
+				got = append(got, string(record))
+			}
+			if tt.Error {
+				t.Fatal("expected error")
+			}
+			if diff := cmp.Diff(tt.Expected, got); diff != "" {
+				t.Fatalf("unexpected records: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAccObserveHTTPPostBatch(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	file := filepath.Join(t.TempDir(), "records.csv")
+	if err := os.WriteFile(file, []byte("key,value\na,1\nb,2\nc,3\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "observe_http_post" "records" {
+				  records        = [for i in range(100) : jsonencode({"prefix"="%[1]s", "index"=i})]
+				  max_chunk_size = 1024
+				  gzip           = true
+				}
+
+				resource "observe_http_post" "file" {
+				  file   = "%[2]s"
+				  format = "csv"
+				}`, randomPrefix, file),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_http_post.records", "observations", "100"),
+					resource.TestCheckResourceAttr("observe_http_post.file", "observations", "3"),
+				),
+			},
+			{
+				Config: `
+				resource "observe_http_post" "test" {
+				  records        = [jsonencode({"a"="short"}), jsonencode({"b"="a record too long to fit"})]
+				  max_chunk_size = 16
+				}`,
+				ExpectError: regexp.MustCompile("exceeds maximum chunk size"),
+			},
+		},
+	})
+}
@@ -0,0 +1,399 @@
+package observe
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func resourceIncident() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("incident", "description"),
+		CreateContext: resourceIncidentCreate,
+		UpdateContext: resourceIncidentUpdate,
+		ReadContext:   resourceIncidentRead,
+		DeleteContext: resourceIncidentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateOID(oid.TypeWorkspace),
+				Description:      descriptions.Get("common", "schema", "workspace"),
+			},
+			"folder": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true, // Default folder when unset
+				ValidateDiagFunc: validateOID(oid.TypeFolder),
+				Description:      descriptions.Get("incident", "schema", "folder"),
+			},
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateIsString(),
+				Description:      descriptions.Get("incident", "schema", "name"),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("incident", "schema", "description"),
+			},
+			"icon_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: descriptions.Get("common", "schema", "icon_url"),
+			},
+			"status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          string(gql.IncidentStatusActive),
+				ValidateDiagFunc: validateEnums(gql.AllIncidentStatuses),
+				Description:      descriptions.Get("incident", "schema", "status"),
+			},
+			"users": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateOID(oid.TypeUser),
+				},
+				Description: descriptions.Get("incident", "schema", "users"),
+			},
+			"slack_channel": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connection_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: descriptions.Get("incident", "schema", "slack_channel", "connection_id"),
+						},
+						"channel_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: descriptions.Get("incident", "schema", "slack_channel", "channel_id"),
+						},
+					},
+				},
+				Description: descriptions.Get("incident", "schema", "slack_channel", "description"),
+			},
+			"worksheets": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateOID(oid.TypeWorksheet),
+				},
+				Description: descriptions.Get("incident", "schema", "worksheets"),
+			},
+			"dashboards": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateOID(oid.TypeDashboard),
+				},
+				Description: descriptions.Get("incident", "schema", "dashboards"),
+			},
+			// computed values
+			"oid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("common", "schema", "oid"),
+			},
+			"inactive_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("incident", "schema", "inactive_time"),
+			},
+			"closed_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("incident", "schema", "closed_time"),
+			},
+		},
+	}
+}
+
+func newIncidentConfig(data *schema.ResourceData) (input *gql.IncidentInput, diags diag.Diagnostics) {
+	input = &gql.IncidentInput{
+		Name:   data.Get("name").(string),
+		Status: gql.IncidentStatus(data.Get("status").(string)),
+	}
+
+	if v, ok := data.GetOk("folder"); ok {
+		folder, _ := oid.NewOID(v.(string))
+		input.FolderId = folder.Version
+	}
+
+	if v, ok := data.GetOk("description"); ok {
+		input.Description = stringPtr(v.(string))
+	}
+
+	if v, ok := data.GetOk("icon_url"); ok {
+		input.IconUrl = stringPtr(v.(string))
+	}
+	return
+}
+
+// incidentLinkChanges returns the elements added to and removed from a set
+// attribute since the last apply.
+func incidentLinkChanges(data *schema.ResourceData, key string) (add, remove []interface{}) {
+	o, n := data.GetChange(key)
+	old, new := o.(*schema.Set), n.(*schema.Set)
+	return new.Difference(old).List(), old.Difference(new).List()
+}
+
+func incidentUserIds(oids []interface{}) ([]types.UserIdScalar, error) {
+	users := make([]types.UserIdScalar, 0, len(oids))
+	for _, v := range oids {
+		user, _ := oid.NewOID(v.(string))
+		uid, err := types.StringToUserIdScalar(user.Id)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing user: %w", err)
+		}
+		users = append(users, uid)
+	}
+	return users, nil
+}
+
+func incidentObjectIds(oids []interface{}) []string {
+	ids := make([]string, 0, len(oids))
+	for _, v := range oids {
+		o, _ := oid.NewOID(v.(string))
+		ids = append(ids, o.Id)
+	}
+	return ids
+}
+
+func incidentSlackchannels(blocks []interface{}) []gql.IncidentSlackchannelInput {
+	channels := make([]gql.IncidentSlackchannelInput, 0, len(blocks))
+	for _, v := range blocks {
+		m := v.(map[string]interface{})
+		channels = append(channels, gql.IncidentSlackchannelInput{
+			ConnectionID:   m["connection_id"].(string),
+			SlackchannelID: m["channel_id"].(string),
+		})
+	}
+	return channels
+}
+
+// reconcileIncidentLinks adds and removes linked users, Slack channels,
+// worksheets and dashboards to match the configuration.
+func reconcileIncidentLinks(ctx context.Context, client *observe.Client, id string, data *schema.ResourceData) error {
+	if add, remove := incidentLinkChanges(data, "users"); len(add)+len(remove) > 0 {
+		toAdd, err := incidentUserIds(add)
+		if err != nil {
+			return err
+		}
+		toRemove, err := incidentUserIds(remove)
+		if err != nil {
+			return err
+		}
+		if len(toRemove) > 0 {
+			if _, err := client.RemoveIncidentUsers(ctx, id, toRemove); err != nil {
+				return fmt.Errorf("failed to remove incident users: %w", err)
+			}
+		}
+		if len(toAdd) > 0 {
+			if _, err := client.AddIncidentUsers(ctx, id, toAdd); err != nil {
+				return fmt.Errorf("failed to add incident users: %w", err)
+			}
+		}
+	}
+
+	if add, remove := incidentLinkChanges(data, "slack_channel"); len(add)+len(remove) > 0 {
+		if len(remove) > 0 {
+			if _, err := client.RemoveIncidentSlackchannels(ctx, id, incidentSlackchannels(remove)); err != nil {
+				return fmt.Errorf("failed to remove incident slack channels: %w", err)
+			}
+		}
+		if len(add) > 0 {
+			if _, err := client.AddIncidentSlackchannels(ctx, id, incidentSlackchannels(add)); err != nil {
+				return fmt.Errorf("failed to add incident slack channels: %w", err)
+			}
+		}
+	}
+
+	if add, remove := incidentLinkChanges(data, "worksheets"); len(add)+len(remove) > 0 {
+		if len(remove) > 0 {
+			if _, err := client.RemoveIncidentWorksheets(ctx, id, incidentObjectIds(remove)); err != nil {
+				return fmt.Errorf("failed to remove incident worksheets: %w", err)
+			}
+		}
+		if len(add) > 0 {
+			if _, err := client.AddIncidentWorksheets(ctx, id, incidentObjectIds(add)); err != nil {
+				return fmt.Errorf("failed to add incident worksheets: %w", err)
+			}
+		}
+	}
+
+	if add, remove := incidentLinkChanges(data, "dashboards"); len(add)+len(remove) > 0 {
+		if len(remove) > 0 {
+			if _, err := client.RemoveIncidentDashboards(ctx, id, incidentObjectIds(remove)); err != nil {
+				return fmt.Errorf("failed to remove incident dashboards: %w", err)
+			}
+		}
+		if len(add) > 0 {
+			if _, err := client.AddIncidentDashboards(ctx, id, incidentObjectIds(add)); err != nil {
+				return fmt.Errorf("failed to add incident dashboards: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+func resourceIncidentCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	config, diags := newIncidentConfig(data)
+	if diags.HasError() {
+		return diags
+	}
+
+	id, _ := oid.NewOID(data.Get("workspace").(string))
+	result, err := client.CreateIncident(ctx, id.Id, config)
+	if err != nil {
+		return diag.Errorf("failed to create incident: %s", err.Error())
+	}
+
+	data.SetId(result.Id)
+	if err := reconcileIncidentLinks(ctx, client, result.Id, data); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	return append(diags, resourceIncidentRead(ctx, data, meta)...)
+}
+
+func resourceIncidentUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	config, diags := newIncidentConfig(data)
+	if diags.HasError() {
+		return diags
+	}
+
+	if _, err := client.UpdateIncident(ctx, data.Id(), config); err != nil {
+		return diag.Errorf("failed to update incident: %s", err.Error())
+	}
+
+	if err := reconcileIncidentLinks(ctx, client, data.Id(), data); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	return append(diags, resourceIncidentRead(ctx, data, meta)...)
+}
+
+func resourceIncidentRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	incident, err := client.GetIncident(ctx, data.Id())
+	if err != nil {
+		if gql.HasErrorCode(err, "NOT_FOUND") {
+			data.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to read incident: %s", err.Error())
+	}
+	return incidentToResourceData(incident, data)
+}
+
+func resourceIncidentDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+	if err := client.DeleteIncident(ctx, data.Id()); err != nil {
+		return diag.Errorf("failed to delete incident: %s", err.Error())
+	}
+	return diags
+}
+
+func incidentToResourceData(i *gql.Incident, data *schema.ResourceData) (diags diag.Diagnostics) {
+	if err := data.Set("workspace", oid.WorkspaceOid(i.WorkspaceId).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("folder", oid.FolderOid(i.FolderId, i.WorkspaceId).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("name", i.Name); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("description", i.Description); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("icon_url", i.IconUrl); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("status", string(i.Status)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	users := make([]string, len(i.Users))
+	for j, u := range i.Users {
+		users[j] = oid.UserOid(u.UserId).String()
+	}
+	if err := data.Set("users", users); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	channels := make([]interface{}, len(i.SlackChannels))
+	for j, c := range i.SlackChannels {
+		channels[j] = map[string]interface{}{
+			"connection_id": c.ConnectionID,
+			"channel_id":    c.SlackchannelID,
+		}
+	}
+	if err := data.Set("slack_channel", channels); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	worksheets := make([]string, len(i.Worksheets))
+	for j, w := range i.Worksheets {
+		worksheets[j] = oid.WorksheetOid(w).String()
+	}
+	if err := data.Set("worksheets", worksheets); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	dashboards := make([]string, len(i.Dashboards))
+	for j, d := range i.Dashboards {
+		dashboards[j] = oid.DashboardOid(d).String()
+	}
+	if err := data.Set("dashboards", dashboards); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	var inactiveTime, closedTime string
+	if i.InactiveTime != nil {
+		inactiveTime = i.InactiveTime.String()
+	}
+	if i.ClosedTime != nil {
+		closedTime = i.ClosedTime.String()
+	}
+	if err := data.Set("inactive_time", inactiveTime); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := data.Set("closed_time", closedTime); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("oid", i.Oid().String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	return diags
+}
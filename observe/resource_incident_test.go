@@ -0,0 +1,67 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveIncident(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+`
+				data "observe_user" "system" {
+				  email = "%[1]s"
+				}
+
+				resource "observe_incident" "example" {
+				  workspace   = data.observe_workspace.default.oid
+				  name        = "%[2]s"
+				  description = "game day"
+				  users       = [data.observe_user.system.oid]
+				}
+				`, systemUser(), randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_incident.example", "name", randomPrefix),
+					resource.TestCheckResourceAttr("observe_incident.example", "description", "game day"),
+					resource.TestCheckResourceAttr("observe_incident.example", "status", "Active"),
+					resource.TestCheckResourceAttr("observe_incident.example", "users.#", "1"),
+					resource.TestCheckResourceAttrSet("observe_incident.example", "folder"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(configPreamble+`
+				resource "observe_incident" "example" {
+				  workspace = data.observe_workspace.default.oid
+				  name      = "%[1]s"
+				  status    = "Closed"
+				}
+
+				data "observe_incidents" "closed" {
+				  status = "Closed"
+
+				  depends_on = [observe_incident.example]
+				}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_incident.example", "status", "Closed"),
+					resource.TestCheckResourceAttr("observe_incident.example", "users.#", "0"),
+					resource.TestCheckResourceAttrSet("observe_incident.example", "closed_time"),
+					resource.TestCheckTypeSetElemAttrPair("data.observe_incidents.closed", "oids.*", "observe_incident.example", "oid"),
+				),
+			},
+			{
+				ResourceName:      "observe_incident.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
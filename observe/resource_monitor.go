@@ -969,8 +969,13 @@ func flattenNotificationSpec(spec gql.MonitorNotificationSpecNotificationSpecifi
 
 func resourceMonitorDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	client := meta.(*observe.Client)
+	// recorded so that delete protection on datasets can tell this monitor
+	// was destroyed in the same run
+	end := client.BeginDelete(oid.MonitorOid(data.Id()))
 	if err := client.DeleteMonitor(ctx, data.Id()); err != nil {
+		end(false)
 		return diag.Errorf("failed to delete monitor: %s", err.Error())
 	}
+	end(true)
 	return diags
 }
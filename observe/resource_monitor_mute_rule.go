@@ -0,0 +1,433 @@
+package observe
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func resourceMonitorMuteRule() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("monitor_mute_rule", "description"),
+		CreateContext: resourceMonitorMuteRuleCreate,
+		UpdateContext: resourceMonitorMuteRuleUpdate,
+		ReadContext:   resourceMonitorMuteRuleRead,
+		DeleteContext: resourceMonitorMuteRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateOID(oid.TypeWorkspace),
+				Description:      descriptions.Get("common", "schema", "workspace"),
+			},
+			"folder": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true, // Default folder when unset
+				ValidateDiagFunc: validateOID(oid.TypeFolder),
+				Description:      descriptions.Get("monitor_mute_rule", "schema", "folder"),
+			},
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateIsString(),
+				Description:      descriptions.Get("monitor_mute_rule", "schema", "name"),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("monitor_mute_rule", "schema", "description"),
+			},
+			"icon_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: descriptions.Get("common", "schema", "icon_url"),
+			},
+			"monitors": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateOID(oid.TypeMonitor),
+				},
+				Description: descriptions.Get("monitor_mute_rule", "schema", "monitors"),
+			},
+			"start_date": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateTimestamp,
+				DiffSuppressFunc: diffSuppressTimestamp,
+				Description:      descriptions.Get("monitor_mute_rule", "schema", "start_date"),
+			},
+			"duration": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateTimeDuration,
+				DiffSuppressFunc: diffSuppressTimeDuration,
+				Description:      descriptions.Get("monitor_mute_rule", "schema", "duration"),
+			},
+			"filter_column": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"filter_resource"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"column": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: descriptions.Get("monitor_mute_rule", "schema", "filter_column", "column"),
+						},
+						"values": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: descriptions.Get("monitor_mute_rule", "schema", "filter_column", "values"),
+						},
+					},
+				},
+				Description: descriptions.Get("monitor_mute_rule", "schema", "filter_column", "description"),
+			},
+			"filter_resource": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"filter_column"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dataset": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateOID(oid.TypeDataset),
+							DiffSuppressFunc: diffSuppressOIDVersion,
+							Description:      descriptions.Get("monitor_mute_rule", "schema", "filter_resource", "dataset"),
+						},
+						"resource": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"primary_key": {
+										Type:        schema.TypeMap,
+										Required:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: descriptions.Get("monitor_mute_rule", "schema", "filter_resource", "resource", "primary_key"),
+									},
+								},
+							},
+							Description: descriptions.Get("monitor_mute_rule", "schema", "filter_resource", "resource", "description"),
+						},
+					},
+				},
+				Description: descriptions.Get("monitor_mute_rule", "schema", "filter_resource", "description"),
+			},
+			// computed values
+			"oid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("common", "schema", "oid"),
+			},
+			"end_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("monitor_mute_rule", "schema", "end_date"),
+			},
+		},
+	}
+}
+
+func newMonitorMuteRuleConfig(data *schema.ResourceData) (input *gql.MonitorMuteRuleInput, monitorIds []string, diags diag.Diagnostics) {
+	workspace, _ := oid.NewOID(data.Get("workspace").(string))
+	input = &gql.MonitorMuteRuleInput{
+		WorkspaceId: workspace.Id,
+		Name:        data.Get("name").(string),
+	}
+
+	if v, ok := data.GetOk("folder"); ok {
+		folder, _ := oid.NewOID(v.(string))
+		input.FolderId = folder.Version
+	}
+
+	if v, ok := data.GetOk("description"); ok {
+		input.Description = stringPtr(v.(string))
+	}
+
+	if v, ok := data.GetOk("icon_url"); ok {
+		input.IconUrl = stringPtr(v.(string))
+	}
+
+	if v, ok := data.GetOk("start_date"); ok {
+		t, _ := time.Parse(time.RFC3339, v.(string))
+		input.StartDate = types.TimeScalar(t.UTC()).Ptr()
+	}
+
+	if v, ok := data.GetOk("duration"); ok {
+		d, _ := types.ParseDurationScalar(v.(string))
+		input.Duration = d
+	}
+
+	if _, ok := data.GetOk("filter_column"); ok {
+		input.FilterPerColumn = &gql.MonitorMuteRuleFilterPerColumnInput{
+			ColumnID: data.Get("filter_column.0.column").(string),
+		}
+		for _, v := range data.Get("filter_column.0.values").([]interface{}) {
+			input.FilterPerColumn.Values = append(input.FilterPerColumn.Values, v.(string))
+		}
+	}
+
+	if _, ok := data.GetOk("filter_resource"); ok {
+		dataset, _ := oid.NewOID(data.Get("filter_resource.0.dataset").(string))
+		input.FilterPerResource = &gql.MonitorMuteRuleFilterPerResourceInput{}
+		for _, r := range data.Get("filter_resource.0.resource").([]interface{}) {
+			primaryKey := r.(map[string]interface{})["primary_key"].(map[string]interface{})
+			resourceId := gql.ResourceIdInput{DatasetId: dataset.Id}
+			for _, name := range sortedKeys(primaryKey) {
+				resourceId.PrimaryKeyValue = append(resourceId.PrimaryKeyValue, gql.ColumnAndValueInput{
+					Name:  name,
+					Value: stringPtr(primaryKey[name].(string)),
+				})
+			}
+			input.FilterPerResource.ResourceIds = append(input.FilterPerResource.ResourceIds, resourceId)
+		}
+	}
+
+	for _, v := range data.Get("monitors").(*schema.Set).List() {
+		monitor, _ := oid.NewOID(v.(string))
+		monitorIds = append(monitorIds, monitor.Id)
+	}
+	return
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// monitorMuteRuleEndDate returns the time at which a rule expires, if any.
+func monitorMuteRuleEndDate(startDate, duration string) (time.Time, bool) {
+	start, err := time.Parse(time.RFC3339, startDate)
+	if err != nil {
+		return time.Time{}, false
+	}
+	d, err := time.ParseDuration(duration)
+	if err != nil || d <= 0 {
+		return time.Time{}, false
+	}
+	return start.Add(d), true
+}
+
+// monitorMuteRuleExpired reports whether the rule recorded in state has run
+// its course. Expired rules may be removed by the API at any point, and are
+// no longer refreshed so that they do not produce a diff.
+func monitorMuteRuleExpired(data *schema.ResourceData, now time.Time) bool {
+	end, ok := monitorMuteRuleEndDate(data.Get("start_date").(string), data.Get("duration").(string))
+	return ok && !now.Before(end)
+}
+
+func resourceMonitorMuteRuleCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	config, monitorIds, diags := newMonitorMuteRuleConfig(data)
+	if diags.HasError() {
+		return diags
+	}
+
+	result, err := client.CreateMonitorMuteRule(ctx, config, monitorIds)
+	if err != nil {
+		return diag.Errorf("failed to create monitor mute rule: %s", err.Error())
+	}
+
+	data.SetId(result.Id)
+	return append(diags, resourceMonitorMuteRuleRead(ctx, data, meta)...)
+}
+
+func resourceMonitorMuteRuleUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	config, monitorIds, diags := newMonitorMuteRuleConfig(data)
+	if diags.HasError() {
+		return diags
+	}
+
+	_, err := client.UpdateMonitorMuteRule(ctx, data.Id(), config, monitorIds)
+	if gql.HasErrorCode(err, "NOT_FOUND") {
+		// an expired rule was removed, but the window has since been changed
+		return resourceMonitorMuteRuleCreate(ctx, data, meta)
+	}
+	if err != nil {
+		return diag.Errorf("failed to update monitor mute rule: %s", err.Error())
+	}
+	return append(diags, resourceMonitorMuteRuleRead(ctx, data, meta)...)
+}
+
+func resourceMonitorMuteRuleRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	// workspace is only unset when importing
+	importing := data.Get("workspace").(string) == ""
+	if !importing && monitorMuteRuleExpired(data, time.Now()) {
+		return nil
+	}
+
+	rule, err := client.GetMonitorMuteRule(ctx, data.Id())
+	if err != nil {
+		if gql.HasErrorCode(err, "NOT_FOUND") {
+			data.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to read monitor mute rule: %s", err.Error())
+	}
+
+	if diags = monitorMuteRuleToResourceData(rule, data); diags.HasError() {
+		return diags
+	}
+
+	// attachments can only be observed while the rule is muting monitors
+	if start := rule.StartDate; start != nil && time.Now().After(time.Time(*start)) {
+		monitors, err := activeMonitorMuteRuleMonitors(ctx, client, rule.Id, data.Get("monitors").(*schema.Set).List())
+		if err != nil {
+			return append(diags, diag.Errorf("failed to read monitor mute rule attachments: %s", err.Error())...)
+		}
+		if err := data.Set("monitors", monitors); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+	return diags
+}
+
+// activeMonitorMuteRuleMonitors returns the subset of monitors which are
+// currently muted by the given rule.
+func activeMonitorMuteRuleMonitors(ctx context.Context, client *observe.Client, id string, monitors []interface{}) ([]string, error) {
+	var result []string
+	for _, v := range monitors {
+		monitor, _ := oid.NewOID(v.(string))
+		ids, err := client.ListActiveMonitorMuteRuleIds(ctx, monitor.Id)
+		if gql.HasErrorCode(err, "NOT_FOUND") {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		for _, ruleId := range ids {
+			if ruleId == id {
+				result = append(result, v.(string))
+				break
+			}
+		}
+	}
+	return result, nil
+}
+
+func resourceMonitorMuteRuleDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+	if err := client.DeleteMonitorMuteRule(ctx, data.Id()); err != nil && !gql.HasErrorCode(err, "NOT_FOUND") {
+		return diag.Errorf("failed to delete monitor mute rule: %s", err.Error())
+	}
+	return diags
+}
+
+func monitorMuteRuleToResourceData(r *gql.MonitorMuteRule, data *schema.ResourceData) (diags diag.Diagnostics) {
+	if err := data.Set("workspace", oid.WorkspaceOid(r.WorkspaceId).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("folder", oid.FolderOid(r.FolderId, r.WorkspaceId).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("name", r.Name); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("description", r.Description); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("icon_url", r.IconUrl); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	var startDate, duration, endDate string
+	if r.StartDate != nil {
+		startDate = r.StartDate.String()
+	}
+	if r.Duration != nil && *r.Duration > 0 {
+		duration = r.Duration.String()
+	}
+	if end, ok := monitorMuteRuleEndDate(startDate, duration); ok {
+		endDate = end.UTC().Format(time.RFC3339)
+	}
+	if err := data.Set("start_date", startDate); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := data.Set("duration", duration); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := data.Set("end_date", endDate); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	var filterColumn, filterResource []interface{}
+	if r.Filter != nil {
+		switch f := (*r.Filter).(type) {
+		case *gql.MonitorMuteRuleFilterMonitorMuteRuleFilterPerColumn:
+			filterColumn = append(filterColumn, map[string]interface{}{
+				"column": f.ColumnID,
+				"values": f.Values,
+			})
+		case *gql.MonitorMuteRuleFilterMonitorMuteRuleFilterPerResource:
+			var (
+				dataset   string
+				resources []interface{}
+			)
+			for _, resourceId := range f.ResourceIds {
+				dataset = oid.DatasetOid(resourceId.DatasetId).String()
+				primaryKey := make(map[string]interface{}, len(resourceId.PrimaryKeyValue))
+				for _, kv := range resourceId.PrimaryKeyValue {
+					if kv.Value != nil {
+						primaryKey[kv.Name] = *kv.Value
+					}
+				}
+				resources = append(resources, map[string]interface{}{"primary_key": primaryKey})
+			}
+			if len(resources) > 0 {
+				filterResource = append(filterResource, map[string]interface{}{
+					"dataset":  dataset,
+					"resource": resources,
+				})
+			}
+		}
+	}
+	if err := data.Set("filter_column", filterColumn); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := data.Set("filter_resource", filterResource); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("oid", r.Oid().String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	return diags
+}
@@ -0,0 +1,135 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestMonitorMuteRuleEndDate(t *testing.T) {
+	testcases := []struct {
+		startDate string
+		duration  string
+		expected  string
+	}{
+		{startDate: "2024-01-01T00:00:00Z", duration: "2h", expected: "2024-01-01T02:00:00Z"},
+		{startDate: "2024-01-01T00:00:00+01:00", duration: "30m", expected: "2023-12-31T23:30:00Z"},
+		// indefinite rules never expire
+		{startDate: "2024-01-01T00:00:00Z", duration: ""},
+		{startDate: "2024-01-01T00:00:00Z", duration: "0s"},
+		{startDate: "", duration: "2h"},
+	}
+
+	for i, tc := range testcases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			end, ok := monitorMuteRuleEndDate(tc.startDate, tc.duration)
+			if ok != (tc.expected != "") {
+				t.Fatalf("expected end date %q, got %v", tc.expected, ok)
+			}
+			if ok && end.UTC().Format(time.RFC3339) != tc.expected {
+				t.Errorf("expected end date %s, got %s", tc.expected, end.UTC().Format(time.RFC3339))
+			}
+		})
+	}
+}
+
+func TestAccObserveMonitorMuteRule(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+	startDate := time.Now().UTC().Add(time.Hour).Truncate(time.Second).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(monitorConfigPreamble+`
+				resource "observe_monitor" "first" {
+					workspace = data.observe_workspace.default.oid
+					name      = "%[1]s"
+
+					inputs = {
+						"test" = observe_datastream.test.dataset
+					}
+
+					stage {}
+
+					rule {
+						count {
+							compare_function = "less_or_equal"
+							compare_values   = [1]
+							lookback_time    = "1m"
+						}
+					}
+				}
+
+				resource "observe_monitor_mute_rule" "example" {
+					workspace  = data.observe_workspace.default.oid
+					name       = "%[1]s"
+					monitors   = [observe_monitor.first.oid]
+					start_date = "%[2]s"
+					duration   = "2h"
+
+					filter_column {
+						column = "OBSERVATION_KIND"
+						values = ["test"]
+					}
+				}`, randomPrefix, startDate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_monitor_mute_rule.example", "name", randomPrefix),
+					resource.TestCheckResourceAttr("observe_monitor_mute_rule.example", "monitors.#", "1"),
+					resource.TestCheckResourceAttr("observe_monitor_mute_rule.example", "start_date", startDate),
+					resource.TestCheckResourceAttr("observe_monitor_mute_rule.example", "duration", "2h0m0s"),
+					resource.TestCheckResourceAttrSet("observe_monitor_mute_rule.example", "end_date"),
+					resource.TestCheckResourceAttr("observe_monitor_mute_rule.example", "filter_column.0.column", "OBSERVATION_KIND"),
+					resource.TestCheckResourceAttr("observe_monitor_mute_rule.example", "filter_column.0.values.0", "test"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(monitorConfigPreamble+`
+				resource "observe_monitor" "first" {
+					workspace = data.observe_workspace.default.oid
+					name      = "%[1]s"
+
+					inputs = {
+						"test" = observe_datastream.test.dataset
+					}
+
+					stage {}
+
+					rule {
+						count {
+							compare_function = "less_or_equal"
+							compare_values   = [1]
+							lookback_time    = "1m"
+						}
+					}
+				}
+
+				resource "observe_monitor_mute_rule" "example" {
+					workspace  = data.observe_workspace.default.oid
+					name       = "%[1]s"
+					monitors   = [observe_monitor.first.oid]
+					start_date = "%[2]s"
+					duration   = "4h"
+
+					filter_resource {
+						dataset = observe_datastream.test.dataset
+						resource {
+							primary_key = {
+								OBSERVATION_KIND = "test"
+							}
+						}
+					}
+				}`, randomPrefix, startDate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_monitor_mute_rule.example", "duration", "4h0m0s"),
+					resource.TestCheckResourceAttr("observe_monitor_mute_rule.example", "filter_column.#", "0"),
+					resource.TestCheckResourceAttr("observe_monitor_mute_rule.example", "filter_resource.0.resource.0.primary_key.OBSERVATION_KIND", "test"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,152 @@
+package observe
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func resourceObjectOwner() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("object_owner", "description"),
+		CreateContext: resourceObjectOwnerSet,
+		UpdateContext: resourceObjectOwnerSet,
+		ReadContext:   resourceObjectOwnerRead,
+		DeleteContext: resourceObjectOwnerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"object": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateOID(gql.OwnedObjectTypes...),
+				DiffSuppressFunc: diffSuppressObjectOwnerOID,
+				Description:      descriptions.Get("object_owner", "schema", "object"),
+			},
+			"owner": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateOID(oid.TypeUser),
+				Description:      descriptions.Get("object_owner", "schema", "owner"),
+			},
+			// computed values
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("object_owner", "schema", "name"),
+			},
+		},
+	}
+}
+
+// ownedObjectOid strips the version from object OIDs, except for folders,
+// whose OID stores the folder ID as the version.
+func ownedObjectOid(o oid.OID) oid.OID {
+	if o.Type != oid.TypeFolder {
+		o.Version = nil
+	}
+	return o
+}
+
+// ownedObjectId returns the ID of the object identified by an OID.
+func ownedObjectId(o oid.OID) (string, error) {
+	if o.Type == oid.TypeFolder {
+		if o.Version == nil {
+			return "", fmt.Errorf("folder oid %q is missing folder ID", o.String())
+		}
+		return *o.Version, nil
+	}
+	return o.Id, nil
+}
+
+func diffSuppressObjectOwnerOID(k, prv, nxt string, d *schema.ResourceData) bool {
+	o, err := oid.NewOID(prv)
+	if err != nil {
+		return false
+	}
+	n, err := oid.NewOID(nxt)
+	if err != nil {
+		return false
+	}
+	return ownedObjectOid(*o).String() == ownedObjectOid(*n).String()
+}
+
+func resourceObjectOwnerSet(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	object, _ := oid.NewOID(data.Get("object").(string))
+	id, err := ownedObjectId(*object)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	owner, _ := oid.NewOID(data.Get("owner").(string))
+	uid, err := types.StringToUserIdScalar(owner.Id)
+	if err != nil {
+		return diag.Errorf("error parsing owner: %s", err.Error())
+	}
+
+	if err := client.SetWorkspaceObjectOwner(ctx, id, uid); err != nil {
+		return diag.Errorf("failed to set object owner: %s", err.Error())
+	}
+
+	data.SetId(ownedObjectOid(*object).String())
+	return append(diags, resourceObjectOwnerRead(ctx, data, meta)...)
+}
+
+func resourceObjectOwnerRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	object, err := oid.NewOID(data.Id())
+	if err != nil {
+		return diag.Errorf("failed to parse id: %s", err.Error())
+	}
+	id, err := ownedObjectId(*object)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	result, err := client.GetWorkspaceObjectOwner(ctx, object.Type, id)
+	if err != nil {
+		if gql.HasErrorCode(err, "NOT_FOUND") {
+			data.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to read object owner: %s", err.Error())
+	}
+	if result == nil {
+		data.SetId("")
+		return nil
+	}
+
+	if _, ok := data.GetOk("object"); !ok {
+		// imported by object OID
+		if err := data.Set("object", result.Oid.String()); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	if err := data.Set("owner", oid.UserOid(result.Owner).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("name", result.Name); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	return diags
+}
+
+func resourceObjectOwnerDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	// every object has an owner, so there is nothing to unset
+	return diags
+}
@@ -0,0 +1,109 @@
+package observe
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveObjectOwner(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+`
+				data "observe_user" "system" {
+				  email = "%[1]s"
+				}
+
+				resource "observe_folder" "example" {
+				  workspace = data.observe_workspace.default.oid
+				  name      = "%[2]s"
+				}
+
+				resource "observe_object_owner" "example" {
+				  object = observe_folder.example.oid
+				  owner  = data.observe_user.system.oid
+				}
+				`, systemUser(), randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("observe_object_owner.example", "owner", "data.observe_user.system", "oid"),
+					resource.TestCheckResourceAttr("observe_object_owner.example", "name", randomPrefix),
+				),
+			},
+			{
+				ResourceName:      "observe_object_owner.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// TestAccObserveObjectOwnerTransfer verifies that a transfer is reflected in
+// the ownership reported by the API, and not just in our own state.
+func TestAccObserveObjectOwnerTransfer(t *testing.T) {
+	userEmail := os.Getenv("OBSERVE_USER_EMAIL")
+	if userEmail == "" {
+		t.Skip("OBSERVE_USER_EMAIL not set")
+	}
+
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	config := func(owner string) string {
+		return fmt.Sprintf(configPreamble+`
+		data "observe_user" "system" {
+		  email = "%[1]s"
+		}
+
+		data "observe_user" "current" {
+		  email = "%[2]s"
+		}
+
+		resource "observe_folder" "example" {
+		  workspace = data.observe_workspace.default.oid
+		  name      = "%[3]s"
+		}
+
+		resource "observe_object_owner" "example" {
+		  object = observe_folder.example.oid
+		  owner  = data.observe_user.%[4]s.oid
+		}
+
+		data "observe_owned_objects" "example" {
+		  owner     = data.observe_user.%[4]s.oid
+		  workspace = data.observe_workspace.default.oid
+		  types     = ["folder"]
+
+		  depends_on = [observe_object_owner.example]
+		}
+		`, systemUser(), userEmail, randomPrefix, owner)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config("system"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("observe_object_owner.example", "owner", "data.observe_user.system", "oid"),
+					resource.TestCheckTypeSetElemAttrPair("data.observe_owned_objects.example", "oids.*", "observe_folder.example", "oid"),
+				),
+			},
+			{
+				Config: config("current"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("observe_object_owner.example", "owner", "data.observe_user.current", "oid"),
+					resource.TestCheckTypeSetElemAttrPair("data.observe_owned_objects.example", "oids.*", "observe_folder.example", "oid"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,196 @@
+package observe
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+)
+
+const (
+	schemaRbacGroupmembersGroupDescription  = "OID of the RBAC group whose members are managed."
+	schemaRbacGroupmembersUsersDescription  = "OIDs of users which are direct members of the group."
+	schemaRbacGroupmembersGroupsDescription = "OIDs of RBAC groups which are direct members of the group."
+)
+
+func resourceRbacGroupmembers() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages the full set of direct members of a RBAC group. " +
+			"This resource is authoritative and destructive: every apply removes all members not declared here, " +
+			"including members added in the UI and members managed by `observe_rbac_group_member` resources. " +
+			"Undeclared members are read into state on refresh, so their removal is shown in the plan, " +
+			"and every apply which removes them reports them in a warning, since they may be managed by conflicting " +
+			"`observe_rbac_group_member` resources. " +
+			"It must not be combined with `observe_rbac_group_member` resources for the same group.",
+		CreateContext: resourceRbacGroupmembersSet,
+		UpdateContext: resourceRbacGroupmembersSet,
+		ReadContext:   resourceRbacGroupmembersRead,
+		DeleteContext: resourceRbacGroupmembersDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateOID(oid.TypeRbacGroup),
+				DiffSuppressFunc: diffSuppressOIDVersion,
+				Description:      schemaRbacGroupmembersGroupDescription,
+			},
+			"users": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateOID(oid.TypeUser),
+				},
+				Description: schemaRbacGroupmembersUsersDescription,
+			},
+			"groups": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateOID(oid.TypeRbacGroup),
+				},
+				Description: schemaRbacGroupmembersGroupsDescription,
+			},
+		},
+	}
+}
+
+// rbacGroupmemberOids returns the OIDs of all members in a membership list.
+func rbacGroupmemberOids(members []gql.RbacGroupmember) (users []string, groups []string) {
+	for _, m := range members {
+		if m.MemberUserId != nil {
+			users = append(users, oid.UserOid(*m.MemberUserId).String())
+		} else if m.MemberGroupId != nil {
+			groups = append(groups, oid.RbacGroupOid(*m.MemberGroupId).String())
+		}
+	}
+	return users, groups
+}
+
+func resourceRbacGroupmembersSet(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	group, _ := oid.NewOID(data.Get("group").(string))
+
+	// empty lists rather than nil, which would leave members untouched
+	var (
+		users  = make([]types.UserIdScalar, 0)
+		groups = make([]string, 0)
+	)
+	for _, v := range data.Get("users").(*schema.Set).List() {
+		user, _ := oid.NewOID(v.(string))
+		uid, err := types.StringToUserIdScalar(user.Id)
+		if err != nil {
+			return diag.Errorf("error parsing member user: %s", err.Error())
+		}
+		users = append(users, uid)
+	}
+	for _, v := range data.Get("groups").(*schema.Set).List() {
+		member, _ := oid.NewOID(v.(string))
+		groups = append(groups, member.Id)
+	}
+
+	current, err := client.ListRbacGroupmembers(ctx, group.Id)
+	if err != nil {
+		return diag.Errorf("failed to read rbacgroupmembers: %s", err.Error())
+	}
+
+	if _, err := client.SetRbacGroupmembers(ctx, group.Id, users, groups); err != nil {
+		return diag.Errorf("failed to set rbacgroupmembers: %s", err.Error())
+	}
+
+	data.SetId(group.Id)
+	diags = append(diags, rbacGroupmembersUnmanagedDiagnostics(data, current)...)
+	return append(diags, resourceRbacGroupmembersRead(ctx, data, meta)...)
+}
+
+// rbacGroupmembersUnmanagedDiagnostics warns about members which were removed
+// because they are not declared in configuration. These were either added
+// outside of Terraform, or are managed by conflicting observe_rbac_group_member
+// resources, which will add them back on the next apply. Other resources are
+// not visible to the provider, so this cannot be detected before apply.
+//
+// Prior state is deliberately ignored: refresh reads every current member
+// into state, so undeclared members would otherwise be treated as declared
+// from the second apply onwards.
+func rbacGroupmembersUnmanagedDiagnostics(data *schema.ResourceData, current []gql.RbacGroupmember) diag.Diagnostics {
+	// users and groups are not computed, so their planned values are exactly
+	// those configured
+	declared := make(map[string]bool)
+	for _, k := range []string{"users", "groups"} {
+		for _, v := range data.Get(k).(*schema.Set).List() {
+			declared[v.(string)] = true
+		}
+	}
+
+	users, groups := rbacGroupmemberOids(current)
+
+	var removed []string
+	for _, v := range append(users, groups...) {
+		if !declared[v] {
+			removed = append(removed, v)
+		}
+	}
+	if len(removed) == 0 {
+		return nil
+	}
+	sort.Strings(removed)
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("removed %d undeclared members from rbacgroup [id=%s]", len(removed), data.Id()),
+		Detail: "The following members were not declared in observe_rbac_group_members and have been removed:\n" +
+			strings.Join(removed, "\n") +
+			"\n\nIf they are managed by observe_rbac_group_member resources, those resources conflict with " +
+			"observe_rbac_group_members and will keep adding them back.",
+	}}
+}
+
+func resourceRbacGroupmembersRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	members, err := client.ListRbacGroupmembers(ctx, data.Id())
+	if err != nil {
+		return diag.Errorf("failed to read rbacgroupmembers: %s", err.Error())
+	}
+
+	users, groups := rbacGroupmemberOids(members)
+
+	if _, ok := data.GetOk("group"); !ok {
+		// imported by group ID
+		if err := data.Set("group", oid.RbacGroupOid(data.Id()).String()); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	if err := data.Set("users", users); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("groups", groups); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceRbacGroupmembersDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+	if _, err := client.SetRbacGroupmembers(ctx, data.Id(), []types.UserIdScalar{}, []string{}); err != nil {
+		return diag.Errorf("failed to delete rbacgroupmembers: %s", err.Error())
+	}
+	return diags
+}
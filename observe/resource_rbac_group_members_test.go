@@ -0,0 +1,132 @@
+package observe
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+)
+
+func TestRbacGroupmembersUnmanagedDiagnostics(t *testing.T) {
+	user := func(id string) gql.RbacGroupmember {
+		uid, _ := types.StringToUserIdScalar(id)
+		return gql.RbacGroupmember{MemberUserId: &uid}
+	}
+	group := func(id string) gql.RbacGroupmember {
+		return gql.RbacGroupmember{MemberGroupId: &id}
+	}
+
+	testcases := []struct {
+		Name     string
+		Config   map[string]interface{}
+		Current  []gql.RbacGroupmember
+		Expected []string
+	}{
+		{
+			Name:    "all declared",
+			Config:  map[string]interface{}{"users": []interface{}{"o:::user:1"}, "groups": []interface{}{"o:::rbacgroup:2"}},
+			Current: []gql.RbacGroupmember{user("1"), group("2")},
+		},
+		{
+			Name:     "undeclared members",
+			Config:   map[string]interface{}{"users": []interface{}{"o:::user:1"}},
+			Current:  []gql.RbacGroupmember{user("1"), user("3"), group("4")},
+			Expected: []string{"o:::rbacgroup:4", "o:::user:3"},
+		},
+		{
+			Name:     "no members declared",
+			Config:   map[string]interface{}{},
+			Current:  []gql.RbacGroupmember{user("1")},
+			Expected: []string{"o:::user:1"},
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.Name, func(t *testing.T) {
+			tt.Config["group"] = "o:::rbacgroup:100"
+			data := schema.TestResourceDataRaw(t, resourceRbacGroupmembers().Schema, tt.Config)
+			data.SetId("100")
+
+			diags := rbacGroupmembersUnmanagedDiagnostics(data, tt.Current)
+			if len(tt.Expected) == 0 {
+				if len(diags) != 0 {
+					t.Fatalf("unexpected diagnostics: %v", diags)
+				}
+				return
+			}
+			if len(diags) != 1 {
+				t.Fatalf("expected a single warning, got %v", diags)
+			}
+			if expected := strings.Join(tt.Expected, "\n"); !strings.Contains(diags[0].Detail, expected) {
+				t.Fatalf("expected %q in %q", expected, diags[0].Detail)
+			}
+		})
+	}
+}
+
+func TestAccObserveRbacGroupmembers(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+`
+				data "observe_user" "system" {
+				  email = "%[1]s"
+				}
+
+				data "observe_rbac_group" "reader" {
+				  name = "%[2]s"
+				}
+
+				resource "observe_rbac_group" "example" {
+				  name = "%[3]s"
+				}
+
+				resource "observe_rbac_group_members" "example" {
+				  group  = observe_rbac_group.example.oid
+				  users  = [data.observe_user.system.oid]
+				  groups = [data.observe_rbac_group.reader.oid]
+				}
+				`, systemUser(), defaultRbacGroupReaderName, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_rbac_group_members.example", "users.#", "1"),
+					resource.TestCheckResourceAttr("observe_rbac_group_members.example", "groups.#", "1"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(configPreamble+`
+				data "observe_user" "system" {
+				  email = "%[1]s"
+				}
+
+				resource "observe_rbac_group" "example" {
+				  name = "%[2]s"
+				}
+
+				resource "observe_rbac_group_members" "example" {
+				  group = observe_rbac_group.example.oid
+				  users = [data.observe_user.system.oid]
+				}
+				`, systemUser(), randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_rbac_group_members.example", "users.#", "1"),
+					resource.TestCheckResourceAttr("observe_rbac_group_members.example", "groups.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "observe_rbac_group_members.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,523 @@
+package observe
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+)
+
+const (
+	schemaRbacPolicyStatementDescription = "RBAC statements managed by this policy. " +
+		"Each statement requires exactly one subject attribute and exactly one of `id`, `folder`, `workspace`, `type` or `all` in its object."
+	schemaRbacPolicyOidsDescription = "OIDs of the managed RBAC statements, in the order of `statement`."
+)
+
+func resourceRbacPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a set of RBAC statements as a single unit. " +
+			"All statement creates, updates and deletes are applied in one transaction. " +
+			"It can be imported using a filter of comma separated `key=value` pairs, " +
+			"where key is one of `subject.user`, `subject.group`, `subject.all`, `object.id`, `object.folder`, " +
+			"`object.workspace`, `object.type`, `role` or `description` (a regular expression). " +
+			"All statements matching the filter are imported.",
+		CreateContext: resourceRbacPolicyCreate,
+		UpdateContext: resourceRbacPolicyUpdate,
+		ReadContext:   resourceRbacPolicyRead,
+		DeleteContext: resourceRbacPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceRbacPolicyImport,
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if d.HasChange("statement") {
+				return d.SetNewComputed("oids")
+			}
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"statement": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"subject": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem:     rbacStatementSubjectResource(false),
+						},
+						"object": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem:     rbacStatementObjectResource(false),
+						},
+						"role": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateEnums(gql.AllRbacRoles),
+						},
+					},
+				},
+				Description: schemaRbacPolicyStatementDescription,
+			},
+			"oids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: schemaRbacPolicyOidsDescription,
+			},
+		},
+	}
+}
+
+func newRbacPolicyConfig(data *schema.ResourceData) (statements []gql.RbacStatementInput, diags diag.Diagnostics) {
+	for i := range data.Get("statement").([]interface{}) {
+		prefix := fmt.Sprintf("statement.%d.", i)
+
+		subject, err := newRbacSubjectInput(data, prefix)
+		if err != nil {
+			return nil, diag.Errorf("statement %d: %s", i, err.Error())
+		}
+		object, err := newRbacObjectInput(data, prefix)
+		if err != nil {
+			return nil, diag.Errorf("statement %d: %s", i, err.Error())
+		}
+		input := gql.RbacStatementInput{
+			Description: data.Get(prefix + "description").(string),
+			Subject:     subject,
+			Object:      object,
+			Role:        gql.RbacRole(data.Get(prefix + "role").(string)),
+		}
+		if err := validateRbacPolicyStatement(&input); err != nil {
+			return nil, diag.Errorf("statement %d: %s", i, err.Error())
+		}
+		statements = append(statements, input)
+	}
+	return statements, nil
+}
+
+// validateRbacPolicyStatement enforces the exclusivity constraints which
+// observe_rbac_statement declares in its schema.
+func validateRbacPolicyStatement(s *gql.RbacStatementInput) error {
+	subjects := 0
+	for _, set := range []bool{s.Subject.UserId != nil, s.Subject.GroupId != nil, isTrue(s.Subject.All)} {
+		if set {
+			subjects++
+		}
+	}
+	if subjects != 1 {
+		return fmt.Errorf("subject requires exactly one of user, group or all")
+	}
+
+	objects := 0
+	for _, set := range []bool{s.Object.ObjectId != nil, s.Object.FolderId != nil, s.Object.WorkspaceId != nil, s.Object.Type != nil, isTrue(s.Object.All)} {
+		if set {
+			objects++
+		}
+	}
+	if objects != 1 {
+		return fmt.Errorf("object requires exactly one of id, folder, workspace, type or all")
+	}
+	if s.Object.Type == nil && isTrue(s.Object.Owner) {
+		return fmt.Errorf("object owner requires type")
+	}
+	return nil
+}
+
+func isTrue(b *bool) bool {
+	return b != nil && *b
+}
+
+func rbacStatementToInput(r *gql.RbacStatement) gql.RbacStatementInput {
+	return gql.RbacStatementInput{
+		Description: r.Description,
+		Subject: gql.RbacSubjectInput{
+			UserId:  r.Subject.UserId,
+			GroupId: r.Subject.GroupId,
+			All:     r.Subject.All,
+		},
+		Object: gql.RbacObjectInput{
+			ObjectId:    r.Object.ObjectId,
+			FolderId:    r.Object.FolderId,
+			WorkspaceId: r.Object.WorkspaceId,
+			Type:        r.Object.Type,
+			Name:        r.Object.Name,
+			Owner:       r.Object.Owner,
+			All:         r.Object.All,
+		},
+		Role: r.Role,
+	}
+}
+
+// rbacStatementKey returns a canonical representation of a statement, such
+// that configured statements can be compared to those read back from the
+// server, which may omit false booleans.
+func rbacStatementKey(s *gql.RbacStatementInput) string {
+	str := func(v *string) string {
+		if v == nil {
+			return ""
+		}
+		return strconv.Quote(*v)
+	}
+	var user string
+	if s.Subject.UserId != nil {
+		user = s.Subject.UserId.String()
+	}
+	return strings.Join([]string{
+		strconv.Quote(s.Description),
+		user,
+		str(s.Subject.GroupId),
+		strconv.FormatBool(isTrue(s.Subject.All)),
+		str(s.Object.ObjectId),
+		str(s.Object.FolderId),
+		str(s.Object.WorkspaceId),
+		str(s.Object.Type),
+		str(s.Object.Name),
+		strconv.FormatBool(isTrue(s.Object.Owner)),
+		strconv.FormatBool(isTrue(s.Object.All)),
+		string(s.Role),
+	}, "|")
+}
+
+// rbacPolicyPlan is the minimal set of changes which turns the current
+// statements into the desired ones.
+type rbacPolicyPlan struct {
+	// ids holds the statement ID for each desired statement, or an empty
+	// string if the statement is yet to be created
+	ids    []string
+	create []gql.RbacStatementInput
+	update []gql.UpdateRbacStatementInput
+	delete []string
+}
+
+func (p *rbacPolicyPlan) empty() bool {
+	return len(p.create) == 0 && len(p.update) == 0 && len(p.delete) == 0
+}
+
+// planRbacPolicy matches desired statements against current ones. Identical
+// statements are left untouched, remaining current statements are updated in
+// place, and only any surplus is created or deleted.
+func planRbacPolicy(current []gql.RbacStatement, desired []gql.RbacStatementInput) *rbacPolicyPlan {
+	p := &rbacPolicyPlan{ids: make([]string, len(desired))}
+
+	byKey := make(map[string][]string)
+	for i := range current {
+		in := rbacStatementToInput(&current[i])
+		k := rbacStatementKey(&in)
+		byKey[k] = append(byKey[k], current[i].Id)
+	}
+
+	used := make(map[string]bool)
+	for i := range desired {
+		k := rbacStatementKey(&desired[i])
+		if ids := byKey[k]; len(ids) > 0 {
+			p.ids[i] = ids[0]
+			byKey[k] = ids[1:]
+			used[ids[0]] = true
+		}
+	}
+
+	var spare []string
+	for _, s := range current {
+		if !used[s.Id] {
+			spare = append(spare, s.Id)
+		}
+	}
+
+	for i, s := range desired {
+		if p.ids[i] != "" {
+			continue
+		}
+		if len(spare) > 0 {
+			p.ids[i] = spare[0]
+			spare = spare[1:]
+			p.update = append(p.update, gql.UpdateRbacStatementInput{
+				Id:          p.ids[i],
+				Description: s.Description,
+				Subject:     s.Subject,
+				Object:      s.Object,
+				Role:        s.Role,
+			})
+			continue
+		}
+		p.create = append(p.create, s)
+	}
+
+	p.delete = spare
+	return p
+}
+
+// resolve fills in the IDs of created statements.
+func (p *rbacPolicyPlan) resolve(desired []gql.RbacStatementInput, created []gql.RbacStatement) error {
+	for i := range created {
+		in := rbacStatementToInput(&created[i])
+		k := rbacStatementKey(&in)
+		found := false
+		for j := range desired {
+			if p.ids[j] == "" && rbacStatementKey(&desired[j]) == k {
+				p.ids[j] = created[i].Id
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("unexpected rbacstatement created [id=%s]", created[i].Id)
+		}
+	}
+	return nil
+}
+
+// rbacPolicyStatementIds returns the IDs of statements in the prior state.
+func rbacPolicyStatementIds(data *schema.ResourceData) (ids []string) {
+	old, _ := data.GetChange("oids")
+	for _, v := range old.([]interface{}) {
+		if o, err := oid.NewOID(v.(string)); err == nil {
+			ids = append(ids, o.Id)
+		}
+	}
+	return ids
+}
+
+// lookupRbacStatements returns the statements for the given IDs, in order,
+// skipping any which no longer exist.
+func lookupRbacStatements(ctx context.Context, client *observe.Client, ids []string) ([]gql.RbacStatement, error) {
+	all, err := client.ListRbacStatements(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byId := make(map[string]*gql.RbacStatement, len(all))
+	for i := range all {
+		byId[all[i].Id] = &all[i]
+	}
+	var result []gql.RbacStatement
+	for _, id := range ids {
+		if s, ok := byId[id]; ok {
+			result = append(result, *s)
+		}
+	}
+	return result, nil
+}
+
+func resourceRbacPolicyApply(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	desired, diags := newRbacPolicyConfig(data)
+	if diags.HasError() {
+		return diags
+	}
+
+	current, err := lookupRbacStatements(ctx, client, rbacPolicyStatementIds(data))
+	if err != nil {
+		return diag.Errorf("failed to read rbacstatements: %s", err.Error())
+	}
+
+	plan := planRbacPolicy(current, desired)
+	if !plan.empty() {
+		result, err := client.MutateRbacStatements(ctx, plan.create, plan.update, plan.delete)
+		if err != nil {
+			return diag.Errorf("failed to apply rbacpolicy: %s", err.Error())
+		}
+		if err := plan.resolve(desired, result.CreatedStatements); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	oids := make([]string, len(plan.ids))
+	for i, id := range plan.ids {
+		oids[i] = oid.RbacStatementOid(id).String()
+	}
+	if err := data.Set("oids", oids); err != nil {
+		return diag.FromErr(err)
+	}
+	return diags
+}
+
+func resourceRbacPolicyCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	if diags = resourceRbacPolicyApply(ctx, data, meta); diags.HasError() {
+		return diags
+	}
+	data.SetId(id.UniqueId())
+	return append(diags, resourceRbacPolicyRead(ctx, data, meta)...)
+}
+
+func resourceRbacPolicyUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	if diags = resourceRbacPolicyApply(ctx, data, meta); diags.HasError() {
+		return diags
+	}
+	return append(diags, resourceRbacPolicyRead(ctx, data, meta)...)
+}
+
+func resourceRbacPolicyRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	var ids []string
+	for _, v := range data.Get("oids").([]interface{}) {
+		if o, err := oid.NewOID(v.(string)); err == nil {
+			ids = append(ids, o.Id)
+		}
+	}
+
+	statements, err := lookupRbacStatements(ctx, client, ids)
+	if err != nil {
+		return diag.Errorf("failed to read rbacpolicy: %s", err.Error())
+	}
+	return rbacPolicyToResourceData(statements, data)
+}
+
+func resourceRbacPolicyDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	current, err := lookupRbacStatements(ctx, client, rbacPolicyStatementIds(data))
+	if err != nil {
+		return diag.Errorf("failed to read rbacstatements: %s", err.Error())
+	}
+	if len(current) == 0 {
+		return diags
+	}
+
+	toDelete := make([]string, len(current))
+	for i, s := range current {
+		toDelete[i] = s.Id
+	}
+	if _, err := client.MutateRbacStatements(ctx, nil, nil, toDelete); err != nil {
+		return diag.Errorf("failed to delete rbacpolicy: %s", err.Error())
+	}
+	return diags
+}
+
+func resourceRbacPolicyImport(ctx context.Context, data *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*observe.Client)
+
+	match, err := newRbacPolicyFilter(data.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	all, err := client.ListRbacStatements(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rbacstatements: %w", err)
+	}
+
+	var oids []string
+	for i := range all {
+		if match(&all[i]) {
+			oids = append(oids, all[i].Oid().String())
+		}
+	}
+	if len(oids) == 0 {
+		return nil, fmt.Errorf("no rbacstatements match filter %q", data.Id())
+	}
+
+	if err := data.Set("oids", oids); err != nil {
+		return nil, err
+	}
+	data.SetId(id.UniqueId())
+	return []*schema.ResourceData{data}, nil
+}
+
+// newRbacPolicyFilter parses an import filter of comma separated key=value
+// pairs. All pairs must match for a statement to be selected.
+func newRbacPolicyFilter(filter string) (func(*gql.RbacStatement) bool, error) {
+	var matchers []func(*gql.RbacStatement) bool
+
+	// user and group may be given as OID or as plain ID
+	idOf := func(v string) string {
+		if o, err := oid.NewOID(v); err == nil {
+			return o.Id
+		}
+		return v
+	}
+	eq := func(p *string, v string) bool {
+		return p != nil && *p == v
+	}
+
+	for _, pair := range strings.Split(filter, ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid rbacpolicy filter %q: expected key=value", pair)
+		}
+		var m func(*gql.RbacStatement) bool
+		switch k {
+		case "subject.user":
+			m = func(s *gql.RbacStatement) bool {
+				return s.Subject.UserId != nil && s.Subject.UserId.String() == idOf(v)
+			}
+		case "subject.group":
+			m = func(s *gql.RbacStatement) bool { return eq(s.Subject.GroupId, idOf(v)) }
+		case "subject.all":
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid rbacpolicy filter %q: %w", pair, err)
+			}
+			m = func(s *gql.RbacStatement) bool { return isTrue(s.Subject.All) == b }
+		case "object.id":
+			m = func(s *gql.RbacStatement) bool { return eq(s.Object.ObjectId, v) }
+		case "object.folder":
+			m = func(s *gql.RbacStatement) bool { return eq(s.Object.FolderId, v) }
+		case "object.workspace":
+			m = func(s *gql.RbacStatement) bool { return eq(s.Object.WorkspaceId, v) }
+		case "object.type":
+			m = func(s *gql.RbacStatement) bool { return eq(s.Object.Type, v) }
+		case "role":
+			m = func(s *gql.RbacStatement) bool { return string(s.Role) == v }
+		case "description":
+			re, err := regexp.Compile(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid rbacpolicy filter %q: %w", pair, err)
+			}
+			m = func(s *gql.RbacStatement) bool { return re.MatchString(s.Description) }
+		default:
+			return nil, fmt.Errorf("invalid rbacpolicy filter %q: unknown key %q", pair, k)
+		}
+		matchers = append(matchers, m)
+	}
+
+	return func(s *gql.RbacStatement) bool {
+		for _, m := range matchers {
+			if !m(s) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+func rbacPolicyToResourceData(statements []gql.RbacStatement, data *schema.ResourceData) (diags diag.Diagnostics) {
+	var (
+		list = make([]interface{}, len(statements))
+		oids = make([]string, len(statements))
+	)
+	for i := range statements {
+		s := &statements[i]
+		list[i] = map[string]interface{}{
+			"description": s.Description,
+			"subject":     []interface{}{flattenRbacSubject(&s.Subject)},
+			"object":      []interface{}{flattenRbacObject(&s.Object)},
+			"role":        string(s.Role),
+		}
+		oids[i] = s.Oid().String()
+	}
+
+	if err := data.Set("statement", list); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("oids", oids); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	return diags
+}
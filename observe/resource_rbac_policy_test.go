@@ -0,0 +1,202 @@
+package observe
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+)
+
+func TestPlanRbacPolicy(t *testing.T) {
+	statement := func(id, group string, role gql.RbacRole) gql.RbacStatement {
+		return gql.RbacStatement{
+			Id:      id,
+			Subject: gql.RbacStatementSubjectRbacSubject{GroupId: &group},
+			Object:  gql.RbacStatementObjectRbacObject{All: boolPtr(true)},
+			Role:    role,
+		}
+	}
+	input := func(group string, role gql.RbacRole) gql.RbacStatementInput {
+		return gql.RbacStatementInput{
+			Subject: gql.RbacSubjectInput{GroupId: &group, All: boolPtr(false)},
+			Object:  gql.RbacObjectInput{All: boolPtr(true), Owner: boolPtr(false)},
+			Role:    role,
+		}
+	}
+
+	testcases := []struct {
+		current    []gql.RbacStatement
+		desired    []gql.RbacStatementInput
+		ids        []string
+		numCreate  int
+		updatedIds []string
+		deletedIds []string
+	}{
+		{
+			// unchanged, regardless of order
+			current: []gql.RbacStatement{statement("1", "a", gql.RbacRoleViewer), statement("2", "b", gql.RbacRoleEditor)},
+			desired: []gql.RbacStatementInput{input("b", gql.RbacRoleEditor), input("a", gql.RbacRoleViewer)},
+			ids:     []string{"2", "1"},
+		},
+		{
+			// changed statements are updated in place
+			current:    []gql.RbacStatement{statement("1", "a", gql.RbacRoleViewer), statement("2", "b", gql.RbacRoleEditor)},
+			desired:    []gql.RbacStatementInput{input("a", gql.RbacRoleViewer), input("b", gql.RbacRoleViewer)},
+			ids:        []string{"1", "2"},
+			updatedIds: []string{"2"},
+		},
+		{
+			// surplus statements are created or deleted
+			current:    []gql.RbacStatement{statement("1", "a", gql.RbacRoleViewer), statement("2", "b", gql.RbacRoleEditor)},
+			desired:    []gql.RbacStatementInput{input("c", gql.RbacRoleViewer)},
+			ids:        []string{"1"},
+			updatedIds: []string{"1"},
+			deletedIds: []string{"2"},
+		},
+		{
+			current:   []gql.RbacStatement{statement("1", "a", gql.RbacRoleViewer)},
+			desired:   []gql.RbacStatementInput{input("a", gql.RbacRoleViewer), input("b", gql.RbacRoleViewer)},
+			ids:       []string{"1", ""},
+			numCreate: 1,
+		},
+	}
+
+	for i, tc := range testcases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			p := planRbacPolicy(tc.current, tc.desired)
+			if !reflect.DeepEqual(p.ids, tc.ids) {
+				t.Errorf("expected ids %v, got %v", tc.ids, p.ids)
+			}
+			if len(p.create) != tc.numCreate {
+				t.Errorf("expected %d creates, got %d", tc.numCreate, len(p.create))
+			}
+			var updatedIds []string
+			for _, u := range p.update {
+				updatedIds = append(updatedIds, u.Id)
+			}
+			if !reflect.DeepEqual(updatedIds, tc.updatedIds) {
+				t.Errorf("expected updates %v, got %v", tc.updatedIds, updatedIds)
+			}
+			if fmt.Sprint(p.delete) != fmt.Sprint(tc.deletedIds) {
+				t.Errorf("expected deletes %v, got %v", tc.deletedIds, p.delete)
+			}
+		})
+	}
+}
+
+func TestAccObserveRbacPolicy(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+`
+				resource "observe_rbac_group" "example" {
+				  name = "%[1]s"
+				}
+
+				resource "observe_rbac_policy" "example" {
+				  statement {
+				    description = "%[1]s"
+				    subject {
+				      group = observe_rbac_group.example.oid
+				    }
+				    object {
+				      workspace = data.observe_workspace.default.id
+				    }
+				    role = "Lister"
+				  }
+
+				  statement {
+				    description = "%[1]s"
+				    subject {
+				      group = observe_rbac_group.example.oid
+				    }
+				    object {
+				      type = "dataset"
+				    }
+				    role = "Viewer"
+				  }
+				}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_rbac_policy.example", "statement.#", "2"),
+					resource.TestCheckResourceAttr("observe_rbac_policy.example", "statement.0.role", "Lister"),
+					resource.TestCheckResourceAttr("observe_rbac_policy.example", "statement.1.object.0.type", "dataset"),
+					resource.TestCheckResourceAttr("observe_rbac_policy.example", "oids.#", "2"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(configPreamble+`
+				resource "observe_rbac_group" "example" {
+				  name = "%[1]s"
+				}
+
+				resource "observe_rbac_policy" "example" {
+				  statement {
+				    description = "%[1]s"
+				    subject {
+				      group = observe_rbac_group.example.oid
+				    }
+				    object {
+				      type = "dataset"
+				    }
+				    role = "Editor"
+				  }
+				}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_rbac_policy.example", "statement.#", "1"),
+					resource.TestCheckResourceAttr("observe_rbac_policy.example", "statement.0.role", "Editor"),
+					resource.TestCheckResourceAttr("observe_rbac_policy.example", "oids.#", "1"),
+				),
+			},
+			{
+				ResourceName:  "observe_rbac_policy.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("description=^%s$", randomPrefix),
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 state, got %d", len(states))
+					}
+					attrs := states[0].Attributes
+					if attrs["statement.#"] != "1" || attrs["statement.0.role"] != "Editor" {
+						return fmt.Errorf("unexpected imported statements: %v", attrs)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestNewRbacPolicyFilter(t *testing.T) {
+	if _, err := newRbacPolicyFilter("role"); err == nil || !strings.Contains(err.Error(), "expected key=value") {
+		t.Errorf("expected missing value error, got %v", err)
+	}
+	if _, err := newRbacPolicyFilter("color=red"); err == nil {
+		t.Error("expected unknown key error")
+	}
+
+	match, err := newRbacPolicyFilter("subject.group=o:::rbacgroup:123, role=Viewer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	group := "123"
+	s := gql.RbacStatement{Subject: gql.RbacStatementSubjectRbacSubject{GroupId: &group}, Role: gql.RbacRoleViewer}
+	if !match(&s) {
+		t.Error("expected statement to match")
+	}
+	s.Role = gql.RbacRoleEditor
+	if match(&s) {
+		t.Error("expected statement not to match")
+	}
+}
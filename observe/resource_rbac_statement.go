@@ -53,82 +53,13 @@ func resourceRbacStatement() *schema.Resource {
 				Type:     schema.TypeList,
 				Required: true,
 				MaxItems: 1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"user": {
-							Type:             schema.TypeString,
-							ExactlyOneOf:     []string{"subject.0.user", "subject.0.group", "subject.0.all"},
-							Optional:         true,
-							ValidateDiagFunc: validateOID(oid.TypeUser),
-							Description:      schemaRbacStatementSubjectUserDescription,
-						},
-						"group": {
-							Type:             schema.TypeString,
-							ExactlyOneOf:     []string{"subject.0.user", "subject.0.group", "subject.0.all"},
-							Optional:         true,
-							ValidateDiagFunc: validateOID(oid.TypeRbacGroup),
-							Description:      schemaRbacStatementSubjectGroupDescription,
-						},
-						"all": {
-							Type:         schema.TypeBool,
-							ExactlyOneOf: []string{"subject.0.user", "subject.0.group", "subject.0.all"},
-							Optional:     true,
-							Default:      false,
-						},
-					},
-				},
+				Elem:     rbacStatementSubjectResource(true),
 			},
 			"object": {
 				Type:     schema.TypeList,
 				Required: true,
 				MaxItems: 1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"id": {
-							Type:         schema.TypeString,
-							ExactlyOneOf: rbacStatementObjectTypes,
-							Optional:     true,
-							Description:  schemaRbacStatementObjectIdDescription,
-						},
-						"folder": {
-							Type:         schema.TypeString,
-							ExactlyOneOf: rbacStatementObjectTypes,
-							Optional:     true,
-							Description:  schemaRbacStatementObjectFolderDescription,
-						},
-						"workspace": {
-							Type:         schema.TypeString,
-							ExactlyOneOf: rbacStatementObjectTypes,
-							Optional:     true,
-							Description:  schemaRbacStatementObjectWorkspaceDescription,
-						},
-						"type": {
-							Type:         schema.TypeString,
-							ExactlyOneOf: rbacStatementObjectTypes,
-							Optional:     true,
-							Description:  schemaRbacStatementObjectTypeDescription,
-						},
-						"name": {
-							Type:         schema.TypeString,
-							RequiredWith: []string{"object.0.type"},
-							Optional:     true,
-							Description:  schemaRbacStatementObjectNameDescription,
-						},
-						"owner": {
-							Type:         schema.TypeBool,
-							RequiredWith: []string{"object.0.type"},
-							Optional:     true,
-							Default:      false,
-							Description:  schemaRbacStatementObjectOwnerDescription,
-						},
-						"all": {
-							Type:         schema.TypeBool,
-							ExactlyOneOf: rbacStatementObjectTypes,
-							Optional:     true,
-							Default:      false,
-						},
-					},
-				},
+				Elem:     rbacStatementObjectResource(true),
 			},
 			"role": {
 				Type:             schema.TypeString,
@@ -143,6 +74,99 @@ func resourceRbacStatement() *schema.Resource {
 	}
 }
 
+// rbacStatementSubjectResource returns the subject block schema. Exclusivity
+// between attributes can only be enforced by the SDK on top-level blocks, so
+// nested usages pass validate=false and check it themselves.
+func rbacStatementSubjectResource(validate bool) *schema.Resource {
+	var exactlyOneOf []string
+	if validate {
+		exactlyOneOf = []string{"subject.0.user", "subject.0.group", "subject.0.all"}
+	}
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"user": {
+				Type:             schema.TypeString,
+				ExactlyOneOf:     exactlyOneOf,
+				Optional:         true,
+				ValidateDiagFunc: validateOID(oid.TypeUser),
+				Description:      schemaRbacStatementSubjectUserDescription,
+			},
+			"group": {
+				Type:             schema.TypeString,
+				ExactlyOneOf:     exactlyOneOf,
+				Optional:         true,
+				ValidateDiagFunc: validateOID(oid.TypeRbacGroup),
+				Description:      schemaRbacStatementSubjectGroupDescription,
+			},
+			"all": {
+				Type:         schema.TypeBool,
+				ExactlyOneOf: exactlyOneOf,
+				Optional:     true,
+				Default:      false,
+			},
+		},
+	}
+}
+
+// rbacStatementObjectResource returns the object block schema.
+func rbacStatementObjectResource(validate bool) *schema.Resource {
+	var (
+		exactlyOneOf []string
+		requiredWith []string
+	)
+	if validate {
+		exactlyOneOf = rbacStatementObjectTypes
+		requiredWith = []string{"object.0.type"}
+	}
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				ExactlyOneOf: exactlyOneOf,
+				Optional:     true,
+				Description:  schemaRbacStatementObjectIdDescription,
+			},
+			"folder": {
+				Type:         schema.TypeString,
+				ExactlyOneOf: exactlyOneOf,
+				Optional:     true,
+				Description:  schemaRbacStatementObjectFolderDescription,
+			},
+			"workspace": {
+				Type:         schema.TypeString,
+				ExactlyOneOf: exactlyOneOf,
+				Optional:     true,
+				Description:  schemaRbacStatementObjectWorkspaceDescription,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				ExactlyOneOf: exactlyOneOf,
+				Optional:     true,
+				Description:  schemaRbacStatementObjectTypeDescription,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				RequiredWith: requiredWith,
+				Optional:     true,
+				Description:  schemaRbacStatementObjectNameDescription,
+			},
+			"owner": {
+				Type:         schema.TypeBool,
+				RequiredWith: requiredWith,
+				Optional:     true,
+				Default:      false,
+				Description:  schemaRbacStatementObjectOwnerDescription,
+			},
+			"all": {
+				Type:         schema.TypeBool,
+				ExactlyOneOf: exactlyOneOf,
+				Optional:     true,
+				Default:      false,
+			},
+		},
+	}
+}
+
 func newRbacStatementConfig(data *schema.ResourceData) (input *gql.RbacStatementInput, diags diag.Diagnostics) {
 	input = &gql.RbacStatementInput{}
 
@@ -150,13 +174,13 @@ func newRbacStatementConfig(data *schema.ResourceData) (input *gql.RbacStatement
 		input.Description = v.(string)
 	}
 
-	subject, err := newRbacSubjectInput(data)
+	subject, err := newRbacSubjectInput(data, "")
 	if err != nil {
 		return nil, diag.Errorf(err.Error())
 	}
 	input.Subject = subject
 
-	object, err := newRbacObjectInput(data)
+	object, err := newRbacObjectInput(data, "")
 	if err != nil {
 		return nil, diag.Errorf(err.Error())
 	}
@@ -168,9 +192,10 @@ func newRbacStatementConfig(data *schema.ResourceData) (input *gql.RbacStatement
 	return
 }
 
-func newRbacSubjectInput(data *schema.ResourceData) (gql.RbacSubjectInput, error) {
+// newRbacSubjectInput reads the subject block found under prefix.
+func newRbacSubjectInput(data *schema.ResourceData, prefix string) (gql.RbacSubjectInput, error) {
 	subject := gql.RbacSubjectInput{}
-	if v, ok := data.GetOk("subject.0.user"); ok {
+	if v, ok := data.GetOk(prefix + "subject.0.user"); ok {
 		subUser, _ := oid.NewOID(v.(string))
 		uid, err := types.StringToUserIdScalar(subUser.Id)
 		if err != nil {
@@ -178,33 +203,34 @@ func newRbacSubjectInput(data *schema.ResourceData) (gql.RbacSubjectInput, error
 		}
 		subject.UserId = &uid
 	}
-	if v, ok := data.GetOk("subject.0.group"); ok {
+	if v, ok := data.GetOk(prefix + "subject.0.group"); ok {
 		subGroup, _ := oid.NewOID(v.(string))
 		subject.GroupId = &subGroup.Id
 	}
-	subject.All = boolPtr(data.Get("subject.0.all").(bool))
+	subject.All = boolPtr(data.Get(prefix + "subject.0.all").(bool))
 	return subject, nil
 }
 
-func newRbacObjectInput(data *schema.ResourceData) (gql.RbacObjectInput, error) {
+// newRbacObjectInput reads the object block found under prefix.
+func newRbacObjectInput(data *schema.ResourceData, prefix string) (gql.RbacObjectInput, error) {
 	object := gql.RbacObjectInput{}
-	if v, ok := data.GetOk("object.0.id"); ok {
+	if v, ok := data.GetOk(prefix + "object.0.id"); ok {
 		object.ObjectId = stringPtr(v.(string))
 	}
-	if v, ok := data.GetOk("object.0.folder"); ok {
+	if v, ok := data.GetOk(prefix + "object.0.folder"); ok {
 		object.FolderId = stringPtr(v.(string))
 	}
-	if v, ok := data.GetOk("object.0.workspace"); ok {
+	if v, ok := data.GetOk(prefix + "object.0.workspace"); ok {
 		object.WorkspaceId = stringPtr(v.(string))
 	}
-	if v, ok := data.GetOk("object.0.type"); ok {
+	if v, ok := data.GetOk(prefix + "object.0.type"); ok {
 		object.Type = stringPtr(v.(string))
-		if oname, ok := data.GetOk("object.0.name"); ok {
+		if oname, ok := data.GetOk(prefix + "object.0.name"); ok {
 			object.Name = stringPtr(oname.(string))
 		}
 	}
-	object.Owner = boolPtr(data.Get("object.0.owner").(bool))
-	object.All = boolPtr(data.Get("object.0.all").(bool))
+	object.Owner = boolPtr(data.Get(prefix + "object.0.owner").(bool))
+	object.All = boolPtr(data.Get(prefix + "object.0.all").(bool))
 	return object, nil
 }
 
@@ -265,39 +291,11 @@ func rbacStatementToResourceData(r *gql.RbacStatement, data *schema.ResourceData
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
-	// subject
-	subject := make(map[string]interface{}, 0)
-	if r.Subject.UserId != nil {
-		subject["user"] = oid.UserOid(*r.Subject.UserId).String()
-	} else if r.Subject.GroupId != nil {
-		subject["group"] = oid.RbacGroupOid(*r.Subject.GroupId).String()
-	} else if r.Subject.All != nil {
-		subject["all"] = *r.Subject.All
-	}
-	if err := data.Set("subject", []interface{}{subject}); err != nil {
+	if err := data.Set("subject", []interface{}{flattenRbacSubject(&r.Subject)}); err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
-	// object
-	object := make(map[string]interface{}, 0)
-	if r.Object.ObjectId != nil {
-		object["id"] = *r.Object.ObjectId
-	} else if r.Object.FolderId != nil {
-		object["folder"] = *r.Object.FolderId
-	} else if r.Object.WorkspaceId != nil {
-		object["workspace"] = *r.Object.WorkspaceId
-	} else if r.Object.Type != nil {
-		object["type"] = *r.Object.Type
-		if r.Object.Name != nil {
-			object["name"] = *r.Object.Name
-		}
-		if r.Object.Owner != nil {
-			object["owner"] = *r.Object.Owner
-		}
-	} else if r.Object.All != nil {
-		object["all"] = *r.Object.All
-	}
-	if err := data.Set("object", []interface{}{object}); err != nil {
+	if err := data.Set("object", []interface{}{flattenRbacObject(&r.Object)}); err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
@@ -311,3 +309,37 @@ func rbacStatementToResourceData(r *gql.RbacStatement, data *schema.ResourceData
 	}
 	return diags
 }
+
+func flattenRbacSubject(s *gql.RbacStatementSubjectRbacSubject) map[string]interface{} {
+	subject := make(map[string]interface{}, 0)
+	if s.UserId != nil {
+		subject["user"] = oid.UserOid(*s.UserId).String()
+	} else if s.GroupId != nil {
+		subject["group"] = oid.RbacGroupOid(*s.GroupId).String()
+	} else if s.All != nil {
+		subject["all"] = *s.All
+	}
+	return subject
+}
+
+func flattenRbacObject(o *gql.RbacStatementObjectRbacObject) map[string]interface{} {
+	object := make(map[string]interface{}, 0)
+	if o.ObjectId != nil {
+		object["id"] = *o.ObjectId
+	} else if o.FolderId != nil {
+		object["folder"] = *o.FolderId
+	} else if o.WorkspaceId != nil {
+		object["workspace"] = *o.WorkspaceId
+	} else if o.Type != nil {
+		object["type"] = *o.Type
+		if o.Name != nil {
+			object["name"] = *o.Name
+		}
+		if o.Owner != nil {
+			object["owner"] = *o.Owner
+		}
+	} else if o.All != nil {
+		object["all"] = *o.All
+	}
+	return object
+}
@@ -11,6 +11,7 @@ import (
 	gql "github.com/observeinc/terraform-provider-observe/client/meta"
 	"github.com/observeinc/terraform-provider-observe/client/meta/types"
 	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
 )
 
 var sourceDatasetFieldResource = &schema.Resource{
@@ -131,6 +132,16 @@ func resourceSourceDataset() *schema.Resource {
 				ValidateDiagFunc: validateTimeDuration,
 				DiffSuppressFunc: diffSuppressDuration,
 			},
+			"delete_protection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: descriptions.Get("dataset", "schema", "delete_protection"),
+			},
+			"force_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: descriptions.Get("dataset", "schema", "force_delete"),
+			},
 		},
 	}
 }
@@ -377,8 +388,15 @@ func resourceSourceDatasetUpdate(ctx context.Context, data *schema.ResourceData,
 
 func resourceSourceDatasetDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	client := meta.(*observe.Client)
+	end := client.BeginDelete(oid.DatasetOid(data.Id()))
+	if diags := datasetDeleteProtection(ctx, client, data); diags.HasError() {
+		end(false)
+		return diags
+	}
 	if err := client.DeleteDataset(ctx, data.Id()); err != nil {
+		end(false)
 		return diag.Errorf("failed to delete dataset: %s", err)
 	}
+	end(true)
 	return diags
 }
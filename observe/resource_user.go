@@ -0,0 +1,214 @@
+package observe
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+)
+
+const (
+	schemaUserResourceEmailDescription = "User email. The user is invited to Observe at this address on creation."
+	schemaUserLabelDescription         = "User display name."
+	schemaUserRoleDescription          = "User role. Admin privilege is required to change it."
+	schemaUserDisabledDescription      = "Disable the user, preventing them from logging in."
+	schemaUserStatusDescription        = "User status. One of `Created` (invited, but not signed up yet), `Active`, `Disabled`, `IdpDisabled` or `Deleted`."
+)
+
+func resourceUser() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages an Observe user. Users are invited by email on creation, " +
+			"and deactivated rather than deleted on destroy. Creating a user for the " +
+			"email of a previously deactivated user reactivates them.",
+		CreateContext: resourceUserCreate,
+		UpdateContext: resourceUserUpdate,
+		ReadContext:   resourceUserRead,
+		DeleteContext: resourceUserDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"email": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+				Description:      schemaUserResourceEmailDescription,
+			},
+			"label": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: schemaUserLabelDescription,
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: schemaUserRoleDescription,
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: schemaUserCommentDescription,
+			},
+			"disabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: schemaUserDisabledDescription,
+			},
+			"oid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: schemaUserOIDDescription,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: schemaUserStatusDescription,
+			},
+		},
+	}
+}
+
+func userStatusString(s gql.UserStatus) string {
+	return strings.TrimPrefix(string(s), "UserStatus")
+}
+
+func userIsInactive(u *gql.User) bool {
+	return u.Status == gql.UserStatusDisabled || u.Status == gql.UserStatusDeleted
+}
+
+func newUserConfig(data *schema.ResourceData) (input *gql.UserInput, diags diag.Diagnostics) {
+	input = &gql.UserInput{}
+	if v, ok := data.GetOk("label"); ok {
+		input.Label = stringPtr(v.(string))
+	}
+	if v, ok := data.GetOk("role"); ok {
+		input.Role = stringPtr(v.(string))
+	}
+	input.Comment = stringPtr(data.Get("comment").(string))
+
+	status := gql.UserStatusActive
+	if data.Get("disabled").(bool) {
+		status = gql.UserStatusDisabled
+	}
+	input.Status = &status
+	return
+}
+
+func resourceUserCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+	email := data.Get("email").(string)
+
+	config, diags := newUserConfig(data)
+	if diags.HasError() {
+		return diags
+	}
+
+	users, err := client.ListUsers(ctx)
+	if err != nil {
+		return diag.Errorf("failed to list users: %s", err.Error())
+	}
+
+	var existing *gql.User
+	for i, u := range users {
+		if u.Email == email {
+			existing = &users[i]
+			break
+		}
+	}
+
+	var result *gql.User
+	switch {
+	case existing == nil:
+		invite := *config
+		invite.Email = &email
+		// a freshly invited user has status Created until they sign up
+		invite.Status = nil
+		if result, err = client.InviteUser(ctx, &invite); err != nil {
+			return diag.Errorf("failed to invite user: %s", err.Error())
+		}
+		if data.Get("disabled").(bool) {
+			if result, err = client.UpdateUser(ctx, result.Id.String(), config); err != nil {
+				return diag.Errorf("failed to disable user: %s", err.Error())
+			}
+		}
+	case userIsInactive(existing):
+		if result, err = client.UpdateUser(ctx, existing.Id.String(), config); err != nil {
+			return diag.Errorf("failed to reactivate user: %s", err.Error())
+		}
+	default:
+		return diag.Errorf("user %q already exists [id=%s], import it instead", email, existing.Id.String())
+	}
+
+	data.SetId(result.Id.String())
+	return append(diags, resourceUserRead(ctx, data, meta)...)
+}
+
+func resourceUserUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	config, diags := newUserConfig(data)
+	if diags.HasError() {
+		return diags
+	}
+
+	if !data.HasChange("disabled") && data.Get("status").(string) == userStatusString(gql.UserStatusCreated) {
+		// do not flip pending invitations to active
+		config.Status = nil
+	}
+
+	if _, err := client.UpdateUser(ctx, data.Id(), config); err != nil {
+		return diag.Errorf("failed to update user: %s", err.Error())
+	}
+	return append(diags, resourceUserRead(ctx, data, meta)...)
+}
+
+func resourceUserRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	u, err := client.GetUser(ctx, data.Id())
+	if err != nil {
+		return diag.Errorf("failed to read user: %s", err.Error())
+	}
+
+	if u == nil || u.Status == gql.UserStatusDeleted {
+		data.SetId("")
+		return diags
+	}
+
+	if err := data.Set("label", u.Label); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("role", u.Role); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("disabled", u.Status == gql.UserStatusDisabled); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("status", userStatusString(u.Status)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return append(diags, userToResourceData(u, data)...)
+}
+
+func resourceUserDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	status := gql.UserStatusDisabled
+	if _, err := client.UpdateUser(ctx, data.Id(), &gql.UserInput{Status: &status}); err != nil {
+		return diag.Errorf("failed to deactivate user: %s", err.Error())
+	}
+	return diags
+}
@@ -0,0 +1,132 @@
+package observe
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func resourceUserFeatureFlag() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("user_feature_flag", "description"),
+		CreateContext: resourceUserFeatureFlagCreate,
+		UpdateContext: resourceUserFeatureFlagUpdate,
+		ReadContext:   resourceUserFeatureFlagRead,
+		DeleteContext: resourceUserFeatureFlagDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUserFeatureFlagImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateIsString(),
+				Description:      descriptions.Get("user_feature_flag", "schema", "name"),
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: descriptions.Get("user_feature_flag", "schema", "enabled"),
+			},
+			// computed values
+			"user": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("user_feature_flag", "schema", "user"),
+			},
+			"initial_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: descriptions.Get("user_feature_flag", "schema", "initial_enabled"),
+			},
+		},
+	}
+}
+
+func resourceUserFeatureFlagCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	name := data.Get("name").(string)
+	current, err := client.GetFeatureFlag(ctx, name)
+	if err != nil {
+		return diag.Errorf("failed to read feature flag: %s", err.Error())
+	}
+
+	if _, err := client.UpdateFeatureFlagForUser(ctx, name, data.Get("enabled").(bool)); err != nil {
+		return diag.Errorf("failed to update feature flag: %s", err.Error())
+	}
+
+	data.SetId(name)
+	if err := data.Set("initial_enabled", current.IsEnabled); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	return append(diags, resourceUserFeatureFlagRead(ctx, data, meta)...)
+}
+
+func resourceUserFeatureFlagUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+	if _, err := client.UpdateFeatureFlagForUser(ctx, data.Id(), data.Get("enabled").(bool)); err != nil {
+		return diag.Errorf("failed to update feature flag: %s", err.Error())
+	}
+	return resourceUserFeatureFlagRead(ctx, data, meta)
+}
+
+func resourceUserFeatureFlagRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	flag, err := client.GetFeatureFlag(ctx, data.Id())
+	if err != nil {
+		if gql.HasErrorCode(err, "NOT_FOUND") {
+			data.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to read feature flag: %s", err.Error())
+	}
+
+	user, err := client.GetCurrentUser(ctx)
+	if err != nil {
+		return diag.Errorf("failed to read current user: %s", err.Error())
+	}
+
+	if err := data.Set("name", flag.Name); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("enabled", flag.IsEnabled); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("user", user.Oid().String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	return diags
+}
+
+func resourceUserFeatureFlagDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+	if _, err := client.UpdateFeatureFlagForUser(ctx, data.Id(), data.Get("initial_enabled").(bool)); err != nil {
+		return diag.Errorf("failed to restore feature flag: %s", err.Error())
+	}
+	return diags
+}
+
+// resourceUserFeatureFlagImport treats the value at import time as the value
+// to restore on destroy.
+func resourceUserFeatureFlagImport(ctx context.Context, data *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*observe.Client)
+
+	flag, err := client.GetFeatureFlag(ctx, data.Id())
+	if err != nil {
+		return nil, err
+	}
+	if err := data.Set("initial_enabled", flag.IsEnabled); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{data}, nil
+}
@@ -0,0 +1,52 @@
+package observe
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveUserFeatureFlag(t *testing.T) {
+	// flags differ across tenants, so a self service flag must be provided
+	name := os.Getenv("OBSERVE_TEST_FEATURE_FLAG")
+	if name == "" {
+		t.Skip("OBSERVE_TEST_FEATURE_FLAG must be set to a self service feature flag")
+	}
+
+	config := `
+	resource "observe_user_feature_flag" "example" {
+		name    = "%s"
+		enabled = %t
+	}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(config, name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_user_feature_flag.example", "name", name),
+					resource.TestCheckResourceAttr("observe_user_feature_flag.example", "enabled", "true"),
+					resource.TestCheckResourceAttrSet("observe_user_feature_flag.example", "user"),
+					resource.TestCheckResourceAttrSet("observe_user_feature_flag.example", "initial_enabled"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(config, name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_user_feature_flag.example", "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      "observe_user_feature_flag.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the value at import time is restored on destroy
+				ImportStateVerifyIgnore: []string{"initial_enabled"},
+			},
+		},
+	})
+}
@@ -0,0 +1,63 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveUserResource(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+	email := randomPrefix + "@example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "observe_user" "example" {
+				  email   = "%s"
+				  label   = "%s"
+				  comment = "managed by terraform"
+				}`, email, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("observe_user.example", "oid"),
+					resource.TestCheckResourceAttr("observe_user.example", "email", email),
+					resource.TestCheckResourceAttr("observe_user.example", "label", randomPrefix),
+					resource.TestCheckResourceAttr("observe_user.example", "status", "Created"),
+					resource.TestCheckResourceAttr("observe_user.example", "disabled", "false"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+				resource "observe_user" "example" {
+				  email    = "%s"
+				  label    = "%s-renamed"
+				  disabled = true
+				}
+
+				data "observe_users" "disabled" {
+				  email_regex = "^%s"
+				  status      = ["Disabled"]
+
+				  depends_on = [observe_user.example]
+				}`, email, randomPrefix, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_user.example", "label", randomPrefix+"-renamed"),
+					resource.TestCheckResourceAttr("observe_user.example", "status", "Disabled"),
+					resource.TestCheckResourceAttr("data.observe_users.disabled", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.observe_users.disabled", "users.0.email", email),
+					resource.TestCheckResourceAttrPair("data.observe_users.disabled", "oids.0", "observe_user.example", "oid"),
+				),
+			},
+			{
+				ResourceName:      "observe_user.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
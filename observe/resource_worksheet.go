@@ -12,6 +12,7 @@ import (
 	gql "github.com/observeinc/terraform-provider-observe/client/meta"
 	"github.com/observeinc/terraform-provider-observe/client/meta/types"
 	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
 )
 
 const (
@@ -20,6 +21,11 @@ const (
 	schemaWorksheetIconDescription      = "Icon image."
 	schemaWorksheetJSONDescription      = "Worksheet definition in JSON format."
 	schemaWorksheetOIDDescription       = "The Observe ID for worksheet."
+
+	schemaWorksheetInputsDescription             = "The inputs map binds dataset OIDs to labels which can be referenced within stage pipelines. Required when using `stage` blocks."
+	schemaWorksheetStageDescription              = "Worksheet stages, as an alternative to `queries`. A stage processes an input according to the provided pipeline. If no input is provided, a stage will implicitly follow on from the result of its predecessor."
+	schemaWorksheetStageVisualizationDescription = "How the stage results are presented, e.g. `table` or `timeseries`."
+	schemaWorksheetStageLabelDescription         = "Label displayed for the stage."
 )
 
 func resourceWorksheet() *schema.Resource {
@@ -32,6 +38,7 @@ func resourceWorksheet() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceWorksheetCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"workspace": {
 				Type:             schema.TypeString,
@@ -51,11 +58,62 @@ func resourceWorksheet() *schema.Resource {
 			},
 			"queries": {
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"queries", "stage"},
 				ValidateDiagFunc: validateStringIsJSON,
 				DiffSuppressFunc: diffSuppressStageQueryInput,
 				Description:      schemaWorksheetJSONDescription,
 			},
+			"inputs": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				RequiredWith:     []string{"stage"},
+				ValidateDiagFunc: validateMapValues(validateOID()),
+				Description:      schemaWorksheetInputsDescription,
+			},
+			"stage": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				RequiredWith: []string{"inputs"},
+				Description:  schemaWorksheetStageDescription,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alias": {
+							Type:     schema.TypeString,
+							Optional: true,
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								// ignore alias for last stage, because it won't be set anyway
+								stage := d.Get("stage").([]interface{})
+								return k == fmt.Sprintf("stage.%d.alias", len(stage)-1)
+							},
+							Description: descriptions.Get("transform", "schema", "stage", "alias"),
+						},
+						"input": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: descriptions.Get("transform", "schema", "stage", "input"),
+						},
+						"pipeline": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: diffSuppressPipeline,
+							Description:      descriptions.Get("transform", "schema", "stage", "pipeline"),
+						},
+						"label": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: schemaWorksheetStageLabelDescription,
+						},
+						"visualization": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "table",
+							Description: schemaWorksheetStageVisualizationDescription,
+						},
+					},
+				},
+			},
 			"oid": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -65,7 +123,9 @@ func resourceWorksheet() *schema.Resource {
 	}
 }
 
-func newWorksheetConfig(data *schema.ResourceData) (input *gql.WorksheetInput, diags diag.Diagnostics) {
+// newWorksheetConfig builds the worksheet input. Stage layouts are merged with
+// the layouts of current stages, if any, to preserve UI state.
+func newWorksheetConfig(data *schema.ResourceData, current []gql.StageQuery) (input *gql.WorksheetInput, diags diag.Diagnostics) {
 	input = &gql.WorksheetInput{
 		Name: stringPtr(data.Get("name").(string)),
 	}
@@ -74,7 +134,24 @@ func newWorksheetConfig(data *schema.ResourceData) (input *gql.WorksheetInput, d
 		input.Icon = stringPtr(v.(string))
 	}
 
-	if v, ok := data.GetOk("queries"); ok {
+	if _, ok := data.GetOk("stage"); ok {
+		query, diags := newQuery(data)
+		if diags.HasError() {
+			return nil, diags
+		}
+		currentLayouts := currentWorksheetStageLayouts(current, query.Stages)
+		for i := range query.Stages {
+			layout, err := mergeWorksheetStageLayout(currentLayouts[i], worksheetStageLayout{
+				Type:  data.Get(fmt.Sprintf("stage.%d.visualization", i)).(string),
+				Label: data.Get(fmt.Sprintf("stage.%d.label", i)).(string),
+			})
+			if err != nil {
+				return nil, diag.Errorf("stage-%d: %s", i, err)
+			}
+			query.Stages[i].Layout = layout
+		}
+		input.Stages = query.Stages
+	} else if v, ok := data.GetOk("queries"); ok {
 		data := v.(string)
 		if err := json.Unmarshal([]byte(data), &input.Stages); err != nil {
 			diagErr := fmt.Errorf("failed to parse 'queries' request field: %w", err)
@@ -118,6 +195,19 @@ func worksheetToResourceData(d *gql.Worksheet, data *schema.ResourceData) (diags
 		} else if err := data.Set("queries", string(stagesRaw)); err != nil {
 			diags = append(diags, diag.FromErr(err)...)
 		}
+
+		// only flatten into stage blocks when they are in use, so that imported
+		// and JSON-managed worksheets are left untouched. The data source shares
+		// this function but has no stage blocks.
+		if v, ok := data.Get("stage").([]interface{}); ok && len(v) > 0 {
+			if err := flattenWorksheetStages(data, d.Stages); err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "worksheet queries cannot be represented by 'stage' blocks",
+					Detail:   err.Error(),
+				})
+			}
+		}
 	}
 
 	if err := data.Set("oid", d.Oid().String()); err != nil {
@@ -127,9 +217,131 @@ func worksheetToResourceData(d *gql.Worksheet, data *schema.ResourceData) (diags
 	return diags
 }
 
+// worksheetStageLayout holds the presentation settings of a stage, which are
+// stored alongside other UI state in the stage layout.
+type worksheetStageLayout struct {
+	Type  string `json:"type,omitempty"`
+	Label string `json:"label,omitempty"`
+}
+
+// currentWorksheetStageLayouts returns the layout of the current stage
+// matching each of stages, if any. Stages are matched by ID, and any remaining
+// stages are paired up in order, since stage blocks are assigned IDs by index
+// whereas the UI generates its own.
+func currentWorksheetStageLayouts(current []gql.StageQuery, stages []gql.StageQueryInput) []*types.JsonObject {
+	byId := make(map[string]int, len(current))
+	for i, stage := range current {
+		if stage.Id != nil {
+			byId[*stage.Id] = i
+		}
+	}
+
+	layouts := make([]*types.JsonObject, len(stages))
+	matched := make([]bool, len(stages))
+	claimed := make(map[int]bool, len(current))
+	for i, stage := range stages {
+		if stage.Id == nil {
+			continue
+		}
+		if j, ok := byId[*stage.Id]; ok && !claimed[j] {
+			layouts[i], matched[i], claimed[j] = current[j].Layout, true, true
+		}
+	}
+	next := 0
+	for i := range stages {
+		if matched[i] {
+			continue
+		}
+		for next < len(current) && claimed[next] {
+			next++
+		}
+		if next == len(current) {
+			break
+		}
+		layouts[i], claimed[next] = current[next].Layout, true
+	}
+	return layouts
+}
+
+// mergeWorksheetStageLayout sets the presentation settings managed by
+// Terraform on an existing stage layout, leaving all other keys untouched.
+// Unset settings are removed from the layout.
+func mergeWorksheetStageLayout(existing *types.JsonObject, layout worksheetStageLayout) (*types.JsonObject, error) {
+	merged := make(map[string]interface{})
+	if existing != nil && *existing != "" {
+		if err := json.Unmarshal([]byte(*existing), &merged); err != nil {
+			return nil, fmt.Errorf("failed to parse existing layout: %w", err)
+		}
+		if merged == nil {
+			// layout was JSON null
+			merged = make(map[string]interface{})
+		}
+	}
+	for k, v := range map[string]string{"type": layout.Type, "label": layout.Label} {
+		if v == "" {
+			delete(merged, k)
+		} else {
+			merged[k] = v
+		}
+	}
+	b, err := json.Marshal(merged)
+	if err != nil {
+		return nil, err
+	}
+	return types.JsonObject(b).Ptr(), nil
+}
+
+func flattenWorksheetStages(data *schema.ResourceData, gqlStages []gql.StageQuery) error {
+	queryData, err := flattenQuery(gqlStages, "")
+	if err != nil {
+		return err
+	}
+
+	if err := data.Set("inputs", flattenQueryInputs(data, queryData)); err != nil {
+		return err
+	}
+
+	stages := make([]interface{}, len(queryData.Stages))
+	for i, stage := range queryData.Stages {
+		var layout worksheetStageLayout
+		if l := gqlStages[i].Layout; l != nil && *l != "" {
+			if err := json.Unmarshal([]byte(*l), &layout); err != nil {
+				return fmt.Errorf("failed to parse layout of stage %d: %w", i, err)
+			}
+		}
+		s := map[string]interface{}{
+			"pipeline":      stage.Pipeline,
+			"label":         layout.Label,
+			"visualization": layout.Type,
+		}
+		if stage.Alias != nil {
+			s["alias"] = stage.Alias
+		}
+		if stage.Input != nil {
+			s["input"] = stage.Input
+		} else if i == 0 {
+			s["input"] = data.Get("stage.0.input")
+		}
+		stages[i] = s
+	}
+	return data.Set("stage", stages)
+}
+
+// resourceWorksheetCustomizeDiff marks the JSON representation as changing
+// whenever it is generated from stage blocks.
+func resourceWorksheetCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if len(d.Get("stage").([]interface{})) == 0 {
+		return nil
+	}
+	if d.HasChange("stage") || d.HasChange("inputs") {
+		return d.SetNewComputed("queries")
+	}
+	return nil
+}
+
 func resourceWorksheetCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	client := meta.(*observe.Client)
-	config, diags := newWorksheetConfig(data)
+	config, diags := newWorksheetConfig(data, nil)
 	if diags.HasError() {
 		return diags
 	}
@@ -165,7 +377,18 @@ func resourceWorksheetRead(ctx context.Context, data *schema.ResourceData, meta
 
 func resourceWorksheetUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	client := meta.(*observe.Client)
-	config, diags := newWorksheetConfig(data)
+
+	var current []gql.StageQuery
+	if _, ok := data.GetOk("stage"); ok {
+		// stage layouts hold UI state we must preserve
+		worksheet, err := client.GetWorksheet(ctx, data.Id())
+		if err != nil {
+			return diag.Errorf("failed to retrieve worksheet [id=%s]: %s", data.Id(), err)
+		}
+		current = worksheet.Stages
+	}
+
+	config, diags := newWorksheetConfig(data, current)
 	if diags.HasError() {
 		return diags
 	}
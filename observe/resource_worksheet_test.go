@@ -2,12 +2,135 @@ package observe
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
 )
 
+func TestMergeWorksheetStageLayout(t *testing.T) {
+	testcases := []struct {
+		Name     string
+		Existing *types.JsonObject
+		Layout   worksheetStageLayout
+		Expected string
+		Error    bool
+	}{
+		{
+			Name:     "new stage",
+			Layout:   worksheetStageLayout{Type: "table", Label: "errors"},
+			Expected: `{"label":"errors","type":"table"}`,
+		},
+		{
+			Name:     "preserve ui state",
+			Existing: types.JsonObject(`{"type":"timeseries","label":"old","viewModel":{"sidePanel":{"open":true}},"index":2}`).Ptr(),
+			Layout:   worksheetStageLayout{Type: "table", Label: "errors"},
+			Expected: `{"index":2,"label":"errors","type":"table","viewModel":{"sidePanel":{"open":true}}}`,
+		},
+		{
+			Name:     "remove unset settings",
+			Existing: types.JsonObject(`{"type":"timeseries","label":"old","index":2}`).Ptr(),
+			Expected: `{"index":2}`,
+		},
+		{
+			Name:     "null layout",
+			Existing: types.JsonObject(`null`).Ptr(),
+			Layout:   worksheetStageLayout{Label: "errors"},
+			Expected: `{"label":"errors"}`,
+		},
+		{
+			Name:     "invalid layout",
+			Existing: types.JsonObject(`[1, 2]`).Ptr(),
+			Error:    true,
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.Name, func(t *testing.T) {
+			got, err := mergeWorksheetStageLayout(tt.Existing, tt.Layout)
+			if tt.Error {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(*got) != tt.Expected {
+				t.Fatalf("expected %s, got %s", tt.Expected, *got)
+			}
+		})
+	}
+}
+
+func TestCurrentWorksheetStageLayouts(t *testing.T) {
+	current := func(ids ...string) (stages []gql.StageQuery) {
+		for _, id := range ids {
+			stages = append(stages, gql.StageQuery{Id: stringPtr(id), Layout: types.JsonObject(`{"id":"` + id + `"}`).Ptr()})
+		}
+		return stages
+	}
+	stages := func(ids ...string) (stages []gql.StageQueryInput) {
+		for _, id := range ids {
+			stages = append(stages, gql.StageQueryInput{Id: stringPtr(id)})
+		}
+		return stages
+	}
+
+	testcases := []struct {
+		Name     string
+		Current  []gql.StageQuery
+		Stages   []gql.StageQueryInput
+		Expected []string // ID of matched current stage, empty if none
+	}{
+		{
+			Name:     "new worksheet",
+			Stages:   stages("stage-0", "stage-1"),
+			Expected: []string{"", ""},
+		},
+		{
+			Name:     "match by id",
+			Current:  current("stage-1", "stage-0"),
+			Stages:   stages("stage-0", "stage-1"),
+			Expected: []string{"stage-0", "stage-1"},
+		},
+		{
+			Name:     "match ui generated ids in order",
+			Current:  current("stage-jag28lhh", "stage-o2ml8196"),
+			Stages:   stages("stage-0", "stage-1", "stage-2"),
+			Expected: []string{"stage-jag28lhh", "stage-o2ml8196", ""},
+		},
+		{
+			Name:     "id matches take precedence",
+			Current:  current("stage-jag28lhh", "stage-0"),
+			Stages:   stages("stage-0", "stage-1"),
+			Expected: []string{"stage-0", "stage-jag28lhh"},
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.Name, func(t *testing.T) {
+			var got []string
+			for _, layout := range currentWorksheetStageLayouts(tt.Current, tt.Stages) {
+				var id string
+				if layout != nil {
+					id = string(*layout)[len(`{"id":"`) : len(*layout)-len(`"}`)]
+				}
+				got = append(got, id)
+			}
+			if diff := cmp.Diff(tt.Expected, got); diff != "" {
+				t.Fatalf("unexpected matches (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 // Verify we can create worksheet
 func TestAccObserveWorksheetCreate(t *testing.T) {
 	randomPrefix := acctest.RandomWithPrefix("tf")
@@ -610,3 +733,110 @@ func TestAccObserveWorksheetCreate(t *testing.T) {
 		},
 	})
 }
+
+// Verify worksheets can move between JSON queries and stage blocks in place
+func TestAccObserveWorksheetStages(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+				resource "observe_worksheet" "first" {
+					workspace = data.observe_workspace.default.oid
+					name      = "%[1]s"
+					inputs    = { "test" = observe_datastream.test.dataset }
+
+					stage {
+						alias    = "filtered"
+						pipeline = "filter true"
+						label    = "Filtered"
+					}
+
+					stage {
+						pipeline      = "statsby count:count(), group_by()"
+						visualization = "singleValue"
+					}
+				}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_worksheet.first", "stage.#", "2"),
+					resource.TestCheckResourceAttr("observe_worksheet.first", "stage.0.label", "Filtered"),
+					resource.TestCheckResourceAttr("observe_worksheet.first", "stage.0.visualization", "table"),
+					resource.TestCheckResourceAttr("observe_worksheet.first", "stage.1.visualization", "singleValue"),
+					resource.TestCheckResourceAttrSet("observe_worksheet.first", "queries"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+				resource "observe_worksheet" "first" {
+					workspace = data.observe_workspace.default.oid
+					name      = "%[1]s"
+					queries = jsonencode([{
+						id       = "stage-0"
+						pipeline = "filter true"
+						input = [{
+							inputName = "test"
+							inputRole = "Data"
+							datasetId = regex("^o:::dataset:(\\d+)$", observe_datastream.test.dataset)[0]
+						}]
+					}])
+				}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_worksheet.first", "stage.#", "0"),
+					resource.TestCheckResourceAttrSet("observe_worksheet.first", "queries"),
+				),
+			},
+			{
+				// stages authored in the UI carry generated IDs and UI state
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+				resource "observe_worksheet" "first" {
+					workspace = data.observe_workspace.default.oid
+					name      = "%[1]s"
+					queries = jsonencode([{
+						id       = "stage-jag28lhh"
+						pipeline = "filter true"
+						input = [{
+							inputName = "test"
+							inputRole = "Data"
+							datasetId = regex("^o:::dataset:(\\d+)$", observe_datastream.test.dataset)[0]
+						}]
+						layout = {
+							type      = "timeseries"
+							label     = "From UI"
+							viewModel = { sidePanel = { open = true } }
+						}
+					}])
+				}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("observe_worksheet.first", "queries", regexp.MustCompile(`"sidePanel"`)),
+				),
+			},
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+				resource "observe_worksheet" "first" {
+					workspace = data.observe_workspace.default.oid
+					name      = "%[1]s"
+					inputs    = { "test" = observe_datastream.test.dataset }
+
+					stage {
+						pipeline = "filter true"
+						label    = "From Terraform"
+					}
+				}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_worksheet.first", "stage.#", "1"),
+					resource.TestCheckResourceAttr("observe_worksheet.first", "stage.0.label", "From Terraform"),
+					resource.TestCheckResourceAttr("observe_worksheet.first", "stage.0.visualization", "table"),
+					// UI state of the replaced stage is preserved
+					resource.TestMatchResourceAttr("observe_worksheet.first", "queries", regexp.MustCompile(`"sidePanel"`)),
+				),
+			},
+		},
+	})
+}